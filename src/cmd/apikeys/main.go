@@ -0,0 +1,156 @@
+// Command apikeys manages API keys: it issues the first admin key, which then manages the rest over HTTP.
+//
+//	apikeys create -name ci -scopes pr:write,stats:read
+//	apikeys list
+//	apikeys revoke -id 3
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"PrService/src/cmd/config"
+	"PrService/src/internal/application/services"
+	"PrService/src/internal/domain"
+	"PrService/src/internal/infrastructure/data"
+	"PrService/src/internal/infrastructure/data/repositories"
+)
+
+const usage = `usage:
+  apikeys create -name <name> -scopes <scope>[,<scope>...]
+  apikeys list
+  apikeys revoke -id <id>
+
+scopes: pr:read, pr:write, team:read, team:admin, stats:read, admin`
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Println(usage)
+		os.Exit(2)
+	}
+
+	cfg, err := config.Load()
+	if err != nil {
+		fmt.Printf("failed to load config: %v\n", err)
+		os.Exit(1)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	dsn := fmt.Sprintf(
+		"postgres://%s:%s@%s:%d/%s?sslmode=%s",
+		cfg.DB.User,
+		cfg.DB.Password,
+		cfg.DB.Host,
+		cfg.DB.Port,
+		cfg.DB.Name,
+		cfg.DB.SSLMode,
+	)
+
+	pool, err := data.NewPgxPool(ctx, data.PostgresConfig{
+		DSN:               dsn,
+		MaxConns:          cfg.DB.MaxConns,
+		MinConns:          cfg.DB.MinConns,
+		MaxConnLifetime:   cfg.DB.MaxConnLifetime,
+		MaxConnIdleTime:   cfg.DB.MaxConnIdleTime,
+		HealthCheckPeriod: cfg.DB.HealthCheckPeriod,
+	})
+	if err != nil {
+		fmt.Println("failed to create pgx pool", "err", err)
+		os.Exit(1)
+	}
+	defer pool.Close()
+
+	service := services.NewAPIKeyService(repositories.NewAPIKeyRepository(pool))
+
+	switch os.Args[1] {
+	case "create":
+		err = create(ctx, service, os.Args[2:])
+	case "list":
+		err = list(ctx, service)
+	case "revoke":
+		err = revoke(ctx, service, os.Args[2:])
+	default:
+		fmt.Println(usage)
+		os.Exit(2)
+	}
+
+	if err != nil {
+		fmt.Println(os.Args[1], "failed", "err", err)
+		os.Exit(1)
+	}
+}
+
+func create(ctx context.Context, service *services.APIKeyService, args []string) error {
+	fs := flag.NewFlagSet("create", flag.ExitOnError)
+	name := fs.String("name", "", "name telling what the key is for")
+	scopeList := fs.String("scopes", "", "comma-separated scopes")
+	//nolint:errcheck // ExitOnError exits instead of returning
+	_ = fs.Parse(args)
+
+	scopes := make([]domain.APIKeyScope, 0)
+	for _, scope := range strings.Split(*scopeList, ",") {
+		if scope = strings.TrimSpace(scope); scope != "" {
+			scopes = append(scopes, domain.APIKeyScope(scope))
+		}
+	}
+
+	issued, err := service.Create(ctx, *name, scopes)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("created API key %d (%s)\n", issued.Key.ID, issued.Key.Name)
+	fmt.Println("store the key now, it cannot be shown again:")
+	fmt.Println(issued.Secret)
+
+	return nil
+}
+
+func list(ctx context.Context, service *services.APIKeyService) error {
+	keys, err := service.List(ctx)
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tNAME\tPREFIX\tSCOPES\tCREATED\tREVOKED")
+	for _, key := range keys {
+		scopes := make([]string, 0, len(key.Scopes))
+		for _, scope := range key.Scopes {
+			scopes = append(scopes, string(scope))
+		}
+
+		revoked := "-"
+		if key.RevokedAt != nil {
+			revoked = key.RevokedAt.UTC().Format(time.RFC3339)
+		}
+
+		fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%s\t%s\n",
+			key.ID, key.Name, key.Prefix, strings.Join(scopes, ","), key.CreatedAt.UTC().Format(time.RFC3339), revoked)
+	}
+
+	return w.Flush()
+}
+
+func revoke(ctx context.Context, service *services.APIKeyService, args []string) error {
+	fs := flag.NewFlagSet("revoke", flag.ExitOnError)
+	id := fs.Int64("id", 0, "ID of the key to revoke")
+	//nolint:errcheck // ExitOnError exits instead of returning
+	_ = fs.Parse(args)
+
+	key, err := service.Revoke(ctx, domain.APIKeyID(*id))
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("revoked API key %d (%s)\n", key.ID, key.Name)
+
+	return nil
+}
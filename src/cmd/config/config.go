@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/joho/godotenv"
@@ -23,11 +24,105 @@ type DBConfig struct {
 	HealthCheckPeriod time.Duration
 }
 
+type WebhooksConfig struct {
+	// GitHubSecret enables /webhooks/github when set.
+	GitHubSecret string
+	// GitLabToken enables /webhooks/gitlab when set.
+	GitLabToken string
+	// DeliveryInterval is how often due outbound webhook deliveries are sent.
+	DeliveryInterval time.Duration
+	// DeliveryTimeout bounds a single outbound webhook request.
+	DeliveryTimeout time.Duration
+	// DeliveryMaxAttempts is the number of attempts after which a delivery is marked FAILED.
+	DeliveryMaxAttempts int
+}
+
+type OutboxConfig struct {
+	// RelayInterval is how often pending outbox events are relayed to sinks.
+	RelayInterval time.Duration
+	// Sinks lists extra sinks besides webhook subscriptions: log, http, file.
+	Sinks []string
+	// HTTPURL is the endpoint of the http sink.
+	HTTPURL string
+	// FilePath is the NDJSON file of the file sink.
+	FilePath string
+}
+
+type ReviewSLAConfig struct {
+	// CheckInterval is how often overdue review assignments are looked up and acted on.
+	CheckInterval time.Duration
+}
+
+type StatsConfig struct {
+	// OverviewCacheTTL is how long /stats/overview serves a computed overview; 0 turns caching off.
+	OverviewCacheTTL time.Duration
+}
+
+type ScimConfig struct {
+	// BearerToken enables /scim/v2 when set; identity providers send it as a bearer token.
+	BearerToken string
+	// DefaultTeam is the team users provisioned outside of any group join.
+	DefaultTeam string
+}
+
+type JWTConfig struct {
+	// JWKSFile or JWKSURL enables bearer JWT authentication; the file wins when both are set.
+	JWKSFile string
+	JWKSURL  string
+	// JWKSRefreshInterval is how often keys fetched from JWKSURL are refreshed.
+	JWKSRefreshInterval time.Duration
+	// Issuer and Audience are checked against the iss and aud claims when set.
+	Issuer   string
+	Audience string
+	// UserIDClaim and RoleClaim name the claims the user ID and the role are read from;
+	// a dotted name reaches into nested claims.
+	UserIDClaim string
+	RoleClaim   string
+}
+
+type EventStreamConfig struct {
+	// PollInterval is how often an open /events/stream connection checks for new events.
+	PollInterval time.Duration
+	// HeartbeatInterval is how often an idle stream sends a comment to keep proxies from closing it.
+	HeartbeatInterval time.Duration
+}
+
+type NotificationsConfig struct {
+	// ChatProvider enables chat notifications: slack or mattermost. Empty disables them.
+	ChatProvider string
+	// ChatWebhookURL is the incoming webhook messages are posted to.
+	ChatWebhookURL string
+	// ChatUsername is the sender name shown in chat.
+	ChatUsername string
+	// SMTPHost enables email notifications and the daily digest. Empty disables them.
+	SMTPHost     string
+	SMTPPort     int
+	SMTPUsername string
+	SMTPPassword string
+	// SMTPFrom is the sender address of review emails.
+	SMTPFrom    string
+	SMTPTimeout time.Duration
+	// EmailTemplatesDir overrides the built-in email templates with files of the same name.
+	EmailTemplatesDir string
+	// DigestTime is the local time of day, as an offset from midnight, the daily digest is sent at.
+	// DigestEnabled is false when DIGEST_TIME is "off".
+	DigestTime    time.Duration
+	DigestEnabled bool
+}
+
 type Config struct {
 	HTTPPort      string
 	LogLevel      string
 	LogFormat     string
 	DB            DBConfig
+	Webhooks      WebhooksConfig
+	Outbox        OutboxConfig
+	EventStream   EventStreamConfig
+	ReviewSLA     ReviewSLAConfig
+	Stats         StatsConfig
+	Scim          ScimConfig
+	JWT           JWTConfig
+	Notifications NotificationsConfig
 	MigrationsDir string
 }
 
@@ -46,11 +141,90 @@ func Load() (*Config, error) {
 			Name:     getEnv("DB_NAME", "db"),
 			SSLMode:  getEnv("DB_SSLMODE", "disable"),
 		},
+		Webhooks: WebhooksConfig{
+			GitHubSecret: getEnv("GITHUB_WEBHOOK_SECRET", ""),
+			GitLabToken:  getEnv("GITLAB_WEBHOOK_TOKEN", ""),
+		},
+		Outbox: OutboxConfig{
+			Sinks:    getEnvList("OUTBOX_SINKS", "log"),
+			HTTPURL:  getEnv("OUTBOX_HTTP_URL", ""),
+			FilePath: getEnv("OUTBOX_FILE_PATH", "outbox_events.ndjson"),
+		},
+		Scim: ScimConfig{
+			BearerToken: getEnv("SCIM_BEARER_TOKEN", ""),
+			DefaultTeam: getEnv("SCIM_DEFAULT_TEAM", "unassigned"),
+		},
+		JWT: JWTConfig{
+			JWKSFile:    getEnv("JWT_JWKS_FILE", ""),
+			JWKSURL:     getEnv("JWT_JWKS_URL", ""),
+			Issuer:      getEnv("JWT_ISSUER", ""),
+			Audience:    getEnv("JWT_AUDIENCE", ""),
+			UserIDClaim: getEnv("JWT_USER_ID_CLAIM", "sub"),
+			RoleClaim:   getEnv("JWT_ROLE_CLAIM", "role"),
+		},
+		Notifications: NotificationsConfig{
+			ChatProvider:      getEnv("CHAT_NOTIFIER", ""),
+			ChatWebhookURL:    getEnv("CHAT_WEBHOOK_URL", ""),
+			ChatUsername:      getEnv("CHAT_USERNAME", "PR Reviewer"),
+			SMTPHost:          getEnv("SMTP_HOST", ""),
+			SMTPUsername:      getEnv("SMTP_USERNAME", ""),
+			SMTPPassword:      getEnv("SMTP_PASSWORD", ""),
+			SMTPFrom:          getEnv("SMTP_FROM", "pr-reviewer@localhost"),
+			EmailTemplatesDir: getEnv("EMAIL_TEMPLATES_DIR", ""),
+		},
 		MigrationsDir: getEnv("MIGRATIONS_DIR", "src/internal/infrastructure/data/migrations"),
 	}
 
 	var err error
 
+	if cfg.Webhooks.DeliveryInterval, err = getEnvDurationSeconds("WEBHOOK_DELIVERY_INTERVAL", 5); err != nil {
+		return nil, fmt.Errorf("parse WEBHOOK_DELIVERY_INTERVAL: %w", err)
+	}
+	if cfg.Webhooks.DeliveryTimeout, err = getEnvDurationSeconds("WEBHOOK_DELIVERY_TIMEOUT", 10); err != nil {
+		return nil, fmt.Errorf("parse WEBHOOK_DELIVERY_TIMEOUT: %w", err)
+	}
+	if cfg.Webhooks.DeliveryMaxAttempts, err = getEnvInt("WEBHOOK_DELIVERY_MAX_ATTEMPTS", 8); err != nil {
+		return nil, fmt.Errorf("parse WEBHOOK_DELIVERY_MAX_ATTEMPTS: %w", err)
+	}
+
+	if cfg.Outbox.RelayInterval, err = getEnvDurationSeconds("OUTBOX_RELAY_INTERVAL", 1); err != nil {
+		return nil, fmt.Errorf("parse OUTBOX_RELAY_INTERVAL: %w", err)
+	}
+
+	if cfg.ReviewSLA.CheckInterval, err = getEnvDurationSeconds("REVIEW_SLA_CHECK_INTERVAL", 60); err != nil {
+		return nil, fmt.Errorf("parse REVIEW_SLA_CHECK_INTERVAL: %w", err)
+	}
+
+	if cfg.Stats.OverviewCacheTTL, err = getEnvDurationSeconds("STATS_OVERVIEW_CACHE_TTL", 30); err != nil {
+		return nil, fmt.Errorf("parse STATS_OVERVIEW_CACHE_TTL: %w", err)
+	}
+
+	if cfg.JWT.JWKSRefreshInterval, err = getEnvDurationSeconds("JWT_JWKS_REFRESH_INTERVAL", 300); err != nil {
+		return nil, fmt.Errorf("parse JWT_JWKS_REFRESH_INTERVAL: %w", err)
+	}
+
+	if cfg.EventStream.PollInterval, err = getEnvDurationSeconds("EVENT_STREAM_POLL_INTERVAL", 1); err != nil {
+		return nil, fmt.Errorf("parse EVENT_STREAM_POLL_INTERVAL: %w", err)
+	}
+	cfg.EventStream.HeartbeatInterval, err = getEnvDurationSeconds("EVENT_STREAM_HEARTBEAT_INTERVAL", 15)
+	if err != nil {
+		return nil, fmt.Errorf("parse EVENT_STREAM_HEARTBEAT_INTERVAL: %w", err)
+	}
+
+	if cfg.Notifications.SMTPPort, err = getEnvInt("SMTP_PORT", 25); err != nil {
+		return nil, fmt.Errorf("parse SMTP_PORT: %w", err)
+	}
+	if cfg.Notifications.SMTPTimeout, err = getEnvDurationSeconds("SMTP_TIMEOUT", 10); err != nil {
+		return nil, fmt.Errorf("parse SMTP_TIMEOUT: %w", err)
+	}
+	digestTime := getEnv("DIGEST_TIME", "09:00")
+	if !strings.EqualFold(digestTime, "off") {
+		if cfg.Notifications.DigestTime, err = parseTimeOfDay(digestTime); err != nil {
+			return nil, fmt.Errorf("parse DIGEST_TIME: %w", err)
+		}
+		cfg.Notifications.DigestEnabled = true
+	}
+
 	if cfg.DB.Port, err = getEnvInt("DB_PORT", 5432); err != nil {
 		return nil, fmt.Errorf("parse DB_PORT: %w", err)
 	}
@@ -87,6 +261,16 @@ func getEnv(key, def string) string {
 	return def
 }
 
+func getEnvList(key, def string) []string {
+	values := make([]string, 0)
+	for _, v := range strings.Split(getEnv(key, def), ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			values = append(values, v)
+		}
+	}
+	return values
+}
+
 func getEnvInt(key string, def int) (int, error) {
 	valStr, ok := os.LookupEnv(key)
 	if !ok || valStr == "" {
@@ -110,3 +294,12 @@ func getEnvDurationSeconds(key string, defSeconds int) (time.Duration, error) {
 	}
 	return time.Duration(secs) * time.Second, nil
 }
+
+// parseTimeOfDay parses HH:MM into the offset from midnight.
+func parseTimeOfDay(val string) (time.Duration, error) {
+	t, err := time.Parse("15:04", val)
+	if err != nil {
+		return 0, err
+	}
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute, nil
+}
@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"os"
 	"strings"
+	"sync"
 	"time"
 
 	"PrService/src/internal/infrastructure/data/repositories"
@@ -19,7 +20,11 @@ import (
 	"PrService/src/internal/http_api/middlewares"
 
 	"PrService/src/cmd/config"
+	"PrService/src/internal/infrastructure/auth"
 	"PrService/src/internal/infrastructure/data"
+	"PrService/src/internal/infrastructure/notifications"
+	"PrService/src/internal/infrastructure/outbox"
+	"PrService/src/internal/infrastructure/webhooks"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/chi/v5/middleware"
@@ -31,10 +36,14 @@ import (
 )
 
 type App struct {
-	cfg    *config.Config
-	logger *slog.Logger
-	pool   *pgxpool.Pool
-	server *http.Server
+	cfg               *config.Config
+	logger            *slog.Logger
+	pool              *pgxpool.Pool
+	server            *http.Server
+	webhookDispatcher *services.WebhookSubscriptionService
+	outboxRelay       *services.OutboxRelay
+	reviewSLAs        *services.ReviewSLAService
+	reviewDigests     *services.ReviewDigestService
 }
 
 func NewApp(cfg *config.Config) (*App, error) {
@@ -48,30 +57,77 @@ func NewApp(cfg *config.Config) (*App, error) {
 		return nil, fmt.Errorf("init pg pool: %w", err)
 	}
 
-	prRepo, teamRepo, userRepo := initRepositories(pool)
+	repos := initRepositories(pool)
 	txManager := data.NewTxManager(pool)
-	prService, teamService, userService := initServices(prRepo, teamRepo, userRepo, txManager)
+	svcs := initServices(repos, txManager, cfg.Webhooks, cfg.Stats, cfg.Scim)
+
+	emailNotifier, err := initEmailNotifier(cfg.Notifications)
+	if err != nil {
+		pool.Close()
+		return nil, fmt.Errorf("init email notifier: %w", err)
+	}
+
+	sinks, err := initOutboxSinks(cfg, repos, svcs.webhookSubscriptions, emailNotifier, logger)
+	if err != nil {
+		pool.Close()
+		return nil, fmt.Errorf("init outbox sinks: %w", err)
+	}
+	relay := services.NewOutboxRelay(repos.outbox, sinks, services.DefaultOutboxRelayPolicy())
+
+	var reviewDigests *services.ReviewDigestService
+	if emailNotifier != nil && cfg.Notifications.DigestEnabled {
+		reviewDigests = services.NewReviewDigestService(repos.users, repos.pullRequests, emailNotifier)
+	} else {
+		logger.Info("SMTP_HOST or DIGEST_TIME is not set, the daily review digest is disabled")
+	}
+
+	authenticators, err := initAuthenticators(cfg.JWT, svcs.apiKeys, logger)
+	if err != nil {
+		pool.Close()
+		return nil, fmt.Errorf("init authentication: %w", err)
+	}
+
 	validate := validator.New()
-	prController, teamController, userController, healthController := initControllers(
-		prService,
-		teamService,
-		userService,
-		validate,
-		logger,
-	)
-	server := initServer(prController, teamController, userController, healthController, logger, cfg.HTTPPort)
+	server := initServer(initControllers(svcs, cfg, validate, logger), authenticators, logger, cfg.HTTPPort)
 
 	return &App{
-		cfg:    cfg,
-		logger: logger,
-		pool:   pool,
-		server: server,
+		cfg:               cfg,
+		logger:            logger,
+		pool:              pool,
+		server:            server,
+		webhookDispatcher: svcs.webhookSubscriptions,
+		outboxRelay:       relay,
+		reviewSLAs:        svcs.reviewSLAs,
+		reviewDigests:     reviewDigests,
 	}, nil
 }
 
 func (a *App) Run(ctx context.Context) error {
 	a.logger.Info("starting server", "addr", "http://localhost:"+a.cfg.HTTPPort)
 
+	workersCtx, stopWorkers := context.WithCancel(ctx)
+	var workers sync.WaitGroup
+	workers.Go(func() {
+		a.runPeriodically(workersCtx, a.cfg.Outbox.RelayInterval, a.relayOutbox)
+	})
+	workers.Go(func() {
+		a.runPeriodically(workersCtx, a.cfg.Webhooks.DeliveryInterval, a.deliverWebhooks)
+	})
+	workers.Go(func() {
+		a.runPeriodically(workersCtx, a.cfg.ReviewSLA.CheckInterval, a.enforceReviewSLAs)
+	})
+	if a.reviewDigests != nil {
+		workers.Go(func() {
+			a.runDaily(workersCtx, a.cfg.Notifications.DigestTime, a.sendReviewDigests)
+		})
+	}
+	defer func() {
+		stopWorkers()
+		workers.Wait()
+		a.pool.Close()
+		a.logger.Info("pgx pool closed")
+	}()
+
 	errCh := make(chan error, 1)
 	go func() {
 		if err := a.server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
@@ -96,28 +152,118 @@ func (a *App) Run(ctx context.Context) error {
 	}
 	a.logger.Info("server gracefully stopped")
 
-	a.pool.Close()
-	a.logger.Info("pgx pool closed")
-
 	return nil
 }
 
+// runPeriodically calls job every interval until ctx is cancelled.
+func (a *App) runPeriodically(ctx context.Context, interval time.Duration, job func(ctx context.Context)) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			job(ctx)
+		}
+	}
+}
+
+// runDaily calls job once a day at timeOfDay local time until ctx is cancelled.
+func (a *App) runDaily(ctx context.Context, timeOfDay time.Duration, job func(ctx context.Context)) {
+	for {
+		timer := time.NewTimer(time.Until(services.NextDailyRun(time.Now(), timeOfDay)))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+			job(ctx)
+		}
+	}
+}
+
+// relayOutbox drains pending outbox events. A batch holds at most one event per aggregate,
+// so it keeps relaying while the previous batch published or gave up on events.
+func (a *App) relayOutbox(ctx context.Context) {
+	for ctx.Err() == nil {
+		result, err := a.outboxRelay.RelayPending(ctx)
+		if err != nil {
+			if ctx.Err() == nil {
+				a.logger.Error("failed to relay outbox events", "err", err)
+			}
+			return
+		}
+		if result.Retried > 0 {
+			a.logger.Warn("outbox events failed to relay, will retry", "count", result.Retried)
+		}
+		if result.Failed > 0 {
+			a.logger.Error("outbox events failed to relay, giving up", "count", result.Failed)
+		}
+		if result.Published == 0 && result.Failed == 0 {
+			return
+		}
+		a.logger.Debug("outbox events relayed", "count", result.Published)
+	}
+}
+
+// deliverWebhooks sends due outbound webhook deliveries.
+func (a *App) deliverWebhooks(ctx context.Context) {
+	sent, err := a.webhookDispatcher.DeliverDue(ctx)
+	if err != nil && ctx.Err() == nil {
+		a.logger.Error("failed to deliver webhooks", "err", err)
+	}
+	if sent > 0 {
+		a.logger.Debug("webhook deliveries attempted", "count", sent)
+	}
+}
+
+// enforceReviewSLAs reminds, reassigns or escalates overdue review assignments.
+func (a *App) enforceReviewSLAs(ctx context.Context) {
+	result, err := a.reviewSLAs.EnforceOverdue(ctx)
+	if err != nil && ctx.Err() == nil {
+		a.logger.Error("failed to enforce some review SLAs", "err", err)
+	}
+	if result != (services.ReviewSLAResult{}) {
+		a.logger.Info("review SLAs enforced",
+			"notified", result.Notified,
+			"reassigned", result.Reassigned,
+			"escalated", result.Escalated,
+		)
+	}
+}
+
+// sendReviewDigests emails every reviewer the OPEN reviews waiting on them.
+func (a *App) sendReviewDigests(ctx context.Context) {
+	sent, err := a.reviewDigests.SendDigests(ctx)
+	if err != nil && ctx.Err() == nil {
+		a.logger.Error("failed to send some review digests", "err", err)
+	}
+	a.logger.Info("review digests sent", "count", sent)
+}
+
+// controller registers its handlers on the router.
+type controller interface {
+	UseHandlers(r chi.Router)
+}
+
+// initServer puts the actor of a request into its context before it is logged; controllers require
+// scopes per route.
 func initServer(
-	prController *controllers.PullRequestController,
-	teamController *controllers.TeamController,
-	userController *controllers.UserController,
-	healthController *controllers.HealthController,
+	appControllers []controller,
+	authenticators []func(http.Handler) http.Handler,
 	logger *slog.Logger,
 	port string,
 ) *http.Server {
 	r := chi.NewRouter()
 	r.Use(middleware.RequestID)
+	r.Use(authenticators...)
 	requestLogMiddleware := middlewares.NewRequestLogger(logger)
 	r.Use(requestLogMiddleware.LogRequest)
-	prController.UseHandlers(r)
-	teamController.UseHandlers(r)
-	userController.UseHandlers(r)
-	healthController.UseHandlers(r)
+	for _, c := range appControllers {
+		c.UseHandlers(r)
+	}
 
 	r.Get("/swagger/*", httpSwagger.WrapHandler)
 
@@ -129,48 +275,308 @@ func initServer(
 	return server
 }
 
+// initAuthenticators returns the middlewares that authenticate requests: API keys always,
+// bearer JWTs when JWT_JWKS_FILE or JWT_JWKS_URL is set.
+func initAuthenticators(
+	cfg config.JWTConfig,
+	apiKeys domain.APIKeyService,
+	logger *slog.Logger,
+) ([]func(http.Handler) http.Handler, error) {
+	authenticators := []func(http.Handler) http.Handler{
+		middlewares.NewAPIKeyAuth(apiKeys, logger).Authenticate,
+	}
+
+	var keys auth.KeySet
+	switch {
+	case cfg.JWKSFile != "":
+		keySet, err := auth.LoadKeySetFile(cfg.JWKSFile)
+		if err != nil {
+			return nil, err
+		}
+		keys = keySet
+	case cfg.JWKSURL != "":
+		keys = auth.NewRemoteKeySet(cfg.JWKSURL, &http.Client{Timeout: 10 * time.Second}, cfg.JWKSRefreshInterval)
+	default:
+		logger.Info("JWT_JWKS_FILE and JWT_JWKS_URL are not set, JWT authentication is disabled")
+		return authenticators, nil
+	}
+
+	jwtAuth := middlewares.NewJWTAuth(
+		auth.NewJWTVerifier(keys, cfg.Issuer, cfg.Audience),
+		middlewares.JWTClaims{UserID: cfg.UserIDClaim, Role: cfg.RoleClaim},
+		logger,
+	)
+
+	return append(authenticators, jwtAuth.Authenticate), nil
+}
+
 func initControllers(
-	prService domain.PullRequestService,
-	teamService domain.TeamService,
-	userService domain.UserService,
+	svcs appServices,
+	cfg *config.Config,
 	validate *validator.Validate,
 	logger *slog.Logger,
-) (
-	*controllers.PullRequestController,
-	*controllers.TeamController,
-	*controllers.UserController,
-	*controllers.HealthController,
-) {
-	prController := controllers.NewPullRequestController(prService, validate, logger)
-	teamController := controllers.NewTeamController(teamService, validate, logger)
-	userController := controllers.NewUserController(userService, validate, logger)
-	healthController := controllers.NewHealthController(validate, logger)
-	return prController, teamController, userController, healthController
+) []controller {
+	webhooks := cfg.Webhooks
+	appControllers := []controller{
+		controllers.NewPullRequestController(svcs.pullRequests, validate, logger),
+		controllers.NewTeamController(svcs.teams, validate, logger),
+		controllers.NewTeamImportController(svcs.teams, validate, logger),
+		controllers.NewTeamSyncController(svcs.teamSync, validate, logger),
+		controllers.NewTeamRuleController(svcs.teamRules, validate, logger),
+		controllers.NewCodeOwnersController(svcs.codeOwners, validate, logger),
+		controllers.NewUserController(svcs.users, validate, logger),
+		controllers.NewReviewSLAController(svcs.reviewSLAs, validate, logger),
+		controllers.NewStatsController(svcs.stats, validate, logger),
+		controllers.NewExportController(svcs.export, validate, logger),
+		controllers.NewWebhookSubscriptionController(svcs.webhookSubscriptions, validate, logger),
+		controllers.NewAPIKeyController(svcs.apiKeys, validate, logger),
+		controllers.NewEventStreamController(
+			svcs.eventStream,
+			cfg.EventStream.PollInterval,
+			cfg.EventStream.HeartbeatInterval,
+			validate,
+			logger,
+		),
+		controllers.NewHealthController(validate, logger),
+	}
+
+	if webhooks.GitHubSecret != "" {
+		appControllers = append(appControllers,
+			controllers.NewGitHubWebhookController(svcs.pullRequestEvents, webhooks.GitHubSecret, validate, logger),
+		)
+	} else {
+		logger.Info("GITHUB_WEBHOOK_SECRET is not set, /webhooks/github is disabled")
+	}
+
+	if webhooks.GitLabToken != "" {
+		appControllers = append(appControllers,
+			controllers.NewGitLabWebhookController(svcs.pullRequestEvents, webhooks.GitLabToken, validate, logger),
+		)
+	} else {
+		logger.Info("GITLAB_WEBHOOK_TOKEN is not set, /webhooks/gitlab is disabled")
+	}
+
+	if cfg.Scim.BearerToken != "" {
+		appControllers = append(appControllers,
+			controllers.NewScimController(svcs.provisioning, cfg.Scim.BearerToken, validate, logger),
+		)
+	} else {
+		logger.Info("SCIM_BEARER_TOKEN is not set, /scim/v2 is disabled")
+	}
+
+	return appControllers
+}
+
+type appServices struct {
+	pullRequests         domain.PullRequestService
+	pullRequestEvents    domain.PullRequestEventService
+	teams                domain.TeamService
+	teamSync             domain.TeamSyncService
+	teamRules            domain.TeamRuleService
+	codeOwners           domain.CodeOwnersService
+	users                domain.UserService
+	reviewSLAs           *services.ReviewSLAService
+	stats                domain.StatsService
+	export               domain.ExportService
+	webhookSubscriptions *services.WebhookSubscriptionService
+	eventStream          domain.EventStreamService
+	provisioning         domain.ProvisioningService
+	apiKeys              domain.APIKeyService
 }
 
 func initServices(
-	prRepo domain.PullRequestRepository,
-	teamRepo domain.TeamRepository,
-	userRepo domain.UserRepository,
+	repos appRepositories,
 	txManager contracts.TxManager,
-) (domain.PullRequestService, domain.TeamService, domain.UserService) {
-	prService := services.NewPullRequestService(prRepo, teamRepo, txManager)
-	teamService := services.NewTeamService(teamRepo, userRepo, txManager)
-	userService := services.NewUserService(userRepo, prRepo)
+	webhooksCfg config.WebhooksConfig,
+	statsCfg config.StatsConfig,
+	scimCfg config.ScimConfig,
+) appServices {
+	deliveryPolicy := services.DefaultWebhookDeliveryPolicy()
+	deliveryPolicy.MaxAttempts = webhooksCfg.DeliveryMaxAttempts
+	webhookSubscriptions := services.NewWebhookSubscriptionService(
+		repos.webhookSubscriptions,
+		webhooks.NewHTTPSender(webhooksCfg.DeliveryTimeout),
+		deliveryPolicy,
+	)
+
+	eventPublisher := services.NewOutboxPublisher(repos.outbox)
+	pullRequests := services.NewPullRequestService(repos.pullRequests, repos.teams, txManager, eventPublisher)
+	teams := services.NewTeamService(repos.teams, repos.users, txManager, eventPublisher)
+	users := services.NewUserService(
+		repos.users,
+		repos.pullRequests,
+		repos.externalAccounts,
+		repos.pullRequestHistory,
+		pullRequests,
+		txManager,
+		eventPublisher,
+	)
+
+	teamSync := services.NewTeamSyncService(teams, users, txManager)
+	reviewSLAs := services.NewReviewSLAService(
+		repos.reviewSLAs,
+		repos.teams,
+		repos.pullRequests,
+		repos.pullRequestHistory,
+		pullRequests,
+		txManager,
+		eventPublisher,
+	)
 
-	return prService, teamService, userService
+	return appServices{
+		pullRequests: pullRequests,
+		pullRequestEvents: services.NewPullRequestEventService(
+			pullRequests,
+			reviewSLAs,
+			repos.externalAccounts,
+			repos.webhookDeliveries,
+			txManager,
+		),
+		teams:                teams,
+		teamSync:             teamSync,
+		teamRules:            services.NewTeamRuleService(repos.teams, repos.teamRules, txManager),
+		codeOwners:           services.NewCodeOwnersService(repos.teams, repos.codeOwners, txManager),
+		users:                users,
+		reviewSLAs:           reviewSLAs,
+		stats:                services.NewStatsService(repos.stats, repos.teams, statsCfg.OverviewCacheTTL),
+		export:               services.NewExportService(repos.export, repos.teams),
+		webhookSubscriptions: webhookSubscriptions,
+		eventStream:          services.NewEventStreamService(repos.outbox, repos.teams),
+		provisioning: services.NewProvisioningService(
+			repos.users,
+			repos.teams,
+			teams,
+			users,
+			teamSync,
+			txManager,
+			domain.TeamName(scimCfg.DefaultTeam),
+		),
+		apiKeys: services.NewAPIKeyService(repos.apiKeys),
+	}
 }
 
-func initRepositories(pool *pgxpool.Pool) (
-	domain.PullRequestRepository,
-	domain.TeamRepository,
-	domain.UserRepository,
-) {
-	pr := repositories.NewPullRequestRepository(pool)
-	team := repositories.NewTeamRepository(pool)
-	user := repositories.NewUserRepository(pool)
+// initOutboxSinks returns the sinks outbox events are relayed to.
+// Webhook subscriptions always receive events, chat notifications when CHAT_NOTIFIER is set,
+// email notifications when SMTP_HOST is set; the rest are picked by OUTBOX_SINKS.
+func initOutboxSinks(
+	cfg *config.Config,
+	repos appRepositories,
+	webhookSubscriptions *services.WebhookSubscriptionService,
+	emailNotifier *notifications.SMTPNotifier,
+	logger *slog.Logger,
+) ([]contracts.EventSink, error) {
+	sender := webhooks.NewHTTPSender(cfg.Webhooks.DeliveryTimeout)
+	sinks := []contracts.EventSink{webhookSubscriptions}
 
-	return pr, team, user
+	chatNotifier, err := initChatNotifier(cfg.Notifications, sender)
+	if err != nil {
+		return nil, err
+	}
+	if chatNotifier != nil {
+		sinks = append(sinks, services.NewReviewNotificationSink(repos.users, chatNotifier, "chat"))
+	} else {
+		logger.Info("CHAT_NOTIFIER is not set, chat notifications are disabled")
+	}
+
+	if emailNotifier != nil {
+		sinks = append(sinks, services.NewReviewNotificationSink(repos.users, emailNotifier, "email"))
+	} else {
+		logger.Info("SMTP_HOST is not set, email notifications are disabled")
+	}
+
+	for _, name := range cfg.Outbox.Sinks {
+		switch strings.ToLower(name) {
+		case "log":
+			sinks = append(sinks, outbox.NewLogSink(logger))
+		case "http":
+			if cfg.Outbox.HTTPURL == "" {
+				return nil, errors.New("OUTBOX_HTTP_URL is required for the http sink")
+			}
+			sinks = append(sinks, outbox.NewHTTPSink(cfg.Outbox.HTTPURL, sender))
+		case "file":
+			sinks = append(sinks, outbox.NewFileSink(cfg.Outbox.FilePath))
+		default:
+			return nil, fmt.Errorf("unknown outbox sink: %s", name)
+		}
+	}
+
+	return sinks, nil
+}
+
+func initChatNotifier(cfg config.NotificationsConfig, sender contracts.WebhookSender) (contracts.Notifier, error) {
+	provider := strings.ToLower(cfg.ChatProvider)
+	if provider == "" {
+		return nil, nil
+	}
+	if cfg.ChatWebhookURL == "" {
+		return nil, errors.New("CHAT_WEBHOOK_URL is required for chat notifications")
+	}
+
+	switch provider {
+	case "slack":
+		return notifications.NewSlackNotifier(cfg.ChatWebhookURL, cfg.ChatUsername, sender), nil
+	case "mattermost":
+		return notifications.NewMattermostNotifier(cfg.ChatWebhookURL, cfg.ChatUsername, sender), nil
+	default:
+		return nil, fmt.Errorf("unknown chat notifier: %s", cfg.ChatProvider)
+	}
+}
+
+// initEmailNotifier returns nil when SMTP_HOST is not set.
+func initEmailNotifier(cfg config.NotificationsConfig) (*notifications.SMTPNotifier, error) {
+	if cfg.SMTPHost == "" {
+		return nil, nil
+	}
+
+	templates, err := notifications.LoadEmailTemplates(cfg.EmailTemplatesDir)
+	if err != nil {
+		return nil, err
+	}
+
+	return notifications.NewSMTPNotifier(notifications.SMTPConfig{
+		Host:     cfg.SMTPHost,
+		Port:     cfg.SMTPPort,
+		Username: cfg.SMTPUsername,
+		Password: cfg.SMTPPassword,
+		From:     cfg.SMTPFrom,
+		Timeout:  cfg.SMTPTimeout,
+	}, templates), nil
+}
+
+type appRepositories struct {
+	pullRequests         domain.PullRequestRepository
+	teams                domain.TeamRepository
+	teamRules            domain.TeamRuleRepository
+	codeOwners           domain.CodeOwnersRepository
+	users                domain.UserRepository
+	externalAccounts     domain.ExternalAccountRepository
+	webhookDeliveries    domain.WebhookDeliveryRepository
+	webhookSubscriptions domain.WebhookSubscriptionRepository
+	outbox               domain.OutboxRepository
+	reviewSLAs           domain.ReviewSLARepository
+	pullRequestHistory   domain.PullRequestHistoryRepository
+	stats                domain.StatsRepository
+	export               domain.ExportRepository
+	apiKeys              domain.APIKeyRepository
+}
+
+func initRepositories(pool *pgxpool.Pool) appRepositories {
+	return appRepositories{
+		pullRequests:         repositories.NewPullRequestRepository(pool),
+		teams:                repositories.NewTeamRepository(pool),
+		teamRules:            repositories.NewTeamRuleRepository(pool),
+		codeOwners:           repositories.NewCodeOwnersRepository(pool),
+		users:                repositories.NewUserRepository(pool),
+		externalAccounts:     repositories.NewExternalAccountRepository(pool),
+		webhookDeliveries:    repositories.NewWebhookDeliveryRepository(pool),
+		webhookSubscriptions: repositories.NewWebhookSubscriptionRepository(pool),
+		apiKeys:              repositories.NewAPIKeyRepository(pool),
+		outbox:               repositories.NewOutboxRepository(pool),
+		reviewSLAs:           repositories.NewReviewSLARepository(pool),
+		pullRequestHistory:   repositories.NewPullRequestHistoryRepository(pool),
+		stats:                repositories.NewStatsRepository(pool),
+		export:               repositories.NewExportRepository(pool),
+	}
 }
 
 func initPgPool(cfg config.DBConfig) (*pgxpool.Pool, error) {
@@ -2,6 +2,16 @@
 //
 // @title		PR Reviewer Assignment Service (Test Task, Fall 2025)
 // @version	1.0.0
+//
+// @securityDefinitions.apikey	ApiKeyAuth
+// @in							header
+// @name						X-API-Key
+// @description				API key with the scope the endpoint requires; "Authorization: Bearer <key>" works as well.
+//
+// @securityDefinitions.apikey	BearerAuth
+// @in							header
+// @name						Authorization
+// @description				"Bearer <JWT>" of the identity provider when JWT_JWKS_FILE or JWT_JWKS_URL is set.
 package main
 
 import (
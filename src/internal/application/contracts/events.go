@@ -0,0 +1,27 @@
+package contracts
+
+import (
+	"context"
+
+	"PrService/src/internal/domain"
+)
+
+// EventPublisher publishes domain events. Services call it inside the transaction of the
+// state change, so a failed publication rolls the change back.
+type EventPublisher interface {
+	Publish(ctx context.Context, events ...domain.Event) error
+}
+
+// EventSink receives events relayed from the outbox. Delivery is at-least-once: a message is offered
+// to a sink again until it accepts it or the relay gives up, so sinks should tolerate seeing a message
+// ID twice. Name identifies the sink in the stored delivery state and must be unique and stable.
+type EventSink interface {
+	Name() string
+	Publish(ctx context.Context, message domain.OutboxMessage) error
+}
+
+// WebhookSender POSTs a JSON body with the given headers and returns the response status code.
+// A non-2xx response is reported as an error along with its status code.
+type WebhookSender interface {
+	Send(ctx context.Context, url string, body []byte, headers map[string]string) (int, error)
+}
@@ -0,0 +1,39 @@
+package contracts
+
+import (
+	"context"
+	"time"
+
+	"PrService/src/internal/domain"
+)
+
+// Notification is a short personal message about a review, e.g. a new assignment.
+type Notification struct {
+	Recipient domain.User
+	Subject   string
+	Text      string
+}
+
+// Notifier delivers notifications over one channel such as chat or email.
+// A recipient without an address on that channel is skipped without an error.
+type Notifier interface {
+	Notify(ctx context.Context, notification Notification) error
+}
+
+// Digest lists the OPEN reviews waiting on one reviewer, oldest first.
+type Digest struct {
+	Recipient   domain.User
+	Reviews     []DigestReview
+	GeneratedAt time.Time
+}
+
+type DigestReview struct {
+	PullRequest domain.PullRequest
+	// Age is how long the pull request has been open at GeneratedAt.
+	Age time.Duration
+}
+
+// DigestSender delivers review digests. Like Notifier, it skips recipients it has no address for.
+type DigestSender interface {
+	SendDigest(ctx context.Context, digest Digest) error
+}
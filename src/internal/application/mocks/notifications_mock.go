@@ -0,0 +1,94 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: src/internal/application/contracts/notifications.go
+//
+// Generated by this command:
+//
+//	mockgen -source=src/internal/application/contracts/notifications.go -package=mocks -destination=src/internal/application/mocks/notifications_mock.go
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	contracts "PrService/src/internal/application/contracts"
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockNotifier is a mock of Notifier interface.
+type MockNotifier struct {
+	ctrl     *gomock.Controller
+	recorder *MockNotifierMockRecorder
+	isgomock struct{}
+}
+
+// MockNotifierMockRecorder is the mock recorder for MockNotifier.
+type MockNotifierMockRecorder struct {
+	mock *MockNotifier
+}
+
+// NewMockNotifier creates a new mock instance.
+func NewMockNotifier(ctrl *gomock.Controller) *MockNotifier {
+	mock := &MockNotifier{ctrl: ctrl}
+	mock.recorder = &MockNotifierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNotifier) EXPECT() *MockNotifierMockRecorder {
+	return m.recorder
+}
+
+// Notify mocks base method.
+func (m *MockNotifier) Notify(ctx context.Context, notification contracts.Notification) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Notify", ctx, notification)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Notify indicates an expected call of Notify.
+func (mr *MockNotifierMockRecorder) Notify(ctx, notification any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Notify", reflect.TypeOf((*MockNotifier)(nil).Notify), ctx, notification)
+}
+
+// MockDigestSender is a mock of DigestSender interface.
+type MockDigestSender struct {
+	ctrl     *gomock.Controller
+	recorder *MockDigestSenderMockRecorder
+	isgomock struct{}
+}
+
+// MockDigestSenderMockRecorder is the mock recorder for MockDigestSender.
+type MockDigestSenderMockRecorder struct {
+	mock *MockDigestSender
+}
+
+// NewMockDigestSender creates a new mock instance.
+func NewMockDigestSender(ctrl *gomock.Controller) *MockDigestSender {
+	mock := &MockDigestSender{ctrl: ctrl}
+	mock.recorder = &MockDigestSenderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDigestSender) EXPECT() *MockDigestSenderMockRecorder {
+	return m.recorder
+}
+
+// SendDigest mocks base method.
+func (m *MockDigestSender) SendDigest(ctx context.Context, digest contracts.Digest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendDigest", ctx, digest)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendDigest indicates an expected call of SendDigest.
+func (mr *MockDigestSenderMockRecorder) SendDigest(ctx, digest any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendDigest", reflect.TypeOf((*MockDigestSender)(nil).SendDigest), ctx, digest)
+}
@@ -13,6 +13,7 @@ import (
 	domain "PrService/src/internal/domain"
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	gomock "go.uber.org/mock/gomock"
 )
@@ -100,6 +101,140 @@ func (mr *MockTeamRepositoryMockRecorder) GetStats(ctx, name any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStats", reflect.TypeOf((*MockTeamRepository)(nil).GetStats), ctx, name)
 }
 
+// GetStatsTimeSeries mocks base method.
+func (m *MockTeamRepository) GetStatsTimeSeries(ctx context.Context, name domain.TeamName, interval domain.StatsInterval, window domain.StatsWindow) (*domain.TeamStatsTimeSeries, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStatsTimeSeries", ctx, name, interval, window)
+	ret0, _ := ret[0].(*domain.TeamStatsTimeSeries)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStatsTimeSeries indicates an expected call of GetStatsTimeSeries.
+func (mr *MockTeamRepositoryMockRecorder) GetStatsTimeSeries(ctx, name, interval, window any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStatsTimeSeries", reflect.TypeOf((*MockTeamRepository)(nil).GetStatsTimeSeries), ctx, name, interval, window)
+}
+
+// List mocks base method.
+func (m *MockTeamRepository) List(ctx context.Context) ([]domain.Team, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx)
+	ret0, _ := ret[0].([]domain.Team)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockTeamRepositoryMockRecorder) List(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockTeamRepository)(nil).List), ctx)
+}
+
+// SetMaxReviewers mocks base method.
+func (m *MockTeamRepository) SetMaxReviewers(ctx context.Context, name domain.TeamName, maxReviewers int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetMaxReviewers", ctx, name, maxReviewers)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetMaxReviewers indicates an expected call of SetMaxReviewers.
+func (mr *MockTeamRepositoryMockRecorder) SetMaxReviewers(ctx, name, maxReviewers any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetMaxReviewers", reflect.TypeOf((*MockTeamRepository)(nil).SetMaxReviewers), ctx, name, maxReviewers)
+}
+
+// MockTeamRuleRepository is a mock of TeamRuleRepository interface.
+type MockTeamRuleRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockTeamRuleRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockTeamRuleRepositoryMockRecorder is the mock recorder for MockTeamRuleRepository.
+type MockTeamRuleRepositoryMockRecorder struct {
+	mock *MockTeamRuleRepository
+}
+
+// NewMockTeamRuleRepository creates a new mock instance.
+func NewMockTeamRuleRepository(ctrl *gomock.Controller) *MockTeamRuleRepository {
+	mock := &MockTeamRuleRepository{ctrl: ctrl}
+	mock.recorder = &MockTeamRuleRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTeamRuleRepository) EXPECT() *MockTeamRuleRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockTeamRuleRepository) Create(ctx context.Context, rule *domain.TeamRule) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, rule)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockTeamRuleRepositoryMockRecorder) Create(ctx, rule any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockTeamRuleRepository)(nil).Create), ctx, rule)
+}
+
+// Delete mocks base method.
+func (m *MockTeamRuleRepository) Delete(ctx context.Context, name domain.TeamName, id domain.TeamRuleID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, name, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockTeamRuleRepositoryMockRecorder) Delete(ctx, name, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockTeamRuleRepository)(nil).Delete), ctx, name, id)
+}
+
+// MockCodeOwnersRepository is a mock of CodeOwnersRepository interface.
+type MockCodeOwnersRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockCodeOwnersRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockCodeOwnersRepositoryMockRecorder is the mock recorder for MockCodeOwnersRepository.
+type MockCodeOwnersRepositoryMockRecorder struct {
+	mock *MockCodeOwnersRepository
+}
+
+// NewMockCodeOwnersRepository creates a new mock instance.
+func NewMockCodeOwnersRepository(ctrl *gomock.Controller) *MockCodeOwnersRepository {
+	mock := &MockCodeOwnersRepository{ctrl: ctrl}
+	mock.recorder = &MockCodeOwnersRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCodeOwnersRepository) EXPECT() *MockCodeOwnersRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Upsert mocks base method.
+func (m *MockCodeOwnersRepository) Upsert(ctx context.Context, codeOwners *domain.TeamCodeOwners) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upsert", ctx, codeOwners)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Upsert indicates an expected call of Upsert.
+func (mr *MockCodeOwnersRepositoryMockRecorder) Upsert(ctx, codeOwners any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upsert", reflect.TypeOf((*MockCodeOwnersRepository)(nil).Upsert), ctx, codeOwners)
+}
+
 // MockUserRepository is a mock of UserRepository interface.
 type MockUserRepository struct {
 	ctrl     *gomock.Controller
@@ -139,6 +274,21 @@ func (mr *MockUserRepositoryMockRecorder) GetByID(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockUserRepository)(nil).GetByID), ctx, id)
 }
 
+// List mocks base method.
+func (m *MockUserRepository) List(ctx context.Context, filter domain.UserFilter) ([]domain.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, filter)
+	ret0, _ := ret[0].([]domain.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockUserRepositoryMockRecorder) List(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockUserRepository)(nil).List), ctx, filter)
+}
+
 // Update mocks base method.
 func (m *MockUserRepository) Update(ctx context.Context, user *domain.User) error {
 	m.ctrl.T.Helper()
@@ -167,6 +317,98 @@ func (mr *MockUserRepositoryMockRecorder) UpsertBatch(ctx, users any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertBatch", reflect.TypeOf((*MockUserRepository)(nil).UpsertBatch), ctx, users)
 }
 
+// MockExternalAccountRepository is a mock of ExternalAccountRepository interface.
+type MockExternalAccountRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockExternalAccountRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockExternalAccountRepositoryMockRecorder is the mock recorder for MockExternalAccountRepository.
+type MockExternalAccountRepositoryMockRecorder struct {
+	mock *MockExternalAccountRepository
+}
+
+// NewMockExternalAccountRepository creates a new mock instance.
+func NewMockExternalAccountRepository(ctrl *gomock.Controller) *MockExternalAccountRepository {
+	mock := &MockExternalAccountRepository{ctrl: ctrl}
+	mock.recorder = &MockExternalAccountRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockExternalAccountRepository) EXPECT() *MockExternalAccountRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetUserID mocks base method.
+func (m *MockExternalAccountRepository) GetUserID(ctx context.Context, provider domain.ExternalProvider, login string) (domain.UserID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserID", ctx, provider, login)
+	ret0, _ := ret[0].(domain.UserID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserID indicates an expected call of GetUserID.
+func (mr *MockExternalAccountRepositoryMockRecorder) GetUserID(ctx, provider, login any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserID", reflect.TypeOf((*MockExternalAccountRepository)(nil).GetUserID), ctx, provider, login)
+}
+
+// Upsert mocks base method.
+func (m *MockExternalAccountRepository) Upsert(ctx context.Context, account domain.ExternalAccount) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upsert", ctx, account)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Upsert indicates an expected call of Upsert.
+func (mr *MockExternalAccountRepositoryMockRecorder) Upsert(ctx, account any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upsert", reflect.TypeOf((*MockExternalAccountRepository)(nil).Upsert), ctx, account)
+}
+
+// MockWebhookDeliveryRepository is a mock of WebhookDeliveryRepository interface.
+type MockWebhookDeliveryRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebhookDeliveryRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockWebhookDeliveryRepositoryMockRecorder is the mock recorder for MockWebhookDeliveryRepository.
+type MockWebhookDeliveryRepositoryMockRecorder struct {
+	mock *MockWebhookDeliveryRepository
+}
+
+// NewMockWebhookDeliveryRepository creates a new mock instance.
+func NewMockWebhookDeliveryRepository(ctrl *gomock.Controller) *MockWebhookDeliveryRepository {
+	mock := &MockWebhookDeliveryRepository{ctrl: ctrl}
+	mock.recorder = &MockWebhookDeliveryRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWebhookDeliveryRepository) EXPECT() *MockWebhookDeliveryRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Register mocks base method.
+func (m *MockWebhookDeliveryRepository) Register(ctx context.Context, provider domain.ExternalProvider, deliveryID string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Register", ctx, provider, deliveryID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Register indicates an expected call of Register.
+func (mr *MockWebhookDeliveryRepositoryMockRecorder) Register(ctx, provider, deliveryID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Register", reflect.TypeOf((*MockWebhookDeliveryRepository)(nil).Register), ctx, provider, deliveryID)
+}
+
 // MockPullRequestRepository is a mock of PullRequestRepository interface.
 type MockPullRequestRepository struct {
 	ctrl     *gomock.Controller
@@ -191,6 +433,21 @@ func (m *MockPullRequestRepository) EXPECT() *MockPullRequestRepositoryMockRecor
 	return m.recorder
 }
 
+// CountOpenReviews mocks base method.
+func (m *MockPullRequestRepository) CountOpenReviews(ctx context.Context, reviewerIDs []domain.UserID) (map[domain.UserID]int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountOpenReviews", ctx, reviewerIDs)
+	ret0, _ := ret[0].(map[domain.UserID]int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountOpenReviews indicates an expected call of CountOpenReviews.
+func (mr *MockPullRequestRepositoryMockRecorder) CountOpenReviews(ctx, reviewerIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountOpenReviews", reflect.TypeOf((*MockPullRequestRepository)(nil).CountOpenReviews), ctx, reviewerIDs)
+}
+
 // Create mocks base method.
 func (m *MockPullRequestRepository) Create(ctx context.Context, pr *domain.PullRequest) error {
 	m.ctrl.T.Helper()
@@ -248,3 +505,608 @@ func (mr *MockPullRequestRepositoryMockRecorder) Update(ctx, pr any) *gomock.Cal
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockPullRequestRepository)(nil).Update), ctx, pr)
 }
+
+// MockReviewSLARepository is a mock of ReviewSLARepository interface.
+type MockReviewSLARepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockReviewSLARepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockReviewSLARepositoryMockRecorder is the mock recorder for MockReviewSLARepository.
+type MockReviewSLARepositoryMockRecorder struct {
+	mock *MockReviewSLARepository
+}
+
+// NewMockReviewSLARepository creates a new mock instance.
+func NewMockReviewSLARepository(ctrl *gomock.Controller) *MockReviewSLARepository {
+	mock := &MockReviewSLARepository{ctrl: ctrl}
+	mock.recorder = &MockReviewSLARepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReviewSLARepository) EXPECT() *MockReviewSLARepositoryMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockReviewSLARepository) Delete(ctx context.Context, name domain.TeamName) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockReviewSLARepositoryMockRecorder) Delete(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockReviewSLARepository)(nil).Delete), ctx, name)
+}
+
+// Get mocks base method.
+func (m *MockReviewSLARepository) Get(ctx context.Context, name domain.TeamName) (*domain.ReviewSLA, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, name)
+	ret0, _ := ret[0].(*domain.ReviewSLA)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockReviewSLARepositoryMockRecorder) Get(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockReviewSLARepository)(nil).Get), ctx, name)
+}
+
+// ListOverdue mocks base method.
+func (m *MockReviewSLARepository) ListOverdue(ctx context.Context, now time.Time, limit int) ([]domain.OverdueReview, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOverdue", ctx, now, limit)
+	ret0, _ := ret[0].([]domain.OverdueReview)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOverdue indicates an expected call of ListOverdue.
+func (mr *MockReviewSLARepositoryMockRecorder) ListOverdue(ctx, now, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOverdue", reflect.TypeOf((*MockReviewSLARepository)(nil).ListOverdue), ctx, now, limit)
+}
+
+// MarkReviewed mocks base method.
+func (m *MockReviewSLARepository) MarkReviewed(ctx context.Context, id domain.PullRequestID, reviewerID domain.UserID, at time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkReviewed", ctx, id, reviewerID, at)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkReviewed indicates an expected call of MarkReviewed.
+func (mr *MockReviewSLARepositoryMockRecorder) MarkReviewed(ctx, id, reviewerID, at any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkReviewed", reflect.TypeOf((*MockReviewSLARepository)(nil).MarkReviewed), ctx, id, reviewerID, at)
+}
+
+// SetStage mocks base method.
+func (m *MockReviewSLARepository) SetStage(ctx context.Context, id domain.PullRequestID, reviewerID domain.UserID, from, to domain.ReviewSLAStage, at time.Time) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetStage", ctx, id, reviewerID, from, to, at)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetStage indicates an expected call of SetStage.
+func (mr *MockReviewSLARepositoryMockRecorder) SetStage(ctx, id, reviewerID, from, to, at any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetStage", reflect.TypeOf((*MockReviewSLARepository)(nil).SetStage), ctx, id, reviewerID, from, to, at)
+}
+
+// Upsert mocks base method.
+func (m *MockReviewSLARepository) Upsert(ctx context.Context, sla domain.ReviewSLA) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upsert", ctx, sla)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Upsert indicates an expected call of Upsert.
+func (mr *MockReviewSLARepositoryMockRecorder) Upsert(ctx, sla any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upsert", reflect.TypeOf((*MockReviewSLARepository)(nil).Upsert), ctx, sla)
+}
+
+// MockPullRequestHistoryRepository is a mock of PullRequestHistoryRepository interface.
+type MockPullRequestHistoryRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockPullRequestHistoryRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockPullRequestHistoryRepositoryMockRecorder is the mock recorder for MockPullRequestHistoryRepository.
+type MockPullRequestHistoryRepositoryMockRecorder struct {
+	mock *MockPullRequestHistoryRepository
+}
+
+// NewMockPullRequestHistoryRepository creates a new mock instance.
+func NewMockPullRequestHistoryRepository(ctrl *gomock.Controller) *MockPullRequestHistoryRepository {
+	mock := &MockPullRequestHistoryRepository{ctrl: ctrl}
+	mock.recorder = &MockPullRequestHistoryRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPullRequestHistoryRepository) EXPECT() *MockPullRequestHistoryRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Append mocks base method.
+func (m *MockPullRequestHistoryRepository) Append(ctx context.Context, entry *domain.PullRequestHistoryEntry) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Append", ctx, entry)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Append indicates an expected call of Append.
+func (mr *MockPullRequestHistoryRepositoryMockRecorder) Append(ctx, entry any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Append", reflect.TypeOf((*MockPullRequestHistoryRepository)(nil).Append), ctx, entry)
+}
+
+// List mocks base method.
+func (m *MockPullRequestHistoryRepository) List(ctx context.Context, id domain.PullRequestID) ([]domain.PullRequestHistoryEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, id)
+	ret0, _ := ret[0].([]domain.PullRequestHistoryEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockPullRequestHistoryRepositoryMockRecorder) List(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockPullRequestHistoryRepository)(nil).List), ctx, id)
+}
+
+// MockWebhookSubscriptionRepository is a mock of WebhookSubscriptionRepository interface.
+type MockWebhookSubscriptionRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebhookSubscriptionRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockWebhookSubscriptionRepositoryMockRecorder is the mock recorder for MockWebhookSubscriptionRepository.
+type MockWebhookSubscriptionRepositoryMockRecorder struct {
+	mock *MockWebhookSubscriptionRepository
+}
+
+// NewMockWebhookSubscriptionRepository creates a new mock instance.
+func NewMockWebhookSubscriptionRepository(ctrl *gomock.Controller) *MockWebhookSubscriptionRepository {
+	mock := &MockWebhookSubscriptionRepository{ctrl: ctrl}
+	mock.recorder = &MockWebhookSubscriptionRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWebhookSubscriptionRepository) EXPECT() *MockWebhookSubscriptionRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ClaimDueDeliveries mocks base method.
+func (m *MockWebhookSubscriptionRepository) ClaimDueDeliveries(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]domain.WebhookSubscriptionDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimDueDeliveries", ctx, now, lease, limit)
+	ret0, _ := ret[0].([]domain.WebhookSubscriptionDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimDueDeliveries indicates an expected call of ClaimDueDeliveries.
+func (mr *MockWebhookSubscriptionRepositoryMockRecorder) ClaimDueDeliveries(ctx, now, lease, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimDueDeliveries", reflect.TypeOf((*MockWebhookSubscriptionRepository)(nil).ClaimDueDeliveries), ctx, now, lease, limit)
+}
+
+// Create mocks base method.
+func (m *MockWebhookSubscriptionRepository) Create(ctx context.Context, subscription *domain.WebhookSubscription) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, subscription)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockWebhookSubscriptionRepositoryMockRecorder) Create(ctx, subscription any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockWebhookSubscriptionRepository)(nil).Create), ctx, subscription)
+}
+
+// Delete mocks base method.
+func (m *MockWebhookSubscriptionRepository) Delete(ctx context.Context, id domain.WebhookSubscriptionID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockWebhookSubscriptionRepositoryMockRecorder) Delete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockWebhookSubscriptionRepository)(nil).Delete), ctx, id)
+}
+
+// EnqueueDeliveries mocks base method.
+func (m *MockWebhookSubscriptionRepository) EnqueueDeliveries(ctx context.Context, deliveries []domain.WebhookSubscriptionDelivery) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnqueueDeliveries", ctx, deliveries)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EnqueueDeliveries indicates an expected call of EnqueueDeliveries.
+func (mr *MockWebhookSubscriptionRepositoryMockRecorder) EnqueueDeliveries(ctx, deliveries any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnqueueDeliveries", reflect.TypeOf((*MockWebhookSubscriptionRepository)(nil).EnqueueDeliveries), ctx, deliveries)
+}
+
+// GetByID mocks base method.
+func (m *MockWebhookSubscriptionRepository) GetByID(ctx context.Context, id domain.WebhookSubscriptionID) (*domain.WebhookSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*domain.WebhookSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockWebhookSubscriptionRepositoryMockRecorder) GetByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockWebhookSubscriptionRepository)(nil).GetByID), ctx, id)
+}
+
+// List mocks base method.
+func (m *MockWebhookSubscriptionRepository) List(ctx context.Context) ([]domain.WebhookSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx)
+	ret0, _ := ret[0].([]domain.WebhookSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockWebhookSubscriptionRepositoryMockRecorder) List(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockWebhookSubscriptionRepository)(nil).List), ctx)
+}
+
+// ListByEventType mocks base method.
+func (m *MockWebhookSubscriptionRepository) ListByEventType(ctx context.Context, eventType domain.EventType) ([]domain.WebhookSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByEventType", ctx, eventType)
+	ret0, _ := ret[0].([]domain.WebhookSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByEventType indicates an expected call of ListByEventType.
+func (mr *MockWebhookSubscriptionRepositoryMockRecorder) ListByEventType(ctx, eventType any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByEventType", reflect.TypeOf((*MockWebhookSubscriptionRepository)(nil).ListByEventType), ctx, eventType)
+}
+
+// ListDeliveries mocks base method.
+func (m *MockWebhookSubscriptionRepository) ListDeliveries(ctx context.Context, id domain.WebhookSubscriptionID, limit int) ([]domain.WebhookSubscriptionDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDeliveries", ctx, id, limit)
+	ret0, _ := ret[0].([]domain.WebhookSubscriptionDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDeliveries indicates an expected call of ListDeliveries.
+func (mr *MockWebhookSubscriptionRepositoryMockRecorder) ListDeliveries(ctx, id, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeliveries", reflect.TypeOf((*MockWebhookSubscriptionRepository)(nil).ListDeliveries), ctx, id, limit)
+}
+
+// UpdateDelivery mocks base method.
+func (m *MockWebhookSubscriptionRepository) UpdateDelivery(ctx context.Context, delivery domain.WebhookSubscriptionDelivery) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateDelivery", ctx, delivery)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateDelivery indicates an expected call of UpdateDelivery.
+func (mr *MockWebhookSubscriptionRepositoryMockRecorder) UpdateDelivery(ctx, delivery any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDelivery", reflect.TypeOf((*MockWebhookSubscriptionRepository)(nil).UpdateDelivery), ctx, delivery)
+}
+
+// MockOutboxRepository is a mock of OutboxRepository interface.
+type MockOutboxRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockOutboxRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockOutboxRepositoryMockRecorder is the mock recorder for MockOutboxRepository.
+type MockOutboxRepositoryMockRecorder struct {
+	mock *MockOutboxRepository
+}
+
+// NewMockOutboxRepository creates a new mock instance.
+func NewMockOutboxRepository(ctrl *gomock.Controller) *MockOutboxRepository {
+	mock := &MockOutboxRepository{ctrl: ctrl}
+	mock.recorder = &MockOutboxRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOutboxRepository) EXPECT() *MockOutboxRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Append mocks base method.
+func (m *MockOutboxRepository) Append(ctx context.Context, messages []domain.OutboxMessage) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Append", ctx, messages)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Append indicates an expected call of Append.
+func (mr *MockOutboxRepositoryMockRecorder) Append(ctx, messages any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Append", reflect.TypeOf((*MockOutboxRepository)(nil).Append), ctx, messages)
+}
+
+// ClaimPending mocks base method.
+func (m *MockOutboxRepository) ClaimPending(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]domain.OutboxMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimPending", ctx, now, lease, limit)
+	ret0, _ := ret[0].([]domain.OutboxMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimPending indicates an expected call of ClaimPending.
+func (mr *MockOutboxRepositoryMockRecorder) ClaimPending(ctx, now, lease, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimPending", reflect.TypeOf((*MockOutboxRepository)(nil).ClaimPending), ctx, now, lease, limit)
+}
+
+// LatestCursor mocks base method.
+func (m *MockOutboxRepository) LatestCursor(ctx context.Context) (domain.EventStreamCursor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LatestCursor", ctx)
+	ret0, _ := ret[0].(domain.EventStreamCursor)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LatestCursor indicates an expected call of LatestCursor.
+func (mr *MockOutboxRepositoryMockRecorder) LatestCursor(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LatestCursor", reflect.TypeOf((*MockOutboxRepository)(nil).LatestCursor), ctx)
+}
+
+// ListAfter mocks base method.
+func (m *MockOutboxRepository) ListAfter(ctx context.Context, after domain.EventStreamCursor, eventTypes []domain.EventType, limit int) ([]domain.OutboxMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAfter", ctx, after, eventTypes, limit)
+	ret0, _ := ret[0].([]domain.OutboxMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAfter indicates an expected call of ListAfter.
+func (mr *MockOutboxRepositoryMockRecorder) ListAfter(ctx, after, eventTypes, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAfter", reflect.TypeOf((*MockOutboxRepository)(nil).ListAfter), ctx, after, eventTypes, limit)
+}
+
+// Update mocks base method.
+func (m *MockOutboxRepository) Update(ctx context.Context, message domain.OutboxMessage) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, message)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockOutboxRepositoryMockRecorder) Update(ctx, message any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockOutboxRepository)(nil).Update), ctx, message)
+}
+
+// MockStatsRepository is a mock of StatsRepository interface.
+type MockStatsRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockStatsRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockStatsRepositoryMockRecorder is the mock recorder for MockStatsRepository.
+type MockStatsRepositoryMockRecorder struct {
+	mock *MockStatsRepository
+}
+
+// NewMockStatsRepository creates a new mock instance.
+func NewMockStatsRepository(ctrl *gomock.Controller) *MockStatsRepository {
+	mock := &MockStatsRepository{ctrl: ctrl}
+	mock.recorder = &MockStatsRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStatsRepository) EXPECT() *MockStatsRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Overview mocks base method.
+func (m *MockStatsRepository) Overview(ctx context.Context, limit int) (*domain.StatsOverview, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Overview", ctx, limit)
+	ret0, _ := ret[0].(*domain.StatsOverview)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Overview indicates an expected call of Overview.
+func (mr *MockStatsRepositoryMockRecorder) Overview(ctx, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Overview", reflect.TypeOf((*MockStatsRepository)(nil).Overview), ctx, limit)
+}
+
+// UserReviewStats mocks base method.
+func (m *MockStatsRepository) UserReviewStats(ctx context.Context, filter domain.UserStatsFilter) ([]domain.UserReviewStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UserReviewStats", ctx, filter)
+	ret0, _ := ret[0].([]domain.UserReviewStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UserReviewStats indicates an expected call of UserReviewStats.
+func (mr *MockStatsRepositoryMockRecorder) UserReviewStats(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserReviewStats", reflect.TypeOf((*MockStatsRepository)(nil).UserReviewStats), ctx, filter)
+}
+
+// MockExportRepository is a mock of ExportRepository interface.
+type MockExportRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockExportRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockExportRepositoryMockRecorder is the mock recorder for MockExportRepository.
+type MockExportRepositoryMockRecorder struct {
+	mock *MockExportRepository
+}
+
+// NewMockExportRepository creates a new mock instance.
+func NewMockExportRepository(ctrl *gomock.Controller) *MockExportRepository {
+	mock := &MockExportRepository{ctrl: ctrl}
+	mock.recorder = &MockExportRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockExportRepository) EXPECT() *MockExportRepositoryMockRecorder {
+	return m.recorder
+}
+
+// StreamAssignments mocks base method.
+func (m *MockExportRepository) StreamAssignments(ctx context.Context, filter domain.AssignmentExportFilter, fn func(domain.AssignmentExportRow) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StreamAssignments", ctx, filter, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StreamAssignments indicates an expected call of StreamAssignments.
+func (mr *MockExportRepositoryMockRecorder) StreamAssignments(ctx, filter, fn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamAssignments", reflect.TypeOf((*MockExportRepository)(nil).StreamAssignments), ctx, filter, fn)
+}
+
+// StreamPullRequests mocks base method.
+func (m *MockExportRepository) StreamPullRequests(ctx context.Context, filter domain.PullRequestExportFilter, fn func(domain.PullRequestExportRow) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StreamPullRequests", ctx, filter, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StreamPullRequests indicates an expected call of StreamPullRequests.
+func (mr *MockExportRepositoryMockRecorder) StreamPullRequests(ctx, filter, fn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamPullRequests", reflect.TypeOf((*MockExportRepository)(nil).StreamPullRequests), ctx, filter, fn)
+}
+
+// MockAPIKeyRepository is a mock of APIKeyRepository interface.
+type MockAPIKeyRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockAPIKeyRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockAPIKeyRepositoryMockRecorder is the mock recorder for MockAPIKeyRepository.
+type MockAPIKeyRepositoryMockRecorder struct {
+	mock *MockAPIKeyRepository
+}
+
+// NewMockAPIKeyRepository creates a new mock instance.
+func NewMockAPIKeyRepository(ctrl *gomock.Controller) *MockAPIKeyRepository {
+	mock := &MockAPIKeyRepository{ctrl: ctrl}
+	mock.recorder = &MockAPIKeyRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAPIKeyRepository) EXPECT() *MockAPIKeyRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockAPIKeyRepository) Create(ctx context.Context, key *domain.APIKey) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockAPIKeyRepositoryMockRecorder) Create(ctx, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockAPIKeyRepository)(nil).Create), ctx, key)
+}
+
+// GetByHash mocks base method.
+func (m *MockAPIKeyRepository) GetByHash(ctx context.Context, hash string) (*domain.APIKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByHash", ctx, hash)
+	ret0, _ := ret[0].(*domain.APIKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByHash indicates an expected call of GetByHash.
+func (mr *MockAPIKeyRepositoryMockRecorder) GetByHash(ctx, hash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByHash", reflect.TypeOf((*MockAPIKeyRepository)(nil).GetByHash), ctx, hash)
+}
+
+// List mocks base method.
+func (m *MockAPIKeyRepository) List(ctx context.Context) ([]domain.APIKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx)
+	ret0, _ := ret[0].([]domain.APIKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockAPIKeyRepositoryMockRecorder) List(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockAPIKeyRepository)(nil).List), ctx)
+}
+
+// Revoke mocks base method.
+func (m *MockAPIKeyRepository) Revoke(ctx context.Context, id domain.APIKeyID, at time.Time) (*domain.APIKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Revoke", ctx, id, at)
+	ret0, _ := ret[0].(*domain.APIKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Revoke indicates an expected call of Revoke.
+func (mr *MockAPIKeyRepositoryMockRecorder) Revoke(ctx, id, at any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Revoke", reflect.TypeOf((*MockAPIKeyRepository)(nil).Revoke), ctx, id, at)
+}
@@ -0,0 +1,93 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"time"
+
+	"PrService/src/internal/domain"
+)
+
+const (
+	apiKeySecretBytes = 32
+	// apiKeyPrefixLength is how much of the secret is kept in plain text to tell keys apart.
+	apiKeyPrefixLength = len(domain.APIKeySecretPrefix) + 8
+)
+
+type APIKeyService struct {
+	apiKeyRepository domain.APIKeyRepository
+	now              func() time.Time
+}
+
+func NewAPIKeyService(apiKeyRepository domain.APIKeyRepository) *APIKeyService {
+	return &APIKeyService{
+		apiKeyRepository: apiKeyRepository,
+		now:              time.Now,
+	}
+}
+
+// Create issues a random secret with the scopes; only its hash is stored.
+func (s *APIKeyService) Create(
+	ctx context.Context,
+	name string,
+	scopes []domain.APIKeyScope,
+) (*domain.IssuedAPIKey, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, fmt.Errorf("%w: name is required", domain.ErrInvalidAPIKey)
+	}
+	if len(scopes) == 0 {
+		return nil, fmt.Errorf("%w: no scopes", domain.ErrInvalidAPIKey)
+	}
+	for _, scope := range scopes {
+		if !scope.IsValid() {
+			return nil, fmt.Errorf("%w: unknown scope %s", domain.ErrInvalidAPIKey, scope)
+		}
+	}
+
+	raw := make([]byte, apiKeySecretBytes)
+	if _, err := rand.Read(raw); err != nil {
+		return nil, fmt.Errorf("generate API key: %w", err)
+	}
+	secret := domain.APIKeySecretPrefix + base64.RawURLEncoding.EncodeToString(raw)
+
+	key := domain.APIKey{
+		Name:   name,
+		Prefix: secret[:apiKeyPrefixLength],
+		Hash:   domain.HashAPIKey(secret),
+		Scopes: scopes,
+	}
+	if err := s.apiKeyRepository.Create(ctx, &key); err != nil {
+		return nil, err
+	}
+
+	return &domain.IssuedAPIKey{Key: key, Secret: secret}, nil
+}
+
+func (s *APIKeyService) List(ctx context.Context) ([]domain.APIKey, error) {
+	return s.apiKeyRepository.List(ctx)
+}
+
+func (s *APIKeyService) Revoke(ctx context.Context, id domain.APIKeyID) (*domain.APIKey, error) {
+	return s.apiKeyRepository.Revoke(ctx, id, s.now())
+}
+
+// Authenticate treats revoked keys as unknown, so callers cannot tell them apart.
+func (s *APIKeyService) Authenticate(ctx context.Context, secret string) (*domain.APIKey, error) {
+	if secret == "" {
+		return nil, domain.ErrAPIKeyNotFound
+	}
+
+	key, err := s.apiKeyRepository.GetByHash(ctx, domain.HashAPIKey(secret))
+	if err != nil {
+		return nil, err
+	}
+	if key.IsRevoked() {
+		return nil, domain.ErrAPIKeyNotFound
+	}
+
+	return key, nil
+}
@@ -0,0 +1,110 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"PrService/src/internal/application/mocks"
+	"PrService/src/internal/domain"
+
+	"go.uber.org/mock/gomock"
+)
+
+func newAPIKeyService(t *testing.T) (*APIKeyService, *mocks.MockAPIKeyRepository) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	repo := mocks.NewMockAPIKeyRepository(ctrl)
+
+	return NewAPIKeyService(repo), repo
+}
+
+func TestAPIKeyService_Create_StoresOnlyHash(t *testing.T) {
+	service, repo := newAPIKeyService(t)
+	ctx := context.Background()
+
+	var stored domain.APIKey
+	repo.
+		EXPECT().
+		Create(ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, key *domain.APIKey) error {
+			key.ID = 7
+			stored = *key
+			return nil
+		})
+
+	issued, err := service.Create(ctx, " ci ", []domain.APIKeyScope{domain.ScopePullRequestWrite})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !strings.HasPrefix(issued.Secret, domain.APIKeySecretPrefix) || len(issued.Secret) < 40 {
+		t.Fatalf("unexpected secret %q", issued.Secret)
+	}
+	if stored.Hash != domain.HashAPIKey(issued.Secret) || strings.Contains(stored.Hash, issued.Secret) {
+		t.Fatalf("expected only the hash of the secret to be stored, got %+v", stored)
+	}
+	if stored.Name != "ci" || !strings.HasPrefix(issued.Secret, stored.Prefix) || issued.Key.ID != 7 {
+		t.Fatalf("unexpected key: %+v", issued.Key)
+	}
+}
+
+func TestAPIKeyService_Create_RejectsUnknownScope(t *testing.T) {
+	service, _ := newAPIKeyService(t)
+
+	_, err := service.Create(context.Background(), "ci", []domain.APIKeyScope{"team:delete"})
+
+	if !errors.Is(err, domain.ErrInvalidAPIKey) {
+		t.Fatalf("expected ErrInvalidAPIKey, got %v", err)
+	}
+}
+
+func TestAPIKeyService_Authenticate(t *testing.T) {
+	revokedAt := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		key     *domain.APIKey
+		repoErr error
+		wantErr error
+	}{
+		{
+			name: "active key",
+			key:  &domain.APIKey{ID: 1, Scopes: []domain.APIKeyScope{domain.ScopeStatsRead}},
+		},
+		{
+			name:    "revoked key",
+			key:     &domain.APIKey{ID: 1, RevokedAt: &revokedAt},
+			wantErr: domain.ErrAPIKeyNotFound,
+		},
+		{
+			name:    "unknown key",
+			repoErr: domain.ErrAPIKeyNotFound,
+			wantErr: domain.ErrAPIKeyNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, repo := newAPIKeyService(t)
+			ctx := context.Background()
+
+			repo.
+				EXPECT().
+				GetByHash(ctx, domain.HashAPIKey("prs_secret")).
+				Return(tt.key, tt.repoErr)
+
+			key, err := service.Authenticate(ctx, "prs_secret")
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("expected %v, got %v", tt.wantErr, err)
+			}
+			if tt.wantErr == nil && key.ID != tt.key.ID {
+				t.Fatalf("unexpected key: %+v", key)
+			}
+		})
+	}
+}
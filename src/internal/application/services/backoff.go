@@ -0,0 +1,14 @@
+package services
+
+import "time"
+
+// exponentialBackoff returns the delay before the attempt following the given number of failed attempts:
+// base after the first failure, doubling with every next one up to maxDelay.
+func exponentialBackoff(base, maxDelay time.Duration, attempts int) time.Duration {
+	delay := base
+	for i := 1; i < attempts && delay < maxDelay; i++ {
+		delay *= 2
+	}
+
+	return min(delay, maxDelay)
+}
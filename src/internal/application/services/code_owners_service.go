@@ -0,0 +1,72 @@
+package services
+
+import (
+	"context"
+
+	"PrService/src/internal/application/contracts"
+
+	"PrService/src/internal/domain"
+)
+
+type CodeOwnersService struct {
+	teamRepository       domain.TeamRepository
+	codeOwnersRepository domain.CodeOwnersRepository
+	txManager            contracts.TxManager
+}
+
+func NewCodeOwnersService(
+	teamRepository domain.TeamRepository,
+	codeOwnersRepository domain.CodeOwnersRepository,
+	txManager contracts.TxManager,
+) *CodeOwnersService {
+	return &CodeOwnersService{
+		teamRepository:       teamRepository,
+		codeOwnersRepository: codeOwnersRepository,
+		txManager:            txManager,
+	}
+}
+
+func (s *CodeOwnersService) Upload(
+	ctx context.Context,
+	codeOwners *domain.TeamCodeOwners,
+) (*domain.TeamCodeOwners, error) {
+	if codeOwners.Mode == "" {
+		codeOwners.Mode = domain.CodeOwnersModePrefer
+	}
+	if codeOwners.FillStrategy == "" {
+		codeOwners.FillStrategy = domain.FillStrategyRandom
+	}
+
+	entries, err := domain.ParseCodeOwners(codeOwners.Content)
+	if err != nil {
+		return nil, err
+	}
+	codeOwners.Entries = entries
+
+	err = s.txManager.WithinTransaction(ctx, func(txCtx context.Context) error {
+		if _, err := s.teamRepository.GetByName(txCtx, codeOwners.TeamName); err != nil {
+			return err
+		}
+
+		return s.codeOwnersRepository.Upsert(txCtx, codeOwners)
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return codeOwners, nil
+}
+
+func (s *CodeOwnersService) Get(ctx context.Context, name domain.TeamName) (*domain.TeamCodeOwners, error) {
+	team, err := s.teamRepository.GetByName(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	if team.CodeOwners == nil {
+		return nil, domain.ErrCodeOwnersNotFound
+	}
+
+	return team.CodeOwners, nil
+}
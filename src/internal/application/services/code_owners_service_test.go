@@ -0,0 +1,115 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"PrService/src/internal/application/mocks"
+	"PrService/src/internal/domain"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestCodeOwnersService_Upload_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	teamRepo := mocks.NewMockTeamRepository(ctrl)
+	codeOwnersRepo := mocks.NewMockCodeOwnersRepository(ctrl)
+	txManager := mocks.NewMockTxManager(ctrl)
+
+	svc := NewCodeOwnersService(teamRepo, codeOwnersRepo, txManager)
+
+	ctx := context.Background()
+	codeOwners := &domain.TeamCodeOwners{TeamName: "backend", Content: "*.go @alice\n/docs/ @org/docs\n"}
+
+	txManager.
+		EXPECT().
+		WithinTransaction(ctx, gomock.Any()).
+		DoAndReturn(func(c context.Context, fn func(context.Context) error) error {
+			return fn(c)
+		})
+
+	teamRepo.EXPECT().
+		GetByName(gomock.Any(), domain.TeamName("backend")).
+		Return(&domain.Team{Name: "backend"}, nil)
+
+	codeOwnersRepo.EXPECT().
+		Upsert(gomock.Any(), codeOwners).
+		Return(nil)
+
+	got, err := svc.Upload(ctx, codeOwners)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if got.Mode != domain.CodeOwnersModePrefer || got.FillStrategy != domain.FillStrategyRandom {
+		t.Fatalf("expected default mode and fill strategy, got %s/%s", got.Mode, got.FillStrategy)
+	}
+	if len(got.Entries) != 2 {
+		t.Fatalf("expected 2 parsed entries, got %+v", got.Entries)
+	}
+}
+
+func TestCodeOwnersService_Upload_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		teamErr error
+		wantErr error
+	}{
+		{name: "invalid syntax", content: "!*.md @alice", wantErr: domain.ErrInvalidCodeOwners},
+		{name: "team not found", content: "* @alice", teamErr: domain.ErrTeamNotFound, wantErr: domain.ErrTeamNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			teamRepo := mocks.NewMockTeamRepository(ctrl)
+			txManager := mocks.NewMockTxManager(ctrl)
+
+			svc := NewCodeOwnersService(teamRepo, mocks.NewMockCodeOwnersRepository(ctrl), txManager)
+
+			ctx := context.Background()
+			if tt.teamErr != nil {
+				txManager.
+					EXPECT().
+					WithinTransaction(ctx, gomock.Any()).
+					DoAndReturn(func(c context.Context, fn func(context.Context) error) error {
+						return fn(c)
+					})
+
+				teamRepo.EXPECT().
+					GetByName(gomock.Any(), domain.TeamName("backend")).
+					Return(nil, tt.teamErr)
+			}
+
+			got, err := svc.Upload(ctx, &domain.TeamCodeOwners{TeamName: "backend", Content: tt.content})
+			if got != nil {
+				t.Fatalf("expected nil result, got %+v", got)
+			}
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("expected %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestCodeOwnersService_Get_NotUploaded(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	teamRepo := mocks.NewMockTeamRepository(ctrl)
+	svc := NewCodeOwnersService(teamRepo, nil, nil)
+
+	teamRepo.EXPECT().
+		GetByName(gomock.Any(), domain.TeamName("backend")).
+		Return(&domain.Team{Name: "backend"}, nil)
+
+	_, err := svc.Get(context.Background(), "backend")
+	if !errors.Is(err, domain.ErrCodeOwnersNotFound) {
+		t.Fatalf("expected ErrCodeOwnersNotFound, got %v", err)
+	}
+}
@@ -0,0 +1,123 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"PrService/src/internal/domain"
+)
+
+const eventStreamBatchSize = 200
+
+// EventStreamService reads stream events from the outbox in commit-safe order,
+// so clients can resume from the cursor of the last event they received.
+type EventStreamService struct {
+	outboxRepository domain.OutboxRepository
+	teamRepository   domain.TeamRepository
+}
+
+func NewEventStreamService(
+	outboxRepository domain.OutboxRepository,
+	teamRepository domain.TeamRepository,
+) *EventStreamService {
+	return &EventStreamService{
+		outboxRepository: outboxRepository,
+		teamRepository:   teamRepository,
+	}
+}
+
+func (s *EventStreamService) LatestCursor(ctx context.Context) (domain.EventStreamCursor, error) {
+	return s.outboxRepository.LatestCursor(ctx)
+}
+
+// ListAfter returns the stream events past the cursor that match the filter.
+// It fails with ErrTeamNotFound when the filter names an unknown team.
+func (s *EventStreamService) ListAfter(
+	ctx context.Context,
+	filter domain.EventStreamFilter,
+	after domain.EventStreamCursor,
+) (*domain.EventStreamPage, error) {
+	var teamMembers map[domain.UserID]struct{}
+	if filter.TeamName != "" {
+		team, err := s.teamRepository.GetByName(ctx, filter.TeamName)
+		if err != nil {
+			return nil, err
+		}
+
+		teamMembers = make(map[domain.UserID]struct{}, len(team.Members))
+		for _, member := range team.Members {
+			teamMembers[member.ID] = struct{}{}
+		}
+	}
+
+	messages, err := s.outboxRepository.ListAfter(ctx, after, domain.StreamEventTypes, eventStreamBatchSize)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &domain.EventStreamPage{Events: make([]domain.OutboxMessage, 0), Cursor: after}
+	for _, message := range messages {
+		page.Cursor = message.StreamCursor()
+
+		involved, err := involvedUsers(message)
+		if err != nil {
+			return nil, fmt.Errorf("decode outbox message %d: %w", message.ID, err)
+		}
+
+		if matchesStreamFilter(involved, filter.UserID, teamMembers) {
+			page.Events = append(page.Events, message)
+		}
+	}
+
+	return page, nil
+}
+
+// involvedUsers returns the author, every reviewer and every lead a pull request event mentions.
+func involvedUsers(message domain.OutboxMessage) ([]domain.UserID, error) {
+	var payload struct {
+		PullRequest *struct {
+			AuthorID          domain.UserID   `json:"author_id"`
+			AssignedReviewers []domain.UserID `json:"assigned_reviewers"`
+		} `json:"pull_request"`
+		OldReviewerID domain.UserID   `json:"old_reviewer_id"`
+		NewReviewerID domain.UserID   `json:"new_reviewer_id"`
+		EscalatedTo   []domain.UserID `json:"escalated_to"`
+	}
+	if err := json.Unmarshal(message.Payload, &payload); err != nil {
+		return nil, err
+	}
+
+	users := make([]domain.UserID, 0)
+	if pr := payload.PullRequest; pr != nil {
+		users = append(users, pr.AuthorID)
+		users = append(users, pr.AssignedReviewers...)
+	}
+	for _, id := range []domain.UserID{payload.OldReviewerID, payload.NewReviewerID} {
+		if id != "" {
+			users = append(users, id)
+		}
+	}
+	users = append(users, payload.EscalatedTo...)
+
+	return users, nil
+}
+
+func matchesStreamFilter(
+	involved []domain.UserID,
+	userID domain.UserID,
+	teamMembers map[domain.UserID]struct{},
+) bool {
+	userMatched := userID == ""
+	teamMatched := teamMembers == nil
+	for _, id := range involved {
+		if id == userID {
+			userMatched = true
+		}
+		if _, ok := teamMembers[id]; ok {
+			teamMatched = true
+		}
+	}
+
+	return userMatched && teamMatched
+}
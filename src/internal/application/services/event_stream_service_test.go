@@ -0,0 +1,136 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"PrService/src/internal/application/mocks"
+	"PrService/src/internal/domain"
+
+	"go.uber.org/mock/gomock"
+)
+
+func newEventStreamService(
+	t *testing.T,
+) (*EventStreamService, *mocks.MockOutboxRepository, *mocks.MockTeamRepository) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	outboxRepo := mocks.NewMockOutboxRepository(ctrl)
+	teamRepo := mocks.NewMockTeamRepository(ctrl)
+
+	service := NewEventStreamService(outboxRepo, teamRepo)
+
+	return service, outboxRepo, teamRepo
+}
+
+func streamMessages() []domain.OutboxMessage {
+	return []domain.OutboxMessage{
+		{
+			ID:        11,
+			TxID:      100,
+			EventType: domain.EventPullRequestCreated,
+			Payload:   []byte(`{"pull_request":{"author_id":"u1","assigned_reviewers":["u2","u3"]}}`),
+		},
+		{
+			ID:        12,
+			TxID:      100,
+			EventType: domain.EventReviewerReassigned,
+			Payload: []byte(`{"pull_request":{"author_id":"u1","assigned_reviewers":["u3","u4"]},` +
+				`"old_reviewer_id":"u2","new_reviewer_id":"u4"}`),
+		},
+		{
+			ID:        13,
+			TxID:      101,
+			EventType: domain.EventPullRequestMerged,
+			Payload:   []byte(`{"pull_request":{"author_id":"u5","assigned_reviewers":["u6"]}}`),
+		},
+	}
+}
+
+func TestEventStreamService_ListAfter_FiltersByUser(t *testing.T) {
+	service, outboxRepo, _ := newEventStreamService(t)
+
+	ctx := context.Background()
+	cursor := domain.EventStreamCursor{TxID: 99, ID: 10}
+
+	outboxRepo.
+		EXPECT().
+		ListAfter(ctx, cursor, domain.StreamEventTypes, 200).
+		Return(streamMessages(), nil)
+
+	page, err := service.ListAfter(ctx, domain.EventStreamFilter{UserID: "u2"}, cursor)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// u2 is a reviewer of the first event and the replaced reviewer of the second.
+	if len(page.Events) != 2 || page.Events[0].ID != 11 || page.Events[1].ID != 12 {
+		t.Fatalf("unexpected events: %+v", page.Events)
+	}
+	if page.Cursor != (domain.EventStreamCursor{TxID: 101, ID: 13}) {
+		t.Fatalf("expected cursor to move past unmatched events, got %+v", page.Cursor)
+	}
+}
+
+func TestEventStreamService_ListAfter_FiltersByTeam(t *testing.T) {
+	service, outboxRepo, teamRepo := newEventStreamService(t)
+
+	ctx := context.Background()
+
+	teamRepo.
+		EXPECT().
+		GetByName(ctx, domain.TeamName("platform")).
+		Return(&domain.Team{Name: "platform", Members: []domain.TeamMember{{ID: "u6"}}}, nil)
+
+	outboxRepo.
+		EXPECT().
+		ListAfter(ctx, domain.EventStreamCursor{}, domain.StreamEventTypes, 200).
+		Return(streamMessages(), nil)
+
+	page, err := service.ListAfter(ctx, domain.EventStreamFilter{TeamName: "platform"}, domain.EventStreamCursor{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(page.Events) != 1 || page.Events[0].ID != 13 {
+		t.Fatalf("unexpected events: %+v", page.Events)
+	}
+}
+
+func TestEventStreamService_ListAfter_NoEventsKeepsCursor(t *testing.T) {
+	service, outboxRepo, _ := newEventStreamService(t)
+
+	ctx := context.Background()
+	cursor := domain.EventStreamCursor{TxID: 7, ID: 42}
+
+	outboxRepo.
+		EXPECT().
+		ListAfter(ctx, cursor, domain.StreamEventTypes, 200).
+		Return([]domain.OutboxMessage{}, nil)
+
+	page, err := service.ListAfter(ctx, domain.EventStreamFilter{}, cursor)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(page.Events) != 0 || page.Cursor != cursor {
+		t.Fatalf("unexpected page: %+v", page)
+	}
+}
+
+func TestEventStreamService_ListAfter_UnknownTeam(t *testing.T) {
+	service, _, teamRepo := newEventStreamService(t)
+
+	ctx := context.Background()
+
+	teamRepo.
+		EXPECT().
+		GetByName(ctx, domain.TeamName("ghost")).
+		Return(nil, domain.ErrTeamNotFound)
+
+	_, err := service.ListAfter(ctx, domain.EventStreamFilter{TeamName: "ghost"}, domain.EventStreamCursor{})
+	if !errors.Is(err, domain.ErrTeamNotFound) {
+		t.Fatalf("expected ErrTeamNotFound, got %v", err)
+	}
+}
@@ -0,0 +1,66 @@
+package services
+
+import (
+	"context"
+
+	"PrService/src/internal/domain"
+)
+
+// ExportService streams raw pull requests and review assignments for offline analysis.
+// Filters are checked before the first row is passed on.
+type ExportService struct {
+	exportRepository domain.ExportRepository
+	teamRepository   domain.TeamRepository
+}
+
+func NewExportService(
+	exportRepository domain.ExportRepository,
+	teamRepository domain.TeamRepository,
+) *ExportService {
+	return &ExportService{
+		exportRepository: exportRepository,
+		teamRepository:   teamRepository,
+	}
+}
+
+func (s *ExportService) ExportPullRequests(
+	ctx context.Context,
+	filter domain.PullRequestExportFilter,
+	fn func(domain.PullRequestExportRow) error,
+) error {
+	if err := filter.Validate(); err != nil {
+		return err
+	}
+
+	if err := s.checkTeam(ctx, filter.TeamName); err != nil {
+		return err
+	}
+
+	return s.exportRepository.StreamPullRequests(ctx, filter, fn)
+}
+
+func (s *ExportService) ExportAssignments(
+	ctx context.Context,
+	filter domain.AssignmentExportFilter,
+	fn func(domain.AssignmentExportRow) error,
+) error {
+	if err := filter.Window.Validate(); err != nil {
+		return err
+	}
+
+	if err := s.checkTeam(ctx, filter.TeamName); err != nil {
+		return err
+	}
+
+	return s.exportRepository.StreamAssignments(ctx, filter, fn)
+}
+
+// checkTeam reports ErrTeamNotFound for a team filter naming no team; an empty name is not checked.
+func (s *ExportService) checkTeam(ctx context.Context, name domain.TeamName) error {
+	if name == "" {
+		return nil
+	}
+
+	_, err := s.teamRepository.GetByName(ctx, name)
+	return err
+}
@@ -0,0 +1,78 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"PrService/src/internal/application/mocks"
+	"PrService/src/internal/domain"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestExportService_ExportPullRequests_InvalidStatus(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	service := NewExportService(mocks.NewMockExportRepository(ctrl), mocks.NewMockTeamRepository(ctrl))
+
+	filter := domain.PullRequestExportFilter{Status: "REVIEWING"}
+	err := service.ExportPullRequests(context.Background(), filter, func(domain.PullRequestExportRow) error {
+		t.Fatal("no row expected")
+		return nil
+	})
+	if !errors.Is(err, domain.ErrInvalidPullRequestStatus) {
+		t.Fatalf("expected ErrInvalidPullRequestStatus, got %v", err)
+	}
+}
+
+func TestExportService_ExportPullRequests_TeamNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	teamRepo := mocks.NewMockTeamRepository(ctrl)
+	service := NewExportService(mocks.NewMockExportRepository(ctrl), teamRepo)
+
+	teamRepo.EXPECT().GetByName(gomock.Any(), domain.TeamName("ghost")).Return(nil, domain.ErrTeamNotFound)
+
+	filter := domain.PullRequestExportFilter{TeamName: "ghost"}
+	err := service.ExportPullRequests(context.Background(), filter, func(domain.PullRequestExportRow) error {
+		return nil
+	})
+	if !errors.Is(err, domain.ErrTeamNotFound) {
+		t.Fatalf("expected ErrTeamNotFound, got %v", err)
+	}
+}
+
+func TestExportService_ExportAssignments_StreamsRows(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	exportRepo := mocks.NewMockExportRepository(ctrl)
+	teamRepo := mocks.NewMockTeamRepository(ctrl)
+	service := NewExportService(exportRepo, teamRepo)
+
+	filter := domain.AssignmentExportFilter{TeamName: "backend", ReviewerID: "u2"}
+	teamRepo.EXPECT().GetByName(gomock.Any(), domain.TeamName("backend")).Return(&domain.Team{Name: "backend"}, nil)
+	exportRepo.EXPECT().
+		StreamAssignments(gomock.Any(), filter, gomock.Any()).
+		DoAndReturn(func(
+			_ context.Context,
+			_ domain.AssignmentExportFilter,
+			fn func(domain.AssignmentExportRow) error,
+		) error {
+			for _, id := range []domain.PullRequestID{"pr-1", "pr-2"} {
+				if err := fn(domain.AssignmentExportRow{PullRequestID: id, ReviewerID: "u2"}); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+
+	var got []domain.PullRequestID
+	err := service.ExportAssignments(context.Background(), filter, func(row domain.AssignmentExportRow) error {
+		got = append(got, row.PullRequestID)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(got) != 2 || got[0] != "pr-1" || got[1] != "pr-2" {
+		t.Fatalf("unexpected rows: %v", got)
+	}
+}
@@ -0,0 +1,34 @@
+package services
+
+import (
+	"context"
+
+	"PrService/src/internal/domain"
+)
+
+// OutboxPublisher stores published events in the outbox with the caller's transaction,
+// so an event exists exactly when the state change it describes was committed.
+type OutboxPublisher struct {
+	outboxRepository domain.OutboxRepository
+}
+
+func NewOutboxPublisher(outboxRepository domain.OutboxRepository) *OutboxPublisher {
+	return &OutboxPublisher{outboxRepository: outboxRepository}
+}
+
+func (p *OutboxPublisher) Publish(ctx context.Context, events ...domain.Event) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	messages := make([]domain.OutboxMessage, 0, len(events))
+	for _, event := range events {
+		message, err := domain.NewOutboxMessage(event)
+		if err != nil {
+			return err
+		}
+		messages = append(messages, message)
+	}
+
+	return p.outboxRepository.Append(ctx, messages)
+}
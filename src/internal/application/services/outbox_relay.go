@@ -0,0 +1,134 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"PrService/src/internal/application/contracts"
+
+	"PrService/src/internal/domain"
+)
+
+// OutboxRelayPolicy controls how outbox messages are relayed and retried.
+type OutboxRelayPolicy struct {
+	BatchSize int
+	// MaxAttempts is the number of failed relays after which a message is marked FAILED.
+	MaxAttempts int
+	// BaseBackoff is the delay after the first failed relay of a message; it doubles up to MaxBackoff.
+	BaseBackoff time.Duration
+	MaxBackoff  time.Duration
+	// Lease postpones claimed messages so that another relay does not pick them up while sinks run.
+	Lease time.Duration
+}
+
+func DefaultOutboxRelayPolicy() OutboxRelayPolicy {
+	return OutboxRelayPolicy{
+		BatchSize:   100,
+		MaxAttempts: 20,
+		BaseBackoff: time.Second,
+		MaxBackoff:  5 * time.Minute,
+		Lease:       time.Minute,
+	}
+}
+
+// OutboxRelayResult reports one relay pass.
+type OutboxRelayResult struct {
+	Published int
+	// Retried counts messages that will be offered again to the sinks that did not accept them.
+	Retried int
+	// Failed counts messages marked FAILED in this pass.
+	Failed int
+}
+
+// OutboxRelay publishes outbox messages to the sinks. Each sink gets a message until it accepts it once;
+// a message is marked published when every sink has. Otherwise it is retried with backoff, and later
+// messages of its aggregate wait, until the policy gives up on it.
+type OutboxRelay struct {
+	outboxRepository domain.OutboxRepository
+	sinks            []contracts.EventSink
+	policy           OutboxRelayPolicy
+	now              func() time.Time
+}
+
+func NewOutboxRelay(
+	outboxRepository domain.OutboxRepository,
+	sinks []contracts.EventSink,
+	policy OutboxRelayPolicy,
+) *OutboxRelay {
+	return &OutboxRelay{
+		outboxRepository: outboxRepository,
+		sinks:            sinks,
+		policy:           policy,
+		now:              time.Now,
+	}
+}
+
+// RelayPending relays one batch of due messages. The batch holds at most one message per aggregate,
+// so callers drain the outbox by repeating while messages are published or given up on.
+// Messages are leased rather than locked, so sinks run outside of any transaction.
+func (r *OutboxRelay) RelayPending(ctx context.Context) (OutboxRelayResult, error) {
+	var result OutboxRelayResult
+
+	messages, err := r.outboxRepository.ClaimPending(ctx, r.now(), r.policy.Lease, r.policy.BatchSize)
+	if err != nil {
+		return result, err
+	}
+
+	for _, message := range messages {
+		r.relay(ctx, &message)
+
+		switch message.Status {
+		case domain.OutboxPublished:
+			result.Published++
+		case domain.OutboxFailed:
+			result.Failed++
+		default:
+			result.Retried++
+		}
+
+		if err := r.outboxRepository.Update(ctx, message); err != nil {
+			return result, err
+		}
+	}
+
+	return result, nil
+}
+
+// relay offers the message to every sink that has not accepted it yet and records the outcome.
+func (r *OutboxRelay) relay(ctx context.Context, message *domain.OutboxMessage) {
+	claimed := *message
+	message.DeliveredSinks = slices.Clone(claimed.DeliveredSinks)
+
+	var errs []error
+	for _, sink := range r.sinks {
+		name := sink.Name()
+		if slices.Contains(claimed.DeliveredSinks, name) {
+			continue
+		}
+
+		if err := sink.Publish(ctx, claimed); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", name, err))
+			continue
+		}
+		message.DeliveredSinks = append(message.DeliveredSinks, name)
+	}
+
+	now := r.now()
+	if len(errs) == 0 {
+		message.Status = domain.OutboxPublished
+		message.LastError = ""
+		message.PublishedAt = &now
+		return
+	}
+
+	message.Attempts++
+	message.LastError = errors.Join(errs...).Error()
+	if message.Attempts >= r.policy.MaxAttempts {
+		message.Status = domain.OutboxFailed
+		return
+	}
+	message.NextAttemptAt = now.Add(exponentialBackoff(r.policy.BaseBackoff, r.policy.MaxBackoff, message.Attempts))
+}
@@ -0,0 +1,269 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"PrService/src/internal/application/contracts"
+	"PrService/src/internal/application/mocks"
+	"PrService/src/internal/domain"
+
+	"go.uber.org/mock/gomock"
+)
+
+var outboxTestNow = time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+
+func newOutboxRelay(
+	t *testing.T,
+	sinkNames ...string,
+) (*OutboxRelay, *mocks.MockOutboxRepository, []*mocks.MockEventSink) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	repo := mocks.NewMockOutboxRepository(ctrl)
+
+	sinkMocks := make([]*mocks.MockEventSink, 0, len(sinkNames))
+	sinks := make([]contracts.EventSink, 0, len(sinkNames))
+	for _, name := range sinkNames {
+		sink := mocks.NewMockEventSink(ctrl)
+		sink.EXPECT().Name().Return(name).AnyTimes()
+		sinkMocks = append(sinkMocks, sink)
+		sinks = append(sinks, sink)
+	}
+
+	policy := OutboxRelayPolicy{
+		BatchSize:   10,
+		MaxAttempts: 5,
+		BaseBackoff: time.Second,
+		MaxBackoff:  time.Minute,
+		Lease:       time.Minute,
+	}
+	relay := NewOutboxRelay(repo, sinks, policy)
+	relay.now = func() time.Time { return outboxTestNow }
+
+	return relay, repo, sinkMocks
+}
+
+func TestOutboxPublisher_Publish_AppendsEncodedEvents(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mocks.NewMockOutboxRepository(ctrl)
+	publisher := NewOutboxPublisher(repo)
+
+	ctx := context.Background()
+	prEvent := domain.NewPullRequestEvent(domain.EventPullRequestCreated, domain.PullRequest{ID: "pr-1"})
+	teamEvent := domain.NewTeamEvent(domain.EventTeamUpdated, domain.Team{Name: "backend", MaxReviewers: 3})
+
+	repo.
+		EXPECT().
+		Append(ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, messages []domain.OutboxMessage) error {
+			if len(messages) != 2 {
+				t.Fatalf("expected 2 messages, got %d", len(messages))
+			}
+			if m := messages[0]; m.AggregateType != domain.AggregatePullRequest || m.AggregateID != "pr-1" ||
+				m.EventType != domain.EventPullRequestCreated {
+				t.Fatalf("unexpected pull request message: %+v", m)
+			}
+			if m := messages[1]; m.AggregateType != domain.AggregateTeam || m.AggregateID != "backend" {
+				t.Fatalf("unexpected team message: %+v", m)
+			}
+
+			var payload struct {
+				Team struct {
+					MaxReviewers int `json:"max_reviewers"`
+				} `json:"team"`
+			}
+			if err := json.Unmarshal(messages[1].Payload, &payload); err != nil {
+				t.Fatalf("payload is not JSON: %v", err)
+			}
+			if payload.Team.MaxReviewers != 3 {
+				t.Fatalf("unexpected team payload: %s", messages[1].Payload)
+			}
+			return nil
+		})
+
+	if err := publisher.Publish(ctx, prEvent, teamEvent); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestOutboxRelay_RelayPending_MarksPublished(t *testing.T) {
+	relay, repo, sinks := newOutboxRelay(t, "webhooks", "chat")
+
+	ctx := context.Background()
+	message := domain.OutboxMessage{
+		ID: 5, EventType: domain.EventPullRequestMerged, Payload: []byte(`{}`), Status: domain.OutboxPending,
+	}
+
+	repo.
+		EXPECT().
+		ClaimPending(ctx, outboxTestNow, time.Minute, 10).
+		Return([]domain.OutboxMessage{message}, nil)
+
+	for _, sink := range sinks {
+		sink.EXPECT().Publish(ctx, message).Return(nil)
+	}
+
+	repo.
+		EXPECT().
+		Update(ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, m domain.OutboxMessage) error {
+			if m.Status != domain.OutboxPublished || m.PublishedAt == nil || !m.PublishedAt.Equal(outboxTestNow) {
+				t.Fatalf("expected message to be published, got %+v", m)
+			}
+			if !slices.Equal(m.DeliveredSinks, []string{"webhooks", "chat"}) || m.Attempts != 0 {
+				t.Fatalf("expected both sinks to be recorded, got %+v", m)
+			}
+			return nil
+		})
+
+	result, err := relay.RelayPending(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result != (OutboxRelayResult{Published: 1}) {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+}
+
+func TestOutboxRelay_RelayPending_SinkFailureRetriesWithBackoff(t *testing.T) {
+	relay, repo, sinks := newOutboxRelay(t, "http", "chat")
+
+	ctx := context.Background()
+	message := domain.OutboxMessage{
+		ID: 5, Attempts: 2, EventType: domain.EventUserDeactivated, Status: domain.OutboxPending,
+	}
+
+	repo.
+		EXPECT().
+		ClaimPending(ctx, outboxTestNow, time.Minute, 10).
+		Return([]domain.OutboxMessage{message}, nil)
+
+	// Every sink is offered the message even when an earlier one fails.
+	sinks[0].EXPECT().Publish(ctx, message).Return(errors.New("connection refused"))
+	sinks[1].EXPECT().Publish(ctx, message).Return(nil)
+
+	repo.
+		EXPECT().
+		Update(ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, m domain.OutboxMessage) error {
+			if m.Status != domain.OutboxPending || m.PublishedAt != nil || m.Attempts != 3 {
+				t.Fatalf("expected message to stay pending with 3 attempts, got %+v", m)
+			}
+			if !slices.Equal(m.DeliveredSinks, []string{"chat"}) {
+				t.Fatalf("expected the sink that accepted the message to be recorded, got %v", m.DeliveredSinks)
+			}
+			if !strings.Contains(m.LastError, "http: connection refused") {
+				t.Fatalf("expected sink error to be recorded, got %q", m.LastError)
+			}
+			if !m.NextAttemptAt.Equal(outboxTestNow.Add(4 * time.Second)) {
+				t.Fatalf("expected retry after 4s, got %v", m.NextAttemptAt)
+			}
+			return nil
+		})
+
+	result, err := relay.RelayPending(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result != (OutboxRelayResult{Retried: 1}) {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+}
+
+func TestOutboxRelay_RelayPending_RetrySkipsDeliveredSinks(t *testing.T) {
+	relay, repo, sinks := newOutboxRelay(t, "http", "chat")
+
+	ctx := context.Background()
+	message := domain.OutboxMessage{
+		ID: 5, Attempts: 1, EventType: domain.EventReviewerAssigned, Status: domain.OutboxPending,
+		DeliveredSinks: []string{"chat"}, LastError: "http: connection refused",
+	}
+
+	repo.
+		EXPECT().
+		ClaimPending(ctx, outboxTestNow, time.Minute, 10).
+		Return([]domain.OutboxMessage{message}, nil)
+
+	// The chat sink already sent its notifications and must not send them again.
+	sinks[0].EXPECT().Publish(ctx, message).Return(nil)
+	sinks[1].EXPECT().Publish(gomock.Any(), gomock.Any()).Times(0)
+
+	repo.
+		EXPECT().
+		Update(ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, m domain.OutboxMessage) error {
+			if m.Status != domain.OutboxPublished || m.LastError != "" {
+				t.Fatalf("expected message to be published, got %+v", m)
+			}
+			if !slices.Equal(m.DeliveredSinks, []string{"chat", "http"}) {
+				t.Fatalf("unexpected delivered sinks: %v", m.DeliveredSinks)
+			}
+			return nil
+		})
+
+	result, err := relay.RelayPending(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result != (OutboxRelayResult{Published: 1}) {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+}
+
+func TestOutboxRelay_RelayPending_GivesUpAfterMaxAttempts(t *testing.T) {
+	relay, repo, sinks := newOutboxRelay(t, "http")
+
+	ctx := context.Background()
+	message := domain.OutboxMessage{
+		ID: 5, Attempts: 4, EventType: domain.EventTeamUpdated, Status: domain.OutboxPending,
+	}
+
+	repo.
+		EXPECT().
+		ClaimPending(ctx, outboxTestNow, time.Minute, 10).
+		Return([]domain.OutboxMessage{message}, nil)
+
+	sinks[0].EXPECT().Publish(ctx, message).Return(errors.New("410 gone"))
+
+	repo.
+		EXPECT().
+		Update(ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, m domain.OutboxMessage) error {
+			if m.Status != domain.OutboxFailed || m.Attempts != 5 || m.PublishedAt != nil {
+				t.Fatalf("expected message to be marked failed after 5 attempts, got %+v", m)
+			}
+			return nil
+		})
+
+	result, err := relay.RelayPending(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result != (OutboxRelayResult{Failed: 1}) {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+}
+
+func TestOutboxRelay_RelayPending_ClaimError(t *testing.T) {
+	relay, repo, _ := newOutboxRelay(t, "http")
+
+	ctx := context.Background()
+	expectedErr := errors.New("db down")
+
+	repo.
+		EXPECT().
+		ClaimPending(ctx, outboxTestNow, time.Minute, 10).
+		Return(nil, expectedErr)
+
+	if _, err := relay.RelayPending(ctx); !errors.Is(err, expectedErr) {
+		t.Fatalf("expected %v, got %v", expectedErr, err)
+	}
+}
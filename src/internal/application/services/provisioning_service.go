@@ -0,0 +1,273 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"PrService/src/internal/application/contracts"
+
+	"PrService/src/internal/domain"
+)
+
+// ProvisioningService lets an identity provider manage reviewers. Users it creates join defaultTeam until
+// a group takes them in, as every user belongs to a team; users that leave a group are deactivated and
+// hand their reviews over, like members dropped by a team sync.
+type ProvisioningService struct {
+	userRepository  domain.UserRepository
+	teamRepository  domain.TeamRepository
+	teamService     domain.TeamService
+	userService     domain.UserService
+	teamSyncService domain.TeamSyncService
+	txManager       contracts.TxManager
+	defaultTeam     domain.TeamName
+}
+
+func NewProvisioningService(
+	userRepository domain.UserRepository,
+	teamRepository domain.TeamRepository,
+	teamService domain.TeamService,
+	userService domain.UserService,
+	teamSyncService domain.TeamSyncService,
+	txManager contracts.TxManager,
+	defaultTeam domain.TeamName,
+) *ProvisioningService {
+	return &ProvisioningService{
+		userRepository:  userRepository,
+		teamRepository:  teamRepository,
+		teamService:     teamService,
+		userService:     userService,
+		teamSyncService: teamSyncService,
+		txManager:       txManager,
+		defaultTeam:     defaultTeam,
+	}
+}
+
+func (s *ProvisioningService) ListUsers(
+	ctx context.Context,
+	filter domain.ProvisioningUserFilter,
+) ([]domain.User, error) {
+	users, err := s.userRepository.List(ctx, domain.UserFilter{TeamName: filter.TeamName})
+	if err != nil {
+		return nil, err
+	}
+
+	matched := make([]domain.User, 0, len(users))
+	for _, user := range users {
+		if filter.Matches(user) {
+			matched = append(matched, user)
+		}
+	}
+
+	return matched, nil
+}
+
+func (s *ProvisioningService) GetUser(ctx context.Context, id domain.UserID) (*domain.User, error) {
+	return s.userRepository.GetByID(ctx, id)
+}
+
+// CreateUser adds the user to its team, or to the default team when it has none; a missing team is created.
+func (s *ProvisioningService) CreateUser(ctx context.Context, user domain.User) (*domain.User, error) {
+	if user.TeamName == "" {
+		user.TeamName = s.defaultTeam
+	}
+	user.Email = strings.TrimSpace(user.Email)
+
+	var created *domain.User
+	err := s.txManager.WithinTransaction(ctx, func(txCtx context.Context) error {
+		_, err := s.userRepository.GetByID(txCtx, user.ID)
+		switch {
+		case err == nil:
+			return domain.ErrUserAlreadyExists
+		case !errors.Is(err, domain.ErrUserNotFound):
+			return err
+		}
+
+		if err := s.ensureTeam(txCtx, user.TeamName); err != nil {
+			return err
+		}
+
+		if err := s.userRepository.UpsertBatch(txCtx, []domain.User{user}); err != nil {
+			return err
+		}
+		if user.Email != "" {
+			if err := s.userRepository.Update(txCtx, &user); err != nil {
+				return err
+			}
+		}
+
+		created, err = s.userRepository.GetByID(txCtx, user.ID)
+		return err
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+// ReplaceUser updates the username, email and activity of the user; its team is managed through groups.
+// Deactivation hands the open reviews of the user over.
+func (s *ProvisioningService) ReplaceUser(ctx context.Context, user domain.User) (*domain.User, error) {
+	var updated *domain.User
+	err := s.txManager.WithinTransaction(ctx, func(txCtx context.Context) error {
+		current, err := s.userRepository.GetByID(txCtx, user.ID)
+		if err != nil {
+			return err
+		}
+
+		current.Username = user.Username
+		current.Email = strings.TrimSpace(user.Email)
+		if err := s.userRepository.Update(txCtx, current); err != nil {
+			return err
+		}
+
+		if current.IsActive == user.IsActive {
+			updated = current
+			return nil
+		}
+
+		updated, _, err = s.userService.SetIsActive(txCtx, user.ID, user.IsActive, true)
+		return err
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+// DeactivateUser stands for deleting the user: its review history is kept.
+func (s *ProvisioningService) DeactivateUser(ctx context.Context, id domain.UserID) (*domain.User, error) {
+	user, _, err := s.userService.SetIsActive(ctx, id, false, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+func (s *ProvisioningService) ListGroups(
+	ctx context.Context,
+	filter domain.ProvisioningGroupFilter,
+) ([]domain.Team, error) {
+	teams, err := s.teamRepository.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	matched := make([]domain.Team, 0, len(teams))
+	for _, team := range teams {
+		if filter.Matches(team) {
+			matched = append(matched, team)
+		}
+	}
+
+	return matched, nil
+}
+
+func (s *ProvisioningService) GetGroup(ctx context.Context, name domain.TeamName) (*domain.Team, error) {
+	return s.teamRepository.GetByName(ctx, name)
+}
+
+func (s *ProvisioningService) CreateGroup(
+	ctx context.Context,
+	name domain.TeamName,
+	memberIDs []domain.UserID,
+) (*domain.Team, error) {
+	var team *domain.Team
+	err := s.txManager.WithinTransaction(ctx, func(txCtx context.Context) error {
+		_, err := s.teamRepository.GetByName(txCtx, name)
+		switch {
+		case err == nil:
+			return domain.ErrTeamAlreadyExists
+		case !errors.Is(err, domain.ErrTeamNotFound):
+			return err
+		}
+
+		team, err = s.syncGroup(txCtx, name, memberIDs)
+		return err
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return team, nil
+}
+
+// UpdateGroupMembers applies the operations to the active members of the team. Added users join the team
+// active; removed ones are deactivated and hand their reviews over.
+func (s *ProvisioningService) UpdateGroupMembers(
+	ctx context.Context,
+	name domain.TeamName,
+	ops []domain.GroupMembersOp,
+) (*domain.Team, error) {
+	var team *domain.Team
+	err := s.txManager.WithinTransaction(ctx, func(txCtx context.Context) error {
+		current, err := s.teamRepository.GetByName(txCtx, name)
+		if err != nil {
+			return err
+		}
+
+		members := domain.GroupMembers(*current)
+		memberIDs := make([]domain.UserID, 0, len(members))
+		for _, member := range members {
+			memberIDs = append(memberIDs, member.ID)
+		}
+
+		team, err = s.syncGroup(txCtx, name, domain.ApplyGroupMembersOps(memberIDs, ops))
+		return err
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return team, nil
+}
+
+// syncGroup makes the users the active members of the team, keeping their other attributes.
+func (s *ProvisioningService) syncGroup(
+	ctx context.Context,
+	name domain.TeamName,
+	memberIDs []domain.UserID,
+) (*domain.Team, error) {
+	team := domain.Team{Name: name, Members: make([]domain.TeamMember, 0, len(memberIDs))}
+	for _, id := range memberIDs {
+		user, err := s.userRepository.GetByID(ctx, id)
+		if err != nil {
+			if errors.Is(err, domain.ErrUserNotFound) {
+				return nil, fmt.Errorf("%w: unknown user %s", domain.ErrInvalidGroupMember, id)
+			}
+			return nil, err
+		}
+
+		team.Members = append(team.Members, domain.TeamMember{
+			ID:        user.ID,
+			Username:  user.Username,
+			IsActive:  true,
+			Seniority: user.Seniority,
+			Tags:      user.Tags,
+		})
+	}
+
+	if _, err := s.teamSyncService.Sync(ctx, team); err != nil {
+		return nil, err
+	}
+
+	return s.teamRepository.GetByName(ctx, name)
+}
+
+func (s *ProvisioningService) ensureTeam(ctx context.Context, name domain.TeamName) error {
+	_, err := s.teamRepository.GetByName(ctx, name)
+	if !errors.Is(err, domain.ErrTeamNotFound) {
+		return err
+	}
+
+	_, err = s.teamService.Create(ctx, &domain.Team{Name: name})
+	return err
+}
@@ -0,0 +1,176 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"PrService/src/internal/application/mocks"
+	"PrService/src/internal/domain"
+
+	"go.uber.org/mock/gomock"
+)
+
+type provisioningFixture struct {
+	service  *ProvisioningService
+	teamRepo *mocks.MockTeamRepository
+	userRepo *mocks.MockUserRepository
+	prRepo   *mocks.MockPullRequestRepository
+}
+
+func newProvisioningFixture(t *testing.T) provisioningFixture {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	f := provisioningFixture{
+		teamRepo: mocks.NewMockTeamRepository(ctrl),
+		userRepo: mocks.NewMockUserRepository(ctrl),
+		prRepo:   mocks.NewMockPullRequestRepository(ctrl),
+	}
+
+	txMgr := passthroughTxManager(ctrl)
+	publisher := anyEventPublisher(ctrl)
+	historyRepo := mocks.NewMockPullRequestHistoryRepository(ctrl)
+	historyRepo.EXPECT().Append(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	teams := NewTeamService(f.teamRepo, f.userRepo, txMgr, publisher)
+	users := NewUserService(
+		f.userRepo,
+		f.prRepo,
+		nil,
+		historyRepo,
+		NewPullRequestService(f.prRepo, f.teamRepo, txMgr, publisher),
+		txMgr,
+		publisher,
+	)
+	f.service = NewProvisioningService(
+		f.userRepo,
+		f.teamRepo,
+		teams,
+		users,
+		NewTeamSyncService(teams, users, txMgr),
+		txMgr,
+		"unassigned",
+	)
+
+	return f
+}
+
+func TestProvisioningService_CreateUser_JoinsDefaultTeam(t *testing.T) {
+	f := newProvisioningFixture(t)
+
+	user := domain.User{ID: "u1", Username: "Alice", TeamName: "unassigned", IsActive: true, Email: "alice@example.com"}
+
+	gomock.InOrder(
+		f.userRepo.EXPECT().GetByID(gomock.Any(), domain.UserID("u1")).Return(nil, domain.ErrUserNotFound),
+		f.teamRepo.EXPECT().GetByName(gomock.Any(), domain.TeamName("unassigned")).Return(nil, domain.ErrTeamNotFound),
+		f.teamRepo.EXPECT().Create(gomock.Any(), domain.TeamName("unassigned")).Return(nil),
+		f.userRepo.EXPECT().UpsertBatch(gomock.Any(), []domain.User{}).Return(nil),
+		f.userRepo.EXPECT().UpsertBatch(gomock.Any(), []domain.User{user}).Return(nil),
+		f.userRepo.EXPECT().Update(gomock.Any(), &user).Return(nil),
+		f.userRepo.EXPECT().GetByID(gomock.Any(), domain.UserID("u1")).Return(&user, nil),
+	)
+
+	created, err := f.service.CreateUser(context.Background(), domain.User{
+		ID:       "u1",
+		Username: "Alice",
+		IsActive: true,
+		Email:    " alice@example.com ",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if created.TeamName != "unassigned" {
+		t.Fatalf("expected the default team, got %+v", created)
+	}
+}
+
+func TestProvisioningService_CreateUser_AlreadyExists(t *testing.T) {
+	f := newProvisioningFixture(t)
+
+	f.userRepo.EXPECT().GetByID(gomock.Any(), domain.UserID("u1")).Return(&domain.User{ID: "u1"}, nil)
+
+	_, err := f.service.CreateUser(context.Background(), domain.User{ID: "u1", Username: "Alice"})
+	if !errors.Is(err, domain.ErrUserAlreadyExists) {
+		t.Fatalf("expected ErrUserAlreadyExists, got %v", err)
+	}
+}
+
+func TestProvisioningService_ReplaceUser_DeactivationHandsReviewsOver(t *testing.T) {
+	f := newProvisioningFixture(t)
+
+	current := domain.User{ID: "u1", Username: "Alice", TeamName: "backend", IsActive: true}
+	renamed := current
+	renamed.Username = "Alice Smith"
+	deactivated := renamed
+	deactivated.IsActive = false
+
+	gomock.InOrder(
+		f.userRepo.EXPECT().GetByID(gomock.Any(), domain.UserID("u1")).Return(&current, nil),
+		f.userRepo.EXPECT().Update(gomock.Any(), &renamed).Return(nil),
+		f.userRepo.EXPECT().GetByID(gomock.Any(), domain.UserID("u1")).Return(&renamed, nil),
+		f.userRepo.EXPECT().Update(gomock.Any(), &deactivated).Return(nil),
+		f.prRepo.EXPECT().ListByReviewer(gomock.Any(), domain.UserID("u1")).Return(nil, nil),
+	)
+
+	updated, err := f.service.ReplaceUser(context.Background(), domain.User{
+		ID:       "u1",
+		Username: "Alice Smith",
+		IsActive: false,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if updated.IsActive || updated.Username != "Alice Smith" || updated.TeamName != "backend" {
+		t.Fatalf("unexpected user: %+v", updated)
+	}
+}
+
+func TestProvisioningService_UpdateGroupMembers(t *testing.T) {
+	f := newProvisioningFixture(t)
+
+	backend := &domain.Team{Name: "backend", Members: []domain.TeamMember{
+		{ID: "u1", Username: "Alice", IsActive: true},
+		{ID: "u2", Username: "Bob", IsActive: true},
+		{ID: "u3", Username: "Carol", IsActive: false},
+	}}
+	f.teamRepo.EXPECT().GetByName(gomock.Any(), domain.TeamName("backend")).Return(backend, nil).AnyTimes()
+	f.teamRepo.EXPECT().GetByName(gomock.Any(), domain.TeamName("frontend")).Return(&domain.Team{Name: "frontend"}, nil)
+	f.userRepo.EXPECT().GetByID(gomock.Any(), domain.UserID("u1")).
+		Return(&domain.User{ID: "u1", Username: "Alice", TeamName: "backend", IsActive: true}, nil)
+	f.userRepo.EXPECT().GetByID(gomock.Any(), domain.UserID("u4")).
+		Return(&domain.User{ID: "u4", Username: "Dave", TeamName: "frontend", IsActive: true}, nil)
+	f.userRepo.EXPECT().List(gomock.Any(), domain.UserFilter{}).Return([]domain.User{
+		{ID: "u1", Username: "Alice", TeamName: "backend", IsActive: true},
+		{ID: "u2", Username: "Bob", TeamName: "backend", IsActive: true},
+		{ID: "u3", Username: "Carol", TeamName: "backend", IsActive: false},
+		{ID: "u4", Username: "Dave", TeamName: "frontend", IsActive: true},
+	}, nil)
+	f.userRepo.EXPECT().UpsertBatch(gomock.Any(), []domain.User{
+		{ID: "u4", Username: "Dave", TeamName: "backend", IsActive: true},
+		{ID: "u2", Username: "Bob", TeamName: "backend", IsActive: false},
+	}).Return(nil)
+	f.prRepo.EXPECT().ListByReviewer(gomock.Any(), domain.UserID("u2")).Return(nil, nil)
+
+	_, err := f.service.UpdateGroupMembers(context.Background(), "backend", []domain.GroupMembersOp{
+		{Kind: domain.GroupMembersRemove, UserIDs: []domain.UserID{"u2"}},
+		{Kind: domain.GroupMembersAdd, UserIDs: []domain.UserID{"u4"}},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestProvisioningService_UpdateGroupMembers_UnknownUser(t *testing.T) {
+	f := newProvisioningFixture(t)
+
+	f.teamRepo.EXPECT().GetByName(gomock.Any(), domain.TeamName("backend")).Return(&domain.Team{Name: "backend"}, nil)
+	f.userRepo.EXPECT().GetByID(gomock.Any(), domain.UserID("ghost")).Return(nil, domain.ErrUserNotFound)
+
+	_, err := f.service.UpdateGroupMembers(context.Background(), "backend", []domain.GroupMembersOp{
+		{Kind: domain.GroupMembersAdd, UserIDs: []domain.UserID{"ghost"}},
+	})
+	if !errors.Is(err, domain.ErrInvalidGroupMember) {
+		t.Fatalf("expected ErrInvalidGroupMember, got %v", err)
+	}
+}
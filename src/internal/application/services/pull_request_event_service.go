@@ -0,0 +1,139 @@
+package services
+
+import (
+	"context"
+	"errors"
+
+	"PrService/src/internal/application/contracts"
+
+	"PrService/src/internal/domain"
+)
+
+// PullRequestEventService applies pull request lifecycle events received from code hosting webhooks.
+type PullRequestEventService struct {
+	pullRequestService        domain.PullRequestService
+	reviewSLAService          domain.ReviewSLAService
+	externalAccountRepository domain.ExternalAccountRepository
+	webhookDeliveryRepository domain.WebhookDeliveryRepository
+	txManager                 contracts.TxManager
+}
+
+func NewPullRequestEventService(
+	pullRequestService domain.PullRequestService,
+	reviewSLAService domain.ReviewSLAService,
+	externalAccountRepository domain.ExternalAccountRepository,
+	webhookDeliveryRepository domain.WebhookDeliveryRepository,
+	txManager contracts.TxManager,
+) *PullRequestEventService {
+	return &PullRequestEventService{
+		pullRequestService:        pullRequestService,
+		reviewSLAService:          reviewSLAService,
+		externalAccountRepository: externalAccountRepository,
+		webhookDeliveryRepository: webhookDeliveryRepository,
+		txManager:                 txManager,
+	}
+}
+
+// Handle applies the event and returns the affected pull request.
+// It returns nil without an error when the event has nothing to apply: a delivery that was
+// already applied, a redelivered "opened", a review by a user who is unknown or not assigned
+// or an action the service does not track.
+// The delivery is recorded in the same transaction, so a failed event can be redelivered.
+func (s *PullRequestEventService) Handle(
+	ctx context.Context,
+	event domain.PullRequestEvent,
+) (*domain.PullRequest, error) {
+	var pullRequest *domain.PullRequest
+	err := s.txManager.WithinTransaction(ctx, func(txCtx context.Context) error {
+		if event.DeliveryID != "" {
+			registered, err := s.webhookDeliveryRepository.Register(txCtx, event.Provider, event.DeliveryID)
+			if err != nil {
+				return err
+			}
+			if !registered {
+				return nil
+			}
+		}
+
+		pr, err := s.apply(txCtx, event)
+		if err != nil {
+			return err
+		}
+		pullRequest = pr
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return pullRequest, nil
+}
+
+func (s *PullRequestEventService) apply(
+	ctx context.Context,
+	event domain.PullRequestEvent,
+) (*domain.PullRequest, error) {
+	switch event.Action {
+	case domain.PullRequestEventOpened:
+		return s.open(ctx, event)
+	case domain.PullRequestEventMerged:
+		return s.pullRequestService.Merge(ctx, event.PullRequestID)
+	case domain.PullRequestEventClosed:
+		return s.pullRequestService.Close(ctx, event.PullRequestID)
+	case domain.PullRequestEventReadyForReview:
+		return s.pullRequestService.MarkReady(ctx, event.PullRequestID)
+	case domain.PullRequestEventReopened:
+		return s.pullRequestService.Reopen(ctx, event.PullRequestID)
+	case domain.PullRequestEventUpdated:
+		return s.pullRequestService.UpdateDetails(ctx, event.PullRequestID, event.Title, event.Draft)
+	case domain.PullRequestEventReviewed:
+		return s.review(ctx, event)
+	default:
+		return nil, nil
+	}
+}
+
+func (s *PullRequestEventService) open(
+	ctx context.Context,
+	event domain.PullRequestEvent,
+) (*domain.PullRequest, error) {
+	login := domain.NormalizeLogin(event.AuthorLogin)
+	authorID, err := s.externalAccountRepository.GetUserID(ctx, event.Provider, login)
+	if err != nil {
+		return nil, err
+	}
+
+	pr, err := s.pullRequestService.Create(ctx, event.PullRequestID, event.Title, authorID,
+		domain.CreatePullRequestOptions{Draft: event.Draft},
+	)
+	if errors.Is(err, domain.ErrPullRequestExists) {
+		return nil, nil
+	}
+
+	return pr, err
+}
+
+// review ends the review SLA of the reviewer. Anyone may comment on a pull request on the code host,
+// so reviews by users the service does not know or has not assigned are skipped.
+func (s *PullRequestEventService) review(
+	ctx context.Context,
+	event domain.PullRequestEvent,
+) (*domain.PullRequest, error) {
+	login := domain.NormalizeLogin(event.ReviewerLogin)
+	reviewerID, err := s.externalAccountRepository.GetUserID(ctx, event.Provider, login)
+	if errors.Is(err, domain.ErrExternalAccountNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	pr, err := s.reviewSLAService.MarkReviewed(ctx, event.PullRequestID, reviewerID)
+	if errors.Is(err, domain.ErrReviewerIsNotAssigned) {
+		return nil, nil
+	}
+
+	return pr, err
+}
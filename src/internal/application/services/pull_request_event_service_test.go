@@ -0,0 +1,358 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"PrService/src/internal/application/mocks"
+	"PrService/src/internal/domain"
+
+	"go.uber.org/mock/gomock"
+)
+
+type eventServiceMocks struct {
+	prRepo       *mocks.MockPullRequestRepository
+	teamRepo     *mocks.MockTeamRepository
+	slaRepo      *mocks.MockReviewSLARepository
+	accountRepo  *mocks.MockExternalAccountRepository
+	deliveryRepo *mocks.MockWebhookDeliveryRepository
+	txMgr        *mocks.MockTxManager
+}
+
+func newPullRequestEventService(t *testing.T) (*PullRequestEventService, eventServiceMocks) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	m := eventServiceMocks{
+		prRepo:       mocks.NewMockPullRequestRepository(ctrl),
+		teamRepo:     mocks.NewMockTeamRepository(ctrl),
+		slaRepo:      mocks.NewMockReviewSLARepository(ctrl),
+		accountRepo:  mocks.NewMockExternalAccountRepository(ctrl),
+		deliveryRepo: mocks.NewMockWebhookDeliveryRepository(ctrl),
+		txMgr:        mocks.NewMockTxManager(ctrl),
+	}
+
+	publisher := anyEventPublisher(ctrl)
+	pullRequests := NewPullRequestService(m.prRepo, m.teamRepo, m.txMgr, publisher)
+	reviewSLAs := NewReviewSLAService(
+		m.slaRepo,
+		m.teamRepo,
+		m.prRepo,
+		mocks.NewMockPullRequestHistoryRepository(ctrl),
+		pullRequests,
+		m.txMgr,
+		publisher,
+	)
+
+	service := NewPullRequestEventService(
+		pullRequests,
+		reviewSLAs,
+		m.accountRepo,
+		m.deliveryRepo,
+		m.txMgr,
+	)
+
+	return service, m
+}
+
+// expectTransactions lets the event transaction and the nested pull request transaction run.
+func (m eventServiceMocks) expectTransactions(times int) {
+	m.txMgr.
+		EXPECT().
+		WithinTransaction(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(c context.Context, fn func(context.Context) error) error {
+			return fn(c)
+		}).
+		Times(times)
+}
+
+func TestPullRequestEventService_Handle_OpenedDraft(t *testing.T) {
+	service, m := newPullRequestEventService(t)
+
+	ctx := context.Background()
+	authorID := domain.UserID("u1")
+
+	m.expectTransactions(2)
+
+	m.deliveryRepo.
+		EXPECT().
+		Register(gomock.Any(), domain.ExternalProviderGitHub, "delivery-1").
+		Return(true, nil)
+
+	m.accountRepo.
+		EXPECT().
+		GetUserID(gomock.Any(), domain.ExternalProviderGitHub, "octocat").
+		Return(authorID, nil)
+
+	m.teamRepo.
+		EXPECT().
+		GetByUserID(gomock.Any(), authorID).
+		Return(&domain.Team{
+			Name: "backend",
+			Members: []domain.TeamMember{
+				{ID: authorID, IsActive: true},
+				{ID: "u2", IsActive: true},
+			},
+		}, nil)
+
+	m.prRepo.
+		EXPECT().
+		Create(gomock.Any(), gomock.Any()).
+		Return(nil)
+
+	pr, err := service.Handle(ctx, domain.PullRequestEvent{
+		Provider:      domain.ExternalProviderGitHub,
+		DeliveryID:    "delivery-1",
+		Action:        domain.PullRequestEventOpened,
+		PullRequestID: "octo-org/pr-service#42",
+		Title:         "Add reviewer load balancing",
+		AuthorLogin:   "Octocat",
+		Draft:         true,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if pr.AuthorID != authorID || pr.Status != domain.PullRequestStatusDraft {
+		t.Fatalf("expected draft PR of %s, got %+v", authorID, pr)
+	}
+	if len(pr.AssignedReviewers) != 1 || pr.AssignedReviewers[0] != "u2" {
+		t.Fatalf("expected reviewers to be assigned on draft, got %v", pr.AssignedReviewers)
+	}
+}
+
+func TestPullRequestEventService_Handle_DuplicateDelivery(t *testing.T) {
+	service, m := newPullRequestEventService(t)
+
+	m.expectTransactions(1)
+
+	m.deliveryRepo.
+		EXPECT().
+		Register(gomock.Any(), domain.ExternalProviderGitLab, "uuid-1").
+		Return(false, nil)
+
+	pr, err := service.Handle(context.Background(), domain.PullRequestEvent{
+		Provider:      domain.ExternalProviderGitLab,
+		DeliveryID:    "uuid-1",
+		Action:        domain.PullRequestEventMerged,
+		PullRequestID: "group/project!7",
+	})
+	if err != nil || pr != nil {
+		t.Fatalf("expected duplicate delivery to be ignored, got pr=%+v err=%v", pr, err)
+	}
+}
+
+func TestPullRequestEventService_Handle_OpenedForExistingPR(t *testing.T) {
+	service, m := newPullRequestEventService(t)
+
+	m.expectTransactions(2)
+
+	m.accountRepo.
+		EXPECT().
+		GetUserID(gomock.Any(), domain.ExternalProviderGitHub, "octocat").
+		Return(domain.UserID("u1"), nil)
+
+	m.teamRepo.
+		EXPECT().
+		GetByUserID(gomock.Any(), domain.UserID("u1")).
+		Return(&domain.Team{Name: "backend"}, nil)
+
+	m.prRepo.
+		EXPECT().
+		Create(gomock.Any(), gomock.Any()).
+		Return(domain.ErrPullRequestExists)
+
+	pr, err := service.Handle(context.Background(), domain.PullRequestEvent{
+		Provider:      domain.ExternalProviderGitHub,
+		Action:        domain.PullRequestEventOpened,
+		PullRequestID: "octo-org/pr-service#42",
+		AuthorLogin:   "octocat",
+	})
+	if err != nil || pr != nil {
+		t.Fatalf("expected existing PR to be ignored, got pr=%+v err=%v", pr, err)
+	}
+}
+
+func TestPullRequestEventService_Handle_UnknownAuthor(t *testing.T) {
+	service, m := newPullRequestEventService(t)
+
+	m.expectTransactions(1)
+
+	m.deliveryRepo.
+		EXPECT().
+		Register(gomock.Any(), domain.ExternalProviderGitHub, "delivery-2").
+		Return(true, nil)
+
+	m.accountRepo.
+		EXPECT().
+		GetUserID(gomock.Any(), domain.ExternalProviderGitHub, "stranger").
+		Return(domain.UserID(""), domain.ErrExternalAccountNotFound)
+
+	_, err := service.Handle(context.Background(), domain.PullRequestEvent{
+		Provider:    domain.ExternalProviderGitHub,
+		DeliveryID:  "delivery-2",
+		Action:      domain.PullRequestEventOpened,
+		AuthorLogin: "stranger",
+	})
+	if !errors.Is(err, domain.ErrExternalAccountNotFound) {
+		t.Fatalf("expected ErrExternalAccountNotFound, got %v", err)
+	}
+}
+
+func TestPullRequestEventService_Handle_StatusEvents(t *testing.T) {
+	tests := []struct {
+		name       string
+		event      domain.PullRequestEvent
+		from       domain.PullRequest
+		wantStatus domain.PullRequestStatus
+		wantName   string
+	}{
+		{
+			name:       "closed",
+			event:      domain.PullRequestEvent{Action: domain.PullRequestEventClosed},
+			from:       domain.PullRequest{Name: "Fix", Status: domain.PullRequestStatusOpen},
+			wantStatus: domain.PullRequestStatusClosed,
+			wantName:   "Fix",
+		},
+		{
+			name:       "reopened",
+			event:      domain.PullRequestEvent{Action: domain.PullRequestEventReopened},
+			from:       domain.PullRequest{Name: "Fix", Status: domain.PullRequestStatusClosed},
+			wantStatus: domain.PullRequestStatusOpen,
+			wantName:   "Fix",
+		},
+		{
+			name: "updated to draft with new title",
+			event: domain.PullRequestEvent{
+				Action: domain.PullRequestEventUpdated,
+				Title:  "Draft: Fix",
+				Draft:  true,
+			},
+			from:       domain.PullRequest{Name: "Fix", Status: domain.PullRequestStatusOpen},
+			wantStatus: domain.PullRequestStatusDraft,
+			wantName:   "Draft: Fix",
+		},
+		{
+			name:       "updated merged keeps status",
+			event:      domain.PullRequestEvent{Action: domain.PullRequestEventUpdated, Title: "Fix v2"},
+			from:       domain.PullRequest{Name: "Fix", Status: domain.PullRequestStatusMerged},
+			wantStatus: domain.PullRequestStatusMerged,
+			wantName:   "Fix v2",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, m := newPullRequestEventService(t)
+
+			prID := domain.PullRequestID("group/project!7")
+			tt.event.Provider = domain.ExternalProviderGitLab
+			tt.event.PullRequestID = prID
+			tt.from.ID = prID
+
+			m.expectTransactions(2)
+
+			m.prRepo.
+				EXPECT().
+				GetByID(gomock.Any(), prID).
+				Return(&tt.from, nil)
+
+			m.prRepo.
+				EXPECT().
+				Update(gomock.Any(), gomock.Any()).
+				Return(nil)
+
+			pr, err := service.Handle(context.Background(), tt.event)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if pr.Status != tt.wantStatus || pr.Name != tt.wantName {
+				t.Fatalf("expected %s %q, got %s %q", tt.wantStatus, tt.wantName, pr.Status, pr.Name)
+			}
+		})
+	}
+}
+
+func TestPullRequestEventService_Handle_UpdatedWithoutChanges(t *testing.T) {
+	service, m := newPullRequestEventService(t)
+
+	prID := domain.PullRequestID("group/project!7")
+
+	m.expectTransactions(2)
+
+	m.prRepo.
+		EXPECT().
+		GetByID(gomock.Any(), prID).
+		Return(&domain.PullRequest{ID: prID, Name: "Fix", Status: domain.PullRequestStatusOpen}, nil)
+
+	_, err := service.Handle(context.Background(), domain.PullRequestEvent{
+		Provider:      domain.ExternalProviderGitLab,
+		Action:        domain.PullRequestEventUpdated,
+		PullRequestID: prID,
+		Title:         "Fix",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestPullRequestEventService_Handle_Reviewed(t *testing.T) {
+	prID := domain.PullRequestID("octo-org/pr-service#42")
+
+	tests := []struct {
+		name       string
+		accountErr error
+		markErr    error
+		wantPR     bool
+	}{
+		{name: "assigned reviewer", wantPR: true},
+		{name: "unknown login is skipped", accountErr: domain.ErrExternalAccountNotFound},
+		{name: "reviewer not assigned is skipped", markErr: domain.ErrReviewerIsNotAssigned},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, m := newPullRequestEventService(t)
+
+			m.txMgr.
+				EXPECT().
+				WithinTransaction(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(c context.Context, fn func(context.Context) error) error {
+					return fn(c)
+				}).
+				AnyTimes()
+
+			m.accountRepo.
+				EXPECT().
+				GetUserID(gomock.Any(), domain.ExternalProviderGitHub, "hubot").
+				Return(domain.UserID("u2"), tt.accountErr)
+
+			if tt.accountErr == nil {
+				m.prRepo.
+					EXPECT().
+					GetByID(gomock.Any(), prID).
+					Return(&domain.PullRequest{ID: prID, Status: domain.PullRequestStatusOpen}, nil)
+
+				m.slaRepo.
+					EXPECT().
+					MarkReviewed(gomock.Any(), prID, domain.UserID("u2"), gomock.Any()).
+					Return(tt.markErr)
+			}
+
+			pr, err := service.Handle(context.Background(), domain.PullRequestEvent{
+				Provider:      domain.ExternalProviderGitHub,
+				Action:        domain.PullRequestEventReviewed,
+				PullRequestID: prID,
+				ReviewerLogin: "Hubot",
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if (pr != nil) != tt.wantPR {
+				t.Fatalf("expected pull request returned: %v, got %+v", tt.wantPR, pr)
+			}
+		})
+	}
+}
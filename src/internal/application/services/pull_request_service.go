@@ -15,17 +15,20 @@ type PullRequestService struct {
 	pullRequestRepository domain.PullRequestRepository
 	teamRepository        domain.TeamRepository
 	txManager             contracts.TxManager
+	eventPublisher        contracts.EventPublisher
 }
 
 func NewPullRequestService(
 	pullRequestRepository domain.PullRequestRepository,
 	teamRepository domain.TeamRepository,
 	txManager contracts.TxManager,
+	eventPublisher contracts.EventPublisher,
 ) *PullRequestService {
 	return &PullRequestService{
 		pullRequestRepository: pullRequestRepository,
 		teamRepository:        teamRepository,
 		txManager:             txManager,
+		eventPublisher:        eventPublisher,
 	}
 }
 
@@ -34,14 +37,25 @@ func (s *PullRequestService) Create(
 	id domain.PullRequestID,
 	pullRequestName string,
 	userID domain.UserID,
+	opts domain.CreatePullRequestOptions,
 ) (*domain.PullRequest, error) {
+	if actor, ok := domain.ActorFromContext(ctx); ok && !actor.CanActFor(userID) {
+		return nil, domain.ErrForbidden
+	}
+
 	now := time.Now()
+	status := domain.PullRequestStatusOpen
+	if opts.Draft {
+		status = domain.PullRequestStatusDraft
+	}
 	var pullRequest = &domain.PullRequest{
 		ID:                id,
 		Name:              pullRequestName,
 		AuthorID:          userID,
-		Status:            domain.PullRequestStatusOpen,
+		Status:            status,
 		AssignedReviewers: []domain.UserID{},
+		ReviewerTeams:     map[domain.UserID]domain.TeamName{},
+		Tags:              opts.Tags,
 		CreatedAt:         &now,
 		MergedAt:          nil,
 	}
@@ -51,9 +65,35 @@ func (s *PullRequestService) Create(
 			return err
 		}
 
-		pullRequest.AssignedReviewers = assignReviewers(userID, *team)
+		state := assignmentState{
+			coveredTags:  make(map[string]bool, len(opts.Tags)),
+			changedFiles: opts.ChangedFiles,
+		}
+		if err := s.assignRequiredReviewers(txCtx, pullRequest, opts.RequiredReviewers, state); err != nil {
+			return err
+		}
+
+		authorTeamSlots := team.ReviewersLimit() - countTeamReviewers(*pullRequest, team.Name)
+		if err := s.fillTeamSlots(txCtx, pullRequest, *team, authorTeamSlots, state); err != nil {
+			return err
+		}
+
+		if err := s.assignExtraTeamReviewers(txCtx, pullRequest, opts.ExtraTeams, state); err != nil {
+			return err
+		}
 
-		return s.pullRequestRepository.Create(txCtx, pullRequest)
+		for _, tag := range missingTags(pullRequest.Tags, state.coveredTags) {
+			pullRequest.UnfilledSlots = append(pullRequest.UnfilledSlots, domain.UnfilledSlot{
+				TeamName: team.Name,
+				Tag:      tag,
+			})
+		}
+
+		if err := s.pullRequestRepository.Create(txCtx, pullRequest); err != nil {
+			return err
+		}
+
+		return s.eventPublisher.Publish(txCtx, domain.NewPullRequestEvent(domain.EventPullRequestCreated, *pullRequest))
 	})
 
 	if err != nil {
@@ -63,26 +103,153 @@ func (s *PullRequestService) Create(
 	return pullRequest, nil
 }
 
-func assignReviewers(authorID domain.UserID, team domain.Team) []domain.UserID {
-	members := append([]domain.TeamMember(nil), team.Members...)
-	reviewers := make([]domain.UserID, 0)
-	rand.Shuffle(len(members), func(i, j int) {
-		members[i], members[j] = members[j], members[i]
-	})
+func (s *PullRequestService) assignRequiredReviewers(
+	ctx context.Context,
+	pr *domain.PullRequest,
+	reviewerIDs []domain.UserID,
+	state assignmentState,
+) error {
+	for _, reviewerID := range reviewerIDs {
+		if slices.Contains(pr.AssignedReviewers, reviewerID) {
+			continue
+		}
 
-	for _, member := range members {
-		if member.ID == authorID || !member.IsActive {
+		if reviewerID == pr.AuthorID {
+			return domain.ErrReviewerIsAuthor
+		}
+
+		team, err := s.teamRepository.GetByUserID(ctx, reviewerID)
+		if err != nil {
+			return err
+		}
+
+		if !isActiveMember(*team, reviewerID) {
+			return domain.ErrReviewerInactive
+		}
+
+		if err := checkRules(*team, pr.AuthorID, reviewerID, pr.AssignedReviewers); err != nil {
+			return err
+		}
+
+		addReviewer(pr, reviewerID, team.Name)
+		setReviewerReason(pr, reviewerID, domain.ReviewerReason{
+			Source:   domain.ReviewerReasonRequired,
+			TeamName: team.Name,
+		})
+		coverTags(state.coveredTags, *team, reviewerID)
+	}
+
+	return nil
+}
+
+func (s *PullRequestService) assignExtraTeamReviewers(
+	ctx context.Context,
+	pr *domain.PullRequest,
+	teamNames []domain.TeamName,
+	state assignmentState,
+) error {
+	for _, teamName := range teamNames {
+		if countTeamReviewers(*pr, teamName) > 0 {
 			continue
 		}
 
-		if len(reviewers) == domain.PullRequestMaxReviewers {
-			break
+		team, err := s.teamRepository.GetByName(ctx, teamName)
+		if err != nil {
+			return err
+		}
+
+		if err := s.fillTeamSlots(ctx, pr, *team, 1, state); err != nil {
+			return err
 		}
+	}
+
+	return nil
+}
+
+// assignmentState carries the inputs and progress of reviewer assignment for a new pull request.
+type assignmentState struct {
+	coveredTags  map[string]bool
+	changedFiles []string
+}
 
-		reviewers = append(reviewers, member.ID)
+func (s *PullRequestService) fillTeamSlots(
+	ctx context.Context,
+	pr *domain.PullRequest,
+	team domain.Team,
+	slots int,
+	state assignmentState,
+) error {
+	req := pickRequest{
+		authorID:     pr.AuthorID,
+		team:         team,
+		assigned:     pr.AssignedReviewers,
+		limit:        slots,
+		tags:         missingTags(pr.Tags, state.coveredTags),
+		changedFiles: state.changedFiles,
 	}
 
-	return reviewers
+	if team.CodeOwners != nil && team.CodeOwners.FillStrategy == domain.FillStrategyLoad {
+		memberIDs := make([]domain.UserID, 0, len(team.Members))
+		for _, member := range team.Members {
+			memberIDs = append(memberIDs, member.ID)
+		}
+
+		loads, err := s.pullRequestRepository.CountOpenReviews(ctx, memberIDs)
+		if err != nil {
+			return err
+		}
+		req.loads = loads
+	}
+
+	res := pickReviewers(req)
+	for _, reviewer := range res.reviewers {
+		addReviewer(pr, reviewer, team.Name)
+		setReviewerReason(pr, reviewer, res.reasons[reviewer])
+		coverTags(state.coveredTags, team, reviewer)
+	}
+	pr.UnfilledSlots = append(pr.UnfilledSlots, res.unfilled...)
+
+	return nil
+}
+
+func setReviewerReason(pr *domain.PullRequest, reviewerID domain.UserID, reason domain.ReviewerReason) {
+	if pr.AssignmentReasons == nil {
+		pr.AssignmentReasons = map[domain.UserID]domain.ReviewerReason{}
+	}
+	pr.AssignmentReasons[reviewerID] = reason
+}
+
+func addReviewer(pr *domain.PullRequest, reviewerID domain.UserID, teamName domain.TeamName) {
+	pr.AssignedReviewers = append(pr.AssignedReviewers, reviewerID)
+	addReviewerTeam(pr, reviewerID, teamName)
+}
+
+func addReviewerTeam(pr *domain.PullRequest, reviewerID domain.UserID, teamName domain.TeamName) {
+	if pr.ReviewerTeams == nil {
+		pr.ReviewerTeams = map[domain.UserID]domain.TeamName{}
+	}
+	pr.ReviewerTeams[reviewerID] = teamName
+}
+
+func countTeamReviewers(pr domain.PullRequest, teamName domain.TeamName) int {
+	count := 0
+	for _, reviewer := range pr.AssignedReviewers {
+		if pr.ReviewerTeams[reviewer] == teamName {
+			count++
+		}
+	}
+
+	return count
+}
+
+func isActiveMember(team domain.Team, userID domain.UserID) bool {
+	for _, member := range team.Members {
+		if member.ID == userID {
+			return member.IsActive
+		}
+	}
+
+	return false
 }
 
 func (s *PullRequestService) Merge(ctx context.Context, id domain.PullRequestID) (*domain.PullRequest, error) {
@@ -95,16 +262,182 @@ func (s *PullRequestService) Merge(ctx context.Context, id domain.PullRequestID)
 			return err
 		}
 
+		if actor, ok := domain.ActorFromContext(txCtx); ok && !actor.CanMerge(*pr) {
+			return domain.ErrForbidden
+		}
+
 		if pr.Status == domain.PullRequestStatusMerged {
 			pullRequest = pr
 			return nil
 		}
 
+		if pr.Status == domain.PullRequestStatusClosed {
+			return domain.ErrPullRequestClosed
+		}
+
 		pr.Status = domain.PullRequestStatusMerged
 		pr.MergedAt = &now
 		pullRequest = pr
 
-		return s.pullRequestRepository.Update(txCtx, pr)
+		if err := s.pullRequestRepository.Update(txCtx, pr); err != nil {
+			return err
+		}
+
+		return s.eventPublisher.Publish(txCtx, domain.NewPullRequestEvent(domain.EventPullRequestMerged, *pr))
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return pullRequest, nil
+}
+
+// Close marks an unmerged pull request as CLOSED. Closing a closed pull request is a no-op.
+func (s *PullRequestService) Close(ctx context.Context, id domain.PullRequestID) (*domain.PullRequest, error) {
+	var pullRequest *domain.PullRequest
+	err := s.txManager.WithinTransaction(ctx, func(txCtx context.Context) error {
+		pr, err := s.pullRequestRepository.GetByID(txCtx, id)
+		if err != nil {
+			return err
+		}
+
+		switch pr.Status {
+		case domain.PullRequestStatusMerged:
+			return domain.ErrPullRequestMerged
+		case domain.PullRequestStatusClosed:
+			pullRequest = pr
+			return nil
+		}
+
+		pr.Status = domain.PullRequestStatusClosed
+		pullRequest = pr
+
+		if err := s.pullRequestRepository.Update(txCtx, pr); err != nil {
+			return err
+		}
+
+		return s.eventPublisher.Publish(txCtx, domain.NewPullRequestEvent(domain.EventPullRequestClosed, *pr))
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return pullRequest, nil
+}
+
+// MarkReady moves a DRAFT pull request to OPEN. Marking an open pull request is a no-op.
+func (s *PullRequestService) MarkReady(ctx context.Context, id domain.PullRequestID) (*domain.PullRequest, error) {
+	var pullRequest *domain.PullRequest
+	err := s.txManager.WithinTransaction(ctx, func(txCtx context.Context) error {
+		pr, err := s.pullRequestRepository.GetByID(txCtx, id)
+		if err != nil {
+			return err
+		}
+
+		switch pr.Status {
+		case domain.PullRequestStatusMerged:
+			return domain.ErrPullRequestMerged
+		case domain.PullRequestStatusClosed:
+			return domain.ErrPullRequestClosed
+		case domain.PullRequestStatusOpen:
+			pullRequest = pr
+			return nil
+		}
+
+		pr.Status = domain.PullRequestStatusOpen
+		pullRequest = pr
+
+		if err := s.pullRequestRepository.Update(txCtx, pr); err != nil {
+			return err
+		}
+
+		return s.eventPublisher.Publish(txCtx, domain.NewPullRequestEvent(domain.EventPullRequestUpdated, *pr))
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return pullRequest, nil
+}
+
+// Reopen moves a CLOSED pull request back to OPEN. Reopening an unclosed pull request is a no-op.
+func (s *PullRequestService) Reopen(ctx context.Context, id domain.PullRequestID) (*domain.PullRequest, error) {
+	var pullRequest *domain.PullRequest
+	err := s.txManager.WithinTransaction(ctx, func(txCtx context.Context) error {
+		pr, err := s.pullRequestRepository.GetByID(txCtx, id)
+		if err != nil {
+			return err
+		}
+
+		switch pr.Status {
+		case domain.PullRequestStatusMerged:
+			return domain.ErrPullRequestMerged
+		case domain.PullRequestStatusOpen, domain.PullRequestStatusDraft:
+			pullRequest = pr
+			return nil
+		}
+
+		pr.Status = domain.PullRequestStatusOpen
+		pullRequest = pr
+
+		if err := s.pullRequestRepository.Update(txCtx, pr); err != nil {
+			return err
+		}
+
+		return s.eventPublisher.Publish(txCtx, domain.NewPullRequestEvent(domain.EventPullRequestReopened, *pr))
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return pullRequest, nil
+}
+
+// UpdateDetails renames the pull request and, unless it is merged or closed, moves it
+// between DRAFT and OPEN to match draft. An empty name keeps the current one.
+func (s *PullRequestService) UpdateDetails(
+	ctx context.Context,
+	id domain.PullRequestID,
+	name string,
+	draft bool,
+) (*domain.PullRequest, error) {
+	var pullRequest *domain.PullRequest
+	err := s.txManager.WithinTransaction(ctx, func(txCtx context.Context) error {
+		pr, err := s.pullRequestRepository.GetByID(txCtx, id)
+		if err != nil {
+			return err
+		}
+		pullRequest = pr
+
+		changed := false
+		if name != "" && name != pr.Name {
+			pr.Name = name
+			changed = true
+		}
+
+		status := domain.PullRequestStatusOpen
+		if draft {
+			status = domain.PullRequestStatusDraft
+		}
+		isActive := pr.Status == domain.PullRequestStatusOpen || pr.Status == domain.PullRequestStatusDraft
+		if isActive && pr.Status != status {
+			pr.Status = status
+			changed = true
+		}
+
+		if !changed {
+			return nil
+		}
+
+		if err := s.pullRequestRepository.Update(txCtx, pr); err != nil {
+			return err
+		}
+
+		return s.eventPublisher.Publish(txCtx, domain.NewPullRequestEvent(domain.EventPullRequestUpdated, *pr))
 	})
 
 	if err != nil {
@@ -114,10 +447,13 @@ func (s *PullRequestService) Merge(ctx context.Context, id domain.PullRequestID)
 	return pullRequest, nil
 }
 
+// Reassign replaces oldRevID with newRevID, or with a random eligible member of the
+// slot's team when newRevID is empty.
 func (s *PullRequestService) Reassign(
 	ctx context.Context,
 	id domain.PullRequestID,
 	oldRevID domain.UserID,
+	newRevID domain.UserID,
 ) (*domain.PullRequest, domain.UserID, error) {
 	var pullRequest *domain.PullRequest
 	var newReviewer domain.UserID
@@ -131,29 +467,54 @@ func (s *PullRequestService) Reassign(
 			return domain.ErrReassignMergedPullRequest
 		}
 
+		if pr.Status == domain.PullRequestStatusClosed {
+			return domain.ErrPullRequestClosed
+		}
+
 		if !slices.Contains(pr.AssignedReviewers, oldRevID) {
 			return domain.ErrReviewerIsNotAssigned
 		}
 
-		team, err := s.teamRepository.GetByUserID(txCtx, oldRevID)
+		team, err := s.slotTeam(txCtx, *pr, oldRevID)
 		if err != nil {
 			return err
 		}
-
-		newReviewers, newRevID, err := reassignReviewers(pr.AuthorID, oldRevID, pr.AssignedReviewers, *team)
-		if err != nil {
+		if err := s.authorizeReviewerChange(txCtx, *pr, *team); err != nil {
 			return err
 		}
-		newReviewer = newRevID
+
+		var newReviewers []domain.UserID
+		if newRevID != "" {
+			if err := validateNewReviewer(*pr, *team, newRevID); err != nil {
+				return err
+			}
+			newReviewers, newReviewer = replaceReviewer(pr.AssignedReviewers, oldRevID, newRevID), newRevID
+
+			remaining := newReviewers[:len(newReviewers)-1]
+			if err := checkRules(*team, pr.AuthorID, newRevID, remaining); err != nil {
+				return err
+			}
+			if err := checkRequirements(*team, pr.AssignedReviewers, newReviewers); err != nil {
+				return err
+			}
+		} else {
+			tags := slotTags(*pr, *team, oldRevID)
+			newReviewers, newReviewer, err = reassignReviewers(pr.AuthorID, oldRevID, pr.AssignedReviewers, *team, tags)
+			if err != nil {
+				return err
+			}
+		}
 
 		pr.AssignedReviewers = newReviewers
+		delete(pr.ReviewerTeams, oldRevID)
+		addReviewerTeam(pr, newReviewer, team.Name)
 		if err := s.pullRequestRepository.Update(txCtx, pr); err != nil {
 			return err
 		}
 
 		pullRequest = pr
 
-		return nil
+		return s.eventPublisher.Publish(txCtx, domain.NewReviewerReassignedEvent(*pr, oldRevID, newReviewer))
 	})
 
 	if err != nil {
@@ -163,17 +524,264 @@ func (s *PullRequestService) Reassign(
 	return pullRequest, newReviewer, nil
 }
 
+func (s *PullRequestService) AddReviewer(
+	ctx context.Context,
+	id domain.PullRequestID,
+	reviewerID domain.UserID,
+) (*domain.PullRequest, error) {
+	var pullRequest *domain.PullRequest
+	err := s.txManager.WithinTransaction(ctx, func(txCtx context.Context) error {
+		pr, err := s.pullRequestRepository.GetByID(txCtx, id)
+		if err != nil {
+			return err
+		}
+
+		if pr.Status == domain.PullRequestStatusMerged {
+			return domain.ErrChangeMergedPullRequest
+		}
+
+		if pr.Status == domain.PullRequestStatusClosed {
+			return domain.ErrPullRequestClosed
+		}
+
+		// a DRAFT gets its reviewers once it is ready for review
+		if pr.Status != domain.PullRequestStatusOpen {
+			return domain.ErrPullRequestNotOpen
+		}
+
+		team, err := s.teamRepository.GetByUserID(txCtx, reviewerID)
+		if err != nil {
+			return err
+		}
+		if err := s.authorizeReviewerChange(txCtx, *pr, *team); err != nil {
+			return err
+		}
+
+		if err := validateNewReviewer(*pr, *team, reviewerID); err != nil {
+			return err
+		}
+
+		if countTeamReviewers(*pr, team.Name) >= team.ReviewersLimit() {
+			return domain.ErrReviewersLimitExceeded
+		}
+
+		if err := checkRules(*team, pr.AuthorID, reviewerID, pr.AssignedReviewers); err != nil {
+			return err
+		}
+
+		addReviewer(pr, reviewerID, team.Name)
+		if err := s.pullRequestRepository.Update(txCtx, pr); err != nil {
+			return err
+		}
+
+		pullRequest = pr
+
+		return s.eventPublisher.Publish(txCtx, domain.NewReviewerAssignedEvent(*pr, reviewerID))
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return pullRequest, nil
+}
+
+func (s *PullRequestService) RemoveReviewer(
+	ctx context.Context,
+	id domain.PullRequestID,
+	reviewerID domain.UserID,
+) (*domain.PullRequest, error) {
+	var pullRequest *domain.PullRequest
+	err := s.txManager.WithinTransaction(ctx, func(txCtx context.Context) error {
+		pr, err := s.pullRequestRepository.GetByID(txCtx, id)
+		if err != nil {
+			return err
+		}
+
+		if pr.Status == domain.PullRequestStatusMerged {
+			return domain.ErrChangeMergedPullRequest
+		}
+
+		if pr.Status == domain.PullRequestStatusClosed {
+			return domain.ErrPullRequestClosed
+		}
+
+		if !slices.Contains(pr.AssignedReviewers, reviewerID) {
+			return domain.ErrReviewerIsNotAssigned
+		}
+
+		team, err := s.slotTeam(txCtx, *pr, reviewerID)
+		if err != nil {
+			return err
+		}
+		if err := s.authorizeReviewerChange(txCtx, *pr, *team); err != nil {
+			return err
+		}
+
+		remaining := slices.DeleteFunc(slices.Clone(pr.AssignedReviewers), func(assigned domain.UserID) bool {
+			return assigned == reviewerID
+		})
+		if err := checkRequirements(*team, pr.AssignedReviewers, remaining); err != nil {
+			return err
+		}
+
+		pr.AssignedReviewers = remaining
+		delete(pr.ReviewerTeams, reviewerID)
+
+		if err := s.pullRequestRepository.Update(txCtx, pr); err != nil {
+			return err
+		}
+
+		pullRequest = pr
+
+		return s.eventPublisher.Publish(txCtx, domain.NewReviewerRemovedEvent(*pr, reviewerID))
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return pullRequest, nil
+}
+
+// validateNewReviewer checks that the user may take a reviewer slot of the team on the pull request.
+func validateNewReviewer(pr domain.PullRequest, team domain.Team, reviewerID domain.UserID) error {
+	if reviewerID == pr.AuthorID {
+		return domain.ErrReviewerIsAuthor
+	}
+
+	if slices.Contains(pr.AssignedReviewers, reviewerID) {
+		return domain.ErrReviewerAlreadyAssigned
+	}
+
+	idx := slices.IndexFunc(team.Members, func(m domain.TeamMember) bool {
+		return m.ID == reviewerID
+	})
+	if idx < 0 {
+		return domain.ErrReviewerNotInTeam
+	}
+
+	if !team.Members[idx].IsActive {
+		return domain.ErrReviewerInactive
+	}
+
+	return nil
+}
+
+// checkRules reports an EXCLUDE_PAIR or MAX_OF rule of the team that forbids adding the candidate
+// to the reviewers.
+func checkRules(team domain.Team, authorID, candidateID domain.UserID, reviewers []domain.UserID) error {
+	if rule, ok := blockingRule(team, authorID, candidateID, reviewers); ok {
+		return &domain.RuleViolationError{Rule: rule, Err: domain.ErrRuleViolation}
+	}
+
+	return nil
+}
+
+// checkRequirements reports a REQUIRE_ONE_OF rule of the team that the change of the reviewers
+// from before to after leaves unmet.
+func checkRequirements(team domain.Team, before, after []domain.UserID) error {
+	if broken := brokenRequirements(team, before, after); len(broken) > 0 {
+		return &domain.RuleViolationError{Rule: broken[0], Err: domain.ErrRuleViolation}
+	}
+
+	return nil
+}
+
+func replaceReviewer(reviewers []domain.UserID, oldRevID, newRevID domain.UserID) []domain.UserID {
+	newReviewers := make([]domain.UserID, 0, len(reviewers))
+	for _, reviewer := range reviewers {
+		if reviewer == oldRevID {
+			continue
+		}
+
+		newReviewers = append(newReviewers, reviewer)
+	}
+
+	return append(newReviewers, newRevID)
+}
+
+// slotTags returns the pull request tags that the replaced reviewer carries and
+// no other assigned member of the team does.
+func slotTags(pr domain.PullRequest, team domain.Team, oldRevID domain.UserID) []string {
+	covered := make(map[string]bool, len(pr.Tags))
+	for _, reviewer := range pr.AssignedReviewers {
+		if reviewer != oldRevID {
+			coverTags(covered, team, reviewer)
+		}
+	}
+
+	var tags []string
+	for _, tag := range missingTags(pr.Tags, covered) {
+		if slices.ContainsFunc(team.Members, func(member domain.TeamMember) bool {
+			return member.ID == oldRevID && member.HasTag(tag)
+		}) {
+			tags = append(tags, tag)
+		}
+	}
+
+	return tags
+}
+
+// authorizeReviewerChange lets admins, API keys and the author change the reviewers of a pull request, and
+// team leads when they lead the team of the slot or of the author. Background jobs have no actor.
+func (s *PullRequestService) authorizeReviewerChange(
+	ctx context.Context,
+	pr domain.PullRequest,
+	slotTeam domain.Team,
+) error {
+	actor, ok := domain.ActorFromContext(ctx)
+	if !ok || actor.CanMerge(pr) {
+		return nil
+	}
+	if actor.Role != domain.RoleTeamLead {
+		return domain.ErrForbidden
+	}
+	if isActiveMember(slotTeam, actor.UserID) {
+		return nil
+	}
+
+	authorTeam, err := s.teamRepository.GetByUserID(ctx, pr.AuthorID)
+	if err != nil {
+		return err
+	}
+	if !isActiveMember(*authorTeam, actor.UserID) {
+		return domain.ErrForbidden
+	}
+
+	return nil
+}
+
+// slotTeam returns the team a reviewer's slot was filled from. Slots without
+// a recorded origin fall back to the reviewer's current team.
+func (s *PullRequestService) slotTeam(
+	ctx context.Context,
+	pr domain.PullRequest,
+	reviewerID domain.UserID,
+) (*domain.Team, error) {
+	if teamName := pr.ReviewerTeams[reviewerID]; teamName != "" {
+		return s.teamRepository.GetByName(ctx, teamName)
+	}
+
+	return s.teamRepository.GetByUserID(ctx, reviewerID)
+}
+
 func reassignReviewers(
 	authorID,
 	oldRevID domain.UserID,
 	oldReviewers []domain.UserID,
 	team domain.Team,
+	tags []string,
 ) ([]domain.UserID, domain.UserID, error) {
 	members := append([]domain.TeamMember(nil), team.Members...)
 	newReviewers := make([]domain.UserID, 0)
 	rand.Shuffle(len(members), func(i, j int) {
 		members[i], members[j] = members[j], members[i]
 	})
+	// members carrying the tags of the replaced reviewer are tried first
+	slices.SortStableFunc(members, func(a, b domain.TeamMember) int {
+		return countTags(b, tags) - countTags(a, tags)
+	})
 
 	for _, reviewer := range oldReviewers {
 		if reviewer == oldRevID {
@@ -183,7 +791,12 @@ func reassignReviewers(
 		newReviewers = append(newReviewers, reviewer)
 	}
 
-	var newReviewer domain.UserID
+	// a replacement must restore every REQUIRE_ONE_OF rule the removal breaks
+	missing := brokenRequirements(team, oldReviewers, newReviewers)
+	var (
+		newReviewer domain.UserID
+		blocked     *domain.TeamRule
+	)
 	for _, member := range members {
 		if member.ID == authorID || !member.IsActive || member.ID == oldRevID {
 			continue
@@ -193,12 +806,28 @@ func reassignReviewers(
 			continue
 		}
 
+		if rule, ok := blockingRule(team, authorID, member.ID, newReviewers); ok {
+			blocked = &rule
+			continue
+		}
+
+		if idx := slices.IndexFunc(missing, func(rule domain.TeamRule) bool {
+			return !slices.Contains(rule.Group(team.Members), member.ID)
+		}); idx >= 0 {
+			blocked = &missing[idx]
+			continue
+		}
+
 		newReviewers = append(newReviewers, member.ID)
 		newReviewer = member.ID
 		break
 	}
 
 	if len(newReviewers) != len(oldReviewers) {
+		if blocked != nil {
+			return []domain.UserID{}, "", &domain.RuleViolationError{Rule: *blocked, Err: domain.ErrNoCandidate}
+		}
+
 		return []domain.UserID{}, "", domain.ErrNoCandidate
 	}
 
@@ -12,109 +12,12 @@ import (
 	"go.uber.org/mock/gomock"
 )
 
-func TestAssignReviewers_Table(t *testing.T) {
-	author := domain.UserID("author")
-
-	tests := []struct {
-		name         string
-		team         domain.Team
-		authorID     domain.UserID
-		wantExact    []domain.UserID
-		wantLen      int
-		maxReviewers int
-	}{
-		{
-			name: "only author in team -> no reviewers",
-			team: domain.Team{
-				Name: "team",
-				Members: []domain.TeamMember{
-					{ID: author, Username: "author", IsActive: true},
-				},
-			},
-			authorID:  author,
-			wantExact: []domain.UserID{},
-		},
-		{
-			name: "one active member besides author",
-			team: domain.Team{
-				Name: "team",
-				Members: []domain.TeamMember{
-					{ID: author, Username: "author", IsActive: true},
-					{ID: "u2", Username: "u2", IsActive: true},
-				},
-			},
-			authorID:  author,
-			wantExact: []domain.UserID{"u2"},
-		},
-		{
-			name: "inactive members are skipped",
-			team: domain.Team{
-				Name: "team",
-				Members: []domain.TeamMember{
-					{ID: author, Username: "author", IsActive: true},
-					{ID: "active", Username: "active", IsActive: true},
-					{ID: "inactive1", Username: "inactive1", IsActive: false},
-					{ID: "inactive2", Username: "inactive2", IsActive: false},
-				},
-			},
-			authorID:  author,
-			wantExact: []domain.UserID{"active"},
-		},
-		{
-			name: "more active members than PullRequestMaxReviewers",
-			team: domain.Team{
-				Name: "team",
-				Members: []domain.TeamMember{
-					{ID: author, Username: "author", IsActive: true},
-					{ID: "u2", Username: "u2", IsActive: true},
-					{ID: "u3", Username: "u3", IsActive: true},
-					{ID: "u4", Username: "u4", IsActive: true},
-				},
-			},
-			authorID:     author,
-			wantLen:      domain.PullRequestMaxReviewers,
-			maxReviewers: domain.PullRequestMaxReviewers,
-		},
-	}
-
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			got := assignReviewers(tt.authorID, tt.team)
+// anyEventPublisher accepts every published event for tests that do not assert on events.
+func anyEventPublisher(ctrl *gomock.Controller) *mocks.MockEventPublisher {
+	publisher := mocks.NewMockEventPublisher(ctrl)
+	publisher.EXPECT().Publish(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 
-			if tt.wantExact != nil {
-				if !slices.Equal(got, tt.wantExact) {
-					t.Fatalf("expected %v, got %v", tt.wantExact, got)
-				}
-				return
-			}
-
-			if tt.wantLen != 0 && len(got) != tt.wantLen {
-				t.Fatalf("expected len=%d, got %d", tt.wantLen, len(got))
-			}
-
-			if slices.Contains(got, tt.authorID) {
-				t.Fatalf("reviewers must not contain author, got %v", got)
-			}
-			for _, id := range got {
-				found := false
-				for _, m := range tt.team.Members {
-					if m.ID == id {
-						found = true
-						if !m.IsActive {
-							t.Fatalf("inactive member %s selected as reviewer", id)
-						}
-						break
-					}
-				}
-				if !found {
-					t.Fatalf("reviewer %s is not a member of the team", id)
-				}
-			}
-			if len(got) > domain.PullRequestMaxReviewers {
-				t.Fatalf("len(got)=%d > PullRequestMaxReviewers", len(got))
-			}
-		})
-	}
+	return publisher
 }
 
 func TestReassignReviewers_Table(t *testing.T) {
@@ -197,11 +100,49 @@ func TestReassignReviewers_Table(t *testing.T) {
 			wantErr:      domain.ErrNoCandidate,
 			wantNewRevID: "",
 		},
+		{
+			name:         "requirement unmet before the removal does not restrict the replacement",
+			authorID:     author,
+			oldRevID:     oldRev,
+			oldReviewers: []domain.UserID{oldRev},
+			team: domain.Team{
+				Name: "team",
+				Members: []domain.TeamMember{
+					{ID: author, IsActive: true},
+					{ID: oldRev, IsActive: true},
+					{ID: "rev-new", IsActive: true},
+					{ID: "senior", IsActive: false},
+				},
+				Rules: []domain.TeamRule{
+					{ID: 1, Kind: domain.TeamRuleKindRequireOneOf, Users: []domain.UserID{"senior"}},
+				},
+			},
+			wantNew:      []domain.UserID{"rev-new"},
+			wantNewRevID: "rev-new",
+		},
+		{
+			name:         "replacement must restore the requirement the removed reviewer met",
+			authorID:     author,
+			oldRevID:     oldRev,
+			oldReviewers: []domain.UserID{oldRev},
+			team: domain.Team{
+				Name: "team",
+				Members: []domain.TeamMember{
+					{ID: author, IsActive: true},
+					{ID: oldRev, IsActive: true},
+					{ID: "rev-new", IsActive: true},
+				},
+				Rules: []domain.TeamRule{
+					{ID: 1, Kind: domain.TeamRuleKindRequireOneOf, Users: []domain.UserID{oldRev}},
+				},
+			},
+			wantErr: domain.ErrNoCandidate,
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			gotReviewers, gotNewRev, err := reassignReviewers(tt.authorID, tt.oldRevID, tt.oldReviewers, tt.team)
+			gotReviewers, gotNewRev, err := reassignReviewers(tt.authorID, tt.oldRevID, tt.oldReviewers, tt.team, nil)
 
 			if tt.wantErr != nil {
 				if !errors.Is(err, tt.wantErr) {
@@ -242,7 +183,9 @@ func TestPullRequestService_Create_Success(t *testing.T) {
 	teamRepo := mocks.NewMockTeamRepository(ctrl)
 	txMgr := mocks.NewMockTxManager(ctrl)
 
-	service := NewPullRequestService(prRepo, teamRepo, txMgr)
+	publisher := mocks.NewMockEventPublisher(ctrl)
+
+	service := NewPullRequestService(prRepo, teamRepo, txMgr, publisher)
 
 	ctx := context.Background()
 	prID := domain.PullRequestID("pr-1")
@@ -300,7 +243,20 @@ func TestPullRequestService_Create_Success(t *testing.T) {
 			return nil
 		})
 
-	pr, err := service.Create(ctx, prID, "My PR", authorID)
+	publisher.
+		EXPECT().
+		Publish(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, events ...domain.Event) error {
+			if len(events) != 1 || events[0].Type != domain.EventPullRequestCreated {
+				t.Fatalf("expected one PR_CREATED event, got %+v", events)
+			}
+			if events[0].PullRequest == nil || events[0].PullRequest.ID != prID {
+				t.Fatalf("unexpected event pull request: %+v", events[0].PullRequest)
+			}
+			return nil
+		})
+
+	pr, err := service.Create(ctx, prID, "My PR", authorID, domain.CreatePullRequestOptions{})
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -322,7 +278,7 @@ func TestPullRequestService_Create_TxError(t *testing.T) {
 	teamRepo := mocks.NewMockTeamRepository(ctrl)
 	txMgr := mocks.NewMockTxManager(ctrl)
 
-	service := NewPullRequestService(prRepo, teamRepo, txMgr)
+	service := NewPullRequestService(prRepo, teamRepo, txMgr, anyEventPublisher(ctrl))
 
 	ctx := context.Background()
 	prID := domain.PullRequestID("pr-1")
@@ -335,7 +291,7 @@ func TestPullRequestService_Create_TxError(t *testing.T) {
 		WithinTransaction(ctx, gomock.Any()).
 		Return(expectedErr)
 
-	pr, err := service.Create(ctx, prID, "My PR", authorID)
+	pr, err := service.Create(ctx, prID, "My PR", authorID, domain.CreatePullRequestOptions{})
 	if err == nil {
 		t.Fatal("expected error, got nil")
 	}
@@ -347,6 +303,25 @@ func TestPullRequestService_Create_TxError(t *testing.T) {
 	}
 }
 
+func TestPullRequestService_Create_OnBehalfOfAnotherUser(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	service := NewPullRequestService(
+		mocks.NewMockPullRequestRepository(ctrl),
+		mocks.NewMockTeamRepository(ctrl),
+		mocks.NewMockTxManager(ctrl),
+		anyEventPublisher(ctrl),
+	)
+
+	ctx := domain.ContextWithActor(context.Background(), domain.NewUserActor("u2", domain.RoleMember))
+
+	_, err := service.Create(ctx, "pr-1", "My PR", "author", domain.CreatePullRequestOptions{})
+	if !errors.Is(err, domain.ErrForbidden) {
+		t.Fatalf("expected ErrForbidden, got %v", err)
+	}
+}
+
 func TestPullRequestService_Create_GetTeamError(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -355,7 +330,7 @@ func TestPullRequestService_Create_GetTeamError(t *testing.T) {
 	teamRepo := mocks.NewMockTeamRepository(ctrl)
 	txMgr := mocks.NewMockTxManager(ctrl)
 
-	service := NewPullRequestService(prRepo, teamRepo, txMgr)
+	service := NewPullRequestService(prRepo, teamRepo, txMgr, anyEventPublisher(ctrl))
 
 	ctx := context.Background()
 	prID := domain.PullRequestID("pr-1")
@@ -375,7 +350,7 @@ func TestPullRequestService_Create_GetTeamError(t *testing.T) {
 		GetByUserID(gomock.Any(), authorID).
 		Return(nil, expectedErr)
 
-	pr, err := service.Create(ctx, prID, "My PR", authorID)
+	pr, err := service.Create(ctx, prID, "My PR", authorID, domain.CreatePullRequestOptions{})
 	if err == nil {
 		t.Fatal("expected error, got nil")
 	}
@@ -395,7 +370,7 @@ func TestPullRequestService_Create_CreateError(t *testing.T) {
 	teamRepo := mocks.NewMockTeamRepository(ctrl)
 	txMgr := mocks.NewMockTxManager(ctrl)
 
-	service := NewPullRequestService(prRepo, teamRepo, txMgr)
+	service := NewPullRequestService(prRepo, teamRepo, txMgr, anyEventPublisher(ctrl))
 
 	ctx := context.Background()
 	prID := domain.PullRequestID("pr-1")
@@ -428,7 +403,7 @@ func TestPullRequestService_Create_CreateError(t *testing.T) {
 		Create(gomock.Any(), gomock.Any()).
 		Return(expectedErr)
 
-	pr, err := service.Create(ctx, prID, "My PR", authorID)
+	pr, err := service.Create(ctx, prID, "My PR", authorID, domain.CreatePullRequestOptions{})
 	if err == nil {
 		t.Fatal("expected error, got nil")
 	}
@@ -440,7 +415,7 @@ func TestPullRequestService_Create_CreateError(t *testing.T) {
 	}
 }
 
-func TestPullRequestService_Merge_Success(t *testing.T) {
+func TestPullRequestService_Create_WithExtraTeamsAndRequiredReviewers(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
@@ -448,14 +423,34 @@ func TestPullRequestService_Merge_Success(t *testing.T) {
 	teamRepo := mocks.NewMockTeamRepository(ctrl)
 	txMgr := mocks.NewMockTxManager(ctrl)
 
-	service := NewPullRequestService(prRepo, teamRepo, txMgr)
+	service := NewPullRequestService(prRepo, teamRepo, txMgr, anyEventPublisher(ctrl))
 
 	ctx := context.Background()
 	prID := domain.PullRequestID("pr-1")
+	authorID := domain.UserID("author")
+	requiredID := domain.UserID("required")
+	securityID := domain.UserID("sec1")
 
-	pr := &domain.PullRequest{
-		ID:     prID,
-		Status: domain.PullRequestStatusOpen,
+	authorTeam := &domain.Team{
+		Name: "backend",
+		Members: []domain.TeamMember{
+			{ID: authorID, IsActive: true},
+			{ID: "rev1", IsActive: true},
+			{ID: "rev2", IsActive: true},
+		},
+	}
+	requiredTeam := &domain.Team{
+		Name: "frontend",
+		Members: []domain.TeamMember{
+			{ID: requiredID, IsActive: true},
+		},
+	}
+	securityTeam := &domain.Team{
+		Name: "security",
+		Members: []domain.TeamMember{
+			{ID: securityID, IsActive: true},
+			{ID: "sec-inactive", IsActive: false},
+		},
 	}
 
 	txMgr.
@@ -465,38 +460,49 @@ func TestPullRequestService_Merge_Success(t *testing.T) {
 			return fn(c)
 		})
 
-	prRepo.
+	teamRepo.
 		EXPECT().
-		GetByID(gomock.Any(), prID).
-		Return(pr, nil)
+		GetByUserID(gomock.Any(), authorID).
+		Return(authorTeam, nil)
+
+	teamRepo.
+		EXPECT().
+		GetByUserID(gomock.Any(), requiredID).
+		Return(requiredTeam, nil)
+
+	teamRepo.
+		EXPECT().
+		GetByName(gomock.Any(), domain.TeamName("security")).
+		Return(securityTeam, nil)
 
 	prRepo.
 		EXPECT().
-		Update(gomock.Any(), pr).
-		DoAndReturn(func(_ context.Context, updated *domain.PullRequest) error {
-			if updated.Status != domain.PullRequestStatusMerged {
-				t.Errorf("expected status MERGED, got %s", updated.Status)
-			}
-			if updated.MergedAt == nil {
-				t.Errorf("expected MergedAt to be non-nil")
-			}
-			return nil
-		})
+		Create(gomock.Any(), gomock.Any()).
+		Return(nil)
 
-	got, err := service.Merge(ctx, prID)
+	pr, err := service.Create(ctx, prID, "My PR", authorID, domain.CreatePullRequestOptions{
+		ExtraTeams:        []domain.TeamName{"security"},
+		RequiredReviewers: []domain.UserID{requiredID},
+	})
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
 
-	if got == nil {
-		t.Fatalf("expected non-nil pull request")
+	if len(pr.AssignedReviewers) != 4 {
+		t.Fatalf("expected 4 reviewers, got %v", pr.AssignedReviewers)
 	}
-	if got.Status != domain.PullRequestStatusMerged {
-		t.Errorf("expected MERGED, got %s", got.Status)
+	if pr.ReviewerTeams[requiredID] != requiredTeam.Name {
+		t.Errorf("expected required reviewer from %s, got %s", requiredTeam.Name, pr.ReviewerTeams[requiredID])
+	}
+	if pr.ReviewerTeams[securityID] != securityTeam.Name {
+		t.Errorf("expected security reviewer from %s, got %s", securityTeam.Name, pr.ReviewerTeams[securityID])
+	}
+	if pr.ReviewerTeams["rev1"] != authorTeam.Name || pr.ReviewerTeams["rev2"] != authorTeam.Name {
+		t.Errorf("expected both teammates from %s, got %v", authorTeam.Name, pr.ReviewerTeams)
 	}
 }
 
-func TestPullRequestService_Merge_AlreadyMerged(t *testing.T) {
+func TestPullRequestService_Create_WithTags(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
@@ -504,43 +510,58 @@ func TestPullRequestService_Merge_AlreadyMerged(t *testing.T) {
 	teamRepo := mocks.NewMockTeamRepository(ctrl)
 	txMgr := mocks.NewMockTxManager(ctrl)
 
-	service := NewPullRequestService(prRepo, teamRepo, txMgr)
+	service := NewPullRequestService(prRepo, teamRepo, txMgr, anyEventPublisher(ctrl))
 
 	ctx := context.Background()
-	prID := domain.PullRequestID("pr-1")
+	authorID := domain.UserID("author")
 
-	pr := &domain.PullRequest{
-		ID:     prID,
-		Status: domain.PullRequestStatusMerged,
+	authorTeam := &domain.Team{
+		Name:         "backend",
+		MaxReviewers: 1,
+		Members: []domain.TeamMember{
+			{ID: authorID, IsActive: true},
+			{ID: "rev1", IsActive: true},
+			{ID: "rev2", IsActive: true},
+			{ID: "dba", IsActive: true, Tags: []string{"db"}},
+			{ID: "rev3", IsActive: true},
+		},
 	}
 
-	txMgr.
-		EXPECT().
-		WithinTransaction(ctx, gomock.Any()).
-		DoAndReturn(func(c context.Context, fn func(context.Context) error) error {
-			return fn(c)
-		})
+	for range 10 {
+		txMgr.
+			EXPECT().
+			WithinTransaction(ctx, gomock.Any()).
+			DoAndReturn(func(c context.Context, fn func(context.Context) error) error {
+				return fn(c)
+			})
 
-	prRepo.
-		EXPECT().
-		GetByID(gomock.Any(), prID).
-		Return(pr, nil)
+		teamRepo.
+			EXPECT().
+			GetByUserID(gomock.Any(), authorID).
+			Return(authorTeam, nil)
 
-	got, err := service.Merge(ctx, prID)
-	if err != nil {
-		t.Fatalf("unexpected error: %v", err)
-	}
+		prRepo.
+			EXPECT().
+			Create(gomock.Any(), gomock.Any()).
+			Return(nil)
 
-	if got == nil {
-		t.Fatalf("expected non-nil result")
-	}
+		pr, err := service.Create(ctx, "pr-1", "My PR", authorID, domain.CreatePullRequestOptions{
+			Tags: []string{"db", "frontend"},
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
 
-	if got.Status != domain.PullRequestStatusMerged {
-		t.Errorf("expected MERGED, got %s", got.Status)
+		if !slices.Equal(pr.AssignedReviewers, []domain.UserID{"dba"}) {
+			t.Fatalf("expected db reviewer to take the only slot, got %v", pr.AssignedReviewers)
+		}
+		if len(pr.UnfilledSlots) != 1 || pr.UnfilledSlots[0].Tag != "frontend" {
+			t.Fatalf("expected unfilled frontend tag slot, got %+v", pr.UnfilledSlots)
+		}
 	}
 }
 
-func TestPullRequestService_Merge_GetByIDError(t *testing.T) {
+func TestPullRequestService_Create_CodeOwnersWithLoadFill(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
@@ -548,11 +569,30 @@ func TestPullRequestService_Merge_GetByIDError(t *testing.T) {
 	teamRepo := mocks.NewMockTeamRepository(ctrl)
 	txMgr := mocks.NewMockTxManager(ctrl)
 
-	service := NewPullRequestService(prRepo, teamRepo, txMgr)
+	service := NewPullRequestService(prRepo, teamRepo, txMgr, anyEventPublisher(ctrl))
 
 	ctx := context.Background()
-	prID := domain.PullRequestID("pr-1")
-	expectedErr := errors.New("get pr error")
+	authorID := domain.UserID("author")
+
+	entries, err := domain.ParseCodeOwners("/db/ @dba\n")
+	if err != nil {
+		t.Fatalf("failed to parse CODEOWNERS: %v", err)
+	}
+	team := &domain.Team{
+		Name: "backend",
+		Members: []domain.TeamMember{
+			{ID: authorID, IsActive: true},
+			{ID: "dba", IsActive: true},
+			{ID: "busy", IsActive: true},
+			{ID: "idle", IsActive: true},
+		},
+		CodeOwners: &domain.TeamCodeOwners{
+			TeamName:     "backend",
+			Mode:         domain.CodeOwnersModePrefer,
+			FillStrategy: domain.FillStrategyLoad,
+			Entries:      entries,
+		},
+	}
 
 	txMgr.
 		EXPECT().
@@ -561,24 +601,41 @@ func TestPullRequestService_Merge_GetByIDError(t *testing.T) {
 			return fn(c)
 		})
 
+	teamRepo.
+		EXPECT().
+		GetByUserID(gomock.Any(), authorID).
+		Return(team, nil)
+
 	prRepo.
 		EXPECT().
-		GetByID(gomock.Any(), prID).
-		Return(nil, expectedErr)
+		CountOpenReviews(gomock.Any(), []domain.UserID{authorID, "dba", "busy", "idle"}).
+		Return(map[domain.UserID]int{"dba": 7, "busy": 3}, nil)
 
-	result, err := service.Merge(ctx, prID)
-	if err == nil {
-		t.Fatal("expected error, got nil")
+	prRepo.
+		EXPECT().
+		Create(gomock.Any(), gomock.Any()).
+		Return(nil)
+
+	pr, err := service.Create(ctx, "pr-1", "My PR", authorID, domain.CreatePullRequestOptions{
+		ChangedFiles: []string{"db/schema.sql"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
 	}
-	if !errors.Is(err, expectedErr) {
-		t.Fatalf("expected %v, got %v", expectedErr, err)
+
+	if !slices.Equal(pr.AssignedReviewers, []domain.UserID{"dba", "idle"}) {
+		t.Fatalf("expected owner then least loaded member, got %v", pr.AssignedReviewers)
 	}
-	if result != nil {
-		t.Fatal("expected result to be nil on error")
+	if reason := pr.AssignmentReasons["dba"]; reason.Source != domain.ReviewerReasonCodeOwners ||
+		reason.CodeOwners.Pattern != "/db/" {
+		t.Fatalf("expected CODEOWNERS reason for dba, got %+v", reason)
+	}
+	if reason := pr.AssignmentReasons["idle"]; reason.Source != domain.ReviewerReasonLeastLoaded {
+		t.Fatalf("expected LEAST_LOADED reason for idle, got %+v", reason)
 	}
 }
 
-func TestPullRequestService_Merge_TxError(t *testing.T) {
+func TestPullRequestService_Reassign_PrefersSlotTags(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
@@ -586,61 +643,149 @@ func TestPullRequestService_Merge_TxError(t *testing.T) {
 	teamRepo := mocks.NewMockTeamRepository(ctrl)
 	txMgr := mocks.NewMockTxManager(ctrl)
 
-	service := NewPullRequestService(prRepo, teamRepo, txMgr)
+	service := NewPullRequestService(prRepo, teamRepo, txMgr, anyEventPublisher(ctrl))
 
 	ctx := context.Background()
-	prID := domain.PullRequestID("pr-1")
-	expectedErr := errors.New("tx error")
+	team := &domain.Team{
+		Name: "backend",
+		Members: []domain.TeamMember{
+			{ID: "author", IsActive: true},
+			{ID: "old-dba", IsActive: true, Tags: []string{"db"}},
+			{ID: "rev1", IsActive: true},
+			{ID: "rev2", IsActive: true},
+			{ID: "new-dba", IsActive: true, Tags: []string{"db"}},
+		},
+	}
 
-	txMgr.
-		EXPECT().
-		WithinTransaction(ctx, gomock.Any()).
-		Return(expectedErr)
+	for range 10 {
+		pr := &domain.PullRequest{
+			ID:                "pr-1",
+			AuthorID:          "author",
+			Status:            domain.PullRequestStatusOpen,
+			AssignedReviewers: []domain.UserID{"old-dba"},
+			ReviewerTeams:     map[domain.UserID]domain.TeamName{"old-dba": "backend"},
+			Tags:              []string{"db"},
+		}
 
-	result, err := service.Merge(ctx, prID)
-	if err == nil {
-		t.Fatal("expected error, got nil")
-	}
-	if !errors.Is(err, expectedErr) {
-		t.Fatalf("expected %v, got %v", expectedErr, err)
-	}
-	if result != nil {
-		t.Fatal("expected nil result on tx error")
+		txMgr.
+			EXPECT().
+			WithinTransaction(ctx, gomock.Any()).
+			DoAndReturn(func(c context.Context, fn func(context.Context) error) error {
+				return fn(c)
+			})
+
+		prRepo.
+			EXPECT().
+			GetByID(gomock.Any(), pr.ID).
+			Return(pr, nil)
+
+		teamRepo.
+			EXPECT().
+			GetByName(gomock.Any(), team.Name).
+			Return(team, nil)
+
+		prRepo.
+			EXPECT().
+			Update(gomock.Any(), pr).
+			Return(nil)
+
+		_, newRev, err := service.Reassign(ctx, pr.ID, "old-dba", "")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if newRev != "new-dba" {
+			t.Fatalf("expected db reviewer as replacement, got %s", newRev)
+		}
 	}
 }
 
-func TestPullRequestService_Reassign_Success(t *testing.T) {
-	ctrl := gomock.NewController(t)
-	defer ctrl.Finish()
+func TestPullRequestService_Create_RequiredReviewerErrors(t *testing.T) {
+	authorID := domain.UserID("author")
 
-	prRepo := mocks.NewMockPullRequestRepository(ctrl)
-	teamRepo := mocks.NewMockTeamRepository(ctrl)
-	txMgr := mocks.NewMockTxManager(ctrl)
+	tests := []struct {
+		name     string
+		required domain.UserID
+		team     *domain.Team
+		wantErr  error
+	}{
+		{
+			name:     "author is required reviewer",
+			required: authorID,
+			wantErr:  domain.ErrReviewerIsAuthor,
+		},
+		{
+			name:     "required reviewer is inactive",
+			required: "inactive",
+			team: &domain.Team{
+				Name:    "frontend",
+				Members: []domain.TeamMember{{ID: "inactive", IsActive: false}},
+			},
+			wantErr: domain.ErrReviewerInactive,
+		},
+	}
 
-	service := NewPullRequestService(prRepo, teamRepo, txMgr)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			prRepo := mocks.NewMockPullRequestRepository(ctrl)
+			teamRepo := mocks.NewMockTeamRepository(ctrl)
+			txMgr := mocks.NewMockTxManager(ctrl)
+
+			service := NewPullRequestService(prRepo, teamRepo, txMgr, anyEventPublisher(ctrl))
+			ctx := context.Background()
+
+			txMgr.
+				EXPECT().
+				WithinTransaction(ctx, gomock.Any()).
+				DoAndReturn(func(c context.Context, fn func(context.Context) error) error {
+					return fn(c)
+				})
+
+			teamRepo.
+				EXPECT().
+				GetByUserID(gomock.Any(), authorID).
+				Return(&domain.Team{Name: "backend", Members: []domain.TeamMember{{ID: authorID, IsActive: true}}}, nil)
+
+			if tt.team != nil {
+				teamRepo.
+					EXPECT().
+					GetByUserID(gomock.Any(), tt.required).
+					Return(tt.team, nil)
+			}
+
+			pr, err := service.Create(ctx, "pr-1", "My PR", authorID, domain.CreatePullRequestOptions{
+				RequiredReviewers: []domain.UserID{tt.required},
+			})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("expected %v, got %v", tt.wantErr, err)
+			}
+			if pr != nil {
+				t.Fatalf("expected nil pull request, got %#v", pr)
+			}
+		})
+	}
+}
+
+func TestPullRequestService_Merge_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	prRepo := mocks.NewMockPullRequestRepository(ctrl)
+	teamRepo := mocks.NewMockTeamRepository(ctrl)
+	txMgr := mocks.NewMockTxManager(ctrl)
+
+	publisher := mocks.NewMockEventPublisher(ctrl)
+
+	service := NewPullRequestService(prRepo, teamRepo, txMgr, publisher)
 
 	ctx := context.Background()
 	prID := domain.PullRequestID("pr-1")
-	authorID := domain.UserID("author")
-	oldRevID := domain.UserID("rev-old")
-	keepID := domain.UserID("rev-keep")
-	newCandidateID := domain.UserID("rev-new")
 
 	pr := &domain.PullRequest{
-		ID:                prID,
-		AuthorID:          authorID,
-		Status:            domain.PullRequestStatusOpen,
-		AssignedReviewers: []domain.UserID{oldRevID, keepID},
-	}
-
-	team := &domain.Team{
-		Name: "backend",
-		Members: []domain.TeamMember{
-			{ID: authorID, IsActive: true},
-			{ID: oldRevID, IsActive: true},
-			{ID: keepID, IsActive: true},
-			{ID: newCandidateID, IsActive: true},
-		},
+		ID:     prID,
+		Status: domain.PullRequestStatusOpen,
 	}
 
 	txMgr.
@@ -655,48 +800,46 @@ func TestPullRequestService_Reassign_Success(t *testing.T) {
 		GetByID(gomock.Any(), prID).
 		Return(pr, nil)
 
-	teamRepo.
-		EXPECT().
-		GetByUserID(gomock.Any(), oldRevID).
-		Return(team, nil)
-
 	prRepo.
 		EXPECT().
 		Update(gomock.Any(), pr).
 		DoAndReturn(func(_ context.Context, updated *domain.PullRequest) error {
-			if updated.Status != domain.PullRequestStatusOpen {
-				t.Errorf("expected status OPEN, got %s", updated.Status)
-			}
-			if len(updated.AssignedReviewers) != 2 {
-				t.Errorf("expected 2 reviewers, got %d", len(updated.AssignedReviewers))
+			if updated.Status != domain.PullRequestStatusMerged {
+				t.Errorf("expected status MERGED, got %s", updated.Status)
 			}
-			if slices.Contains(updated.AssignedReviewers, oldRevID) {
-				t.Errorf("old reviewer must not be in AssignedReviewers")
+			if updated.MergedAt == nil {
+				t.Errorf("expected MergedAt to be non-nil")
 			}
-			if !slices.Contains(updated.AssignedReviewers, keepID) {
-				t.Errorf("keep reviewer must remain in AssignedReviewers")
+			return nil
+		})
+
+	publisher.
+		EXPECT().
+		Publish(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, events ...domain.Event) error {
+			if len(events) != 1 || events[0].Type != domain.EventPullRequestMerged {
+				t.Fatalf("expected one PR_MERGED event, got %+v", events)
 			}
-			if !slices.Contains(updated.AssignedReviewers, newCandidateID) {
-				t.Errorf("new candidate must be in AssignedReviewers")
+			if events[0].PullRequest.Status != domain.PullRequestStatusMerged {
+				t.Fatalf("expected merged pull request in event, got %s", events[0].PullRequest.Status)
 			}
 			return nil
 		})
 
-	updatedPR, newReviewer, err := service.Reassign(ctx, prID, oldRevID)
+	got, err := service.Merge(ctx, prID)
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
 
-	if updatedPR == nil {
-		t.Fatal("expected non-nil pull request")
+	if got == nil {
+		t.Fatalf("expected non-nil pull request")
 	}
-
-	if newReviewer != newCandidateID {
-		t.Fatalf("expected new reviewer %s, got %s", newCandidateID, newReviewer)
+	if got.Status != domain.PullRequestStatusMerged {
+		t.Errorf("expected MERGED, got %s", got.Status)
 	}
 }
 
-func TestPullRequestService_Reassign_TxError(t *testing.T) {
+func TestPullRequestService_Merge_AlreadyMerged(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
@@ -704,125 +847,173 @@ func TestPullRequestService_Reassign_TxError(t *testing.T) {
 	teamRepo := mocks.NewMockTeamRepository(ctrl)
 	txMgr := mocks.NewMockTxManager(ctrl)
 
-	service := NewPullRequestService(prRepo, teamRepo, txMgr)
+	service := NewPullRequestService(prRepo, teamRepo, txMgr, mocks.NewMockEventPublisher(ctrl))
 
 	ctx := context.Background()
 	prID := domain.PullRequestID("pr-1")
-	oldRevID := domain.UserID("rev-old")
 
-	expectedErr := errors.New("tx error")
+	pr := &domain.PullRequest{
+		ID:     prID,
+		Status: domain.PullRequestStatusMerged,
+	}
 
 	txMgr.
 		EXPECT().
 		WithinTransaction(ctx, gomock.Any()).
-		Return(expectedErr)
+		DoAndReturn(func(c context.Context, fn func(context.Context) error) error {
+			return fn(c)
+		})
 
-	pr, newRev, err := service.Reassign(ctx, prID, oldRevID)
-	if err == nil {
-		t.Fatal("expected error, got nil")
-	}
-	if !errors.Is(err, expectedErr) {
-		t.Fatalf("expected %v, got %v", expectedErr, err)
+	prRepo.
+		EXPECT().
+		GetByID(gomock.Any(), prID).
+		Return(pr, nil)
+
+	got, err := service.Merge(ctx, prID)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
 	}
-	if pr != nil {
-		t.Fatalf("expected nil pull request, got %#v", pr)
+
+	if got == nil {
+		t.Fatalf("expected non-nil result")
 	}
-	if newRev != "" {
-		t.Fatalf("expected empty new reviewer, got %s", newRev)
+
+	if got.Status != domain.PullRequestStatusMerged {
+		t.Errorf("expected MERGED, got %s", got.Status)
 	}
 }
 
-func TestPullRequestService_Reassign_GetByIDError(t *testing.T) {
-	ctrl := gomock.NewController(t)
-	defer ctrl.Finish()
-
-	prRepo := mocks.NewMockPullRequestRepository(ctrl)
-	teamRepo := mocks.NewMockTeamRepository(ctrl)
-	txMgr := mocks.NewMockTxManager(ctrl)
+func TestPullRequestService_Merge_AuthorizesActor(t *testing.T) {
+	tests := []struct {
+		name    string
+		actor   domain.Actor
+		wantErr error
+	}{
+		{name: "author", actor: domain.NewUserActor("u1", domain.RoleMember)},
+		{name: "admin", actor: domain.NewUserActor("u9", domain.RoleAdmin)},
+		{name: "other member", actor: domain.NewUserActor("u2", domain.RoleMember), wantErr: domain.ErrForbidden},
+		{
+			name:    "team lead of the author",
+			actor:   domain.NewUserActor("u3", domain.RoleTeamLead),
+			wantErr: domain.ErrForbidden,
+		},
+	}
 
-	service := NewPullRequestService(prRepo, teamRepo, txMgr)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
 
-	ctx := context.Background()
-	prID := domain.PullRequestID("pr-1")
-	oldRevID := domain.UserID("rev-old")
+			prRepo := mocks.NewMockPullRequestRepository(ctrl)
+			service := NewPullRequestService(prRepo, nil, passthroughTxManager(ctrl), anyEventPublisher(ctrl))
 
-	expectedErr := errors.New("get pr error")
+			ctx := domain.ContextWithActor(context.Background(), tt.actor)
+			pr := &domain.PullRequest{ID: "pr-1", AuthorID: "u1", Status: domain.PullRequestStatusOpen}
 
-	txMgr.
-		EXPECT().
-		WithinTransaction(ctx, gomock.Any()).
-		DoAndReturn(func(c context.Context, fn func(context.Context) error) error {
-			return fn(c)
-		})
+			prRepo.EXPECT().GetByID(ctx, pr.ID).Return(pr, nil)
+			if tt.wantErr == nil {
+				prRepo.EXPECT().Update(ctx, pr).Return(nil)
+			}
 
-	prRepo.
-		EXPECT().
-		GetByID(gomock.Any(), prID).
-		Return(nil, expectedErr)
+			_, err := service.Merge(ctx, pr.ID)
 
-	pr, newRev, err := service.Reassign(ctx, prID, oldRevID)
-	if err == nil {
-		t.Fatal("expected error, got nil")
-	}
-	if !errors.Is(err, expectedErr) {
-		t.Fatalf("expected %v, got %v", expectedErr, err)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("expected %v, got %v", tt.wantErr, err)
+			}
+		})
 	}
-	if pr != nil {
-		t.Fatalf("expected nil pull request, got %#v", pr)
+}
+
+func TestPullRequestService_ReviewerChanges_ForbiddenForActor(t *testing.T) {
+	changes := map[string]func(s *PullRequestService, ctx context.Context) error{
+		"reassign": func(s *PullRequestService, ctx context.Context) error {
+			_, _, err := s.Reassign(ctx, "pr-1", "u2", "")
+			return err
+		},
+		"add reviewer": func(s *PullRequestService, ctx context.Context) error {
+			_, err := s.AddReviewer(ctx, "pr-1", "u3")
+			return err
+		},
+		"remove reviewer": func(s *PullRequestService, ctx context.Context) error {
+			_, err := s.RemoveReviewer(ctx, "pr-1", "u2")
+			return err
+		},
 	}
-	if newRev != "" {
-		t.Fatalf("expected empty new reviewer, got %s", newRev)
+	actors := map[string]domain.Actor{
+		"other member":            domain.NewUserActor("u4", domain.RoleMember),
+		"lead of another team":    domain.NewUserActor("l1", domain.RoleTeamLead),
+		"inactive lead of a team": domain.NewUserActor("u5", domain.RoleTeamLead),
+	}
+
+	for changeName, change := range changes {
+		for actorName, actor := range actors {
+			t.Run(changeName+"/"+actorName, func(t *testing.T) {
+				ctrl := gomock.NewController(t)
+
+				prRepo := mocks.NewMockPullRequestRepository(ctrl)
+				teamRepo := mocks.NewMockTeamRepository(ctrl)
+				service := NewPullRequestService(prRepo, teamRepo, passthroughTxManager(ctrl), anyEventPublisher(ctrl))
+
+				ctx := domain.ContextWithActor(context.Background(), actor)
+				team := &domain.Team{Name: "backend", Members: []domain.TeamMember{
+					{ID: "u1", IsActive: true},
+					{ID: "u2", IsActive: true},
+					{ID: "u3", IsActive: true},
+					{ID: "u4", IsActive: true},
+					{ID: "u5", IsActive: false},
+				}}
+
+				prRepo.EXPECT().GetByID(ctx, domain.PullRequestID("pr-1")).Return(&domain.PullRequest{
+					ID:                "pr-1",
+					AuthorID:          "u1",
+					Status:            domain.PullRequestStatusOpen,
+					AssignedReviewers: []domain.UserID{"u2"},
+					ReviewerTeams:     map[domain.UserID]domain.TeamName{"u2": "backend"},
+				}, nil)
+				teamRepo.EXPECT().GetByName(ctx, team.Name).Return(team, nil).AnyTimes()
+				teamRepo.EXPECT().GetByUserID(ctx, gomock.Any()).Return(team, nil).AnyTimes()
+
+				if err := change(service, ctx); !errors.Is(err, domain.ErrForbidden) {
+					t.Fatalf("expected ErrForbidden, got %v", err)
+				}
+			})
+		}
 	}
 }
 
-func TestPullRequestService_Reassign_MergedPR(t *testing.T) {
+func TestPullRequestService_Reassign_ByLeadOfTheSlotTeam(t *testing.T) {
 	ctrl := gomock.NewController(t)
-	defer ctrl.Finish()
 
 	prRepo := mocks.NewMockPullRequestRepository(ctrl)
 	teamRepo := mocks.NewMockTeamRepository(ctrl)
-	txMgr := mocks.NewMockTxManager(ctrl)
-
-	service := NewPullRequestService(prRepo, teamRepo, txMgr)
-
-	ctx := context.Background()
-	prID := domain.PullRequestID("pr-1")
-	oldRevID := domain.UserID("rev-old")
+	service := NewPullRequestService(prRepo, teamRepo, passthroughTxManager(ctrl), anyEventPublisher(ctrl))
 
+	ctx := domain.ContextWithActor(context.Background(), domain.NewUserActor("l1", domain.RoleTeamLead))
 	pr := &domain.PullRequest{
-		ID:                prID,
-		Status:            domain.PullRequestStatusMerged,
-		AssignedReviewers: []domain.UserID{oldRevID},
+		ID:                "pr-1",
+		AuthorID:          "u1",
+		Status:            domain.PullRequestStatusOpen,
+		AssignedReviewers: []domain.UserID{"u2"},
+		ReviewerTeams:     map[domain.UserID]domain.TeamName{"u2": "frontend"},
 	}
+	team := &domain.Team{Name: "frontend", Members: []domain.TeamMember{
+		{ID: "l1", IsActive: true},
+		{ID: "u2", IsActive: true},
+	}}
 
-	txMgr.
-		EXPECT().
-		WithinTransaction(ctx, gomock.Any()).
-		DoAndReturn(func(c context.Context, fn func(context.Context) error) error {
-			return fn(c)
-		})
-
-	prRepo.
-		EXPECT().
-		GetByID(gomock.Any(), prID).
-		Return(pr, nil)
+	prRepo.EXPECT().GetByID(ctx, pr.ID).Return(pr, nil)
+	teamRepo.EXPECT().GetByName(ctx, team.Name).Return(team, nil)
+	prRepo.EXPECT().Update(ctx, pr).Return(nil)
 
-	prRes, newRev, err := service.Reassign(ctx, prID, oldRevID)
-	if err == nil {
-		t.Fatal("expected error, got nil")
-	}
-	if !errors.Is(err, domain.ErrReassignMergedPullRequest) {
-		t.Fatalf("expected ErrReassignMergedPullRequest, got %v", err)
-	}
-	if prRes != nil {
-		t.Fatalf("expected nil pull request, got %#v", prRes)
+	_, newReviewer, err := service.Reassign(ctx, pr.ID, "u2", "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
 	}
-	if newRev != "" {
-		t.Fatalf("expected empty new reviewer, got %s", newRev)
+	if newReviewer != "l1" {
+		t.Fatalf("expected l1 to take the review, got %s", newReviewer)
 	}
 }
 
-func TestPullRequestService_Reassign_ReviewerNotAssigned(t *testing.T) {
+func TestPullRequestService_Merge_GetByIDError(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
@@ -830,17 +1021,11 @@ func TestPullRequestService_Reassign_ReviewerNotAssigned(t *testing.T) {
 	teamRepo := mocks.NewMockTeamRepository(ctrl)
 	txMgr := mocks.NewMockTxManager(ctrl)
 
-	service := NewPullRequestService(prRepo, teamRepo, txMgr)
+	service := NewPullRequestService(prRepo, teamRepo, txMgr, anyEventPublisher(ctrl))
 
 	ctx := context.Background()
 	prID := domain.PullRequestID("pr-1")
-	oldRevID := domain.UserID("rev-old")
-
-	pr := &domain.PullRequest{
-		ID:                prID,
-		Status:            domain.PullRequestStatusOpen,
-		AssignedReviewers: []domain.UserID{"other"},
-	}
+	expectedErr := errors.New("get pr error")
 
 	txMgr.
 		EXPECT().
@@ -852,24 +1037,21 @@ func TestPullRequestService_Reassign_ReviewerNotAssigned(t *testing.T) {
 	prRepo.
 		EXPECT().
 		GetByID(gomock.Any(), prID).
-		Return(pr, nil)
+		Return(nil, expectedErr)
 
-	prRes, newRev, err := service.Reassign(ctx, prID, oldRevID)
+	result, err := service.Merge(ctx, prID)
 	if err == nil {
 		t.Fatal("expected error, got nil")
 	}
-	if !errors.Is(err, domain.ErrReviewerIsNotAssigned) {
-		t.Fatalf("expected ErrReviewerIsNotAssigned, got %v", err)
-	}
-	if prRes != nil {
-		t.Fatalf("expected nil pull request, got %#v", prRes)
+	if !errors.Is(err, expectedErr) {
+		t.Fatalf("expected %v, got %v", expectedErr, err)
 	}
-	if newRev != "" {
-		t.Fatalf("expected empty new reviewer, got %s", newRev)
+	if result != nil {
+		t.Fatal("expected result to be nil on error")
 	}
 }
 
-func TestPullRequestService_Reassign_GetTeamError(t *testing.T) {
+func TestPullRequestService_Merge_TxError(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
@@ -877,55 +1059,30 @@ func TestPullRequestService_Reassign_GetTeamError(t *testing.T) {
 	teamRepo := mocks.NewMockTeamRepository(ctrl)
 	txMgr := mocks.NewMockTxManager(ctrl)
 
-	service := NewPullRequestService(prRepo, teamRepo, txMgr)
+	service := NewPullRequestService(prRepo, teamRepo, txMgr, anyEventPublisher(ctrl))
 
 	ctx := context.Background()
 	prID := domain.PullRequestID("pr-1")
-	authorID := domain.UserID("author")
-	oldRevID := domain.UserID("rev-old")
-
-	pr := &domain.PullRequest{
-		ID:                prID,
-		AuthorID:          authorID,
-		Status:            domain.PullRequestStatusOpen,
-		AssignedReviewers: []domain.UserID{oldRevID},
-	}
-
-	expectedErr := errors.New("get team error")
+	expectedErr := errors.New("tx error")
 
 	txMgr.
 		EXPECT().
 		WithinTransaction(ctx, gomock.Any()).
-		DoAndReturn(func(c context.Context, fn func(context.Context) error) error {
-			return fn(c)
-		})
-
-	prRepo.
-		EXPECT().
-		GetByID(gomock.Any(), prID).
-		Return(pr, nil)
-
-	teamRepo.
-		EXPECT().
-		GetByUserID(gomock.Any(), oldRevID).
-		Return(nil, expectedErr)
+		Return(expectedErr)
 
-	prRes, newRev, err := service.Reassign(ctx, prID, oldRevID)
+	result, err := service.Merge(ctx, prID)
 	if err == nil {
 		t.Fatal("expected error, got nil")
 	}
 	if !errors.Is(err, expectedErr) {
 		t.Fatalf("expected %v, got %v", expectedErr, err)
 	}
-	if prRes != nil {
-		t.Fatalf("expected nil pull request, got %#v", prRes)
-	}
-	if newRev != "" {
-		t.Fatalf("expected empty new reviewer, got %s", newRev)
+	if result != nil {
+		t.Fatal("expected nil result on tx error")
 	}
 }
 
-func TestPullRequestService_Reassign_NoCandidate(t *testing.T) {
+func TestPullRequestService_Reassign_Success(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
@@ -933,18 +1090,22 @@ func TestPullRequestService_Reassign_NoCandidate(t *testing.T) {
 	teamRepo := mocks.NewMockTeamRepository(ctrl)
 	txMgr := mocks.NewMockTxManager(ctrl)
 
-	service := NewPullRequestService(prRepo, teamRepo, txMgr)
+	publisher := mocks.NewMockEventPublisher(ctrl)
+
+	service := NewPullRequestService(prRepo, teamRepo, txMgr, publisher)
 
 	ctx := context.Background()
 	prID := domain.PullRequestID("pr-1")
 	authorID := domain.UserID("author")
 	oldRevID := domain.UserID("rev-old")
+	keepID := domain.UserID("rev-keep")
+	newCandidateID := domain.UserID("rev-new")
 
 	pr := &domain.PullRequest{
 		ID:                prID,
 		AuthorID:          authorID,
 		Status:            domain.PullRequestStatusOpen,
-		AssignedReviewers: []domain.UserID{oldRevID},
+		AssignedReviewers: []domain.UserID{oldRevID, keepID},
 	}
 
 	team := &domain.Team{
@@ -952,6 +1113,8 @@ func TestPullRequestService_Reassign_NoCandidate(t *testing.T) {
 		Members: []domain.TeamMember{
 			{ID: authorID, IsActive: true},
 			{ID: oldRevID, IsActive: true},
+			{ID: keepID, IsActive: true},
+			{ID: newCandidateID, IsActive: true},
 		},
 	}
 
@@ -972,22 +1135,56 @@ func TestPullRequestService_Reassign_NoCandidate(t *testing.T) {
 		GetByUserID(gomock.Any(), oldRevID).
 		Return(team, nil)
 
-	prRes, newRev, err := service.Reassign(ctx, prID, oldRevID)
-	if err == nil {
-		t.Fatal("expected error, got nil")
-	}
-	if !errors.Is(err, domain.ErrNoCandidate) {
-		t.Fatalf("expected ErrNoCandidate, got %v", err)
+	prRepo.
+		EXPECT().
+		Update(gomock.Any(), pr).
+		DoAndReturn(func(_ context.Context, updated *domain.PullRequest) error {
+			if updated.Status != domain.PullRequestStatusOpen {
+				t.Errorf("expected status OPEN, got %s", updated.Status)
+			}
+			if len(updated.AssignedReviewers) != 2 {
+				t.Errorf("expected 2 reviewers, got %d", len(updated.AssignedReviewers))
+			}
+			if slices.Contains(updated.AssignedReviewers, oldRevID) {
+				t.Errorf("old reviewer must not be in AssignedReviewers")
+			}
+			if !slices.Contains(updated.AssignedReviewers, keepID) {
+				t.Errorf("keep reviewer must remain in AssignedReviewers")
+			}
+			if !slices.Contains(updated.AssignedReviewers, newCandidateID) {
+				t.Errorf("new candidate must be in AssignedReviewers")
+			}
+			return nil
+		})
+
+	publisher.
+		EXPECT().
+		Publish(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, events ...domain.Event) error {
+			if len(events) != 1 || events[0].Type != domain.EventReviewerReassigned {
+				t.Fatalf("expected one REVIEWER_REASSIGNED event, got %+v", events)
+			}
+			if events[0].OldReviewerID != oldRevID || events[0].NewReviewerID != newCandidateID {
+				t.Fatalf("unexpected reviewers in event: %s -> %s", events[0].OldReviewerID, events[0].NewReviewerID)
+			}
+			return nil
+		})
+
+	updatedPR, newReviewer, err := service.Reassign(ctx, prID, oldRevID, "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
 	}
-	if prRes != nil {
-		t.Fatalf("expected nil pull request, got %#v", prRes)
+
+	if updatedPR == nil {
+		t.Fatal("expected non-nil pull request")
 	}
-	if newRev != "" {
-		t.Fatalf("expected empty new reviewer, got %s", newRev)
+
+	if newReviewer != newCandidateID {
+		t.Fatalf("expected new reviewer %s, got %s", newCandidateID, newReviewer)
 	}
 }
 
-func TestPullRequestService_Reassign_UpdateError(t *testing.T) {
+func TestPullRequestService_Reassign_KeepsSlotOriginTeam(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
@@ -995,31 +1192,31 @@ func TestPullRequestService_Reassign_UpdateError(t *testing.T) {
 	teamRepo := mocks.NewMockTeamRepository(ctrl)
 	txMgr := mocks.NewMockTxManager(ctrl)
 
-	service := NewPullRequestService(prRepo, teamRepo, txMgr)
+	service := NewPullRequestService(prRepo, teamRepo, txMgr, anyEventPublisher(ctrl))
 
 	ctx := context.Background()
 	prID := domain.PullRequestID("pr-1")
-	authorID := domain.UserID("author")
-	oldRevID := domain.UserID("rev-old")
+	oldRevID := domain.UserID("sec-old")
+	newCandidateID := domain.UserID("sec-new")
 
 	pr := &domain.PullRequest{
 		ID:                prID,
-		AuthorID:          authorID,
+		AuthorID:          "author",
 		Status:            domain.PullRequestStatusOpen,
-		AssignedReviewers: []domain.UserID{oldRevID},
+		AssignedReviewers: []domain.UserID{"rev1", oldRevID},
+		ReviewerTeams: map[domain.UserID]domain.TeamName{
+			"rev1":   "backend",
+			oldRevID: "security",
+		},
 	}
 
-	team := &domain.Team{
-		Name: "backend",
+	securityTeam := &domain.Team{
+		Name: "security",
 		Members: []domain.TeamMember{
-			{ID: authorID, IsActive: true},
-			{ID: oldRevID, IsActive: true},
-			{ID: "rev-new", IsActive: true},
+			{ID: newCandidateID, IsActive: true},
 		},
 	}
 
-	expectedErr := errors.New("update error")
-
 	txMgr.
 		EXPECT().
 		WithinTransaction(ctx, gomock.Any()).
@@ -1034,21 +1231,195 @@ func TestPullRequestService_Reassign_UpdateError(t *testing.T) {
 
 	teamRepo.
 		EXPECT().
-		GetByUserID(gomock.Any(), oldRevID).
-		Return(team, nil)
+		GetByName(gomock.Any(), domain.TeamName("security")).
+		Return(securityTeam, nil)
 
 	prRepo.
 		EXPECT().
 		Update(gomock.Any(), pr).
+		Return(nil)
+
+	updatedPR, newReviewer, err := service.Reassign(ctx, prID, oldRevID, "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if newReviewer != newCandidateID {
+		t.Fatalf("expected new reviewer %s, got %s", newCandidateID, newReviewer)
+	}
+	if got := updatedPR.ReviewerTeams[newCandidateID]; got != securityTeam.Name {
+		t.Errorf("expected new reviewer slot from %s, got %s", securityTeam.Name, got)
+	}
+	if _, ok := updatedPR.ReviewerTeams[oldRevID]; ok {
+		t.Errorf("old reviewer must not keep a slot team")
+	}
+}
+
+func TestPullRequestService_Reassign_TxError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	prRepo := mocks.NewMockPullRequestRepository(ctrl)
+	teamRepo := mocks.NewMockTeamRepository(ctrl)
+	txMgr := mocks.NewMockTxManager(ctrl)
+
+	service := NewPullRequestService(prRepo, teamRepo, txMgr, anyEventPublisher(ctrl))
+
+	ctx := context.Background()
+	prID := domain.PullRequestID("pr-1")
+	oldRevID := domain.UserID("rev-old")
+
+	expectedErr := errors.New("tx error")
+
+	txMgr.
+		EXPECT().
+		WithinTransaction(ctx, gomock.Any()).
 		Return(expectedErr)
 
-	prRes, newRev, err := service.Reassign(ctx, prID, oldRevID)
+	pr, newRev, err := service.Reassign(ctx, prID, oldRevID, "")
+	if err == nil {
+		t.Fatal("expected error, got nil")
+	}
+	if !errors.Is(err, expectedErr) {
+		t.Fatalf("expected %v, got %v", expectedErr, err)
+	}
+	if pr != nil {
+		t.Fatalf("expected nil pull request, got %#v", pr)
+	}
+	if newRev != "" {
+		t.Fatalf("expected empty new reviewer, got %s", newRev)
+	}
+}
+
+func TestPullRequestService_Reassign_GetByIDError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	prRepo := mocks.NewMockPullRequestRepository(ctrl)
+	teamRepo := mocks.NewMockTeamRepository(ctrl)
+	txMgr := mocks.NewMockTxManager(ctrl)
+
+	service := NewPullRequestService(prRepo, teamRepo, txMgr, anyEventPublisher(ctrl))
+
+	ctx := context.Background()
+	prID := domain.PullRequestID("pr-1")
+	oldRevID := domain.UserID("rev-old")
+
+	expectedErr := errors.New("get pr error")
+
+	txMgr.
+		EXPECT().
+		WithinTransaction(ctx, gomock.Any()).
+		DoAndReturn(func(c context.Context, fn func(context.Context) error) error {
+			return fn(c)
+		})
+
+	prRepo.
+		EXPECT().
+		GetByID(gomock.Any(), prID).
+		Return(nil, expectedErr)
+
+	pr, newRev, err := service.Reassign(ctx, prID, oldRevID, "")
 	if err == nil {
 		t.Fatal("expected error, got nil")
 	}
 	if !errors.Is(err, expectedErr) {
 		t.Fatalf("expected %v, got %v", expectedErr, err)
 	}
+	if pr != nil {
+		t.Fatalf("expected nil pull request, got %#v", pr)
+	}
+	if newRev != "" {
+		t.Fatalf("expected empty new reviewer, got %s", newRev)
+	}
+}
+
+func TestPullRequestService_Reassign_MergedPR(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	prRepo := mocks.NewMockPullRequestRepository(ctrl)
+	teamRepo := mocks.NewMockTeamRepository(ctrl)
+	txMgr := mocks.NewMockTxManager(ctrl)
+
+	service := NewPullRequestService(prRepo, teamRepo, txMgr, anyEventPublisher(ctrl))
+
+	ctx := context.Background()
+	prID := domain.PullRequestID("pr-1")
+	oldRevID := domain.UserID("rev-old")
+
+	pr := &domain.PullRequest{
+		ID:                prID,
+		Status:            domain.PullRequestStatusMerged,
+		AssignedReviewers: []domain.UserID{oldRevID},
+	}
+
+	txMgr.
+		EXPECT().
+		WithinTransaction(ctx, gomock.Any()).
+		DoAndReturn(func(c context.Context, fn func(context.Context) error) error {
+			return fn(c)
+		})
+
+	prRepo.
+		EXPECT().
+		GetByID(gomock.Any(), prID).
+		Return(pr, nil)
+
+	prRes, newRev, err := service.Reassign(ctx, prID, oldRevID, "")
+	if err == nil {
+		t.Fatal("expected error, got nil")
+	}
+	if !errors.Is(err, domain.ErrReassignMergedPullRequest) {
+		t.Fatalf("expected ErrReassignMergedPullRequest, got %v", err)
+	}
+	if prRes != nil {
+		t.Fatalf("expected nil pull request, got %#v", prRes)
+	}
+	if newRev != "" {
+		t.Fatalf("expected empty new reviewer, got %s", newRev)
+	}
+}
+
+func TestPullRequestService_Reassign_ReviewerNotAssigned(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	prRepo := mocks.NewMockPullRequestRepository(ctrl)
+	teamRepo := mocks.NewMockTeamRepository(ctrl)
+	txMgr := mocks.NewMockTxManager(ctrl)
+
+	service := NewPullRequestService(prRepo, teamRepo, txMgr, anyEventPublisher(ctrl))
+
+	ctx := context.Background()
+	prID := domain.PullRequestID("pr-1")
+	oldRevID := domain.UserID("rev-old")
+
+	pr := &domain.PullRequest{
+		ID:                prID,
+		Status:            domain.PullRequestStatusOpen,
+		AssignedReviewers: []domain.UserID{"other"},
+	}
+
+	txMgr.
+		EXPECT().
+		WithinTransaction(ctx, gomock.Any()).
+		DoAndReturn(func(c context.Context, fn func(context.Context) error) error {
+			return fn(c)
+		})
+
+	prRepo.
+		EXPECT().
+		GetByID(gomock.Any(), prID).
+		Return(pr, nil)
+
+	prRes, newRev, err := service.Reassign(ctx, prID, oldRevID, "")
+	if err == nil {
+		t.Fatal("expected error, got nil")
+	}
+	if !errors.Is(err, domain.ErrReviewerIsNotAssigned) {
+		t.Fatalf("expected ErrReviewerIsNotAssigned, got %v", err)
+	}
 	if prRes != nil {
 		t.Fatalf("expected nil pull request, got %#v", prRes)
 	}
@@ -1056,3 +1427,940 @@ func TestPullRequestService_Reassign_UpdateError(t *testing.T) {
 		t.Fatalf("expected empty new reviewer, got %s", newRev)
 	}
 }
+
+func TestPullRequestService_Reassign_GetTeamError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	prRepo := mocks.NewMockPullRequestRepository(ctrl)
+	teamRepo := mocks.NewMockTeamRepository(ctrl)
+	txMgr := mocks.NewMockTxManager(ctrl)
+
+	service := NewPullRequestService(prRepo, teamRepo, txMgr, anyEventPublisher(ctrl))
+
+	ctx := context.Background()
+	prID := domain.PullRequestID("pr-1")
+	authorID := domain.UserID("author")
+	oldRevID := domain.UserID("rev-old")
+
+	pr := &domain.PullRequest{
+		ID:                prID,
+		AuthorID:          authorID,
+		Status:            domain.PullRequestStatusOpen,
+		AssignedReviewers: []domain.UserID{oldRevID},
+	}
+
+	expectedErr := errors.New("get team error")
+
+	txMgr.
+		EXPECT().
+		WithinTransaction(ctx, gomock.Any()).
+		DoAndReturn(func(c context.Context, fn func(context.Context) error) error {
+			return fn(c)
+		})
+
+	prRepo.
+		EXPECT().
+		GetByID(gomock.Any(), prID).
+		Return(pr, nil)
+
+	teamRepo.
+		EXPECT().
+		GetByUserID(gomock.Any(), oldRevID).
+		Return(nil, expectedErr)
+
+	prRes, newRev, err := service.Reassign(ctx, prID, oldRevID, "")
+	if err == nil {
+		t.Fatal("expected error, got nil")
+	}
+	if !errors.Is(err, expectedErr) {
+		t.Fatalf("expected %v, got %v", expectedErr, err)
+	}
+	if prRes != nil {
+		t.Fatalf("expected nil pull request, got %#v", prRes)
+	}
+	if newRev != "" {
+		t.Fatalf("expected empty new reviewer, got %s", newRev)
+	}
+}
+
+func TestPullRequestService_Reassign_NoCandidate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	prRepo := mocks.NewMockPullRequestRepository(ctrl)
+	teamRepo := mocks.NewMockTeamRepository(ctrl)
+	txMgr := mocks.NewMockTxManager(ctrl)
+
+	service := NewPullRequestService(prRepo, teamRepo, txMgr, anyEventPublisher(ctrl))
+
+	ctx := context.Background()
+	prID := domain.PullRequestID("pr-1")
+	authorID := domain.UserID("author")
+	oldRevID := domain.UserID("rev-old")
+
+	pr := &domain.PullRequest{
+		ID:                prID,
+		AuthorID:          authorID,
+		Status:            domain.PullRequestStatusOpen,
+		AssignedReviewers: []domain.UserID{oldRevID},
+	}
+
+	team := &domain.Team{
+		Name: "backend",
+		Members: []domain.TeamMember{
+			{ID: authorID, IsActive: true},
+			{ID: oldRevID, IsActive: true},
+		},
+	}
+
+	txMgr.
+		EXPECT().
+		WithinTransaction(ctx, gomock.Any()).
+		DoAndReturn(func(c context.Context, fn func(context.Context) error) error {
+			return fn(c)
+		})
+
+	prRepo.
+		EXPECT().
+		GetByID(gomock.Any(), prID).
+		Return(pr, nil)
+
+	teamRepo.
+		EXPECT().
+		GetByUserID(gomock.Any(), oldRevID).
+		Return(team, nil)
+
+	prRes, newRev, err := service.Reassign(ctx, prID, oldRevID, "")
+	if err == nil {
+		t.Fatal("expected error, got nil")
+	}
+	if !errors.Is(err, domain.ErrNoCandidate) {
+		t.Fatalf("expected ErrNoCandidate, got %v", err)
+	}
+	if prRes != nil {
+		t.Fatalf("expected nil pull request, got %#v", prRes)
+	}
+	if newRev != "" {
+		t.Fatalf("expected empty new reviewer, got %s", newRev)
+	}
+}
+
+func TestPullRequestService_Reassign_UpdateError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	prRepo := mocks.NewMockPullRequestRepository(ctrl)
+	teamRepo := mocks.NewMockTeamRepository(ctrl)
+	txMgr := mocks.NewMockTxManager(ctrl)
+
+	service := NewPullRequestService(prRepo, teamRepo, txMgr, anyEventPublisher(ctrl))
+
+	ctx := context.Background()
+	prID := domain.PullRequestID("pr-1")
+	authorID := domain.UserID("author")
+	oldRevID := domain.UserID("rev-old")
+
+	pr := &domain.PullRequest{
+		ID:                prID,
+		AuthorID:          authorID,
+		Status:            domain.PullRequestStatusOpen,
+		AssignedReviewers: []domain.UserID{oldRevID},
+	}
+
+	team := &domain.Team{
+		Name: "backend",
+		Members: []domain.TeamMember{
+			{ID: authorID, IsActive: true},
+			{ID: oldRevID, IsActive: true},
+			{ID: "rev-new", IsActive: true},
+		},
+	}
+
+	expectedErr := errors.New("update error")
+
+	txMgr.
+		EXPECT().
+		WithinTransaction(ctx, gomock.Any()).
+		DoAndReturn(func(c context.Context, fn func(context.Context) error) error {
+			return fn(c)
+		})
+
+	prRepo.
+		EXPECT().
+		GetByID(gomock.Any(), prID).
+		Return(pr, nil)
+
+	teamRepo.
+		EXPECT().
+		GetByUserID(gomock.Any(), oldRevID).
+		Return(team, nil)
+
+	prRepo.
+		EXPECT().
+		Update(gomock.Any(), pr).
+		Return(expectedErr)
+
+	prRes, newRev, err := service.Reassign(ctx, prID, oldRevID, "")
+	if err == nil {
+		t.Fatal("expected error, got nil")
+	}
+	if !errors.Is(err, expectedErr) {
+		t.Fatalf("expected %v, got %v", expectedErr, err)
+	}
+	if prRes != nil {
+		t.Fatalf("expected nil pull request, got %#v", prRes)
+	}
+	if newRev != "" {
+		t.Fatalf("expected empty new reviewer, got %s", newRev)
+	}
+}
+
+func TestPullRequestService_AddReviewer_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	prRepo := mocks.NewMockPullRequestRepository(ctrl)
+	teamRepo := mocks.NewMockTeamRepository(ctrl)
+	txMgr := mocks.NewMockTxManager(ctrl)
+	publisher := mocks.NewMockEventPublisher(ctrl)
+
+	service := NewPullRequestService(prRepo, teamRepo, txMgr, publisher)
+
+	ctx := context.Background()
+	prID := domain.PullRequestID("pr-1")
+	reviewerID := domain.UserID("rev-new")
+
+	pr := &domain.PullRequest{
+		ID:                prID,
+		AuthorID:          "author",
+		Status:            domain.PullRequestStatusOpen,
+		AssignedReviewers: []domain.UserID{"rev1"},
+		ReviewerTeams:     map[domain.UserID]domain.TeamName{"rev1": "backend"},
+	}
+
+	team := &domain.Team{
+		Name:         "backend",
+		MaxReviewers: 2,
+		Members: []domain.TeamMember{
+			{ID: "rev1", IsActive: true},
+			{ID: reviewerID, IsActive: true},
+		},
+	}
+
+	txMgr.
+		EXPECT().
+		WithinTransaction(ctx, gomock.Any()).
+		DoAndReturn(func(c context.Context, fn func(context.Context) error) error {
+			return fn(c)
+		})
+
+	prRepo.
+		EXPECT().
+		GetByID(gomock.Any(), prID).
+		Return(pr, nil)
+
+	teamRepo.
+		EXPECT().
+		GetByUserID(gomock.Any(), reviewerID).
+		Return(team, nil)
+
+	prRepo.
+		EXPECT().
+		Update(gomock.Any(), pr).
+		Return(nil)
+
+	publisher.
+		EXPECT().
+		Publish(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, events ...domain.Event) error {
+			if len(events) != 1 || events[0].Type != domain.EventReviewerAssigned ||
+				events[0].NewReviewerID != reviewerID {
+				t.Fatalf("expected one REVIEWER_ASSIGNED event for %s, got %+v", reviewerID, events)
+			}
+			return nil
+		})
+
+	got, err := service.AddReviewer(ctx, prID, reviewerID)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !slices.Equal(got.AssignedReviewers, []domain.UserID{"rev1", reviewerID}) {
+		t.Fatalf("unexpected reviewers: %v", got.AssignedReviewers)
+	}
+	if got.ReviewerTeams[reviewerID] != team.Name {
+		t.Errorf("expected slot team %s, got %s", team.Name, got.ReviewerTeams[reviewerID])
+	}
+}
+
+func TestPullRequestService_AddReviewer_Errors(t *testing.T) {
+	authorID := domain.UserID("author")
+	reviewerID := domain.UserID("rev-new")
+
+	tests := []struct {
+		name       string
+		pr         *domain.PullRequest
+		reviewerID domain.UserID
+		team       *domain.Team
+		wantErr    error
+	}{
+		{
+			name: "merged PR",
+			pr: &domain.PullRequest{
+				AuthorID: authorID,
+				Status:   domain.PullRequestStatusMerged,
+			},
+			reviewerID: reviewerID,
+			wantErr:    domain.ErrChangeMergedPullRequest,
+		},
+		{
+			name: "draft PR",
+			pr: &domain.PullRequest{
+				AuthorID: authorID,
+				Status:   domain.PullRequestStatusDraft,
+			},
+			reviewerID: reviewerID,
+			wantErr:    domain.ErrPullRequestNotOpen,
+		},
+		{
+			name: "author cannot review own PR",
+			pr: &domain.PullRequest{
+				AuthorID: authorID,
+				Status:   domain.PullRequestStatusOpen,
+			},
+			reviewerID: authorID,
+			team: &domain.Team{
+				Name:    "backend",
+				Members: []domain.TeamMember{{ID: authorID, IsActive: true}},
+			},
+			wantErr: domain.ErrReviewerIsAuthor,
+		},
+		{
+			name: "reviewer already assigned",
+			pr: &domain.PullRequest{
+				AuthorID:          authorID,
+				Status:            domain.PullRequestStatusOpen,
+				AssignedReviewers: []domain.UserID{reviewerID},
+			},
+			reviewerID: reviewerID,
+			team: &domain.Team{
+				Name:    "backend",
+				Members: []domain.TeamMember{{ID: reviewerID, IsActive: true}},
+			},
+			wantErr: domain.ErrReviewerAlreadyAssigned,
+		},
+		{
+			name: "reviewer inactive",
+			pr: &domain.PullRequest{
+				AuthorID: authorID,
+				Status:   domain.PullRequestStatusOpen,
+			},
+			reviewerID: reviewerID,
+			team: &domain.Team{
+				Name:    "backend",
+				Members: []domain.TeamMember{{ID: reviewerID, IsActive: false}},
+			},
+			wantErr: domain.ErrReviewerInactive,
+		},
+		{
+			name: "team limit reached",
+			pr: &domain.PullRequest{
+				AuthorID:          authorID,
+				Status:            domain.PullRequestStatusOpen,
+				AssignedReviewers: []domain.UserID{"rev1"},
+				ReviewerTeams:     map[domain.UserID]domain.TeamName{"rev1": "backend"},
+			},
+			reviewerID: reviewerID,
+			team: &domain.Team{
+				Name:         "backend",
+				MaxReviewers: 1,
+				Members: []domain.TeamMember{
+					{ID: "rev1", IsActive: true},
+					{ID: reviewerID, IsActive: true},
+				},
+			},
+			wantErr: domain.ErrReviewersLimitExceeded,
+		},
+		{
+			name: "team rule excludes reviewer",
+			pr: &domain.PullRequest{
+				AuthorID: authorID,
+				Status:   domain.PullRequestStatusOpen,
+			},
+			reviewerID: reviewerID,
+			team: &domain.Team{
+				Name:    "backend",
+				Members: []domain.TeamMember{{ID: reviewerID, IsActive: true}},
+				Rules: []domain.TeamRule{{
+					ID:         1,
+					TeamName:   "backend",
+					Kind:       domain.TeamRuleKindExcludePair,
+					ReviewerID: reviewerID,
+					AuthorID:   authorID,
+				}},
+			},
+			wantErr: domain.ErrRuleViolation,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			prRepo := mocks.NewMockPullRequestRepository(ctrl)
+			teamRepo := mocks.NewMockTeamRepository(ctrl)
+			txMgr := mocks.NewMockTxManager(ctrl)
+
+			service := NewPullRequestService(prRepo, teamRepo, txMgr, anyEventPublisher(ctrl))
+			ctx := context.Background()
+
+			txMgr.
+				EXPECT().
+				WithinTransaction(ctx, gomock.Any()).
+				DoAndReturn(func(c context.Context, fn func(context.Context) error) error {
+					return fn(c)
+				})
+
+			prRepo.
+				EXPECT().
+				GetByID(gomock.Any(), domain.PullRequestID("pr-1")).
+				Return(tt.pr, nil)
+
+			if tt.team != nil {
+				teamRepo.
+					EXPECT().
+					GetByUserID(gomock.Any(), tt.reviewerID).
+					Return(tt.team, nil)
+			}
+
+			got, err := service.AddReviewer(ctx, "pr-1", tt.reviewerID)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("expected %v, got %v", tt.wantErr, err)
+			}
+			if got != nil {
+				t.Fatalf("expected nil pull request, got %#v", got)
+			}
+		})
+	}
+}
+
+func TestPullRequestService_RemoveReviewer_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	prRepo := mocks.NewMockPullRequestRepository(ctrl)
+	teamRepo := mocks.NewMockTeamRepository(ctrl)
+	txMgr := mocks.NewMockTxManager(ctrl)
+
+	publisher := mocks.NewMockEventPublisher(ctrl)
+
+	service := NewPullRequestService(prRepo, teamRepo, txMgr, publisher)
+
+	ctx := context.Background()
+	prID := domain.PullRequestID("pr-1")
+
+	pr := &domain.PullRequest{
+		ID:                prID,
+		AuthorID:          "author",
+		Status:            domain.PullRequestStatusOpen,
+		AssignedReviewers: []domain.UserID{"rev1", "rev2"},
+		ReviewerTeams:     map[domain.UserID]domain.TeamName{"rev1": "backend", "rev2": "backend"},
+	}
+
+	txMgr.
+		EXPECT().
+		WithinTransaction(ctx, gomock.Any()).
+		DoAndReturn(func(c context.Context, fn func(context.Context) error) error {
+			return fn(c)
+		})
+
+	prRepo.
+		EXPECT().
+		GetByID(gomock.Any(), prID).
+		Return(pr, nil)
+
+	teamRepo.
+		EXPECT().
+		GetByName(gomock.Any(), domain.TeamName("backend")).
+		Return(&domain.Team{Name: "backend"}, nil)
+
+	prRepo.
+		EXPECT().
+		Update(gomock.Any(), pr).
+		Return(nil)
+
+	publisher.
+		EXPECT().
+		Publish(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, events ...domain.Event) error {
+			if len(events) != 1 || events[0].Type != domain.EventReviewerRemoved || events[0].OldReviewerID != "rev1" {
+				t.Fatalf("expected one REVIEWER_REMOVED event for rev1, got %+v", events)
+			}
+			if !slices.Equal(events[0].PullRequest.AssignedReviewers, []domain.UserID{"rev2"}) {
+				t.Fatalf("expected reviewers after removal in event, got %v", events[0].PullRequest.AssignedReviewers)
+			}
+			return nil
+		})
+
+	got, err := service.RemoveReviewer(ctx, prID, "rev1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !slices.Equal(got.AssignedReviewers, []domain.UserID{"rev2"}) {
+		t.Fatalf("unexpected reviewers: %v", got.AssignedReviewers)
+	}
+	if _, ok := got.ReviewerTeams["rev1"]; ok {
+		t.Errorf("removed reviewer must not keep a slot team")
+	}
+}
+
+func TestPullRequestService_ChangeReviewers_KeepsRequirements(t *testing.T) {
+	requireSenior := domain.TeamRule{
+		ID: 7, TeamName: "backend", Kind: domain.TeamRuleKindRequireOneOf, Users: []domain.UserID{"senior"},
+	}
+	team := &domain.Team{
+		Name: "backend",
+		Members: []domain.TeamMember{
+			{ID: "author", IsActive: true},
+			{ID: "senior", IsActive: true},
+			{ID: "junior", IsActive: true},
+			{ID: "middle", IsActive: true},
+		},
+		Rules: []domain.TeamRule{requireSenior},
+	}
+
+	tests := []struct {
+		name      string
+		reviewers []domain.UserID
+		change    func(s *PullRequestService, ctx context.Context) error
+		wantErr   error
+	}{
+		{
+			name:      "removing the only required reviewer",
+			reviewers: []domain.UserID{"senior", "junior"},
+			change: func(s *PullRequestService, ctx context.Context) error {
+				_, err := s.RemoveReviewer(ctx, "pr-1", "senior")
+				return err
+			},
+			wantErr: domain.ErrRuleViolation,
+		},
+		{
+			name:      "replacing the only required reviewer with a chosen one",
+			reviewers: []domain.UserID{"senior", "junior"},
+			change: func(s *PullRequestService, ctx context.Context) error {
+				_, _, err := s.Reassign(ctx, "pr-1", "senior", "middle")
+				return err
+			},
+			wantErr: domain.ErrRuleViolation,
+		},
+		{
+			name:      "removing a reviewer from a pull request that never met the rule",
+			reviewers: []domain.UserID{"junior", "middle"},
+			change: func(s *PullRequestService, ctx context.Context) error {
+				_, err := s.RemoveReviewer(ctx, "pr-1", "junior")
+				return err
+			},
+		},
+		{
+			name:      "replacing another reviewer with a chosen one",
+			reviewers: []domain.UserID{"senior", "junior"},
+			change: func(s *PullRequestService, ctx context.Context) error {
+				_, _, err := s.Reassign(ctx, "pr-1", "junior", "middle")
+				return err
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			prRepo := mocks.NewMockPullRequestRepository(ctrl)
+			teamRepo := mocks.NewMockTeamRepository(ctrl)
+
+			service := NewPullRequestService(prRepo, teamRepo, passthroughTxManager(ctrl), anyEventPublisher(ctrl))
+
+			pr := &domain.PullRequest{
+				ID:                "pr-1",
+				AuthorID:          "author",
+				Status:            domain.PullRequestStatusOpen,
+				AssignedReviewers: slices.Clone(tt.reviewers),
+				ReviewerTeams:     map[domain.UserID]domain.TeamName{},
+			}
+			for _, reviewer := range tt.reviewers {
+				pr.ReviewerTeams[reviewer] = team.Name
+			}
+
+			prRepo.EXPECT().GetByID(gomock.Any(), pr.ID).Return(pr, nil)
+			teamRepo.EXPECT().GetByName(gomock.Any(), team.Name).Return(team, nil)
+			if tt.wantErr == nil {
+				prRepo.EXPECT().Update(gomock.Any(), pr).Return(nil)
+			}
+
+			err := tt.change(service, context.Background())
+			if tt.wantErr == nil {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+
+			var ruleErr *domain.RuleViolationError
+			if !errors.Is(err, tt.wantErr) || !errors.As(err, &ruleErr) || ruleErr.Rule.ID != requireSenior.ID {
+				t.Fatalf("expected violation of rule %d, got %v", requireSenior.ID, err)
+			}
+			if !slices.Equal(pr.AssignedReviewers, tt.reviewers) {
+				t.Fatalf("reviewers must stay unchanged, got %v", pr.AssignedReviewers)
+			}
+		})
+	}
+}
+
+func TestPullRequestService_RemoveReviewer_NotAssigned(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	prRepo := mocks.NewMockPullRequestRepository(ctrl)
+	teamRepo := mocks.NewMockTeamRepository(ctrl)
+	txMgr := mocks.NewMockTxManager(ctrl)
+
+	service := NewPullRequestService(prRepo, teamRepo, txMgr, anyEventPublisher(ctrl))
+
+	ctx := context.Background()
+	prID := domain.PullRequestID("pr-1")
+
+	pr := &domain.PullRequest{
+		ID:                prID,
+		Status:            domain.PullRequestStatusOpen,
+		AssignedReviewers: []domain.UserID{"rev1"},
+	}
+
+	txMgr.
+		EXPECT().
+		WithinTransaction(ctx, gomock.Any()).
+		DoAndReturn(func(c context.Context, fn func(context.Context) error) error {
+			return fn(c)
+		})
+
+	prRepo.
+		EXPECT().
+		GetByID(gomock.Any(), prID).
+		Return(pr, nil)
+
+	got, err := service.RemoveReviewer(ctx, prID, "ghost")
+	if !errors.Is(err, domain.ErrReviewerIsNotAssigned) {
+		t.Fatalf("expected ErrReviewerIsNotAssigned, got %v", err)
+	}
+	if got != nil {
+		t.Fatalf("expected nil pull request, got %#v", got)
+	}
+}
+
+func TestPullRequestService_Reassign_ToChosenReviewer(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	prRepo := mocks.NewMockPullRequestRepository(ctrl)
+	teamRepo := mocks.NewMockTeamRepository(ctrl)
+	txMgr := mocks.NewMockTxManager(ctrl)
+
+	service := NewPullRequestService(prRepo, teamRepo, txMgr, anyEventPublisher(ctrl))
+
+	ctx := context.Background()
+	prID := domain.PullRequestID("pr-1")
+	oldRevID := domain.UserID("rev-old")
+	chosenID := domain.UserID("rev-chosen")
+
+	pr := &domain.PullRequest{
+		ID:                prID,
+		AuthorID:          "author",
+		Status:            domain.PullRequestStatusOpen,
+		AssignedReviewers: []domain.UserID{oldRevID, "rev-keep"},
+		ReviewerTeams:     map[domain.UserID]domain.TeamName{oldRevID: "backend", "rev-keep": "backend"},
+	}
+
+	team := &domain.Team{
+		Name: "backend",
+		Members: []domain.TeamMember{
+			{ID: oldRevID, IsActive: true},
+			{ID: "rev-keep", IsActive: true},
+			{ID: "rev-other", IsActive: true},
+			{ID: chosenID, IsActive: true},
+		},
+	}
+
+	txMgr.
+		EXPECT().
+		WithinTransaction(ctx, gomock.Any()).
+		DoAndReturn(func(c context.Context, fn func(context.Context) error) error {
+			return fn(c)
+		})
+
+	prRepo.
+		EXPECT().
+		GetByID(gomock.Any(), prID).
+		Return(pr, nil)
+
+	teamRepo.
+		EXPECT().
+		GetByName(gomock.Any(), domain.TeamName("backend")).
+		Return(team, nil)
+
+	prRepo.
+		EXPECT().
+		Update(gomock.Any(), pr).
+		Return(nil)
+
+	got, newReviewer, err := service.Reassign(ctx, prID, oldRevID, chosenID)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if newReviewer != chosenID {
+		t.Fatalf("expected new reviewer %s, got %s", chosenID, newReviewer)
+	}
+	if !slices.Equal(got.AssignedReviewers, []domain.UserID{"rev-keep", chosenID}) {
+		t.Fatalf("unexpected reviewers: %v", got.AssignedReviewers)
+	}
+	if got.ReviewerTeams[chosenID] != team.Name {
+		t.Errorf("expected slot team %s, got %s", team.Name, got.ReviewerTeams[chosenID])
+	}
+}
+
+func TestPullRequestService_Reassign_ChosenReviewerErrors(t *testing.T) {
+	authorID := domain.UserID("author")
+	oldRevID := domain.UserID("rev-old")
+
+	team := &domain.Team{
+		Name: "backend",
+		Members: []domain.TeamMember{
+			{ID: authorID, IsActive: true},
+			{ID: oldRevID, IsActive: true},
+			{ID: "rev-keep", IsActive: true},
+			{ID: "inactive", IsActive: false},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		newRevID domain.UserID
+		wantErr  error
+	}{
+		{name: "author", newRevID: authorID, wantErr: domain.ErrReviewerIsAuthor},
+		{name: "already assigned", newRevID: "rev-keep", wantErr: domain.ErrReviewerAlreadyAssigned},
+		{name: "same reviewer", newRevID: oldRevID, wantErr: domain.ErrReviewerAlreadyAssigned},
+		{name: "not in slot team", newRevID: "stranger", wantErr: domain.ErrReviewerNotInTeam},
+		{name: "inactive", newRevID: "inactive", wantErr: domain.ErrReviewerInactive},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			prRepo := mocks.NewMockPullRequestRepository(ctrl)
+			teamRepo := mocks.NewMockTeamRepository(ctrl)
+			txMgr := mocks.NewMockTxManager(ctrl)
+
+			service := NewPullRequestService(prRepo, teamRepo, txMgr, anyEventPublisher(ctrl))
+			ctx := context.Background()
+
+			pr := &domain.PullRequest{
+				ID:                "pr-1",
+				AuthorID:          authorID,
+				Status:            domain.PullRequestStatusOpen,
+				AssignedReviewers: []domain.UserID{oldRevID, "rev-keep"},
+			}
+
+			txMgr.
+				EXPECT().
+				WithinTransaction(ctx, gomock.Any()).
+				DoAndReturn(func(c context.Context, fn func(context.Context) error) error {
+					return fn(c)
+				})
+
+			prRepo.
+				EXPECT().
+				GetByID(gomock.Any(), pr.ID).
+				Return(pr, nil)
+
+			teamRepo.
+				EXPECT().
+				GetByUserID(gomock.Any(), oldRevID).
+				Return(team, nil)
+
+			got, newReviewer, err := service.Reassign(ctx, pr.ID, oldRevID, tt.newRevID)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("expected %v, got %v", tt.wantErr, err)
+			}
+			if got != nil {
+				t.Fatalf("expected nil pull request, got %#v", got)
+			}
+			if newReviewer != "" {
+				t.Fatalf("expected empty new reviewer, got %s", newReviewer)
+			}
+		})
+	}
+}
+
+func TestPullRequestService_StatusTransitions(t *testing.T) {
+	type transition func(
+		s *PullRequestService,
+		ctx context.Context,
+		id domain.PullRequestID,
+	) (*domain.PullRequest, error)
+
+	merge := (*PullRequestService).Merge
+	closePR := (*PullRequestService).Close
+	markReady := (*PullRequestService).MarkReady
+	reopen := (*PullRequestService).Reopen
+	draft := func(s *PullRequestService, ctx context.Context, id domain.PullRequestID) (*domain.PullRequest, error) {
+		return s.UpdateDetails(ctx, id, "", true)
+	}
+	rename := func(s *PullRequestService, ctx context.Context, id domain.PullRequestID) (*domain.PullRequest, error) {
+		return s.UpdateDetails(ctx, id, "new name", false)
+	}
+
+	tests := []struct {
+		name       string
+		call       transition
+		from       domain.PullRequestStatus
+		wantStatus domain.PullRequestStatus
+		wantEvent  domain.EventType
+		wantErr    error
+	}{
+		{name: "merge draft", call: merge, from: domain.PullRequestStatusDraft,
+			wantStatus: domain.PullRequestStatusMerged, wantEvent: domain.EventPullRequestMerged},
+		{name: "merge closed", call: merge, from: domain.PullRequestStatusClosed,
+			wantErr: domain.ErrPullRequestClosed},
+		{name: "close open", call: closePR, from: domain.PullRequestStatusOpen,
+			wantStatus: domain.PullRequestStatusClosed, wantEvent: domain.EventPullRequestClosed},
+		{name: "close draft", call: closePR, from: domain.PullRequestStatusDraft,
+			wantStatus: domain.PullRequestStatusClosed, wantEvent: domain.EventPullRequestClosed},
+		{name: "close closed is no-op", call: closePR, from: domain.PullRequestStatusClosed,
+			wantStatus: domain.PullRequestStatusClosed},
+		{name: "close merged", call: closePR, from: domain.PullRequestStatusMerged,
+			wantErr: domain.ErrPullRequestMerged},
+		{name: "ready draft", call: markReady, from: domain.PullRequestStatusDraft,
+			wantStatus: domain.PullRequestStatusOpen, wantEvent: domain.EventPullRequestUpdated},
+		{name: "ready open is no-op", call: markReady, from: domain.PullRequestStatusOpen,
+			wantStatus: domain.PullRequestStatusOpen},
+		{name: "ready closed", call: markReady, from: domain.PullRequestStatusClosed,
+			wantErr: domain.ErrPullRequestClosed},
+		{name: "ready merged", call: markReady, from: domain.PullRequestStatusMerged,
+			wantErr: domain.ErrPullRequestMerged},
+		{name: "reopen closed", call: reopen, from: domain.PullRequestStatusClosed,
+			wantStatus: domain.PullRequestStatusOpen, wantEvent: domain.EventPullRequestReopened},
+		{name: "reopen open is no-op", call: reopen, from: domain.PullRequestStatusOpen,
+			wantStatus: domain.PullRequestStatusOpen},
+		{name: "back to draft", call: draft, from: domain.PullRequestStatusOpen,
+			wantStatus: domain.PullRequestStatusDraft, wantEvent: domain.EventPullRequestUpdated},
+		{name: "rename closed", call: rename, from: domain.PullRequestStatusClosed,
+			wantStatus: domain.PullRequestStatusClosed, wantEvent: domain.EventPullRequestUpdated},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			prRepo := mocks.NewMockPullRequestRepository(ctrl)
+			txMgr := mocks.NewMockTxManager(ctrl)
+			publisher := mocks.NewMockEventPublisher(ctrl)
+
+			service := NewPullRequestService(prRepo, mocks.NewMockTeamRepository(ctrl), txMgr, publisher)
+
+			ctx := context.Background()
+			prID := domain.PullRequestID("pr-1")
+
+			txMgr.
+				EXPECT().
+				WithinTransaction(ctx, gomock.Any()).
+				DoAndReturn(func(c context.Context, fn func(context.Context) error) error {
+					return fn(c)
+				})
+
+			prRepo.
+				EXPECT().
+				GetByID(gomock.Any(), prID).
+				Return(&domain.PullRequest{ID: prID, Name: "old name", Status: tt.from}, nil)
+
+			if tt.wantEvent != "" {
+				prRepo.
+					EXPECT().
+					Update(gomock.Any(), gomock.Any()).
+					Return(nil)
+
+				publisher.
+					EXPECT().
+					Publish(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, events ...domain.Event) error {
+						if len(events) != 1 || events[0].Type != tt.wantEvent {
+							t.Fatalf("expected one %s event, got %+v", tt.wantEvent, events)
+						}
+						if events[0].PullRequest.Status != tt.wantStatus {
+							t.Fatalf("expected status %s in event, got %s", tt.wantStatus, events[0].PullRequest.Status)
+						}
+						return nil
+					})
+			}
+
+			got, err := tt.call(service, ctx, prID)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("expected error %v, got %v", tt.wantErr, err)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+
+			if got.Status != tt.wantStatus {
+				t.Fatalf("expected status %s, got %s", tt.wantStatus, got.Status)
+			}
+		})
+	}
+}
+
+func TestPullRequestService_ChangeReviewers_ClosedPR(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	prRepo := mocks.NewMockPullRequestRepository(ctrl)
+	txMgr := mocks.NewMockTxManager(ctrl)
+
+	service := NewPullRequestService(prRepo, mocks.NewMockTeamRepository(ctrl), txMgr, anyEventPublisher(ctrl))
+
+	ctx := context.Background()
+	prID := domain.PullRequestID("pr-1")
+
+	txMgr.
+		EXPECT().
+		WithinTransaction(ctx, gomock.Any()).
+		DoAndReturn(func(c context.Context, fn func(context.Context) error) error {
+			return fn(c)
+		}).
+		Times(3)
+
+	prRepo.
+		EXPECT().
+		GetByID(gomock.Any(), prID).
+		Return(&domain.PullRequest{
+			ID:                prID,
+			Status:            domain.PullRequestStatusClosed,
+			AssignedReviewers: []domain.UserID{"u2"},
+		}, nil).
+		Times(3)
+
+	if _, _, err := service.Reassign(ctx, prID, "u2", ""); !errors.Is(err, domain.ErrPullRequestClosed) {
+		t.Fatalf("Reassign: expected ErrPullRequestClosed, got %v", err)
+	}
+	if _, err := service.AddReviewer(ctx, prID, "u3"); !errors.Is(err, domain.ErrPullRequestClosed) {
+		t.Fatalf("AddReviewer: expected ErrPullRequestClosed, got %v", err)
+	}
+	if _, err := service.RemoveReviewer(ctx, prID, "u2"); !errors.Is(err, domain.ErrPullRequestClosed) {
+		t.Fatalf("RemoveReviewer: expected ErrPullRequestClosed, got %v", err)
+	}
+}
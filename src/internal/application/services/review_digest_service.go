@@ -0,0 +1,111 @@
+package services
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"PrService/src/internal/application/contracts"
+
+	"PrService/src/internal/domain"
+)
+
+// ReviewDigestService sends every active reviewer the list of OPEN reviews still waiting on them.
+type ReviewDigestService struct {
+	userRepository        domain.UserRepository
+	pullRequestRepository domain.PullRequestRepository
+	sender                contracts.DigestSender
+	now                   func() time.Time
+}
+
+func NewReviewDigestService(
+	userRepository domain.UserRepository,
+	pullRequestRepository domain.PullRequestRepository,
+	sender contracts.DigestSender,
+) *ReviewDigestService {
+	return &ReviewDigestService{
+		userRepository:        userRepository,
+		pullRequestRepository: pullRequestRepository,
+		sender:                sender,
+		now:                   time.Now,
+	}
+}
+
+// SendDigests sends a digest to each active user who has OPEN reviews and has not opted out of email.
+// A failure for one user does not stop the others; it returns how many digests were sent.
+func (s *ReviewDigestService) SendDigests(ctx context.Context) (int, error) {
+	users, err := s.userRepository.List(ctx, domain.UserFilter{})
+	if err != nil {
+		return 0, err
+	}
+
+	now := s.now().UTC()
+	sent := 0
+	var errs []error
+	for _, user := range users {
+		if !user.IsActive || user.EmailOptOut || user.Email == "" {
+			continue
+		}
+
+		digest, err := s.buildDigest(ctx, user, now)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("build digest for %s: %w", user.ID, err))
+			continue
+		}
+		if len(digest.Reviews) == 0 {
+			continue
+		}
+
+		if err := s.sender.SendDigest(ctx, digest); err != nil {
+			errs = append(errs, fmt.Errorf("send digest to %s: %w", user.ID, err))
+			continue
+		}
+		sent++
+	}
+
+	return sent, errors.Join(errs...)
+}
+
+func (s *ReviewDigestService) buildDigest(
+	ctx context.Context,
+	user domain.User,
+	now time.Time,
+) (contracts.Digest, error) {
+	prs, err := s.pullRequestRepository.ListByReviewer(ctx, user.ID)
+	if err != nil {
+		return contracts.Digest{}, err
+	}
+
+	reviews := make([]contracts.DigestReview, 0, len(prs))
+	for _, pr := range prs {
+		if pr.Status != domain.PullRequestStatusOpen {
+			continue
+		}
+
+		var age time.Duration
+		if pr.CreatedAt != nil {
+			age = now.Sub(*pr.CreatedAt)
+		}
+		reviews = append(reviews, contracts.DigestReview{PullRequest: pr, Age: age})
+	}
+
+	slices.SortStableFunc(reviews, func(a, b contracts.DigestReview) int {
+		return cmp.Compare(b.Age, a.Age)
+	})
+
+	return contracts.Digest{Recipient: user, Reviews: reviews, GeneratedAt: now}, nil
+}
+
+// NextDailyRun returns the first moment after now that falls on the given time of day in now's location.
+func NextDailyRun(now time.Time, timeOfDay time.Duration) time.Time {
+	year, month, day := now.Date()
+	next := time.Date(year, month, day, 0, 0, 0, 0, now.Location()).Add(timeOfDay)
+	if !next.After(now) {
+		next = time.Date(year, month, day+1, 0, 0, 0, 0, now.Location()).Add(timeOfDay)
+	}
+
+	return next
+}
@@ -0,0 +1,157 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"PrService/src/internal/application/contracts"
+	"PrService/src/internal/application/mocks"
+	"PrService/src/internal/domain"
+
+	"go.uber.org/mock/gomock"
+)
+
+var digestTestNow = time.Date(2025, 3, 10, 9, 0, 0, 0, time.UTC)
+
+func newReviewDigestService(
+	t *testing.T,
+) (*ReviewDigestService, *mocks.MockUserRepository, *mocks.MockPullRequestRepository, *mocks.MockDigestSender) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	userRepo := mocks.NewMockUserRepository(ctrl)
+	prRepo := mocks.NewMockPullRequestRepository(ctrl)
+	sender := mocks.NewMockDigestSender(ctrl)
+
+	service := NewReviewDigestService(userRepo, prRepo, sender)
+	service.now = func() time.Time { return digestTestNow }
+
+	return service, userRepo, prRepo, sender
+}
+
+func daysAgo(days int) *time.Time {
+	t := digestTestNow.AddDate(0, 0, -days)
+	return &t
+}
+
+func TestReviewDigestService_SendDigests_OpenReviewsOldestFirst(t *testing.T) {
+	service, userRepo, prRepo, sender := newReviewDigestService(t)
+
+	ctx := context.Background()
+
+	userRepo.
+		EXPECT().
+		List(ctx, domain.UserFilter{}).
+		Return([]domain.User{
+			{ID: "u1", IsActive: true, Email: "alice@example.com"},
+			{ID: "u2", IsActive: true, Email: "bob@example.com", EmailOptOut: true},
+			{ID: "u3", IsActive: false, Email: "carol@example.com"},
+			{ID: "u4", IsActive: true},
+			{ID: "u5", IsActive: true, Email: "dave@example.com"},
+		}, nil)
+
+	prRepo.
+		EXPECT().
+		ListByReviewer(ctx, domain.UserID("u1")).
+		Return([]domain.PullRequest{
+			{ID: "pr-new", Status: domain.PullRequestStatusOpen, CreatedAt: daysAgo(1)},
+			{ID: "pr-merged", Status: domain.PullRequestStatusMerged, CreatedAt: daysAgo(9)},
+			{ID: "pr-old", Status: domain.PullRequestStatusOpen, CreatedAt: daysAgo(5)},
+			{ID: "pr-draft", Status: domain.PullRequestStatusDraft, CreatedAt: daysAgo(7)},
+		}, nil)
+
+	prRepo.
+		EXPECT().
+		ListByReviewer(ctx, domain.UserID("u5")).
+		Return([]domain.PullRequest{}, nil)
+
+	sender.
+		EXPECT().
+		SendDigest(ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, digest contracts.Digest) error {
+			if digest.Recipient.ID != "u1" || !digest.GeneratedAt.Equal(digestTestNow) {
+				t.Fatalf("unexpected digest: %+v", digest)
+			}
+			if len(digest.Reviews) != 2 ||
+				digest.Reviews[0].PullRequest.ID != "pr-old" || digest.Reviews[1].PullRequest.ID != "pr-new" {
+				t.Fatalf("expected open reviews oldest first, got %+v", digest.Reviews)
+			}
+			if digest.Reviews[0].Age != 5*24*time.Hour {
+				t.Fatalf("unexpected age: %v", digest.Reviews[0].Age)
+			}
+			return nil
+		})
+
+	sent, err := service.SendDigests(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if sent != 1 {
+		t.Fatalf("expected 1 digest sent, got %d", sent)
+	}
+}
+
+func TestReviewDigestService_SendDigests_ContinuesAfterFailure(t *testing.T) {
+	service, userRepo, prRepo, sender := newReviewDigestService(t)
+
+	ctx := context.Background()
+	open := []domain.PullRequest{{ID: "pr-1", Status: domain.PullRequestStatusOpen, CreatedAt: daysAgo(1)}}
+
+	userRepo.
+		EXPECT().
+		List(ctx, domain.UserFilter{}).
+		Return([]domain.User{
+			{ID: "u1", IsActive: true, Email: "alice@example.com"},
+			{ID: "u2", IsActive: true, Email: "bob@example.com"},
+		}, nil)
+
+	prRepo.EXPECT().ListByReviewer(ctx, domain.UserID("u1")).Return(open, nil)
+	prRepo.EXPECT().ListByReviewer(ctx, domain.UserID("u2")).Return(open, nil)
+
+	sender.EXPECT().SendDigest(ctx, gomock.Any()).Return(errors.New("smtp: 451 try later"))
+	sender.EXPECT().SendDigest(ctx, gomock.Any()).Return(nil)
+
+	sent, err := service.SendDigests(ctx)
+	if err == nil {
+		t.Fatal("expected error for the failed digest")
+	}
+	if sent != 1 {
+		t.Fatalf("expected the second digest to be sent, got %d", sent)
+	}
+}
+
+func TestNextDailyRun(t *testing.T) {
+	at := 9 * time.Hour
+
+	tests := []struct {
+		name string
+		now  time.Time
+		want time.Time
+	}{
+		{
+			name: "later today",
+			now:  time.Date(2025, 3, 10, 8, 30, 0, 0, time.UTC),
+			want: time.Date(2025, 3, 10, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "exactly at run time schedules tomorrow",
+			now:  time.Date(2025, 3, 10, 9, 0, 0, 0, time.UTC),
+			want: time.Date(2025, 3, 11, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "end of month rolls over",
+			now:  time.Date(2025, 3, 31, 18, 0, 0, 0, time.UTC),
+			want: time.Date(2025, 4, 1, 9, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NextDailyRun(tt.now, at); !got.Equal(tt.want) {
+				t.Fatalf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
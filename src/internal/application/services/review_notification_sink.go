@@ -0,0 +1,140 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"PrService/src/internal/application/contracts"
+
+	"PrService/src/internal/domain"
+)
+
+// ReviewNotificationSink turns relayed review events into personal notifications:
+// reviewers learn about assignments, reassignments and overdue reviews, team leads about escalations
+// and authors about merges.
+type ReviewNotificationSink struct {
+	userRepository domain.UserRepository
+	notifier       contracts.Notifier
+	name           string
+}
+
+func NewReviewNotificationSink(
+	userRepository domain.UserRepository,
+	notifier contracts.Notifier,
+	name string,
+) *ReviewNotificationSink {
+	return &ReviewNotificationSink{
+		userRepository: userRepository,
+		notifier:       notifier,
+		name:           name,
+	}
+}
+
+func (s *ReviewNotificationSink) Name() string {
+	return s.name
+}
+
+// Publish notifies everyone the event concerns. When one notification fails the relay offers the
+// message again, so the others may be sent twice.
+func (s *ReviewNotificationSink) Publish(ctx context.Context, message domain.OutboxMessage) error {
+	switch message.EventType {
+	case domain.EventPullRequestCreated, domain.EventReviewerAssigned, domain.EventReviewerReassigned,
+		domain.EventPullRequestMerged, domain.EventReviewOverdue, domain.EventReviewEscalated:
+	default:
+		return nil
+	}
+
+	event, err := domain.DecodeEvent(message.Payload)
+	if err != nil {
+		return fmt.Errorf("decode outbox message %d: %w", message.ID, err)
+	}
+	if event.PullRequest == nil {
+		return nil
+	}
+
+	var errs []error
+	for _, draft := range reviewNotifications(event) {
+		user, err := s.userRepository.GetByID(ctx, draft.recipientID)
+		if err != nil {
+			if errors.Is(err, domain.ErrUserNotFound) {
+				continue
+			}
+			errs = append(errs, err)
+			continue
+		}
+
+		notification := contracts.Notification{Recipient: *user, Subject: draft.subject, Text: draft.text}
+		if err := s.notifier.Notify(ctx, notification); err != nil {
+			errs = append(errs, fmt.Errorf("notify %s: %w", user.ID, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+type notificationDraft struct {
+	recipientID domain.UserID
+	subject     string
+	text        string
+}
+
+func reviewNotifications(event domain.Event) []notificationDraft {
+	pr := event.PullRequest
+
+	assigned := func(reviewerID domain.UserID) notificationDraft {
+		return notificationDraft{
+			recipientID: reviewerID,
+			subject:     "Review requested: " + pr.Name,
+			text: fmt.Sprintf("You have been assigned to review pull request %q (%s) by %s.",
+				pr.Name, pr.ID, pr.AuthorID),
+		}
+	}
+
+	switch event.Type {
+	case domain.EventPullRequestCreated:
+		drafts := make([]notificationDraft, 0, len(pr.AssignedReviewers))
+		for _, reviewerID := range pr.AssignedReviewers {
+			drafts = append(drafts, assigned(reviewerID))
+		}
+		return drafts
+	case domain.EventReviewerAssigned:
+		return []notificationDraft{assigned(event.NewReviewerID)}
+	case domain.EventReviewerReassigned:
+		return []notificationDraft{
+			assigned(event.NewReviewerID),
+			{
+				recipientID: event.OldReviewerID,
+				subject:     "Review reassigned: " + pr.Name,
+				text: fmt.Sprintf("You are no longer a reviewer of pull request %q (%s); it was reassigned to %s.",
+					pr.Name, pr.ID, event.NewReviewerID),
+			},
+		}
+	case domain.EventPullRequestMerged:
+		return []notificationDraft{{
+			recipientID: pr.AuthorID,
+			subject:     "Merged: " + pr.Name,
+			text:        fmt.Sprintf("Your pull request %q (%s) has been merged.", pr.Name, pr.ID),
+		}}
+	case domain.EventReviewOverdue:
+		return []notificationDraft{{
+			recipientID: event.ReviewerID,
+			subject:     "Review overdue: " + pr.Name,
+			text: fmt.Sprintf("Your review of pull request %q (%s) is past the team review SLA; "+
+				"it will be handed over if it stays pending.", pr.Name, pr.ID),
+		}}
+	case domain.EventReviewEscalated:
+		drafts := make([]notificationDraft, 0, len(event.EscalatedTo))
+		for _, leadID := range event.EscalatedTo {
+			drafts = append(drafts, notificationDraft{
+				recipientID: leadID,
+				subject:     "Review escalated: " + pr.Name,
+				text: fmt.Sprintf("The review of pull request %q (%s) by %s is past the team review SLA.",
+					pr.Name, pr.ID, event.ReviewerID),
+			})
+		}
+		return drafts
+	default:
+		return nil
+	}
+}
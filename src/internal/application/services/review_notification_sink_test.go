@@ -0,0 +1,210 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"PrService/src/internal/application/contracts"
+	"PrService/src/internal/application/mocks"
+	"PrService/src/internal/domain"
+
+	"go.uber.org/mock/gomock"
+)
+
+func newReviewNotificationSink(
+	t *testing.T,
+) (*ReviewNotificationSink, *mocks.MockUserRepository, *mocks.MockNotifier) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	userRepo := mocks.NewMockUserRepository(ctrl)
+	notifier := mocks.NewMockNotifier(ctrl)
+
+	return NewReviewNotificationSink(userRepo, notifier, "chat"), userRepo, notifier
+}
+
+func outboxMessageFor(t *testing.T, event domain.Event) domain.OutboxMessage {
+	t.Helper()
+
+	message, err := domain.NewOutboxMessage(event)
+	if err != nil {
+		t.Fatalf("NewOutboxMessage failed: %v", err)
+	}
+	message.ID = 1
+
+	return message
+}
+
+func TestReviewNotificationSink_Publish_NotifiesAssignedReviewers(t *testing.T) {
+	sink, userRepo, notifier := newReviewNotificationSink(t)
+
+	ctx := context.Background()
+	message := outboxMessageFor(t, domain.NewPullRequestEvent(domain.EventPullRequestCreated, domain.PullRequest{
+		ID:                "pr-1",
+		Name:              "Add search",
+		AuthorID:          "u1",
+		AssignedReviewers: []domain.UserID{"u2", "u3"},
+	}))
+
+	for _, id := range []domain.UserID{"u2", "u3"} {
+		userRepo.EXPECT().GetByID(ctx, id).Return(&domain.User{ID: id, ChatHandle: string(id)}, nil)
+	}
+
+	notified := make([]domain.UserID, 0)
+	notifier.
+		EXPECT().
+		Notify(ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, n contracts.Notification) error {
+			if n.Subject != "Review requested: Add search" || !strings.Contains(n.Text, "pr-1") {
+				t.Fatalf("unexpected notification: %+v", n)
+			}
+			notified = append(notified, n.Recipient.ID)
+			return nil
+		}).
+		Times(2)
+
+	if err := sink.Publish(ctx, message); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(notified) != 2 || notified[0] != "u2" || notified[1] != "u3" {
+		t.Fatalf("unexpected recipients: %v", notified)
+	}
+}
+
+func TestReviewNotificationSink_Publish_ReassignNotifiesBothReviewers(t *testing.T) {
+	sink, userRepo, notifier := newReviewNotificationSink(t)
+
+	ctx := context.Background()
+	message := outboxMessageFor(t, domain.NewReviewerReassignedEvent(domain.PullRequest{
+		ID:                "pr-1",
+		Name:              "Add search",
+		AuthorID:          "u1",
+		AssignedReviewers: []domain.UserID{"u4"},
+	}, "u2", "u4"))
+
+	userRepo.EXPECT().GetByID(ctx, domain.UserID("u4")).Return(&domain.User{ID: "u4"}, nil)
+	userRepo.EXPECT().GetByID(ctx, domain.UserID("u2")).Return(&domain.User{ID: "u2"}, nil)
+
+	gomock.InOrder(
+		notifier.
+			EXPECT().
+			Notify(ctx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, n contracts.Notification) error {
+				if n.Recipient.ID != "u4" || !strings.HasPrefix(n.Subject, "Review requested") {
+					t.Fatalf("expected assignment notice for u4, got %+v", n)
+				}
+				return nil
+			}),
+		notifier.
+			EXPECT().
+			Notify(ctx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, n contracts.Notification) error {
+				if n.Recipient.ID != "u2" || !strings.HasPrefix(n.Subject, "Review reassigned") {
+					t.Fatalf("expected reassignment notice for u2, got %+v", n)
+				}
+				return nil
+			}),
+	)
+
+	if err := sink.Publish(ctx, message); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestReviewNotificationSink_Publish_MergeNotifiesAuthor(t *testing.T) {
+	sink, userRepo, notifier := newReviewNotificationSink(t)
+
+	ctx := context.Background()
+	message := outboxMessageFor(t, domain.NewPullRequestEvent(domain.EventPullRequestMerged, domain.PullRequest{
+		ID:                "pr-1",
+		Name:              "Add search",
+		AuthorID:          "u1",
+		AssignedReviewers: []domain.UserID{"u2"},
+	}))
+
+	userRepo.EXPECT().GetByID(ctx, domain.UserID("u1")).Return(&domain.User{ID: "u1"}, nil)
+	notifier.
+		EXPECT().
+		Notify(ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, n contracts.Notification) error {
+			if n.Recipient.ID != "u1" || n.Subject != "Merged: Add search" {
+				t.Fatalf("unexpected notification: %+v", n)
+			}
+			return nil
+		})
+
+	if err := sink.Publish(ctx, message); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestReviewNotificationSink_Publish_EscalationNotifiesLeads(t *testing.T) {
+	sink, userRepo, notifier := newReviewNotificationSink(t)
+
+	ctx := context.Background()
+	message := outboxMessageFor(t, domain.NewReviewEscalatedEvent(domain.PullRequest{
+		ID:                "pr-1",
+		Name:              "Add search",
+		AuthorID:          "u1",
+		AssignedReviewers: []domain.UserID{"u2"},
+	}, "u2", []domain.UserID{"u5", "u6"}))
+
+	for _, id := range []domain.UserID{"u5", "u6"} {
+		userRepo.EXPECT().GetByID(ctx, id).Return(&domain.User{ID: id}, nil)
+	}
+
+	notified := make([]domain.UserID, 0)
+	notifier.
+		EXPECT().
+		Notify(ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, n contracts.Notification) error {
+			if n.Subject != "Review escalated: Add search" || !strings.Contains(n.Text, "by u2") {
+				t.Fatalf("unexpected notification: %+v", n)
+			}
+			notified = append(notified, n.Recipient.ID)
+			return nil
+		}).
+		Times(2)
+
+	if err := sink.Publish(ctx, message); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(notified) != 2 || notified[0] != "u5" || notified[1] != "u6" {
+		t.Fatalf("unexpected recipients: %v", notified)
+	}
+}
+
+func TestReviewNotificationSink_Publish_IgnoresOtherEvents(t *testing.T) {
+	sink, _, _ := newReviewNotificationSink(t)
+
+	message := outboxMessageFor(t, domain.NewUserEvent(domain.EventUserDeactivated, domain.User{ID: "u1"}))
+
+	if err := sink.Publish(context.Background(), message); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestReviewNotificationSink_Publish_ReportsFailuresAndContinues(t *testing.T) {
+	sink, userRepo, notifier := newReviewNotificationSink(t)
+
+	ctx := context.Background()
+	message := outboxMessageFor(t, domain.NewPullRequestEvent(domain.EventPullRequestCreated, domain.PullRequest{
+		ID:                "pr-1",
+		AuthorID:          "u1",
+		AssignedReviewers: []domain.UserID{"ghost", "u2", "u3"},
+	}))
+
+	userRepo.EXPECT().GetByID(ctx, domain.UserID("ghost")).Return(nil, domain.ErrUserNotFound)
+	userRepo.EXPECT().GetByID(ctx, domain.UserID("u2")).Return(&domain.User{ID: "u2"}, nil)
+	userRepo.EXPECT().GetByID(ctx, domain.UserID("u3")).Return(&domain.User{ID: "u3"}, nil)
+
+	notifier.EXPECT().Notify(ctx, gomock.Any()).Return(errors.New("status 500"))
+	notifier.EXPECT().Notify(ctx, gomock.Any()).Return(nil)
+
+	err := sink.Publish(ctx, message)
+	if err == nil || !strings.Contains(err.Error(), "notify u2: status 500") {
+		t.Fatalf("expected notify failure for u2, got %v", err)
+	}
+}
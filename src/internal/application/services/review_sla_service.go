@@ -0,0 +1,290 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"PrService/src/internal/application/contracts"
+
+	"PrService/src/internal/domain"
+)
+
+// reviewSLABatchSize bounds how many overdue assignments one EnforceOverdue run handles.
+const reviewSLABatchSize = 100
+
+// ReviewSLAResult counts the SLA steps taken by one EnforceOverdue run.
+type ReviewSLAResult struct {
+	Notified   int
+	Reassigned int
+	Escalated  int
+}
+
+// ReviewSLAService keeps team review SLAs and enforces them: an overdue reviewer is reminded first,
+// then the review is reassigned or escalated to the team leads. Every step is recorded in the
+// pull request history.
+type ReviewSLAService struct {
+	reviewSLARepository   domain.ReviewSLARepository
+	teamRepository        domain.TeamRepository
+	pullRequestRepository domain.PullRequestRepository
+	historyRepository     domain.PullRequestHistoryRepository
+	pullRequestService    domain.PullRequestService
+	txManager             contracts.TxManager
+	eventPublisher        contracts.EventPublisher
+	now                   func() time.Time
+}
+
+func NewReviewSLAService(
+	reviewSLARepository domain.ReviewSLARepository,
+	teamRepository domain.TeamRepository,
+	pullRequestRepository domain.PullRequestRepository,
+	historyRepository domain.PullRequestHistoryRepository,
+	pullRequestService domain.PullRequestService,
+	txManager contracts.TxManager,
+	eventPublisher contracts.EventPublisher,
+) *ReviewSLAService {
+	return &ReviewSLAService{
+		reviewSLARepository:   reviewSLARepository,
+		teamRepository:        teamRepository,
+		pullRequestRepository: pullRequestRepository,
+		historyRepository:     historyRepository,
+		pullRequestService:    pullRequestService,
+		txManager:             txManager,
+		eventPublisher:        eventPublisher,
+		now:                   time.Now,
+	}
+}
+
+func (s *ReviewSLAService) Set(ctx context.Context, sla domain.ReviewSLA) (*domain.ReviewSLA, error) {
+	if err := sla.Validate(); err != nil {
+		return nil, err
+	}
+
+	err := s.txManager.WithinTransaction(ctx, func(txCtx context.Context) error {
+		if _, err := s.teamRepository.GetByName(txCtx, sla.TeamName); err != nil {
+			return err
+		}
+
+		return s.reviewSLARepository.Upsert(txCtx, sla)
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &sla, nil
+}
+
+func (s *ReviewSLAService) Get(ctx context.Context, name domain.TeamName) (*domain.ReviewSLA, error) {
+	if _, err := s.teamRepository.GetByName(ctx, name); err != nil {
+		return nil, err
+	}
+
+	return s.reviewSLARepository.Get(ctx, name)
+}
+
+// Delete turns the SLA of the team off; assignments already reminded are not acted on any more.
+func (s *ReviewSLAService) Delete(ctx context.Context, name domain.TeamName) error {
+	return s.reviewSLARepository.Delete(ctx, name)
+}
+
+// History returns the automatic actions taken on the pull request, oldest first.
+func (s *ReviewSLAService) History(
+	ctx context.Context,
+	id domain.PullRequestID,
+) ([]domain.PullRequestHistoryEntry, error) {
+	if _, err := s.pullRequestRepository.GetByID(ctx, id); err != nil {
+		return nil, err
+	}
+
+	return s.historyRepository.List(ctx, id)
+}
+
+// MarkReviewed ends the SLA of the reviewer's assignment, so the reviewer is neither reminded nor
+// escalated any more. Users may only mark their own reviews.
+func (s *ReviewSLAService) MarkReviewed(
+	ctx context.Context,
+	id domain.PullRequestID,
+	reviewerID domain.UserID,
+) (*domain.PullRequest, error) {
+	if actor, ok := domain.ActorFromContext(ctx); ok && !actor.CanActFor(reviewerID) {
+		return nil, domain.ErrForbidden
+	}
+
+	var pullRequest *domain.PullRequest
+	err := s.txManager.WithinTransaction(ctx, func(txCtx context.Context) error {
+		pr, err := s.pullRequestRepository.GetByID(txCtx, id)
+		if err != nil {
+			return err
+		}
+		pullRequest = pr
+
+		return s.reviewSLARepository.MarkReviewed(txCtx, id, reviewerID, s.now())
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return pullRequest, nil
+}
+
+// EnforceOverdue takes the next SLA step for a batch of overdue assignments, each in its own transaction.
+// A failure for one assignment does not stop the others.
+func (s *ReviewSLAService) EnforceOverdue(ctx context.Context) (ReviewSLAResult, error) {
+	var result ReviewSLAResult
+
+	now := s.now()
+	reviews, err := s.reviewSLARepository.ListOverdue(ctx, now, reviewSLABatchSize)
+	if err != nil {
+		return result, err
+	}
+
+	var errs []error
+	for _, review := range reviews {
+		var action domain.PullRequestHistoryAction
+		err := s.txManager.WithinTransaction(ctx, func(txCtx context.Context) error {
+			var err error
+			action, err = s.enforce(txCtx, review, now)
+			return err
+		})
+		if err != nil {
+			errs = append(errs, fmt.Errorf("review of %s by %s: %w", review.PullRequestID, review.ReviewerID, err))
+			continue
+		}
+
+		switch action {
+		case domain.HistoryReviewSLANotified:
+			result.Notified++
+		case domain.HistoryReviewSLAReassigned:
+			result.Reassigned++
+		case domain.HistoryReviewSLAEscalated:
+			result.Escalated++
+		}
+	}
+
+	return result, errors.Join(errs...)
+}
+
+// enforce returns the action taken, or an empty one when another worker already handled the assignment.
+func (s *ReviewSLAService) enforce(
+	ctx context.Context,
+	review domain.OverdueReview,
+	now time.Time,
+) (domain.PullRequestHistoryAction, error) {
+	next := domain.ReviewSLAStageNotified
+	if review.Stage == domain.ReviewSLAStageNotified {
+		next = domain.ReviewSLAStageEscalated
+	}
+
+	claimed, err := s.reviewSLARepository.SetStage(
+		ctx, review.PullRequestID, review.ReviewerID, review.Stage, next, now,
+	)
+	if err != nil || !claimed {
+		return "", err
+	}
+
+	switch {
+	case next == domain.ReviewSLAStageNotified:
+		return domain.HistoryReviewSLANotified, s.remind(ctx, review, now)
+	case review.SLA.Action == domain.ReviewSLAActionReassign:
+		return s.reassign(ctx, review, now)
+	default:
+		return domain.HistoryReviewSLAEscalated, s.escalate(ctx, review, now, "")
+	}
+}
+
+func (s *ReviewSLAService) remind(ctx context.Context, review domain.OverdueReview, now time.Time) error {
+	pr, err := s.pullRequestRepository.GetByID(ctx, review.PullRequestID)
+	if err != nil {
+		return err
+	}
+
+	entry := domain.PullRequestHistoryEntry{
+		PullRequestID: review.PullRequestID,
+		Action:        domain.HistoryReviewSLANotified,
+		ReviewerID:    review.ReviewerID,
+		Details:       fmt.Sprintf("no review within %s of the assignment", review.SLA.FirstReviewWithin),
+		OccurredAt:    now,
+	}
+	if err := s.historyRepository.Append(ctx, &entry); err != nil {
+		return err
+	}
+
+	return s.eventPublisher.Publish(ctx, domain.NewReviewOverdueEvent(*pr, review.ReviewerID))
+}
+
+// reassign hands the review to another eligible member of the slot's team and escalates
+// when there is none.
+func (s *ReviewSLAService) reassign(
+	ctx context.Context,
+	review domain.OverdueReview,
+	now time.Time,
+) (domain.PullRequestHistoryAction, error) {
+	_, newReviewer, err := s.pullRequestService.Reassign(ctx, review.PullRequestID, review.ReviewerID, "")
+	if errors.Is(err, domain.ErrNoCandidate) {
+		return domain.HistoryReviewSLAEscalated, s.escalate(ctx, review, now, "no eligible reviewer to reassign to")
+	}
+	if err != nil {
+		return "", err
+	}
+
+	entry := domain.PullRequestHistoryEntry{
+		PullRequestID: review.PullRequestID,
+		Action:        domain.HistoryReviewSLAReassigned,
+		ReviewerID:    review.ReviewerID,
+		TargetIDs:     []domain.UserID{newReviewer},
+		Details:       fmt.Sprintf("still pending %s after the reminder", review.SLA.EscalateAfter),
+		OccurredAt:    now,
+	}
+
+	return domain.HistoryReviewSLAReassigned, s.historyRepository.Append(ctx, &entry)
+}
+
+// escalate reports the review to the active leads of the SLA's team; the reviewer stays assigned.
+func (s *ReviewSLAService) escalate(
+	ctx context.Context,
+	review domain.OverdueReview,
+	now time.Time,
+	reason string,
+) error {
+	pr, err := s.pullRequestRepository.GetByID(ctx, review.PullRequestID)
+	if err != nil {
+		return err
+	}
+
+	team, err := s.teamRepository.GetByName(ctx, review.SLA.TeamName)
+	if err != nil {
+		return err
+	}
+
+	leads := make([]domain.UserID, 0)
+	for _, member := range team.Members {
+		if member.IsActive && member.Seniority == domain.SeniorityLead && member.ID != review.ReviewerID {
+			leads = append(leads, member.ID)
+		}
+	}
+
+	details := fmt.Sprintf("still pending %s after the reminder", review.SLA.EscalateAfter)
+	if reason != "" {
+		details += "; " + reason
+	}
+	if len(leads) == 0 {
+		details += "; the team has no active lead"
+	}
+
+	entry := domain.PullRequestHistoryEntry{
+		PullRequestID: review.PullRequestID,
+		Action:        domain.HistoryReviewSLAEscalated,
+		ReviewerID:    review.ReviewerID,
+		TargetIDs:     leads,
+		Details:       details,
+		OccurredAt:    now,
+	}
+	if err := s.historyRepository.Append(ctx, &entry); err != nil {
+		return err
+	}
+
+	return s.eventPublisher.Publish(ctx, domain.NewReviewEscalatedEvent(*pr, review.ReviewerID, leads))
+}
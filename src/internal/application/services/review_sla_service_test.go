@@ -0,0 +1,398 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"PrService/src/internal/application/mocks"
+	"PrService/src/internal/domain"
+
+	"go.uber.org/mock/gomock"
+)
+
+var reviewSLATestNow = time.Date(2025, 3, 4, 10, 0, 0, 0, time.UTC)
+
+type reviewSLAServiceMocks struct {
+	slaRepo     *mocks.MockReviewSLARepository
+	teamRepo    *mocks.MockTeamRepository
+	prRepo      *mocks.MockPullRequestRepository
+	historyRepo *mocks.MockPullRequestHistoryRepository
+	publisher   *mocks.MockEventPublisher
+}
+
+func newReviewSLAService(t *testing.T) (*ReviewSLAService, reviewSLAServiceMocks) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	m := reviewSLAServiceMocks{
+		slaRepo:     mocks.NewMockReviewSLARepository(ctrl),
+		teamRepo:    mocks.NewMockTeamRepository(ctrl),
+		prRepo:      mocks.NewMockPullRequestRepository(ctrl),
+		historyRepo: mocks.NewMockPullRequestHistoryRepository(ctrl),
+		publisher:   mocks.NewMockEventPublisher(ctrl),
+	}
+	txMgr := passthroughTxManager(ctrl)
+
+	service := NewReviewSLAService(
+		m.slaRepo,
+		m.teamRepo,
+		m.prRepo,
+		m.historyRepo,
+		NewPullRequestService(m.prRepo, m.teamRepo, txMgr, m.publisher),
+		txMgr,
+		m.publisher,
+	)
+	service.now = func() time.Time { return reviewSLATestNow }
+
+	return service, m
+}
+
+func overdueReview(stage domain.ReviewSLAStage, action domain.ReviewSLAAction) domain.OverdueReview {
+	return domain.OverdueReview{
+		PullRequestID: "pr-1",
+		ReviewerID:    "u2",
+		Stage:         stage,
+		AssignedAt:    reviewSLATestNow.Add(-30 * time.Hour),
+		SLA: domain.ReviewSLA{
+			TeamName:          "backend",
+			FirstReviewWithin: 24 * time.Hour,
+			EscalateAfter:     4 * time.Hour,
+			Action:            action,
+		},
+	}
+}
+
+func openPullRequest() *domain.PullRequest {
+	return &domain.PullRequest{
+		ID:                "pr-1",
+		Name:              "Add search",
+		AuthorID:          "u1",
+		Status:            domain.PullRequestStatusOpen,
+		AssignedReviewers: []domain.UserID{"u2"},
+		ReviewerTeams:     map[domain.UserID]domain.TeamName{"u2": "backend"},
+	}
+}
+
+func TestReviewSLAService_Set_Invalid(t *testing.T) {
+	service, _ := newReviewSLAService(t)
+
+	_, err := service.Set(context.Background(), domain.ReviewSLA{
+		TeamName:          "backend",
+		FirstReviewWithin: time.Hour,
+		Action:            "PING",
+	})
+	if !errors.Is(err, domain.ErrInvalidReviewSLA) {
+		t.Fatalf("expected ErrInvalidReviewSLA, got %v", err)
+	}
+}
+
+func TestReviewSLAService_Set_TeamNotFound(t *testing.T) {
+	service, m := newReviewSLAService(t)
+
+	m.teamRepo.EXPECT().GetByName(gomock.Any(), domain.TeamName("ghosts")).Return(nil, domain.ErrTeamNotFound)
+
+	_, err := service.Set(context.Background(), domain.ReviewSLA{
+		TeamName:          "ghosts",
+		FirstReviewWithin: time.Hour,
+		Action:            domain.ReviewSLAActionEscalate,
+	})
+	if !errors.Is(err, domain.ErrTeamNotFound) {
+		t.Fatalf("expected ErrTeamNotFound, got %v", err)
+	}
+}
+
+func TestReviewSLAService_EnforceOverdue_RemindsPendingReviewer(t *testing.T) {
+	service, m := newReviewSLAService(t)
+
+	review := overdueReview(domain.ReviewSLAStagePending, domain.ReviewSLAActionReassign)
+	m.slaRepo.EXPECT().ListOverdue(gomock.Any(), reviewSLATestNow, reviewSLABatchSize).
+		Return([]domain.OverdueReview{review}, nil)
+	m.slaRepo.EXPECT().
+		SetStage(gomock.Any(), review.PullRequestID, review.ReviewerID,
+			domain.ReviewSLAStagePending, domain.ReviewSLAStageNotified, reviewSLATestNow).
+		Return(true, nil)
+	m.prRepo.EXPECT().GetByID(gomock.Any(), review.PullRequestID).Return(openPullRequest(), nil)
+	m.historyRepo.EXPECT().
+		Append(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, entry *domain.PullRequestHistoryEntry) error {
+			if entry.Action != domain.HistoryReviewSLANotified || entry.ReviewerID != "u2" ||
+				!entry.OccurredAt.Equal(reviewSLATestNow) || !strings.Contains(entry.Details, "24h0m0s") {
+				t.Fatalf("unexpected history entry: %+v", entry)
+			}
+			return nil
+		})
+	m.publisher.EXPECT().
+		Publish(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, event domain.Event) error {
+			if event.Type != domain.EventReviewOverdue || event.ReviewerID != "u2" {
+				t.Fatalf("unexpected event: %+v", event)
+			}
+			return nil
+		})
+
+	result, err := service.EnforceOverdue(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result != (ReviewSLAResult{Notified: 1}) {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+}
+
+func TestReviewSLAService_EnforceOverdue_ReassignsAfterReminder(t *testing.T) {
+	service, m := newReviewSLAService(t)
+
+	review := overdueReview(domain.ReviewSLAStageNotified, domain.ReviewSLAActionReassign)
+	m.slaRepo.EXPECT().ListOverdue(gomock.Any(), reviewSLATestNow, reviewSLABatchSize).
+		Return([]domain.OverdueReview{review}, nil)
+	m.slaRepo.EXPECT().
+		SetStage(gomock.Any(), review.PullRequestID, review.ReviewerID,
+			domain.ReviewSLAStageNotified, domain.ReviewSLAStageEscalated, reviewSLATestNow).
+		Return(true, nil)
+	m.prRepo.EXPECT().GetByID(gomock.Any(), review.PullRequestID).Return(openPullRequest(), nil)
+	m.teamRepo.EXPECT().GetByName(gomock.Any(), domain.TeamName("backend")).Return(&domain.Team{
+		Name: "backend",
+		Members: []domain.TeamMember{
+			{ID: "u1", IsActive: true},
+			{ID: "u2", IsActive: true},
+			{ID: "u3", IsActive: true},
+		},
+	}, nil)
+	m.prRepo.EXPECT().
+		Update(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, pr *domain.PullRequest) error {
+			if len(pr.AssignedReviewers) != 1 || pr.AssignedReviewers[0] != "u3" {
+				t.Fatalf("unexpected reviewers: %v", pr.AssignedReviewers)
+			}
+			return nil
+		})
+	m.publisher.EXPECT().
+		Publish(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, event domain.Event) error {
+			if event.Type != domain.EventReviewerReassigned || event.NewReviewerID != "u3" {
+				t.Fatalf("unexpected event: %+v", event)
+			}
+			return nil
+		})
+	m.historyRepo.EXPECT().
+		Append(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, entry *domain.PullRequestHistoryEntry) error {
+			if entry.Action != domain.HistoryReviewSLAReassigned || entry.ReviewerID != "u2" ||
+				len(entry.TargetIDs) != 1 || entry.TargetIDs[0] != "u3" {
+				t.Fatalf("unexpected history entry: %+v", entry)
+			}
+			return nil
+		})
+
+	result, err := service.EnforceOverdue(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result != (ReviewSLAResult{Reassigned: 1}) {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+}
+
+func TestReviewSLAService_EnforceOverdue_EscalatesWhenNoOneToReassign(t *testing.T) {
+	service, m := newReviewSLAService(t)
+
+	review := overdueReview(domain.ReviewSLAStageNotified, domain.ReviewSLAActionReassign)
+	team := &domain.Team{
+		Name: "backend",
+		Members: []domain.TeamMember{
+			{ID: "u1", IsActive: true},
+			{ID: "u2", IsActive: true},
+			{ID: "u5", IsActive: false, Seniority: domain.SeniorityLead},
+		},
+	}
+
+	m.slaRepo.EXPECT().ListOverdue(gomock.Any(), reviewSLATestNow, reviewSLABatchSize).
+		Return([]domain.OverdueReview{review}, nil)
+	m.slaRepo.EXPECT().
+		SetStage(gomock.Any(), review.PullRequestID, review.ReviewerID,
+			domain.ReviewSLAStageNotified, domain.ReviewSLAStageEscalated, reviewSLATestNow).
+		Return(true, nil)
+	m.prRepo.EXPECT().GetByID(gomock.Any(), review.PullRequestID).Return(openPullRequest(), nil).Times(2)
+	m.teamRepo.EXPECT().GetByName(gomock.Any(), domain.TeamName("backend")).Return(team, nil).Times(2)
+	m.historyRepo.EXPECT().
+		Append(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, entry *domain.PullRequestHistoryEntry) error {
+			if entry.Action != domain.HistoryReviewSLAEscalated || len(entry.TargetIDs) != 0 ||
+				!strings.Contains(entry.Details, "no eligible reviewer") ||
+				!strings.Contains(entry.Details, "no active lead") {
+				t.Fatalf("unexpected history entry: %+v", entry)
+			}
+			return nil
+		})
+	m.publisher.EXPECT().
+		Publish(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, event domain.Event) error {
+			if event.Type != domain.EventReviewEscalated || event.ReviewerID != "u2" {
+				t.Fatalf("unexpected event: %+v", event)
+			}
+			return nil
+		})
+
+	result, err := service.EnforceOverdue(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result != (ReviewSLAResult{Escalated: 1}) {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+}
+
+func TestReviewSLAService_EnforceOverdue_EscalatesToActiveLeads(t *testing.T) {
+	service, m := newReviewSLAService(t)
+
+	review := overdueReview(domain.ReviewSLAStageNotified, domain.ReviewSLAActionEscalate)
+	m.slaRepo.EXPECT().ListOverdue(gomock.Any(), reviewSLATestNow, reviewSLABatchSize).
+		Return([]domain.OverdueReview{review}, nil)
+	m.slaRepo.EXPECT().
+		SetStage(gomock.Any(), review.PullRequestID, review.ReviewerID,
+			domain.ReviewSLAStageNotified, domain.ReviewSLAStageEscalated, reviewSLATestNow).
+		Return(true, nil)
+	m.prRepo.EXPECT().GetByID(gomock.Any(), review.PullRequestID).Return(openPullRequest(), nil)
+	m.teamRepo.EXPECT().GetByName(gomock.Any(), domain.TeamName("backend")).Return(&domain.Team{
+		Name: "backend",
+		Members: []domain.TeamMember{
+			{ID: "u2", IsActive: true, Seniority: domain.SeniorityLead},
+			{ID: "u5", IsActive: true, Seniority: domain.SeniorityLead},
+			{ID: "u6", IsActive: false, Seniority: domain.SeniorityLead},
+			{ID: "u7", IsActive: true, Seniority: domain.SenioritySenior},
+		},
+	}, nil)
+	m.historyRepo.EXPECT().
+		Append(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, entry *domain.PullRequestHistoryEntry) error {
+			if entry.Action != domain.HistoryReviewSLAEscalated || len(entry.TargetIDs) != 1 ||
+				entry.TargetIDs[0] != "u5" {
+				t.Fatalf("unexpected history entry: %+v", entry)
+			}
+			return nil
+		})
+	m.publisher.EXPECT().
+		Publish(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, event domain.Event) error {
+			if event.Type != domain.EventReviewEscalated || len(event.EscalatedTo) != 1 ||
+				event.EscalatedTo[0] != "u5" {
+				t.Fatalf("unexpected event: %+v", event)
+			}
+			return nil
+		})
+
+	result, err := service.EnforceOverdue(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result != (ReviewSLAResult{Escalated: 1}) {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+}
+
+func TestReviewSLAService_EnforceOverdue_SkipsClaimedAndContinuesAfterFailure(t *testing.T) {
+	service, m := newReviewSLAService(t)
+
+	claimed := overdueReview(domain.ReviewSLAStagePending, domain.ReviewSLAActionEscalate)
+	failing := overdueReview(domain.ReviewSLAStagePending, domain.ReviewSLAActionEscalate)
+	failing.PullRequestID = "pr-2"
+	m.slaRepo.EXPECT().ListOverdue(gomock.Any(), reviewSLATestNow, reviewSLABatchSize).
+		Return([]domain.OverdueReview{claimed, failing}, nil)
+
+	m.slaRepo.EXPECT().
+		SetStage(gomock.Any(), domain.PullRequestID("pr-1"), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(false, nil)
+	m.slaRepo.EXPECT().
+		SetStage(gomock.Any(), domain.PullRequestID("pr-2"), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(true, nil)
+	m.prRepo.EXPECT().GetByID(gomock.Any(), domain.PullRequestID("pr-2")).Return(nil, errors.New("db down"))
+
+	result, err := service.EnforceOverdue(context.Background())
+	if err == nil || !strings.Contains(err.Error(), "review of pr-2 by u2: db down") {
+		t.Fatalf("expected pr-2 failure, got %v", err)
+	}
+	if result != (ReviewSLAResult{}) {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+}
+
+func TestReviewSLAService_History_PullRequestNotFound(t *testing.T) {
+	service, m := newReviewSLAService(t)
+
+	m.prRepo.EXPECT().GetByID(gomock.Any(), domain.PullRequestID("pr-x")).Return(nil, domain.ErrPullRequestNotFound)
+
+	if _, err := service.History(context.Background(), "pr-x"); !errors.Is(err, domain.ErrPullRequestNotFound) {
+		t.Fatalf("expected ErrPullRequestNotFound, got %v", err)
+	}
+}
+
+func TestReviewSLAService_MarkReviewed(t *testing.T) {
+	tests := []struct {
+		name     string
+		actor    domain.Actor
+		markErr  error
+		wantMark bool
+		wantErr  error
+	}{
+		{name: "own review", actor: domain.NewUserActor("u2", domain.RoleMember), wantMark: true},
+		{name: "admin for another user", actor: domain.NewUserActor("u9", domain.RoleAdmin), wantMark: true},
+		{
+			name:    "member for another user",
+			actor:   domain.NewUserActor("u3", domain.RoleMember),
+			wantErr: domain.ErrForbidden,
+		},
+		{
+			name:     "reviewer not assigned",
+			actor:    domain.NewUserActor("u2", domain.RoleMember),
+			markErr:  domain.ErrReviewerIsNotAssigned,
+			wantMark: true,
+			wantErr:  domain.ErrReviewerIsNotAssigned,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, m := newReviewSLAService(t)
+
+			if tt.wantMark {
+				m.prRepo.EXPECT().GetByID(gomock.Any(), domain.PullRequestID("pr-1")).Return(openPullRequest(), nil)
+				m.slaRepo.EXPECT().
+					MarkReviewed(gomock.Any(), domain.PullRequestID("pr-1"), domain.UserID("u2"), reviewSLATestNow).
+					Return(tt.markErr)
+			}
+
+			ctx := domain.ContextWithActor(context.Background(), tt.actor)
+			pr, err := service.MarkReviewed(ctx, "pr-1", "u2")
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("expected %v, got %v", tt.wantErr, err)
+			}
+			if tt.wantErr == nil && pr.ID != "pr-1" {
+				t.Fatalf("unexpected pull request: %+v", pr)
+			}
+		})
+	}
+}
+
+func TestReviewSLAService_EnforceOverdue_SkipsReviewedAssignment(t *testing.T) {
+	service, m := newReviewSLAService(t)
+
+	// The review was listed as NOTIFIED but marked REVIEWED before the worker claimed it.
+	review := overdueReview(domain.ReviewSLAStageNotified, domain.ReviewSLAActionEscalate)
+	m.slaRepo.EXPECT().ListOverdue(gomock.Any(), reviewSLATestNow, reviewSLABatchSize).
+		Return([]domain.OverdueReview{review}, nil)
+	m.slaRepo.EXPECT().
+		SetStage(gomock.Any(), domain.PullRequestID("pr-1"), domain.UserID("u2"),
+			domain.ReviewSLAStageNotified, domain.ReviewSLAStageEscalated, reviewSLATestNow).
+		Return(false, nil)
+
+	result, err := service.EnforceOverdue(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result != (ReviewSLAResult{}) {
+		t.Fatalf("expected a reviewed assignment not to be escalated, got %+v", result)
+	}
+}
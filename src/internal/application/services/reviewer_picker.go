@@ -0,0 +1,149 @@
+package services
+
+import (
+	"math/rand/v2"
+	"slices"
+
+	"PrService/src/internal/domain"
+)
+
+type pickRequest struct {
+	authorID     domain.UserID
+	team         domain.Team
+	assigned     []domain.UserID
+	limit        int
+	tags         []string
+	changedFiles []string
+	// loads holds open review counts of the members; nil means random fill.
+	loads map[domain.UserID]int
+}
+
+type pickResult struct {
+	reviewers []domain.UserID
+	reasons   map[domain.UserID]domain.ReviewerReason
+	unfilled  []domain.UnfilledSlot
+}
+
+// pickReviewers selects up to limit reviewers from the team, honouring the team rules.
+// Candidates are taken in order: members satisfying unmet REQUIRE_ONE_OF rules, owners of
+// the changed files according to the team CODEOWNERS, members carrying the requested tags,
+// then random or least loaded members. Rules and required CODEOWNERS entries that left
+// slots unfilled are reported as unfilled slots.
+func pickReviewers(req pickRequest) pickResult {
+	team := req.team
+	members := orderCandidates(team.Members, req.loads)
+	res := pickResult{
+		reviewers: make([]domain.UserID, 0),
+		reasons:   make(map[domain.UserID]domain.ReviewerReason),
+	}
+
+	var blocked []domain.TeamRule
+	isEligible := func(member domain.TeamMember) bool {
+		if member.ID == req.authorID || !member.IsActive {
+			return false
+		}
+
+		current := append(slices.Clone(req.assigned), res.reviewers...)
+		if slices.Contains(current, member.ID) {
+			return false
+		}
+
+		if rule, ok := blockingRule(team, req.authorID, member.ID, current); ok {
+			blocked = append(blocked, rule)
+			return false
+		}
+
+		return true
+	}
+	pick := func(matches func(domain.TeamMember) bool, reason domain.ReviewerReason) bool {
+		if len(res.reviewers) >= req.limit {
+			return false
+		}
+
+		idx := slices.IndexFunc(members, func(member domain.TeamMember) bool {
+			return matches(member) && isEligible(member)
+		})
+		if idx < 0 {
+			return false
+		}
+
+		reason.TeamName = team.Name
+		res.reviewers = append(res.reviewers, members[idx].ID)
+		res.reasons[members[idx].ID] = reason
+
+		return true
+	}
+
+	for _, rule := range missingRequirements(team, req.assigned) {
+		group := rule.Group(team.Members)
+		if countOf(group, res.reviewers) > 0 {
+			continue
+		}
+
+		inGroup := func(member domain.TeamMember) bool { return slices.Contains(group, member.ID) }
+		if !pick(inGroup, domain.ReviewerReason{Source: domain.ReviewerReasonTeamRule, RuleID: rule.ID}) {
+			res.unfilled = appendUnfilledSlot(res.unfilled, team.Name, rule)
+		}
+	}
+
+	if owners := team.CodeOwners; owners != nil {
+		current := func() []domain.UserID { return append(slices.Clone(req.assigned), res.reviewers...) }
+		for _, entry := range owners.MatchAll(req.changedFiles) {
+			owns := func(member domain.TeamMember) bool { return entry.OwnedBy(member, team.Name) }
+			if slices.ContainsFunc(team.Members, func(member domain.TeamMember) bool {
+				return owns(member) && slices.Contains(current(), member.ID)
+			}) {
+				continue
+			}
+
+			picked := pick(owns, domain.ReviewerReason{Source: domain.ReviewerReasonCodeOwners, CodeOwners: entry})
+			if !picked && owners.Mode == domain.CodeOwnersModeRequire {
+				res.unfilled = append(res.unfilled, domain.UnfilledSlot{TeamName: team.Name, CodeOwners: entry})
+			}
+		}
+	}
+
+	for _, tag := range req.tags {
+		if slices.ContainsFunc(members, func(member domain.TeamMember) bool {
+			return member.HasTag(tag) && slices.Contains(res.reviewers, member.ID)
+		}) {
+			continue
+		}
+
+		hasTag := func(member domain.TeamMember) bool { return member.HasTag(tag) }
+		pick(hasTag, domain.ReviewerReason{Source: domain.ReviewerReasonTag, Tag: tag})
+	}
+
+	fillReason := domain.ReviewerReason{Source: domain.ReviewerReasonRandom}
+	if req.loads != nil {
+		fillReason.Source = domain.ReviewerReasonLeastLoaded
+	}
+	anyMember := func(domain.TeamMember) bool { return true }
+	for pick(anyMember, fillReason) {
+		continue
+	}
+
+	if len(res.reviewers) < req.limit {
+		for _, rule := range blocked {
+			res.unfilled = appendUnfilledSlot(res.unfilled, team.Name, rule)
+		}
+	}
+
+	return res
+}
+
+// orderCandidates shuffles the members; with known loads the least loaded members go first.
+func orderCandidates(members []domain.TeamMember, loads map[domain.UserID]int) []domain.TeamMember {
+	ordered := append([]domain.TeamMember(nil), members...)
+	rand.Shuffle(len(ordered), func(i, j int) {
+		ordered[i], ordered[j] = ordered[j], ordered[i]
+	})
+
+	if loads != nil {
+		slices.SortStableFunc(ordered, func(a, b domain.TeamMember) int {
+			return loads[a.ID] - loads[b.ID]
+		})
+	}
+
+	return ordered
+}
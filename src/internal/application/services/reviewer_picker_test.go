@@ -0,0 +1,259 @@
+package services
+
+import (
+	"slices"
+	"testing"
+
+	"PrService/src/internal/domain"
+)
+
+func codeOwnersTestTeam(t *testing.T, mode domain.CodeOwnersMode, content string) domain.Team {
+	t.Helper()
+
+	entries, err := domain.ParseCodeOwners(content)
+	if err != nil {
+		t.Fatalf("failed to parse CODEOWNERS: %v", err)
+	}
+
+	return domain.Team{
+		Name: "backend",
+		Members: []domain.TeamMember{
+			{ID: "author", Username: "author", IsActive: true},
+			{ID: "u1", Username: "alice", IsActive: true},
+			{ID: "u2", Username: "bob", IsActive: true},
+			{ID: "u3", Username: "carol", IsActive: true},
+			{ID: "u4", Username: "dave", IsActive: false},
+		},
+		CodeOwners: &domain.TeamCodeOwners{
+			TeamName: "backend",
+			Mode:     mode,
+			Entries:  entries,
+		},
+	}
+}
+
+func TestPickReviewers_Table(t *testing.T) {
+	author := domain.UserID("author")
+
+	tests := []struct {
+		name         string
+		team         domain.Team
+		authorID     domain.UserID
+		wantExact    []domain.UserID
+		wantLen      int
+		maxReviewers int
+	}{
+		{
+			name: "only author in team -> no reviewers",
+			team: domain.Team{
+				Name: "team",
+				Members: []domain.TeamMember{
+					{ID: author, Username: "author", IsActive: true},
+				},
+			},
+			authorID:  author,
+			wantExact: []domain.UserID{},
+		},
+		{
+			name: "one active member besides author",
+			team: domain.Team{
+				Name: "team",
+				Members: []domain.TeamMember{
+					{ID: author, Username: "author", IsActive: true},
+					{ID: "u2", Username: "u2", IsActive: true},
+				},
+			},
+			authorID:  author,
+			wantExact: []domain.UserID{"u2"},
+		},
+		{
+			name: "inactive members are skipped",
+			team: domain.Team{
+				Name: "team",
+				Members: []domain.TeamMember{
+					{ID: author, Username: "author", IsActive: true},
+					{ID: "active", Username: "active", IsActive: true},
+					{ID: "inactive1", Username: "inactive1", IsActive: false},
+					{ID: "inactive2", Username: "inactive2", IsActive: false},
+				},
+			},
+			authorID:  author,
+			wantExact: []domain.UserID{"active"},
+		},
+		{
+			name: "more active members than PullRequestMaxReviewers",
+			team: domain.Team{
+				Name: "team",
+				Members: []domain.TeamMember{
+					{ID: author, Username: "author", IsActive: true},
+					{ID: "u2", Username: "u2", IsActive: true},
+					{ID: "u3", Username: "u3", IsActive: true},
+					{ID: "u4", Username: "u4", IsActive: true},
+				},
+			},
+			authorID:     author,
+			wantLen:      domain.PullRequestMaxReviewers,
+			maxReviewers: domain.PullRequestMaxReviewers,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := pickReviewers(pickRequest{
+				authorID: tt.authorID,
+				team:     tt.team,
+				limit:    domain.PullRequestMaxReviewers,
+			}).reviewers
+
+			if tt.wantExact != nil {
+				if !slices.Equal(got, tt.wantExact) {
+					t.Fatalf("expected %v, got %v", tt.wantExact, got)
+				}
+				return
+			}
+
+			if tt.wantLen != 0 && len(got) != tt.wantLen {
+				t.Fatalf("expected len=%d, got %d", tt.wantLen, len(got))
+			}
+
+			if slices.Contains(got, tt.authorID) {
+				t.Fatalf("reviewers must not contain author, got %v", got)
+			}
+			for _, id := range got {
+				found := false
+				for _, m := range tt.team.Members {
+					if m.ID == id {
+						found = true
+						if !m.IsActive {
+							t.Fatalf("inactive member %s selected as reviewer", id)
+						}
+						break
+					}
+				}
+				if !found {
+					t.Fatalf("reviewer %s is not a member of the team", id)
+				}
+			}
+			if len(got) > domain.PullRequestMaxReviewers {
+				t.Fatalf("len(got)=%d > PullRequestMaxReviewers", len(got))
+			}
+		})
+	}
+}
+
+func TestPickReviewers_CodeOwners(t *testing.T) {
+	const content = "*.sql @carol\n/api/ @bob\n/legacy/ @dave\n"
+
+	tests := []struct {
+		name         string
+		mode         domain.CodeOwnersMode
+		changedFiles []string
+		limit        int
+		wantContains []domain.UserID
+		wantLen      int
+		wantUnfilled []string
+	}{
+		{
+			name:         "owner of changed file is preferred",
+			mode:         domain.CodeOwnersModePrefer,
+			changedFiles: []string{"db/migrations/0001.sql"},
+			limit:        1,
+			wantContains: []domain.UserID{"u3"},
+			wantLen:      1,
+		},
+		{
+			name:         "owners of every matched entry are picked first",
+			mode:         domain.CodeOwnersModePrefer,
+			changedFiles: []string{"api/handler.go", "db/0001.sql"},
+			limit:        2,
+			wantContains: []domain.UserID{"u2", "u3"},
+			wantLen:      2,
+		},
+		{
+			name:         "preferred owner that is unavailable is filled randomly",
+			mode:         domain.CodeOwnersModePrefer,
+			changedFiles: []string{"legacy/old.go"},
+			limit:        2,
+			wantLen:      2,
+		},
+		{
+			name:         "required owner that is unavailable is reported",
+			mode:         domain.CodeOwnersModeRequire,
+			changedFiles: []string{"legacy/old.go"},
+			limit:        2,
+			wantLen:      2,
+			wantUnfilled: []string{"/legacy/"},
+		},
+		{
+			name:         "required owners beyond the limit are reported",
+			mode:         domain.CodeOwnersModeRequire,
+			changedFiles: []string{"api/handler.go", "db/0001.sql"},
+			limit:        1,
+			wantContains: []domain.UserID{"u2"},
+			wantLen:      1,
+			wantUnfilled: []string{"*.sql"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for range 20 {
+				res := pickReviewers(pickRequest{
+					authorID:     "author",
+					team:         codeOwnersTestTeam(t, tt.mode, content),
+					limit:        tt.limit,
+					changedFiles: tt.changedFiles,
+				})
+
+				if len(res.reviewers) != tt.wantLen {
+					t.Fatalf("expected %d reviewers, got %v", tt.wantLen, res.reviewers)
+				}
+				for _, id := range tt.wantContains {
+					if !slices.Contains(res.reviewers, id) {
+						t.Fatalf("expected %s among reviewers, got %v", id, res.reviewers)
+					}
+					reason := res.reasons[id]
+					if reason.Source != domain.ReviewerReasonCodeOwners || reason.CodeOwners.Pattern == "" {
+						t.Fatalf("expected CODEOWNERS reason for %s, got %+v", id, reason)
+					}
+				}
+
+				var unfilled []string
+				for _, slot := range res.unfilled {
+					unfilled = append(unfilled, slot.CodeOwners.Pattern)
+				}
+				if !slices.Equal(unfilled, tt.wantUnfilled) {
+					t.Fatalf("expected unfilled %v, got %v", tt.wantUnfilled, unfilled)
+				}
+			}
+		})
+	}
+}
+
+func TestPickReviewers_LoadBasedFill(t *testing.T) {
+	team := domain.Team{
+		Name: "backend",
+		Members: []domain.TeamMember{
+			{ID: "author", IsActive: true},
+			{ID: "busy", IsActive: true},
+			{ID: "idle1", IsActive: true},
+			{ID: "idle2", IsActive: true},
+			{ID: "medium", IsActive: true},
+		},
+	}
+	loads := map[domain.UserID]int{"busy": 5, "medium": 2}
+
+	for range 20 {
+		res := pickReviewers(pickRequest{authorID: "author", team: team, limit: 2, loads: loads})
+
+		slices.Sort(res.reviewers)
+		if !slices.Equal(res.reviewers, []domain.UserID{"idle1", "idle2"}) {
+			t.Fatalf("expected least loaded reviewers, got %v", res.reviewers)
+		}
+		for _, id := range res.reviewers {
+			if res.reasons[id].Source != domain.ReviewerReasonLeastLoaded {
+				t.Fatalf("expected LEAST_LOADED reason for %s, got %+v", id, res.reasons[id])
+			}
+		}
+	}
+}
@@ -0,0 +1,110 @@
+package services
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"PrService/src/internal/domain"
+)
+
+// StatsService computes review statistics. The organisation overview is cached for overviewCacheTTL.
+type StatsService struct {
+	statsRepository  domain.StatsRepository
+	teamRepository   domain.TeamRepository
+	overviewCacheTTL time.Duration
+	now              func() time.Time
+
+	mu       sync.Mutex
+	overview *domain.StatsOverview
+}
+
+func NewStatsService(
+	statsRepository domain.StatsRepository,
+	teamRepository domain.TeamRepository,
+	overviewCacheTTL time.Duration,
+) *StatsService {
+	return &StatsService{
+		statsRepository:  statsRepository,
+		teamRepository:   teamRepository,
+		overviewCacheTTL: overviewCacheTTL,
+		now:              time.Now,
+	}
+}
+
+// UserReviewStats returns the review load of every user, or of the members of filter.TeamName when it is set.
+func (s *StatsService) UserReviewStats(
+	ctx context.Context,
+	filter domain.UserStatsFilter,
+) ([]domain.UserReviewStats, error) {
+	if err := filter.Window.Validate(); err != nil {
+		return nil, err
+	}
+
+	if filter.TeamName != "" {
+		if _, err := s.teamRepository.GetByName(ctx, filter.TeamName); err != nil {
+			return nil, err
+		}
+	}
+
+	return s.statsRepository.UserReviewStats(ctx, filter)
+}
+
+// Fairness reports how evenly the assignments made in the window are spread over the currently
+// active members of the team.
+func (s *StatsService) Fairness(
+	ctx context.Context,
+	name domain.TeamName,
+	window domain.StatsWindow,
+) (*domain.FairnessReport, error) {
+	if err := window.Validate(); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.teamRepository.GetByName(ctx, name); err != nil {
+		return nil, err
+	}
+
+	stats, err := s.statsRepository.UserReviewStats(ctx, domain.UserStatsFilter{TeamName: name, Window: window})
+	if err != nil {
+		return nil, err
+	}
+
+	members := make([]domain.MemberLoad, 0, len(stats))
+	for _, st := range stats {
+		if !st.IsActive {
+			continue
+		}
+		members = append(members, domain.MemberLoad{
+			UserID:      st.UserID,
+			Username:    st.Username,
+			Assignments: st.TotalAssignments,
+		})
+	}
+
+	report := domain.NewFairnessReport(name, window, members)
+	return &report, nil
+}
+
+// Overview returns the organisation-wide overview, recomputing it once the cached one is older than the TTL.
+// Concurrent callers wait for a single recomputation.
+func (s *StatsService) Overview(ctx context.Context) (*domain.StatsOverview, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	if s.overview != nil && now.Sub(s.overview.GeneratedAt) < s.overviewCacheTTL {
+		overview := *s.overview
+		return &overview, nil
+	}
+
+	overview, err := s.statsRepository.Overview(ctx, domain.OverviewListLimit)
+	if err != nil {
+		return nil, err
+	}
+	overview.GeneratedAt = now
+
+	s.overview = overview
+	cached := *overview
+	return &cached, nil
+}
@@ -0,0 +1,143 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"PrService/src/internal/application/mocks"
+	"PrService/src/internal/domain"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestStatsService_UserReviewStats_InvalidWindow(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	service := NewStatsService(mocks.NewMockStatsRepository(ctrl), mocks.NewMockTeamRepository(ctrl), 0)
+
+	now := time.Date(2025, 3, 4, 10, 0, 0, 0, time.UTC)
+	filter := domain.UserStatsFilter{Window: domain.StatsWindow{From: now, To: now.Add(-time.Hour)}}
+
+	if _, err := service.UserReviewStats(context.Background(), filter); !errors.Is(err, domain.ErrInvalidStatsWindow) {
+		t.Fatalf("expected ErrInvalidStatsWindow, got %v", err)
+	}
+}
+
+func TestStatsService_UserReviewStats_TeamNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	teamRepo := mocks.NewMockTeamRepository(ctrl)
+	service := NewStatsService(mocks.NewMockStatsRepository(ctrl), teamRepo, 0)
+
+	teamRepo.EXPECT().GetByName(gomock.Any(), domain.TeamName("ghost")).Return(nil, domain.ErrTeamNotFound)
+
+	_, err := service.UserReviewStats(context.Background(), domain.UserStatsFilter{TeamName: "ghost"})
+	if !errors.Is(err, domain.ErrTeamNotFound) {
+		t.Fatalf("expected ErrTeamNotFound, got %v", err)
+	}
+}
+
+func TestStatsService_UserReviewStats_AllUsers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	statsRepo := mocks.NewMockStatsRepository(ctrl)
+	service := NewStatsService(statsRepo, mocks.NewMockTeamRepository(ctrl), 0)
+
+	filter := domain.UserStatsFilter{Window: domain.StatsWindow{From: time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)}}
+	want := []domain.UserReviewStats{{UserID: "u1", TotalAssignments: 3}}
+	statsRepo.EXPECT().UserReviewStats(gomock.Any(), filter).Return(want, nil)
+
+	got, err := service.UserReviewStats(context.Background(), filter)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(got) != 1 || got[0] != want[0] {
+		t.Fatalf("unexpected stats: %+v", got)
+	}
+}
+
+func TestStatsService_Fairness_SkipsInactiveMembers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	statsRepo := mocks.NewMockStatsRepository(ctrl)
+	teamRepo := mocks.NewMockTeamRepository(ctrl)
+	service := NewStatsService(statsRepo, teamRepo, 0)
+
+	teamRepo.EXPECT().GetByName(gomock.Any(), domain.TeamName("backend")).Return(&domain.Team{Name: "backend"}, nil)
+	statsRepo.EXPECT().
+		UserReviewStats(gomock.Any(), domain.UserStatsFilter{TeamName: "backend"}).
+		Return([]domain.UserReviewStats{
+			{UserID: "u1", IsActive: true, TotalAssignments: 6},
+			{UserID: "u2", IsActive: true, TotalAssignments: 3},
+			{UserID: "u3", IsActive: false, TotalAssignments: 0},
+		}, nil)
+
+	report, err := service.Fairness(context.Background(), "backend", domain.StatsWindow{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(report.Members) != 2 || report.TotalAssignments != 9 {
+		t.Fatalf("expected only the active members, got %+v", report.Members)
+	}
+	if report.MaxMinRatio == nil || *report.MaxMinRatio != 2 {
+		t.Fatalf("unexpected max/min ratio: %v", report.MaxMinRatio)
+	}
+}
+
+func TestStatsService_Overview_CachedForTTL(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	statsRepo := mocks.NewMockStatsRepository(ctrl)
+	service := NewStatsService(statsRepo, mocks.NewMockTeamRepository(ctrl), 30*time.Second)
+
+	now := time.Date(2025, 3, 4, 10, 0, 0, 0, time.UTC)
+	service.now = func() time.Time { return now }
+
+	statsRepo.EXPECT().
+		Overview(gomock.Any(), domain.OverviewListLimit).
+		Return(&domain.StatsOverview{Teams: 3}, nil)
+	statsRepo.EXPECT().
+		Overview(gomock.Any(), domain.OverviewListLimit).
+		Return(&domain.StatsOverview{Teams: 4}, nil)
+
+	first, err := service.Overview(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if first.Teams != 3 || !first.GeneratedAt.Equal(now) {
+		t.Fatalf("unexpected overview: %+v", first)
+	}
+
+	now = now.Add(29 * time.Second)
+	cached, err := service.Overview(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cached.Teams != 3 {
+		t.Fatalf("expected the cached overview, got %+v", cached)
+	}
+
+	now = now.Add(time.Second)
+	fresh, err := service.Overview(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if fresh.Teams != 4 || !fresh.GeneratedAt.Equal(now) {
+		t.Fatalf("expected a recomputed overview, got %+v", fresh)
+	}
+}
+
+func TestStatsService_Overview_ErrorNotCached(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	statsRepo := mocks.NewMockStatsRepository(ctrl)
+	service := NewStatsService(statsRepo, mocks.NewMockTeamRepository(ctrl), time.Minute)
+
+	repoErr := errors.New("db down")
+	statsRepo.EXPECT().Overview(gomock.Any(), domain.OverviewListLimit).Return(nil, repoErr)
+	statsRepo.EXPECT().Overview(gomock.Any(), domain.OverviewListLimit).Return(&domain.StatsOverview{Teams: 1}, nil)
+
+	if _, err := service.Overview(context.Background()); !errors.Is(err, repoErr) {
+		t.Fatalf("expected %v, got %v", repoErr, err)
+	}
+	if overview, err := service.Overview(context.Background()); err != nil || overview.Teams != 1 {
+		t.Fatalf("expected a recomputed overview after a failure, got %+v, err=%v", overview, err)
+	}
+}
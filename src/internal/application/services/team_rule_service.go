@@ -0,0 +1,61 @@
+package services
+
+import (
+	"context"
+
+	"PrService/src/internal/application/contracts"
+
+	"PrService/src/internal/domain"
+)
+
+type TeamRuleService struct {
+	teamRepository     domain.TeamRepository
+	teamRuleRepository domain.TeamRuleRepository
+	txManager          contracts.TxManager
+}
+
+func NewTeamRuleService(
+	teamRepository domain.TeamRepository,
+	teamRuleRepository domain.TeamRuleRepository,
+	txManager contracts.TxManager,
+) *TeamRuleService {
+	return &TeamRuleService{
+		teamRepository:     teamRepository,
+		teamRuleRepository: teamRuleRepository,
+		txManager:          txManager,
+	}
+}
+
+func (s *TeamRuleService) Create(ctx context.Context, rule *domain.TeamRule) (*domain.TeamRule, error) {
+	if err := rule.Validate(); err != nil {
+		return nil, err
+	}
+
+	err := s.txManager.WithinTransaction(ctx, func(txCtx context.Context) error {
+		if _, err := s.teamRepository.GetByName(txCtx, rule.TeamName); err != nil {
+			return err
+		}
+
+		return s.teamRuleRepository.Create(txCtx, rule)
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return rule, nil
+}
+
+// List returns the rules of the team; they are loaded together with the team.
+func (s *TeamRuleService) List(ctx context.Context, name domain.TeamName) ([]domain.TeamRule, error) {
+	team, err := s.teamRepository.GetByName(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	return team.Rules, nil
+}
+
+func (s *TeamRuleService) Delete(ctx context.Context, name domain.TeamName, id domain.TeamRuleID) error {
+	return s.teamRuleRepository.Delete(ctx, name, id)
+}
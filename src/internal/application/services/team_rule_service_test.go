@@ -0,0 +1,156 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"PrService/src/internal/application/mocks"
+	"PrService/src/internal/domain"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestTeamRuleService_Create_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	teamRepo := mocks.NewMockTeamRepository(ctrl)
+	ruleRepo := mocks.NewMockTeamRuleRepository(ctrl)
+	txManager := mocks.NewMockTxManager(ctrl)
+
+	svc := NewTeamRuleService(teamRepo, ruleRepo, txManager)
+
+	ctx := context.Background()
+	rule := &domain.TeamRule{
+		TeamName:   "backend",
+		Kind:       domain.TeamRuleKindExcludePair,
+		ReviewerID: "u2",
+		AuthorID:   "u1",
+	}
+
+	txManager.
+		EXPECT().
+		WithinTransaction(ctx, gomock.Any()).
+		DoAndReturn(func(c context.Context, fn func(context.Context) error) error {
+			return fn(c)
+		})
+
+	teamRepo.EXPECT().
+		GetByName(gomock.Any(), domain.TeamName("backend")).
+		Return(&domain.Team{Name: "backend"}, nil)
+
+	ruleRepo.EXPECT().
+		Create(gomock.Any(), rule).
+		DoAndReturn(func(_ context.Context, r *domain.TeamRule) error {
+			r.ID = 7
+			return nil
+		})
+
+	got, err := svc.Create(ctx, rule)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if got.ID != 7 {
+		t.Fatalf("expected rule id 7, got %d", got.ID)
+	}
+}
+
+func TestTeamRuleService_Create_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		rule    domain.TeamRule
+		teamErr error
+		wantErr error
+	}{
+		{
+			name:    "unknown kind",
+			rule:    domain.TeamRule{TeamName: "backend", Kind: "ANY"},
+			wantErr: domain.ErrInvalidTeamRule,
+		},
+		{
+			name:    "require one of without users",
+			rule:    domain.TeamRule{TeamName: "backend", Kind: domain.TeamRuleKindRequireOneOf},
+			wantErr: domain.ErrInvalidTeamRule,
+		},
+		{
+			name: "group of both users and seniority",
+			rule: domain.TeamRule{
+				TeamName: "backend", Kind: domain.TeamRuleKindMaxOf,
+				Users: []domain.UserID{"u1"}, Seniority: domain.SeniorityJunior, MaxCount: 1,
+			},
+			wantErr: domain.ErrInvalidTeamRule,
+		},
+		{
+			name: "unknown seniority",
+			rule: domain.TeamRule{
+				TeamName: "backend", Kind: domain.TeamRuleKindRequireOneOf, Seniority: "GURU",
+			},
+			wantErr: domain.ErrInvalidTeamRule,
+		},
+		{
+			name: "team not found",
+			rule: domain.TeamRule{
+				TeamName: "ghost", Kind: domain.TeamRuleKindMaxOf, Users: []domain.UserID{"u1"}, MaxCount: 1,
+			},
+			teamErr: domain.ErrTeamNotFound,
+			wantErr: domain.ErrTeamNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			teamRepo := mocks.NewMockTeamRepository(ctrl)
+			ruleRepo := mocks.NewMockTeamRuleRepository(ctrl)
+			txManager := mocks.NewMockTxManager(ctrl)
+
+			svc := NewTeamRuleService(teamRepo, ruleRepo, txManager)
+
+			ctx := context.Background()
+			if tt.teamErr != nil {
+				txManager.
+					EXPECT().
+					WithinTransaction(ctx, gomock.Any()).
+					DoAndReturn(func(c context.Context, fn func(context.Context) error) error {
+						return fn(c)
+					})
+
+				teamRepo.EXPECT().
+					GetByName(gomock.Any(), tt.rule.TeamName).
+					Return(nil, tt.teamErr)
+			}
+
+			got, err := svc.Create(ctx, &tt.rule)
+			if got != nil {
+				t.Fatalf("expected nil rule, got %+v", got)
+			}
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("expected %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestTeamRuleService_List(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	teamRepo := mocks.NewMockTeamRepository(ctrl)
+	svc := NewTeamRuleService(teamRepo, nil, nil)
+
+	rules := []domain.TeamRule{{ID: 1, TeamName: "backend", Kind: domain.TeamRuleKindRequireOneOf}}
+	teamRepo.EXPECT().
+		GetByName(gomock.Any(), domain.TeamName("backend")).
+		Return(&domain.Team{Name: "backend", Rules: rules}, nil)
+
+	got, err := svc.List(context.Background(), "backend")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(got) != 1 || got[0].ID != 1 {
+		t.Fatalf("expected team rules, got %+v", got)
+	}
+}
@@ -0,0 +1,126 @@
+package services
+
+import (
+	"slices"
+
+	"PrService/src/internal/domain"
+)
+
+// blockingRule returns the first rule of the team that forbids the candidate from joining the reviewers.
+func blockingRule(
+	team domain.Team,
+	authorID domain.UserID,
+	candidateID domain.UserID,
+	reviewers []domain.UserID,
+) (domain.TeamRule, bool) {
+	for _, rule := range team.Rules {
+		switch rule.Kind {
+		case domain.TeamRuleKindExcludePair:
+			if rule.ReviewerID == candidateID && rule.AuthorID == authorID {
+				return rule, true
+			}
+		case domain.TeamRuleKindMaxOf:
+			group := rule.Group(team.Members)
+			if slices.Contains(group, candidateID) && countOf(group, reviewers) >= rule.MaxCount {
+				return rule, true
+			}
+		}
+	}
+
+	return domain.TeamRule{}, false
+}
+
+// missingRequirements returns REQUIRE_ONE_OF rules of the team that the reviewers do not satisfy yet.
+func missingRequirements(team domain.Team, reviewers []domain.UserID) []domain.TeamRule {
+	var missing []domain.TeamRule
+	for _, rule := range team.Rules {
+		if rule.Kind != domain.TeamRuleKindRequireOneOf {
+			continue
+		}
+
+		if countOf(rule.Group(team.Members), reviewers) == 0 {
+			missing = append(missing, rule)
+		}
+	}
+
+	return missing
+}
+
+// brokenRequirements returns REQUIRE_ONE_OF rules of the team that the reviewers before satisfy and
+// the reviewers after do not. Rules that were unmet already are left to the caller.
+func brokenRequirements(team domain.Team, before, after []domain.UserID) []domain.TeamRule {
+	var broken []domain.TeamRule
+	for _, rule := range team.Rules {
+		if rule.Kind != domain.TeamRuleKindRequireOneOf {
+			continue
+		}
+
+		group := rule.Group(team.Members)
+		if countOf(group, before) > 0 && countOf(group, after) == 0 {
+			broken = append(broken, rule)
+		}
+	}
+
+	return broken
+}
+
+func countOf(group []domain.UserID, reviewers []domain.UserID) int {
+	count := 0
+	for _, reviewer := range reviewers {
+		if slices.Contains(group, reviewer) {
+			count++
+		}
+	}
+
+	return count
+}
+
+func appendUnfilledSlot(
+	slots []domain.UnfilledSlot,
+	teamName domain.TeamName,
+	rule domain.TeamRule,
+) []domain.UnfilledSlot {
+	for _, slot := range slots {
+		if slot.Rule.ID == rule.ID && slot.TeamName == teamName {
+			return slots
+		}
+	}
+
+	return append(slots, domain.UnfilledSlot{TeamName: teamName, Rule: rule})
+}
+
+// coverTags marks the tags carried by the team member as covered.
+func coverTags(covered map[string]bool, team domain.Team, reviewerID domain.UserID) {
+	for _, member := range team.Members {
+		if member.ID != reviewerID {
+			continue
+		}
+
+		for _, tag := range member.Tags {
+			covered[tag] = true
+		}
+	}
+}
+
+// missingTags returns the tags that are not covered yet, in their original order.
+func missingTags(tags []string, covered map[string]bool) []string {
+	var missing []string
+	for _, tag := range tags {
+		if !covered[tag] && !slices.Contains(missing, tag) {
+			missing = append(missing, tag)
+		}
+	}
+
+	return missing
+}
+
+func countTags(member domain.TeamMember, tags []string) int {
+	count := 0
+	for _, tag := range tags {
+		if member.HasTag(tag) {
+			count++
+		}
+	}
+
+	return count
+}
@@ -0,0 +1,194 @@
+package services
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"PrService/src/internal/domain"
+)
+
+func rulesTestTeam(rules ...domain.TeamRule) domain.Team {
+	return domain.Team{
+		Name: "backend",
+		Members: []domain.TeamMember{
+			{ID: "author", IsActive: true},
+			{ID: "junior1", IsActive: true},
+			{ID: "junior2", IsActive: true},
+			{ID: "senior", IsActive: true},
+		},
+		Rules: rules,
+	}
+}
+
+func TestPickReviewers_Rules(t *testing.T) {
+	excludeSenior := domain.TeamRule{
+		ID: 1, TeamName: "backend", Kind: domain.TeamRuleKindExcludePair, ReviewerID: "senior", AuthorID: "author",
+	}
+	requireSenior := domain.TeamRule{
+		ID: 2, TeamName: "backend", Kind: domain.TeamRuleKindRequireOneOf, Users: []domain.UserID{"senior"},
+	}
+	maxOneJunior := domain.TeamRule{
+		ID: 3, TeamName: "backend", Kind: domain.TeamRuleKindMaxOf,
+		Users: []domain.UserID{"junior1", "junior2"}, MaxCount: 1,
+	}
+
+	tests := []struct {
+		name         string
+		rules        []domain.TeamRule
+		wantContains []domain.UserID
+		wantExcluded []domain.UserID
+		wantLen      int
+		wantUnfilled []domain.TeamRuleID
+	}{
+		{
+			name:         "excluded pair is never picked",
+			rules:        []domain.TeamRule{excludeSenior},
+			wantExcluded: []domain.UserID{"senior"},
+			wantLen:      2,
+		},
+		{
+			name:         "required group is always picked",
+			rules:        []domain.TeamRule{requireSenior},
+			wantContains: []domain.UserID{"senior"},
+			wantLen:      2,
+		},
+		{
+			name:         "max of group leaves slot to others",
+			rules:        []domain.TeamRule{maxOneJunior},
+			wantContains: []domain.UserID{"senior"},
+			wantLen:      2,
+		},
+		{
+			name:         "unsatisfiable requirement is reported",
+			rules:        []domain.TeamRule{excludeSenior, requireSenior},
+			wantExcluded: []domain.UserID{"senior"},
+			wantLen:      2,
+			wantUnfilled: []domain.TeamRuleID{2},
+		},
+		{
+			name:         "blocking rules are reported for unfilled slots",
+			rules:        []domain.TeamRule{excludeSenior, maxOneJunior},
+			wantLen:      1,
+			wantUnfilled: []domain.TeamRuleID{1, 3},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for range 20 {
+				res := pickReviewers(pickRequest{authorID: "author", team: rulesTestTeam(tt.rules...), limit: 2})
+				got, unfilled := res.reviewers, res.unfilled
+				if len(got) != tt.wantLen {
+					t.Fatalf("expected %d reviewers, got %v", tt.wantLen, got)
+				}
+				for _, id := range tt.wantContains {
+					if !slices.Contains(got, id) {
+						t.Fatalf("expected %s among reviewers, got %v", id, got)
+					}
+				}
+				for _, id := range tt.wantExcluded {
+					if slices.Contains(got, id) {
+						t.Fatalf("expected %s not to be picked, got %v", id, got)
+					}
+				}
+
+				gotUnfilled := make([]domain.TeamRuleID, 0, len(unfilled))
+				for _, slot := range unfilled {
+					if slot.TeamName != "backend" {
+						t.Fatalf("expected backend slot, got %+v", slot)
+					}
+					gotUnfilled = append(gotUnfilled, slot.Rule.ID)
+				}
+				slices.Sort(gotUnfilled)
+				if !slices.Equal(gotUnfilled, tt.wantUnfilled) {
+					t.Fatalf("expected unfilled rules %v, got %v", tt.wantUnfilled, gotUnfilled)
+				}
+			}
+		})
+	}
+}
+
+func TestPickReviewers_SeniorityRules(t *testing.T) {
+	team := domain.Team{
+		Name: "backend",
+		Members: []domain.TeamMember{
+			{ID: "author", IsActive: true},
+			{ID: "junior1", IsActive: true, Seniority: domain.SeniorityJunior},
+			{ID: "junior2", IsActive: true, Seniority: domain.SeniorityJunior},
+			{ID: "junior3", IsActive: true, Seniority: domain.SeniorityJunior},
+			{ID: "senior", IsActive: true, Seniority: domain.SenioritySenior},
+		},
+		Rules: []domain.TeamRule{
+			{ID: 1, TeamName: "backend", Kind: domain.TeamRuleKindRequireOneOf, Seniority: domain.SenioritySenior},
+			{
+				ID: 2, TeamName: "backend", Kind: domain.TeamRuleKindMaxOf,
+				Seniority: domain.SeniorityJunior, MaxCount: 1,
+			},
+		},
+	}
+
+	for range 20 {
+		res := pickReviewers(pickRequest{authorID: "author", team: team, limit: 3})
+		if !slices.Contains(res.reviewers, "senior") {
+			t.Fatalf("expected the senior to be picked, got %v", res.reviewers)
+		}
+		if len(res.reviewers) != 2 {
+			t.Fatalf("expected one senior and one junior, got %v", res.reviewers)
+		}
+		if len(res.unfilled) != 1 || res.unfilled[0].Rule.ID != 2 {
+			t.Fatalf("expected the junior limit to leave a slot unfilled, got %+v", res.unfilled)
+		}
+	}
+
+	// a member promoted to senior satisfies the rule without the rule being edited
+	team.Members[1].Seniority = domain.SenioritySenior
+	before := []domain.UserID{"senior", "junior2"}
+	if err := checkRequirements(team, before, []domain.UserID{"junior1", "junior2"}); err != nil {
+		t.Fatalf("expected the promoted member to keep the rule met, got %v", err)
+	}
+	err := checkRequirements(team, before, []domain.UserID{"junior2", "junior3"})
+	if !errors.Is(err, domain.ErrRuleViolation) {
+		t.Fatalf("expected ErrRuleViolation, got %v", err)
+	}
+}
+
+func TestReassignReviewers_Rules(t *testing.T) {
+	requireSenior := domain.TeamRule{
+		ID: 2, TeamName: "backend", Kind: domain.TeamRuleKindRequireOneOf, Users: []domain.UserID{"senior", "junior2"},
+	}
+	excludeJunior2 := domain.TeamRule{
+		ID: 4, TeamName: "backend", Kind: domain.TeamRuleKindExcludePair, ReviewerID: "junior2", AuthorID: "author",
+	}
+
+	t.Run("replacement restores required group", func(t *testing.T) {
+		for range 20 {
+			got, newRev, err := reassignReviewers(
+				"author", "senior", []domain.UserID{"junior1", "senior"}, rulesTestTeam(requireSenior), nil,
+			)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if newRev != "junior2" || !slices.Equal(got, []domain.UserID{"junior1", "junior2"}) {
+				t.Fatalf("expected junior2 to replace senior, got %s (%v)", newRev, got)
+			}
+		}
+	})
+
+	t.Run("rule that blocked every candidate is reported", func(t *testing.T) {
+		_, _, err := reassignReviewers(
+			"author", "senior", []domain.UserID{"junior1", "senior"}, rulesTestTeam(requireSenior, excludeJunior2), nil,
+		)
+		if !errors.Is(err, domain.ErrNoCandidate) {
+			t.Fatalf("expected ErrNoCandidate, got %v", err)
+		}
+
+		var ruleErr *domain.RuleViolationError
+		if !errors.As(err, &ruleErr) {
+			t.Fatalf("expected RuleViolationError, got %T", err)
+		}
+		if ruleErr.Rule.ID != excludeJunior2.ID {
+			t.Fatalf("unexpected rule %+v", ruleErr.Rule)
+		}
+	})
+}
@@ -2,6 +2,9 @@ package services
 
 import (
 	"context"
+	"errors"
+	"slices"
+	"time"
 
 	"PrService/src/internal/application/contracts"
 
@@ -12,17 +15,22 @@ type TeamService struct {
 	teamRepository domain.TeamRepository
 	userRepository domain.UserRepository
 	txManager      contracts.TxManager
+	eventPublisher contracts.EventPublisher
+	now            func() time.Time
 }
 
 func NewTeamService(
 	teamRepository domain.TeamRepository,
 	userRepository domain.UserRepository,
 	txManager contracts.TxManager,
+	eventPublisher contracts.EventPublisher,
 ) *TeamService {
 	return &TeamService{
 		teamRepository: teamRepository,
 		userRepository: userRepository,
 		txManager:      txManager,
+		eventPublisher: eventPublisher,
+		now:            time.Now,
 	}
 }
 
@@ -38,7 +46,11 @@ func (s *TeamService) Create(ctx context.Context, team *domain.Team) (*domain.Te
 			users = append(users, user)
 		}
 
-		return s.userRepository.UpsertBatch(txCtx, users)
+		if err := s.userRepository.UpsertBatch(txCtx, users); err != nil {
+			return err
+		}
+
+		return s.eventPublisher.Publish(txCtx, domain.NewTeamEvent(domain.EventTeamCreated, *team))
 	})
 
 	if err != nil {
@@ -55,3 +67,173 @@ func (s *TeamService) Get(ctx context.Context, name domain.TeamName) (*domain.Te
 func (s *TeamService) GetStats(ctx context.Context, name domain.TeamName) (*domain.TeamStats, error) {
 	return s.teamRepository.GetStats(ctx, name)
 }
+
+// GetStatsTimeSeries returns the throughput of the team per interval. A window without an end ends now;
+// one without a start covers the last 30 intervals.
+func (s *TeamService) GetStatsTimeSeries(
+	ctx context.Context,
+	name domain.TeamName,
+	interval domain.StatsInterval,
+	window domain.StatsWindow,
+) (*domain.TeamStatsTimeSeries, error) {
+	window, err := interval.SeriesWindow(window, s.now())
+	if err != nil {
+		return nil, err
+	}
+
+	return s.teamRepository.GetStatsTimeSeries(ctx, name, interval, window)
+}
+
+func (s *TeamService) SetMaxReviewers(
+	ctx context.Context,
+	name domain.TeamName,
+	maxReviewers int,
+) (*domain.Team, error) {
+	var team *domain.Team
+	err := s.txManager.WithinTransaction(ctx, func(txCtx context.Context) error {
+		if err := s.teamRepository.SetMaxReviewers(txCtx, name, maxReviewers); err != nil {
+			return err
+		}
+
+		var err error
+		team, err = s.teamRepository.GetByName(txCtx, name)
+		if err != nil {
+			return err
+		}
+
+		return s.eventPublisher.Publish(txCtx, domain.NewTeamEvent(domain.EventTeamUpdated, *team))
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return team, nil
+}
+
+// Import brings the teams listed in the document to the described state in one transaction and
+// returns the changes made. With dryRun the changes are only computed.
+func (s *TeamService) Import(
+	ctx context.Context,
+	doc domain.TeamImport,
+	dryRun bool,
+) (*domain.TeamImportDiff, error) {
+	if err := doc.Validate(); err != nil {
+		return nil, err
+	}
+
+	var diff domain.TeamImportDiff
+	err := s.txManager.WithinTransaction(ctx, func(txCtx context.Context) error {
+		existingTeams := make(map[domain.TeamName]*domain.Team, len(doc.Teams))
+		for _, team := range doc.Teams {
+			existing, err := s.teamRepository.GetByName(txCtx, team.Name)
+			if errors.Is(err, domain.ErrTeamNotFound) {
+				continue
+			}
+			if err != nil {
+				return err
+			}
+			existingTeams[team.Name] = existing
+		}
+
+		users, err := s.userRepository.List(txCtx, domain.UserFilter{})
+		if err != nil {
+			return err
+		}
+
+		diff = domain.NewTeamImportDiff(doc, existingTeams, users)
+		if dryRun || diff.IsEmpty() {
+			return nil
+		}
+
+		return s.applyImport(txCtx, doc, diff)
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &diff, nil
+}
+
+// applyImport writes the diff and publishes a creation event for every new team, an update event for
+// every other team that gains, loses or changes members or its reviewers limit, and a deactivation
+// event for every deactivated user.
+func (s *TeamService) applyImport(ctx context.Context, doc domain.TeamImport, diff domain.TeamImportDiff) error {
+	for _, name := range diff.CreatedTeams {
+		if err := s.teamRepository.Create(ctx, name); err != nil {
+			return err
+		}
+	}
+
+	created := make(map[domain.TeamName]struct{}, len(diff.CreatedTeams))
+	for _, name := range diff.CreatedTeams {
+		created[name] = struct{}{}
+	}
+
+	for _, team := range doc.Teams {
+		if team.MaxReviewers <= 0 {
+			continue
+		}
+
+		_, isNew := created[team.Name]
+		if !isNew && !slices.Contains(diff.UpdatedTeams, team.Name) {
+			continue
+		}
+		if err := s.teamRepository.SetMaxReviewers(ctx, team.Name, team.MaxReviewers); err != nil {
+			return err
+		}
+	}
+
+	if err := s.userRepository.UpsertBatch(ctx, diff.ChangedUsers()); err != nil {
+		return err
+	}
+
+	updated := make(map[domain.TeamName]struct{})
+	for _, name := range diff.UpdatedTeams {
+		updated[name] = struct{}{}
+	}
+	for _, user := range diff.ChangedUsers() {
+		updated[user.TeamName] = struct{}{}
+	}
+	for _, move := range diff.MovedUsers {
+		updated[move.FromTeam] = struct{}{}
+	}
+
+	for _, name := range diff.CreatedTeams {
+		if err := s.publishTeamEvent(ctx, domain.EventTeamCreated, name); err != nil {
+			return err
+		}
+	}
+
+	names := make([]domain.TeamName, 0, len(updated))
+	for name := range updated {
+		if _, isNew := created[name]; !isNew {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+
+	for _, name := range names {
+		if err := s.publishTeamEvent(ctx, domain.EventTeamUpdated, name); err != nil {
+			return err
+		}
+	}
+
+	for _, user := range diff.DeactivatedUsers {
+		if err := s.eventPublisher.Publish(ctx, domain.NewUserEvent(domain.EventUserDeactivated, user)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *TeamService) publishTeamEvent(ctx context.Context, eventType domain.EventType, name domain.TeamName) error {
+	team, err := s.teamRepository.GetByName(ctx, name)
+	if err != nil {
+		return err
+	}
+
+	return s.eventPublisher.Publish(ctx, domain.NewTeamEvent(eventType, *team))
+}
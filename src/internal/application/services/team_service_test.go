@@ -5,6 +5,7 @@ import (
 	"errors"
 	"reflect"
 	"testing"
+	"time"
 
 	"PrService/src/internal/application/mocks"
 	"PrService/src/internal/domain"
@@ -20,7 +21,9 @@ func TestTeamService_Create_Success(t *testing.T) {
 	userRepo := mocks.NewMockUserRepository(ctrl)
 	txManager := mocks.NewMockTxManager(ctrl)
 
-	service := NewTeamService(teamRepo, userRepo, txManager)
+	publisher := mocks.NewMockEventPublisher(ctrl)
+
+	service := NewTeamService(teamRepo, userRepo, txManager, publisher)
 
 	ctx := context.Background()
 	teamName := domain.TeamName("backend")
@@ -68,6 +71,19 @@ func TestTeamService_Create_Success(t *testing.T) {
 			return nil
 		})
 
+	publisher.
+		EXPECT().
+		Publish(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, events ...domain.Event) error {
+			if len(events) != 1 || events[0].Type != domain.EventTeamCreated {
+				t.Fatalf("expected one TEAM_CREATED event, got %+v", events)
+			}
+			if events[0].Team == nil || len(events[0].Team.Members) != 2 {
+				t.Fatalf("unexpected event team: %+v", events[0].Team)
+			}
+			return nil
+		})
+
 	result, err := service.Create(ctx, team)
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
@@ -90,7 +106,7 @@ func TestTeamService_Create_TeamCreateError(t *testing.T) {
 	userRepo := mocks.NewMockUserRepository(ctrl)
 	txManager := mocks.NewMockTxManager(ctrl)
 
-	service := NewTeamService(teamRepo, userRepo, txManager)
+	service := NewTeamService(teamRepo, userRepo, txManager, anyEventPublisher(ctrl))
 
 	ctx := context.Background()
 
@@ -135,7 +151,7 @@ func TestTeamService_Create_UpsertBatchError(t *testing.T) {
 	userRepo := mocks.NewMockUserRepository(ctrl)
 	txManager := mocks.NewMockTxManager(ctrl)
 
-	service := NewTeamService(teamRepo, userRepo, txManager)
+	service := NewTeamService(teamRepo, userRepo, txManager, anyEventPublisher(ctrl))
 
 	ctx := context.Background()
 	teamName := domain.TeamName("backend")
@@ -201,7 +217,7 @@ func TestTeamService_Create_TxManagerError(t *testing.T) {
 	userRepo := mocks.NewMockUserRepository(ctrl)
 	txManager := mocks.NewMockTxManager(ctrl)
 
-	service := NewTeamService(teamRepo, userRepo, txManager)
+	service := NewTeamService(teamRepo, userRepo, txManager, anyEventPublisher(ctrl))
 
 	ctx := context.Background()
 	team := &domain.Team{
@@ -238,7 +254,7 @@ func TestTeamService_Get_Success(t *testing.T) {
 	userRepo := mocks.NewMockUserRepository(ctrl)
 	txManager := mocks.NewMockTxManager(ctrl)
 
-	service := NewTeamService(teamRepo, userRepo, txManager)
+	service := NewTeamService(teamRepo, userRepo, txManager, anyEventPublisher(ctrl))
 
 	ctx := context.Background()
 	name := domain.TeamName("backend")
@@ -277,7 +293,7 @@ func TestTeamService_Get_Error(t *testing.T) {
 	userRepo := mocks.NewMockUserRepository(ctrl)
 	txManager := mocks.NewMockTxManager(ctrl)
 
-	service := NewTeamService(teamRepo, userRepo, txManager)
+	service := NewTeamService(teamRepo, userRepo, txManager, anyEventPublisher(ctrl))
 
 	ctx := context.Background()
 	name := domain.TeamName("backend")
@@ -309,7 +325,7 @@ func TestTeamService_GetStats_Success(t *testing.T) {
 
 	teamRepo := mocks.NewMockTeamRepository(ctrl)
 
-	svc := NewTeamService(teamRepo, nil, nil)
+	svc := NewTeamService(teamRepo, nil, nil, nil)
 
 	ctx := context.Background()
 	teamName := domain.TeamName("backend")
@@ -343,7 +359,7 @@ func TestTeamService_GetStats_Error(t *testing.T) {
 
 	teamRepo := mocks.NewMockTeamRepository(ctrl)
 
-	svc := NewTeamService(teamRepo, nil, nil)
+	svc := NewTeamService(teamRepo, nil, nil, nil)
 
 	ctx := context.Background()
 	teamName := domain.TeamName("unknown")
@@ -360,3 +376,272 @@ func TestTeamService_GetStats_Error(t *testing.T) {
 		t.Fatalf("expected ErrTeamNotFound, got %v", err)
 	}
 }
+
+func TestTeamService_SetMaxReviewers_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	teamRepo := mocks.NewMockTeamRepository(ctrl)
+	txManager := mocks.NewMockTxManager(ctrl)
+
+	publisher := mocks.NewMockEventPublisher(ctrl)
+
+	svc := NewTeamService(teamRepo, nil, txManager, publisher)
+
+	ctx := context.Background()
+	teamName := domain.TeamName("backend")
+	expected := &domain.Team{Name: teamName, MaxReviewers: 3}
+
+	txManager.
+		EXPECT().
+		WithinTransaction(ctx, gomock.Any()).
+		DoAndReturn(func(c context.Context, fn func(context.Context) error) error {
+			return fn(c)
+		})
+
+	teamRepo.EXPECT().
+		SetMaxReviewers(gomock.Any(), teamName, 3).
+		Return(nil)
+
+	teamRepo.EXPECT().
+		GetByName(gomock.Any(), teamName).
+		Return(expected, nil)
+
+	publisher.
+		EXPECT().
+		Publish(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, events ...domain.Event) error {
+			if len(events) != 1 || events[0].Type != domain.EventTeamUpdated || events[0].Team.MaxReviewers != 3 {
+				t.Fatalf("expected TEAM_UPDATED event with new limit, got %+v", events)
+			}
+			return nil
+		})
+
+	got, err := svc.SetMaxReviewers(ctx, teamName, 3)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if got != expected {
+		t.Fatalf("expected %+v, got %+v", expected, got)
+	}
+}
+
+func TestTeamService_SetMaxReviewers_TeamNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	teamRepo := mocks.NewMockTeamRepository(ctrl)
+	txManager := mocks.NewMockTxManager(ctrl)
+
+	svc := NewTeamService(teamRepo, nil, txManager, anyEventPublisher(ctrl))
+
+	ctx := context.Background()
+	teamName := domain.TeamName("ghost")
+
+	txManager.
+		EXPECT().
+		WithinTransaction(ctx, gomock.Any()).
+		DoAndReturn(func(c context.Context, fn func(context.Context) error) error {
+			return fn(c)
+		})
+
+	teamRepo.EXPECT().
+		SetMaxReviewers(gomock.Any(), teamName, 3).
+		Return(domain.ErrTeamNotFound)
+
+	got, err := svc.SetMaxReviewers(ctx, teamName, 3)
+	if got != nil {
+		t.Fatalf("expected nil team, got %+v", got)
+	}
+	if !errors.Is(err, domain.ErrTeamNotFound) {
+		t.Fatalf("expected ErrTeamNotFound, got %v", err)
+	}
+}
+
+func TestTeamService_Create_PublishError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	teamRepo := mocks.NewMockTeamRepository(ctrl)
+	userRepo := mocks.NewMockUserRepository(ctrl)
+	txManager := mocks.NewMockTxManager(ctrl)
+	publisher := mocks.NewMockEventPublisher(ctrl)
+
+	service := NewTeamService(teamRepo, userRepo, txManager, publisher)
+
+	ctx := context.Background()
+	team := &domain.Team{Name: "backend"}
+	expectedErr := errors.New("outbox error")
+
+	txManager.
+		EXPECT().
+		WithinTransaction(ctx, gomock.Any()).
+		DoAndReturn(func(c context.Context, fn func(context.Context) error) error {
+			return fn(c)
+		})
+
+	teamRepo.EXPECT().Create(gomock.Any(), team.Name).Return(nil)
+	userRepo.EXPECT().UpsertBatch(gomock.Any(), gomock.Any()).Return(nil)
+	publisher.EXPECT().Publish(gomock.Any(), gomock.Any()).Return(expectedErr)
+
+	result, err := service.Create(ctx, team)
+	if !errors.Is(err, expectedErr) {
+		t.Fatalf("expected publish error to fail the transaction, got %v", err)
+	}
+	if result != nil {
+		t.Fatalf("expected nil team on error, got %+v", result)
+	}
+}
+
+func TestTeamService_GetStatsTimeSeries_DefaultWindow(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	teamRepo := mocks.NewMockTeamRepository(ctrl)
+	service := NewTeamService(teamRepo, nil, nil, nil)
+
+	now := time.Date(2025, 3, 31, 15, 0, 0, 0, time.UTC)
+	service.now = func() time.Time { return now }
+
+	want := domain.StatsWindow{From: now.Add(-30 * 7 * 24 * time.Hour), To: now}
+	teamRepo.
+		EXPECT().
+		GetStatsTimeSeries(gomock.Any(), domain.TeamName("backend"), domain.StatsIntervalWeek, want).
+		Return(&domain.TeamStatsTimeSeries{TeamName: "backend", Interval: domain.StatsIntervalWeek}, nil)
+
+	series, err := service.GetStatsTimeSeries(
+		context.Background(), "backend", domain.StatsIntervalWeek, domain.StatsWindow{},
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if series.Interval != domain.StatsIntervalWeek {
+		t.Fatalf("unexpected series: %+v", series)
+	}
+}
+
+func TestTeamService_GetStatsTimeSeries_InvalidInput(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	service := NewTeamService(mocks.NewMockTeamRepository(ctrl), nil, nil, nil)
+	now := time.Date(2025, 3, 31, 15, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		interval domain.StatsInterval
+		window   domain.StatsWindow
+		wantErr  error
+	}{
+		{name: "unknown interval", interval: "month", wantErr: domain.ErrInvalidStatsInterval},
+		{
+			name:     "reversed window",
+			interval: domain.StatsIntervalDay,
+			window:   domain.StatsWindow{From: now, To: now.Add(-time.Hour)},
+			wantErr:  domain.ErrInvalidStatsWindow,
+		},
+		{
+			name:     "too many buckets",
+			interval: domain.StatsIntervalDay,
+			window:   domain.StatsWindow{From: now.AddDate(-2, 0, 0), To: now},
+			wantErr:  domain.ErrInvalidStatsWindow,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := service.GetStatsTimeSeries(context.Background(), "backend", tt.interval, tt.window)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("expected %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestTeamService_Import_DryRunWritesNothing(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	teamRepo := mocks.NewMockTeamRepository(ctrl)
+	userRepo := mocks.NewMockUserRepository(ctrl)
+	service := NewTeamService(teamRepo, userRepo, passthroughTxManager(ctrl), mocks.NewMockEventPublisher(ctrl))
+
+	doc := domain.TeamImport{Teams: []domain.Team{{
+		Name:    "mobile",
+		Members: []domain.TeamMember{{ID: "u1", Username: "Alice", IsActive: true}},
+	}}}
+
+	teamRepo.EXPECT().GetByName(gomock.Any(), domain.TeamName("mobile")).Return(nil, domain.ErrTeamNotFound)
+	userRepo.EXPECT().List(gomock.Any(), domain.UserFilter{}).Return([]domain.User{}, nil)
+
+	diff, err := service.Import(context.Background(), doc, true)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(diff.CreatedTeams) != 1 || len(diff.CreatedUsers) != 1 {
+		t.Fatalf("unexpected diff: %+v", diff)
+	}
+}
+
+func TestTeamService_Import_Applies(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	teamRepo := mocks.NewMockTeamRepository(ctrl)
+	userRepo := mocks.NewMockUserRepository(ctrl)
+	publisher := mocks.NewMockEventPublisher(ctrl)
+	service := NewTeamService(teamRepo, userRepo, passthroughTxManager(ctrl), publisher)
+
+	doc := domain.TeamImport{Teams: []domain.Team{{
+		Name:         "mobile",
+		MaxReviewers: 3,
+		Members: []domain.TeamMember{
+			{ID: "u1", Username: "Alice", IsActive: true},
+			{ID: "u2", Username: "Bob", IsActive: true},
+		},
+	}}}
+	existing := []domain.User{{ID: "u2", Username: "Bob", TeamName: "backend", IsActive: true}}
+
+	gomock.InOrder(
+		teamRepo.EXPECT().GetByName(gomock.Any(), domain.TeamName("mobile")).Return(nil, domain.ErrTeamNotFound),
+		userRepo.EXPECT().List(gomock.Any(), domain.UserFilter{}).Return(existing, nil),
+		teamRepo.EXPECT().Create(gomock.Any(), domain.TeamName("mobile")).Return(nil),
+		teamRepo.EXPECT().SetMaxReviewers(gomock.Any(), domain.TeamName("mobile"), 3).Return(nil),
+		userRepo.EXPECT().UpsertBatch(gomock.Any(), []domain.User{
+			{ID: "u1", Username: "Alice", TeamName: "mobile", IsActive: true},
+			{ID: "u2", Username: "Bob", TeamName: "mobile", IsActive: true},
+		}).Return(nil),
+		teamRepo.EXPECT().GetByName(gomock.Any(), domain.TeamName("mobile")).Return(&domain.Team{Name: "mobile"}, nil),
+		teamRepo.EXPECT().GetByName(gomock.Any(), domain.TeamName("backend")).Return(&domain.Team{}, nil),
+	)
+
+	var events []domain.EventType
+	publisher.EXPECT().Publish(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, event domain.Event) error {
+			events = append(events, event.Type)
+			return nil
+		}).
+		Times(2)
+
+	diff, err := service.Import(context.Background(), doc, false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(diff.MovedUsers) != 1 || diff.MovedUsers[0].FromTeam != "backend" {
+		t.Fatalf("expected u2 to move from backend, got %+v", diff.MovedUsers)
+	}
+	if !reflect.DeepEqual(events, []domain.EventType{domain.EventTeamCreated, domain.EventTeamUpdated}) {
+		t.Fatalf("unexpected events: %v", events)
+	}
+}
+
+func TestTeamService_Import_InvalidDocument(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	service := NewTeamService(
+		mocks.NewMockTeamRepository(ctrl),
+		mocks.NewMockUserRepository(ctrl),
+		mocks.NewMockTxManager(ctrl),
+		mocks.NewMockEventPublisher(ctrl),
+	)
+
+	_, err := service.Import(context.Background(), domain.TeamImport{}, false)
+	if !errors.Is(err, domain.ErrInvalidTeamImport) {
+		t.Fatalf("expected ErrInvalidTeamImport, got %v", err)
+	}
+}
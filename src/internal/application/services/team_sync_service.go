@@ -0,0 +1,65 @@
+package services
+
+import (
+	"context"
+
+	"PrService/src/internal/application/contracts"
+
+	"PrService/src/internal/domain"
+)
+
+// TeamSyncService reconciles a team with its desired member list: it is an import of the single team
+// whose deactivated members also hand their open reviews over. Syncing the same list again changes nothing.
+type TeamSyncService struct {
+	teamService domain.TeamService
+	userService domain.UserService
+	txManager   contracts.TxManager
+}
+
+func NewTeamSyncService(
+	teamService domain.TeamService,
+	userService domain.UserService,
+	txManager contracts.TxManager,
+) *TeamSyncService {
+	return &TeamSyncService{
+		teamService: teamService,
+		userService: userService,
+		txManager:   txManager,
+	}
+}
+
+// Sync creates the team when needed and brings its members to the list. Members missing from the list
+// are deactivated, as a user always belongs to a team; their reviews are reassigned once the new
+// members are in place, so they can take them over.
+func (s *TeamSyncService) Sync(ctx context.Context, team domain.Team) (*domain.TeamSyncResult, error) {
+	doc := domain.TeamImport{Teams: []domain.Team{team}}
+
+	result := &domain.TeamSyncResult{Handovers: make([]domain.UserReviewHandover, 0)}
+	err := s.txManager.WithinTransaction(ctx, func(txCtx context.Context) error {
+		changes, err := s.teamService.Import(txCtx, doc, false)
+		if err != nil {
+			return err
+		}
+		result.Changes = *changes
+
+		for _, user := range changes.DeactivatedUsers {
+			handover, err := s.userService.HandOverReviews(txCtx, user.ID)
+			if err != nil {
+				return err
+			}
+
+			result.Handovers = append(result.Handovers, domain.UserReviewHandover{
+				UserID:         user.ID,
+				ReviewHandover: *handover,
+			})
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
@@ -0,0 +1,106 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"PrService/src/internal/application/mocks"
+	"PrService/src/internal/domain"
+
+	"go.uber.org/mock/gomock"
+)
+
+type teamSyncFixture struct {
+	service  *TeamSyncService
+	teamRepo *mocks.MockTeamRepository
+	userRepo *mocks.MockUserRepository
+	prRepo   *mocks.MockPullRequestRepository
+}
+
+func newTeamSyncFixture(t *testing.T) teamSyncFixture {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	f := teamSyncFixture{
+		teamRepo: mocks.NewMockTeamRepository(ctrl),
+		userRepo: mocks.NewMockUserRepository(ctrl),
+		prRepo:   mocks.NewMockPullRequestRepository(ctrl),
+	}
+
+	txMgr := passthroughTxManager(ctrl)
+	publisher := anyEventPublisher(ctrl)
+	historyRepo := mocks.NewMockPullRequestHistoryRepository(ctrl)
+	historyRepo.EXPECT().Append(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	teams := NewTeamService(f.teamRepo, f.userRepo, txMgr, publisher)
+	users := NewUserService(
+		f.userRepo,
+		f.prRepo,
+		nil,
+		historyRepo,
+		NewPullRequestService(f.prRepo, f.teamRepo, txMgr, publisher),
+		txMgr,
+		publisher,
+	)
+	f.service = NewTeamSyncService(teams, users, txMgr)
+
+	return f
+}
+
+func TestTeamSyncService_Sync_AddsAndDeactivatesMembers(t *testing.T) {
+	f := newTeamSyncFixture(t)
+
+	existing := &domain.Team{Name: "backend", MaxReviewers: 2}
+	f.teamRepo.EXPECT().GetByName(gomock.Any(), domain.TeamName("backend")).Return(existing, nil).Times(2)
+	f.userRepo.EXPECT().List(gomock.Any(), domain.UserFilter{}).Return([]domain.User{
+		{ID: "u1", Username: "Alice", TeamName: "backend", IsActive: true},
+		{ID: "u2", Username: "Bob", TeamName: "backend", IsActive: true},
+	}, nil)
+	f.userRepo.EXPECT().UpsertBatch(gomock.Any(), []domain.User{
+		{ID: "u3", Username: "Carol", TeamName: "backend", IsActive: true},
+		{ID: "u2", Username: "Bob", TeamName: "backend", IsActive: false},
+	}).Return(nil)
+	f.prRepo.EXPECT().ListByReviewer(gomock.Any(), domain.UserID("u2")).Return([]domain.PullRequest{
+		{ID: "pr-merged", Status: domain.PullRequestStatusMerged},
+	}, nil)
+
+	result, err := f.service.Sync(context.Background(), domain.Team{
+		Name: "backend",
+		Members: []domain.TeamMember{
+			{ID: "u1", Username: "Alice", IsActive: true},
+			{ID: "u3", Username: "Carol", IsActive: true},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(result.Changes.CreatedUsers) != 1 || len(result.Changes.DeactivatedUsers) != 1 {
+		t.Fatalf("unexpected changes: %+v", result.Changes)
+	}
+	if len(result.Handovers) != 1 || result.Handovers[0].UserID != "u2" ||
+		len(result.Handovers[0].Reassigned) != 0 || len(result.Handovers[0].Unreassigned) != 0 {
+		t.Fatalf("expected an empty handover of u2, got %+v", result.Handovers)
+	}
+}
+
+func TestTeamSyncService_Sync_IsIdempotent(t *testing.T) {
+	f := newTeamSyncFixture(t)
+
+	f.teamRepo.EXPECT().GetByName(gomock.Any(), domain.TeamName("backend")).Return(&domain.Team{Name: "backend"}, nil)
+	f.userRepo.EXPECT().List(gomock.Any(), domain.UserFilter{}).Return([]domain.User{
+		{ID: "u1", Username: "Alice", TeamName: "backend", IsActive: true},
+		{ID: "u2", Username: "Bob", TeamName: "backend", IsActive: false},
+	}, nil)
+
+	result, err := f.service.Sync(context.Background(), domain.Team{
+		Name:    "backend",
+		Members: []domain.TeamMember{{ID: "u1", Username: "Alice", IsActive: true}},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !result.Changes.IsEmpty() || len(result.Handovers) != 0 {
+		t.Fatalf("expected no changes, got %+v", result)
+	}
+}
@@ -2,39 +2,192 @@ package services
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"PrService/src/internal/application/contracts"
 
 	"PrService/src/internal/domain"
 )
 
 type UserService struct {
-	userRepository        domain.UserRepository
-	pullRequestRepository domain.PullRequestRepository
+	userRepository            domain.UserRepository
+	pullRequestRepository     domain.PullRequestRepository
+	externalAccountRepository domain.ExternalAccountRepository
+	historyRepository         domain.PullRequestHistoryRepository
+	pullRequestService        domain.PullRequestService
+	txManager                 contracts.TxManager
+	eventPublisher            contracts.EventPublisher
+	now                       func() time.Time
 }
 
 func NewUserService(
 	userRepository domain.UserRepository,
 	pullRequestRepository domain.PullRequestRepository,
+	externalAccountRepository domain.ExternalAccountRepository,
+	historyRepository domain.PullRequestHistoryRepository,
+	pullRequestService domain.PullRequestService,
+	txManager contracts.TxManager,
+	eventPublisher contracts.EventPublisher,
 ) *UserService {
 	return &UserService{
-		userRepository:        userRepository,
-		pullRequestRepository: pullRequestRepository,
+		userRepository:            userRepository,
+		pullRequestRepository:     pullRequestRepository,
+		externalAccountRepository: externalAccountRepository,
+		historyRepository:         historyRepository,
+		pullRequestService:        pullRequestService,
+		txManager:                 txManager,
+		eventPublisher:            eventPublisher,
+		now:                       time.Now,
+	}
+}
+
+// SetIsActive updates the activity flag of the user. When reassignReviews is set, deactivating the user
+// also reassigns their OPEN reviews in the same transaction and the handover is returned; otherwise it is nil.
+func (s *UserService) SetIsActive(
+	ctx context.Context,
+	userID domain.UserID,
+	isActive bool,
+	reassignReviews bool,
+) (*domain.User, *domain.ReviewHandover, error) {
+	var user *domain.User
+	var handover *domain.ReviewHandover
+	err := s.txManager.WithinTransaction(ctx, func(txCtx context.Context) error {
+		var err error
+		user, err = s.userRepository.GetByID(txCtx, userID)
+		if err != nil {
+			return err
+		}
+
+		if err := s.authorizeUserChange(txCtx, *user); err != nil {
+			return err
+		}
+
+		deactivated := user.IsActive && !isActive
+		user.IsActive = isActive
+		if err := s.userRepository.Update(txCtx, user); err != nil {
+			return err
+		}
+
+		if !deactivated {
+			return nil
+		}
+
+		if err := s.eventPublisher.Publish(txCtx, domain.NewUserEvent(domain.EventUserDeactivated, *user)); err != nil {
+			return err
+		}
+
+		if !reassignReviews {
+			return nil
+		}
+
+		handover, err = s.handOverReviews(txCtx, user.ID)
+		return err
+	})
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return user, handover, nil
+}
+
+// authorizeUserChange lets admins change anyone and team leads the members of their own team.
+// Requests without a user actor, like API keys and identity provider syncs, are bound by their scopes only.
+func (s *UserService) authorizeUserChange(ctx context.Context, user domain.User) error {
+	actor, ok := domain.ActorFromContext(ctx)
+	if !ok || !actor.IsUser() || actor.Role == domain.RoleAdmin {
+		return nil
+	}
+	if actor.Role != domain.RoleTeamLead {
+		return domain.ErrForbidden
 	}
+
+	lead, err := s.userRepository.GetByID(ctx, actor.UserID)
+	if err != nil {
+		if errors.Is(err, domain.ErrUserNotFound) {
+			return domain.ErrForbidden
+		}
+		return err
+	}
+	if lead.TeamName != user.TeamName {
+		return domain.ErrForbidden
+	}
+
+	return nil
 }
 
-func (s *UserService) SetIsActive(ctx context.Context, userID domain.UserID, isActive bool) (*domain.User, error) {
-	user, err := s.userRepository.GetByID(ctx, userID)
+// HandOverReviews reassigns the OPEN reviews of the user in one transaction, e.g. once it has left its team.
+func (s *UserService) HandOverReviews(ctx context.Context, userID domain.UserID) (*domain.ReviewHandover, error) {
+	var handover *domain.ReviewHandover
+	err := s.txManager.WithinTransaction(ctx, func(txCtx context.Context) error {
+		var err error
+		handover, err = s.handOverReviews(txCtx, userID)
+		return err
+	})
+
 	if err != nil {
 		return nil, err
 	}
 
-	user.IsActive = isActive
-	err = s.userRepository.Update(ctx, user)
+	return handover, nil
+}
 
+// handOverReviews reassigns every OPEN review of the deactivated user. A review without an eligible
+// replacement stays assigned and is reported as unreassigned; both outcomes are recorded in the history.
+func (s *UserService) handOverReviews(ctx context.Context, userID domain.UserID) (*domain.ReviewHandover, error) {
+	prs, err := s.pullRequestRepository.ListByReviewer(ctx, userID)
 	if err != nil {
 		return nil, err
 	}
 
-	return user, nil
+	handover := &domain.ReviewHandover{
+		Reassigned:   make([]domain.ReviewReassignment, 0),
+		Unreassigned: make([]domain.PullRequestID, 0),
+	}
+
+	var actorName string
+	if actor, ok := domain.ActorFromContext(ctx); ok {
+		actorName = actor.String()
+	}
+
+	now := s.now()
+	for _, pr := range prs {
+		if pr.Status != domain.PullRequestStatusOpen {
+			continue
+		}
+
+		entry := domain.PullRequestHistoryEntry{
+			PullRequestID: pr.ID,
+			Action:        domain.HistoryReviewerDeactivated,
+			ReviewerID:    userID,
+			Actor:         actorName,
+			OccurredAt:    now,
+		}
+
+		_, newReviewer, err := s.pullRequestService.Reassign(ctx, pr.ID, userID, "")
+		switch {
+		case errors.Is(err, domain.ErrNoCandidate):
+			handover.Unreassigned = append(handover.Unreassigned, pr.ID)
+			entry.Details = "no eligible reviewer to reassign to"
+		case err != nil:
+			return nil, fmt.Errorf("reassign review of %s: %w", pr.ID, err)
+		default:
+			handover.Reassigned = append(handover.Reassigned, domain.ReviewReassignment{
+				PullRequestID: pr.ID,
+				NewReviewerID: newReviewer,
+			})
+			entry.TargetIDs = []domain.UserID{newReviewer}
+		}
+
+		if err := s.historyRepository.Append(ctx, &entry); err != nil {
+			return nil, err
+		}
+	}
+
+	return handover, nil
 }
 
 func (s *UserService) GetPrs(ctx context.Context, userID domain.UserID) ([]domain.PullRequest, error) {
@@ -45,3 +198,89 @@ func (s *UserService) GetPrs(ctx context.Context, userID domain.UserID) ([]domai
 
 	return prs, nil
 }
+
+func (s *UserService) List(ctx context.Context, filter domain.UserFilter) ([]domain.User, error) {
+	return s.userRepository.List(ctx, filter)
+}
+
+// LinkExternalAccount maps the provider login to the user, replacing a previous mapping of the login.
+func (s *UserService) LinkExternalAccount(
+	ctx context.Context,
+	account domain.ExternalAccount,
+) (*domain.ExternalAccount, error) {
+	user, err := s.userRepository.GetByID(ctx, account.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.authorizeUserChange(ctx, *user); err != nil {
+		return nil, err
+	}
+
+	account.Login = domain.NormalizeLogin(account.Login)
+	if err := s.externalAccountRepository.Upsert(ctx, account); err != nil {
+		return nil, err
+	}
+
+	return &account, nil
+}
+
+// SetChatHandle stores the chat username review notifications are sent to; an empty handle turns them off.
+// A leading "@" is dropped.
+func (s *UserService) SetChatHandle(
+	ctx context.Context,
+	userID domain.UserID,
+	chatHandle string,
+) (*domain.User, error) {
+	var user *domain.User
+	err := s.txManager.WithinTransaction(ctx, func(txCtx context.Context) error {
+		var err error
+		user, err = s.userRepository.GetByID(txCtx, userID)
+		if err != nil {
+			return err
+		}
+		if err := s.authorizeUserChange(txCtx, *user); err != nil {
+			return err
+		}
+
+		user.ChatHandle = strings.TrimPrefix(strings.TrimSpace(chatHandle), "@")
+
+		return s.userRepository.Update(txCtx, user)
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+// SetEmail stores the address review emails and digests are sent to and whether the user opted out of them.
+func (s *UserService) SetEmail(
+	ctx context.Context,
+	userID domain.UserID,
+	email string,
+	optOut bool,
+) (*domain.User, error) {
+	var user *domain.User
+	err := s.txManager.WithinTransaction(ctx, func(txCtx context.Context) error {
+		var err error
+		user, err = s.userRepository.GetByID(txCtx, userID)
+		if err != nil {
+			return err
+		}
+		if err := s.authorizeUserChange(txCtx, *user); err != nil {
+			return err
+		}
+
+		user.Email = strings.TrimSpace(email)
+		user.EmailOptOut = optOut
+
+		return s.userRepository.Update(txCtx, user)
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
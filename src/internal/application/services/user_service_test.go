@@ -3,6 +3,7 @@ package services
 import (
 	"context"
 	"errors"
+	"slices"
 	"testing"
 
 	"PrService/src/internal/application/mocks"
@@ -11,6 +12,20 @@ import (
 	"go.uber.org/mock/gomock"
 )
 
+// passthroughTxManager runs every transaction function with the caller's context.
+func passthroughTxManager(ctrl *gomock.Controller) *mocks.MockTxManager {
+	txMgr := mocks.NewMockTxManager(ctrl)
+	txMgr.
+		EXPECT().
+		WithinTransaction(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(c context.Context, fn func(context.Context) error) error {
+			return fn(c)
+		}).
+		AnyTimes()
+
+	return txMgr
+}
+
 func TestUserService_SetIsActive_Success(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -18,7 +33,9 @@ func TestUserService_SetIsActive_Success(t *testing.T) {
 	userRepo := mocks.NewMockUserRepository(ctrl)
 	prRepo := mocks.NewMockPullRequestRepository(ctrl)
 
-	service := NewUserService(userRepo, prRepo)
+	service := NewUserService(
+		userRepo, prRepo, nil, nil, nil, passthroughTxManager(ctrl), anyEventPublisher(ctrl),
+	)
 
 	ctx := context.Background()
 	var userID domain.UserID
@@ -40,7 +57,7 @@ func TestUserService_SetIsActive_Success(t *testing.T) {
 			return nil
 		})
 
-	updatedUser, err := service.SetIsActive(ctx, userID, true)
+	updatedUser, _, err := service.SetIsActive(ctx, userID, true, false)
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -58,6 +75,215 @@ func TestUserService_SetIsActive_Success(t *testing.T) {
 	}
 }
 
+func TestUserService_SetIsActive_DeactivationPublishesEvent(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userRepo := mocks.NewMockUserRepository(ctrl)
+	publisher := mocks.NewMockEventPublisher(ctrl)
+
+	service := NewUserService(userRepo, nil, nil, nil, nil, passthroughTxManager(ctrl), publisher)
+
+	ctx := context.Background()
+	user := &domain.User{ID: "u1", Username: "alice", TeamName: "backend", IsActive: true}
+
+	userRepo.EXPECT().GetByID(ctx, user.ID).Return(user, nil)
+	userRepo.EXPECT().Update(ctx, user).Return(nil)
+	publisher.
+		EXPECT().
+		Publish(ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, events ...domain.Event) error {
+			if len(events) != 1 || events[0].Type != domain.EventUserDeactivated {
+				t.Fatalf("expected one USER_DEACTIVATED event, got %+v", events)
+			}
+			if events[0].User == nil || events[0].User.ID != user.ID || events[0].User.IsActive {
+				t.Fatalf("unexpected event user: %+v", events[0].User)
+			}
+			return nil
+		})
+
+	if _, _, err := service.SetIsActive(ctx, user.ID, false, false); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestUserService_SetIsActive_AuthorizesActor(t *testing.T) {
+	tests := []struct {
+		name    string
+		actor   domain.Actor
+		lead    *domain.User
+		wantErr error
+	}{
+		{
+			name:  "lead of the team",
+			actor: domain.NewUserActor("lead", domain.RoleTeamLead),
+			lead:  &domain.User{ID: "lead", TeamName: "backend", IsActive: true},
+		},
+		{
+			name:    "lead of another team",
+			actor:   domain.NewUserActor("lead", domain.RoleTeamLead),
+			lead:    &domain.User{ID: "lead", TeamName: "frontend", IsActive: true},
+			wantErr: domain.ErrForbidden,
+		},
+		{
+			name:    "member",
+			actor:   domain.NewUserActor("u2", domain.RoleMember),
+			wantErr: domain.ErrForbidden,
+		},
+		{
+			name:  "admin",
+			actor: domain.NewUserActor("root", domain.RoleAdmin),
+		},
+		{
+			name:  "API key",
+			actor: domain.NewAPIKeyActor(domain.APIKey{ID: 1, Scopes: []domain.APIKeyScope{domain.ScopeUsersWrite}}),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			userRepo := mocks.NewMockUserRepository(ctrl)
+			service := NewUserService(userRepo, nil, nil, nil, nil, passthroughTxManager(ctrl), anyEventPublisher(ctrl))
+
+			ctx := domain.ContextWithActor(context.Background(), tt.actor)
+			user := &domain.User{ID: "u1", TeamName: "backend", IsActive: true}
+
+			userRepo.EXPECT().GetByID(ctx, user.ID).Return(user, nil)
+			if tt.lead != nil {
+				userRepo.EXPECT().GetByID(ctx, tt.lead.ID).Return(tt.lead, nil)
+			}
+			if tt.wantErr == nil {
+				userRepo.EXPECT().Update(ctx, user).Return(nil)
+			}
+
+			_, _, err := service.SetIsActive(ctx, user.ID, false, false)
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("expected %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestUserService_SetIsActive_AlreadyInactiveDoesNotPublish(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userRepo := mocks.NewMockUserRepository(ctrl)
+	publisher := mocks.NewMockEventPublisher(ctrl)
+
+	service := NewUserService(userRepo, nil, nil, nil, nil, passthroughTxManager(ctrl), publisher)
+
+	ctx := context.Background()
+	user := &domain.User{ID: "u1", IsActive: false}
+
+	userRepo.EXPECT().GetByID(ctx, user.ID).Return(user, nil)
+	userRepo.EXPECT().Update(ctx, user).Return(nil)
+
+	if _, _, err := service.SetIsActive(ctx, user.ID, false, false); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestUserService_SetIsActive_DeactivationReassignsOpenReviews(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userRepo := mocks.NewMockUserRepository(ctrl)
+	prRepo := mocks.NewMockPullRequestRepository(ctrl)
+	teamRepo := mocks.NewMockTeamRepository(ctrl)
+	historyRepo := mocks.NewMockPullRequestHistoryRepository(ctrl)
+	txMgr := passthroughTxManager(ctrl)
+	publisher := anyEventPublisher(ctrl)
+
+	service := NewUserService(
+		userRepo, prRepo, nil, historyRepo, NewPullRequestService(prRepo, teamRepo, txMgr, publisher), txMgr, publisher,
+	)
+
+	ctx := domain.ContextWithActor(context.Background(), domain.NewUserActor("root", domain.RoleAdmin))
+	user := &domain.User{ID: "u2", TeamName: "backend", IsActive: true}
+	reassignable := &domain.PullRequest{
+		ID: "pr-1", AuthorID: "u1", Status: domain.PullRequestStatusOpen,
+		AssignedReviewers: []domain.UserID{"u2"}, ReviewerTeams: map[domain.UserID]domain.TeamName{"u2": "backend"},
+	}
+	// the author and the other reviewer are the only remaining members
+	stuck := &domain.PullRequest{
+		ID: "pr-2", AuthorID: "u3", Status: domain.PullRequestStatusOpen,
+		AssignedReviewers: []domain.UserID{"u2", "u1"},
+		ReviewerTeams:     map[domain.UserID]domain.TeamName{"u1": "backend", "u2": "backend"},
+	}
+	merged := domain.PullRequest{ID: "pr-3", AuthorID: "u1", Status: domain.PullRequestStatusMerged}
+
+	userRepo.EXPECT().GetByID(ctx, user.ID).Return(user, nil)
+	userRepo.EXPECT().Update(ctx, user).Return(nil)
+	prRepo.EXPECT().ListByReviewer(ctx, user.ID).Return([]domain.PullRequest{*reassignable, *stuck, merged}, nil)
+	prRepo.EXPECT().GetByID(ctx, reassignable.ID).Return(reassignable, nil)
+	prRepo.EXPECT().GetByID(ctx, stuck.ID).Return(stuck, nil)
+	teamRepo.EXPECT().GetByName(ctx, domain.TeamName("backend")).Return(&domain.Team{
+		Name: "backend",
+		Members: []domain.TeamMember{
+			{ID: "u1", IsActive: true},
+			{ID: "u2", IsActive: false},
+			{ID: "u3", IsActive: true},
+		},
+	}, nil).Times(2)
+	prRepo.EXPECT().Update(ctx, reassignable).Return(nil)
+
+	var entries []domain.PullRequestHistoryEntry
+	historyRepo.EXPECT().
+		Append(ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, entry *domain.PullRequestHistoryEntry) error {
+			entries = append(entries, *entry)
+			return nil
+		}).
+		Times(2)
+
+	_, handover, err := service.SetIsActive(ctx, user.ID, false, true)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []domain.ReviewReassignment{{PullRequestID: "pr-1", NewReviewerID: "u3"}}
+	if handover == nil || !slices.Equal(handover.Reassigned, want) {
+		t.Fatalf("unexpected reassigned reviews: %+v", handover)
+	}
+	if !slices.Equal(handover.Unreassigned, []domain.PullRequestID{"pr-2"}) {
+		t.Fatalf("unexpected unreassigned reviews: %v", handover.Unreassigned)
+	}
+
+	if len(entries) != 2 || entries[0].Action != domain.HistoryReviewerDeactivated ||
+		!slices.Equal(entries[0].TargetIDs, []domain.UserID{"u3"}) ||
+		entries[1].PullRequestID != "pr-2" || len(entries[1].TargetIDs) != 0 || entries[1].Details == "" ||
+		entries[0].Actor != "user:root" {
+		t.Fatalf("unexpected history entries: %+v", entries)
+	}
+}
+
+func TestUserService_SetIsActive_WithoutReassignKeepsReviews(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userRepo := mocks.NewMockUserRepository(ctrl)
+
+	service := NewUserService(userRepo, nil, nil, nil, nil, passthroughTxManager(ctrl), anyEventPublisher(ctrl))
+
+	ctx := context.Background()
+	user := &domain.User{ID: "u2", IsActive: true}
+
+	userRepo.EXPECT().GetByID(ctx, user.ID).Return(user, nil)
+	userRepo.EXPECT().Update(ctx, user).Return(nil)
+
+	_, handover, err := service.SetIsActive(ctx, user.ID, false, false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if handover != nil {
+		t.Fatalf("expected no handover, got %+v", handover)
+	}
+}
+
 func TestUserService_SetIsActive_GetByIDError(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -65,7 +291,9 @@ func TestUserService_SetIsActive_GetByIDError(t *testing.T) {
 	userRepo := mocks.NewMockUserRepository(ctrl)
 	prRepo := mocks.NewMockPullRequestRepository(ctrl)
 
-	service := NewUserService(userRepo, prRepo)
+	service := NewUserService(
+		userRepo, prRepo, nil, nil, nil, passthroughTxManager(ctrl), anyEventPublisher(ctrl),
+	)
 
 	ctx := context.Background()
 	var userID domain.UserID
@@ -77,7 +305,7 @@ func TestUserService_SetIsActive_GetByIDError(t *testing.T) {
 		GetByID(ctx, userID).
 		Return(nil, expectedErr)
 
-	user, err := service.SetIsActive(ctx, userID, true)
+	user, _, err := service.SetIsActive(ctx, userID, true, false)
 	if err == nil {
 		t.Fatal("expected error, got nil")
 	}
@@ -98,7 +326,9 @@ func TestUserService_SetIsActive_UpdateError(t *testing.T) {
 	userRepo := mocks.NewMockUserRepository(ctrl)
 	prRepo := mocks.NewMockPullRequestRepository(ctrl)
 
-	service := NewUserService(userRepo, prRepo)
+	service := NewUserService(
+		userRepo, prRepo, nil, nil, nil, passthroughTxManager(ctrl), anyEventPublisher(ctrl),
+	)
 
 	ctx := context.Background()
 	var userID domain.UserID
@@ -116,7 +346,7 @@ func TestUserService_SetIsActive_UpdateError(t *testing.T) {
 		Update(ctx, user).
 		Return(expectedErr)
 
-	updatedUser, err := service.SetIsActive(ctx, userID, true)
+	updatedUser, _, err := service.SetIsActive(ctx, userID, true, false)
 	if err == nil {
 		t.Fatal("expected error, got nil")
 	}
@@ -137,7 +367,7 @@ func TestUserService_GetPrs_Success(t *testing.T) {
 	userRepo := mocks.NewMockUserRepository(ctrl)
 	prRepo := mocks.NewMockPullRequestRepository(ctrl)
 
-	service := NewUserService(userRepo, prRepo)
+	service := NewUserService(userRepo, prRepo, nil, nil, nil, nil, nil)
 
 	ctx := context.Background()
 	var userID domain.UserID
@@ -173,7 +403,7 @@ func TestUserService_GetPrs_Error(t *testing.T) {
 	userRepo := mocks.NewMockUserRepository(ctrl)
 	prRepo := mocks.NewMockPullRequestRepository(ctrl)
 
-	service := NewUserService(userRepo, prRepo)
+	service := NewUserService(userRepo, prRepo, nil, nil, nil, nil, nil)
 
 	ctx := context.Background()
 	var userID domain.UserID
@@ -198,3 +428,204 @@ func TestUserService_GetPrs_Error(t *testing.T) {
 		t.Fatalf("expected nil slice on error, got %#v", prs)
 	}
 }
+
+func TestUserService_List(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userRepo := mocks.NewMockUserRepository(ctrl)
+	service := NewUserService(userRepo, nil, nil, nil, nil, nil, nil)
+
+	ctx := context.Background()
+	filter := domain.UserFilter{TeamName: "backend", Tags: []string{"db"}}
+	users := []domain.User{{ID: "u1", TeamName: "backend", Tags: []string{"db", "go"}}}
+
+	userRepo.
+		EXPECT().
+		List(ctx, filter).
+		Return(users, nil)
+
+	got, err := service.List(ctx, filter)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(got) != 1 || got[0].ID != "u1" {
+		t.Fatalf("expected listed users, got %+v", got)
+	}
+}
+
+func TestUserService_LinkExternalAccount(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userRepo := mocks.NewMockUserRepository(ctrl)
+	accountRepo := mocks.NewMockExternalAccountRepository(ctrl)
+
+	service := NewUserService(userRepo, nil, accountRepo, nil, nil, nil, nil)
+
+	ctx := context.Background()
+
+	userRepo.
+		EXPECT().
+		GetByID(gomock.Any(), domain.UserID("u1")).
+		Return(&domain.User{ID: "u1"}, nil)
+
+	want := domain.ExternalAccount{Provider: domain.ExternalProviderGitHub, Login: "octocat", UserID: "u1"}
+	accountRepo.
+		EXPECT().
+		Upsert(gomock.Any(), want).
+		Return(nil)
+
+	got, err := service.LinkExternalAccount(ctx, domain.ExternalAccount{
+		Provider: domain.ExternalProviderGitHub,
+		Login:    " OctoCat ",
+		UserID:   "u1",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if *got != want {
+		t.Fatalf("expected %+v, got %+v", want, *got)
+	}
+}
+
+func TestUserService_LinkExternalAccount_UserNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userRepo := mocks.NewMockUserRepository(ctrl)
+	service := NewUserService(userRepo, nil, mocks.NewMockExternalAccountRepository(ctrl), nil, nil, nil, nil)
+
+	userRepo.
+		EXPECT().
+		GetByID(gomock.Any(), domain.UserID("ghost")).
+		Return(nil, domain.ErrUserNotFound)
+
+	_, err := service.LinkExternalAccount(context.Background(), domain.ExternalAccount{
+		Provider: domain.ExternalProviderGitHub,
+		Login:    "ghost",
+		UserID:   "ghost",
+	})
+	if !errors.Is(err, domain.ErrUserNotFound) {
+		t.Fatalf("expected ErrUserNotFound, got %v", err)
+	}
+}
+
+func TestUserService_SetChatHandle_TrimsAt(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userRepo := mocks.NewMockUserRepository(ctrl)
+
+	service := NewUserService(userRepo, nil, nil, nil, nil, passthroughTxManager(ctrl), nil)
+
+	ctx := context.Background()
+	user := &domain.User{ID: "u1", Username: "alice", IsActive: true}
+
+	userRepo.EXPECT().GetByID(ctx, user.ID).Return(user, nil)
+	userRepo.
+		EXPECT().
+		Update(ctx, user).
+		DoAndReturn(func(_ context.Context, u *domain.User) error {
+			if u.ChatHandle != "alice.smith" {
+				t.Fatalf("expected handle without @, got %q", u.ChatHandle)
+			}
+			return nil
+		})
+
+	got, err := service.SetChatHandle(ctx, user.ID, " @alice.smith ")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got.ChatHandle != "alice.smith" {
+		t.Fatalf("unexpected user: %+v", got)
+	}
+}
+
+func TestUserService_SetChatHandle_UserNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userRepo := mocks.NewMockUserRepository(ctrl)
+
+	service := NewUserService(userRepo, nil, nil, nil, nil, passthroughTxManager(ctrl), nil)
+
+	ctx := context.Background()
+
+	userRepo.EXPECT().GetByID(ctx, domain.UserID("ghost")).Return(nil, domain.ErrUserNotFound)
+
+	if _, err := service.SetChatHandle(ctx, "ghost", "ghost"); !errors.Is(err, domain.ErrUserNotFound) {
+		t.Fatalf("expected ErrUserNotFound, got %v", err)
+	}
+}
+
+func TestUserService_SetEmail(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userRepo := mocks.NewMockUserRepository(ctrl)
+
+	service := NewUserService(userRepo, nil, nil, nil, nil, passthroughTxManager(ctrl), nil)
+
+	ctx := context.Background()
+	user := &domain.User{ID: "u1", ChatHandle: "alice"}
+
+	userRepo.EXPECT().GetByID(ctx, user.ID).Return(user, nil)
+	userRepo.
+		EXPECT().
+		Update(ctx, user).
+		DoAndReturn(func(_ context.Context, u *domain.User) error {
+			if u.Email != "alice@example.com" || !u.EmailOptOut || u.ChatHandle != "alice" {
+				t.Fatalf("unexpected user to update: %+v", u)
+			}
+			return nil
+		})
+
+	if _, err := service.SetEmail(ctx, user.ID, " alice@example.com ", true); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestUserService_SetEmail_LeadOfAnotherTeam(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userRepo := mocks.NewMockUserRepository(ctrl)
+
+	service := NewUserService(userRepo, nil, nil, nil, nil, passthroughTxManager(ctrl), nil)
+
+	ctx := domain.ContextWithActor(context.Background(), domain.NewUserActor("lead", domain.RoleTeamLead))
+	user := &domain.User{ID: "u1", TeamName: "backend"}
+
+	userRepo.EXPECT().GetByID(ctx, user.ID).Return(user, nil)
+	userRepo.EXPECT().GetByID(ctx, domain.UserID("lead")).Return(&domain.User{ID: "lead", TeamName: "frontend"}, nil)
+
+	if _, err := service.SetEmail(ctx, user.ID, "alice@example.com", false); !errors.Is(err, domain.ErrForbidden) {
+		t.Fatalf("expected ErrForbidden, got %v", err)
+	}
+}
+
+func TestUserService_LinkExternalAccount_Member(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userRepo := mocks.NewMockUserRepository(ctrl)
+	service := NewUserService(userRepo, nil, mocks.NewMockExternalAccountRepository(ctrl), nil, nil, nil, nil)
+
+	ctx := domain.ContextWithActor(context.Background(), domain.NewUserActor("u2", domain.RoleMember))
+
+	userRepo.
+		EXPECT().
+		GetByID(gomock.Any(), domain.UserID("u1")).
+		Return(&domain.User{ID: "u1", TeamName: "backend"}, nil)
+
+	_, err := service.LinkExternalAccount(ctx, domain.ExternalAccount{
+		Provider: domain.ExternalProviderGitHub,
+		Login:    "octocat",
+		UserID:   "u1",
+	})
+	if !errors.Is(err, domain.ErrForbidden) {
+		t.Fatalf("expected ErrForbidden, got %v", err)
+	}
+}
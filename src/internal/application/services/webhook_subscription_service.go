@@ -0,0 +1,188 @@
+package services
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"time"
+
+	"PrService/src/internal/application/contracts"
+
+	"PrService/src/internal/domain"
+)
+
+const (
+	WebhookSignatureHeader = "X-PRService-Signature-256"
+	WebhookEventHeader     = "X-PRService-Event"
+	WebhookDeliveryHeader  = "X-PRService-Delivery"
+	WebhookEventIDHeader   = "X-PRService-Event-Id"
+)
+
+// WebhookDeliveryPolicy controls how outbound webhook deliveries are retried.
+type WebhookDeliveryPolicy struct {
+	// MaxAttempts is the number of attempts after which a delivery is marked FAILED.
+	MaxAttempts int
+	// BaseBackoff is the delay after the first failed attempt; it doubles with every next one up to MaxBackoff.
+	BaseBackoff time.Duration
+	MaxBackoff  time.Duration
+	// Lease postpones claimed deliveries so that another worker does not pick them up while they are sent.
+	Lease     time.Duration
+	BatchSize int
+}
+
+func DefaultWebhookDeliveryPolicy() WebhookDeliveryPolicy {
+	return WebhookDeliveryPolicy{
+		MaxAttempts: 8,
+		BaseBackoff: 10 * time.Second,
+		MaxBackoff:  time.Hour,
+		Lease:       time.Minute,
+		BatchSize:   50,
+	}
+}
+
+type WebhookSubscriptionService struct {
+	subscriptionRepository domain.WebhookSubscriptionRepository
+	sender                 contracts.WebhookSender
+	policy                 WebhookDeliveryPolicy
+	now                    func() time.Time
+}
+
+func NewWebhookSubscriptionService(
+	subscriptionRepository domain.WebhookSubscriptionRepository,
+	sender contracts.WebhookSender,
+	policy WebhookDeliveryPolicy,
+) *WebhookSubscriptionService {
+	return &WebhookSubscriptionService{
+		subscriptionRepository: subscriptionRepository,
+		sender:                 sender,
+		policy:                 policy,
+		now:                    time.Now,
+	}
+}
+
+func (s *WebhookSubscriptionService) Create(
+	ctx context.Context,
+	subscription *domain.WebhookSubscription,
+) (*domain.WebhookSubscription, error) {
+	if len(subscription.EventTypes) == 0 {
+		return nil, fmt.Errorf("%w: no event types", domain.ErrInvalidWebhookSubscription)
+	}
+	for _, eventType := range subscription.EventTypes {
+		if !eventType.IsValid() {
+			return nil, fmt.Errorf("%w: unknown event type %s", domain.ErrInvalidWebhookSubscription, eventType)
+		}
+	}
+
+	if err := s.subscriptionRepository.Create(ctx, subscription); err != nil {
+		return nil, err
+	}
+
+	return subscription, nil
+}
+
+func (s *WebhookSubscriptionService) List(ctx context.Context) ([]domain.WebhookSubscription, error) {
+	return s.subscriptionRepository.List(ctx)
+}
+
+func (s *WebhookSubscriptionService) Delete(ctx context.Context, id domain.WebhookSubscriptionID) error {
+	return s.subscriptionRepository.Delete(ctx, id)
+}
+
+func (s *WebhookSubscriptionService) ListDeliveries(
+	ctx context.Context,
+	id domain.WebhookSubscriptionID,
+	limit int,
+) ([]domain.WebhookSubscriptionDelivery, error) {
+	if _, err := s.subscriptionRepository.GetByID(ctx, id); err != nil {
+		return nil, err
+	}
+
+	return s.subscriptionRepository.ListDeliveries(ctx, id, limit)
+}
+
+func (s *WebhookSubscriptionService) Name() string {
+	return "webhooks"
+}
+
+// Publish queues a delivery of the outbox message to each subscription of its type; DeliverDue sends them.
+// A message relayed again does not create a second delivery for the same subscription.
+func (s *WebhookSubscriptionService) Publish(ctx context.Context, message domain.OutboxMessage) error {
+	subscriptions, err := s.subscriptionRepository.ListByEventType(ctx, message.EventType)
+	if err != nil {
+		return err
+	}
+	if len(subscriptions) == 0 {
+		return nil
+	}
+
+	deliveries := make([]domain.WebhookSubscriptionDelivery, 0, len(subscriptions))
+	for _, subscription := range subscriptions {
+		deliveries = append(deliveries, domain.WebhookSubscriptionDelivery{
+			SubscriptionID: subscription.ID,
+			EventID:        message.ID,
+			EventType:      message.EventType,
+			Payload:        message.Payload,
+			Status:         domain.WebhookDeliveryPending,
+			NextAttemptAt:  s.now(),
+		})
+	}
+
+	return s.subscriptionRepository.EnqueueDeliveries(ctx, deliveries)
+}
+
+// DeliverDue sends a batch of due deliveries and returns how many were attempted.
+// Failed attempts are rescheduled with exponential backoff until the policy gives up.
+func (s *WebhookSubscriptionService) DeliverDue(ctx context.Context) (int, error) {
+	deliveries, err := s.subscriptionRepository.ClaimDueDeliveries(ctx, s.now(), s.policy.Lease, s.policy.BatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	for i := range deliveries {
+		s.deliver(ctx, &deliveries[i])
+		if err := s.subscriptionRepository.UpdateDelivery(ctx, deliveries[i]); err != nil {
+			return i, err
+		}
+	}
+
+	return len(deliveries), nil
+}
+
+func (s *WebhookSubscriptionService) deliver(ctx context.Context, delivery *domain.WebhookSubscriptionDelivery) {
+	headers := map[string]string{
+		WebhookSignatureHeader: signWebhookPayload(delivery.Secret, delivery.Payload),
+		WebhookEventHeader:     string(delivery.EventType),
+		WebhookDeliveryHeader:  strconv.FormatInt(delivery.ID, 10),
+		WebhookEventIDHeader:   strconv.FormatInt(delivery.EventID, 10),
+	}
+
+	statusCode, err := s.sender.Send(ctx, delivery.URL, delivery.Payload, headers)
+	now := s.now()
+
+	delivery.Attempts++
+	delivery.LastStatusCode = statusCode
+	if err == nil {
+		delivery.Status = domain.WebhookDeliverySucceeded
+		delivery.LastError = ""
+		delivery.DeliveredAt = &now
+		return
+	}
+
+	delivery.LastError = err.Error()
+	if delivery.Attempts >= s.policy.MaxAttempts {
+		delivery.Status = domain.WebhookDeliveryFailed
+		return
+	}
+	delivery.NextAttemptAt = now.Add(exponentialBackoff(s.policy.BaseBackoff, s.policy.MaxBackoff, delivery.Attempts))
+}
+
+// signWebhookPayload returns the "sha256=<hex>" HMAC of the payload, the same scheme GitHub uses.
+func signWebhookPayload(secret string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
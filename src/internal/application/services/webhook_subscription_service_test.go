@@ -0,0 +1,253 @@
+package services
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"testing"
+	"time"
+
+	"PrService/src/internal/application/mocks"
+	"PrService/src/internal/domain"
+
+	"go.uber.org/mock/gomock"
+)
+
+var webhookTestNow = time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+
+func newWebhookSubscriptionService(
+	t *testing.T,
+) (*WebhookSubscriptionService, *mocks.MockWebhookSubscriptionRepository, *mocks.MockWebhookSender) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	repo := mocks.NewMockWebhookSubscriptionRepository(ctrl)
+	sender := mocks.NewMockWebhookSender(ctrl)
+
+	policy := WebhookDeliveryPolicy{
+		MaxAttempts: 3,
+		BaseBackoff: 10 * time.Second,
+		MaxBackoff:  15 * time.Second,
+		Lease:       time.Minute,
+		BatchSize:   10,
+	}
+	service := NewWebhookSubscriptionService(repo, sender, policy)
+	service.now = func() time.Time { return webhookTestNow }
+
+	return service, repo, sender
+}
+
+func TestWebhookSubscriptionService_Create_RejectsUnknownEventType(t *testing.T) {
+	service, _, _ := newWebhookSubscriptionService(t)
+
+	_, err := service.Create(context.Background(), &domain.WebhookSubscription{
+		URL:        "https://hooks.example.com",
+		Secret:     "secret",
+		EventTypes: []domain.EventType{domain.EventPullRequestMerged, "PR_OPENED"},
+	})
+
+	if !errors.Is(err, domain.ErrInvalidWebhookSubscription) {
+		t.Fatalf("expected ErrInvalidWebhookSubscription, got %v", err)
+	}
+}
+
+func TestWebhookSubscriptionService_Publish_EnqueuesForEachSubscription(t *testing.T) {
+	service, repo, _ := newWebhookSubscriptionService(t)
+
+	ctx := context.Background()
+	message := domain.OutboxMessage{
+		ID:            17,
+		AggregateType: domain.AggregatePullRequest,
+		AggregateID:   "pr-1",
+		EventType:     domain.EventPullRequestMerged,
+		Payload:       []byte(`{"type":"PR_MERGED"}`),
+	}
+
+	repo.
+		EXPECT().
+		ListByEventType(ctx, domain.EventPullRequestMerged).
+		Return([]domain.WebhookSubscription{{ID: 1}, {ID: 2}}, nil)
+
+	repo.
+		EXPECT().
+		EnqueueDeliveries(ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, deliveries []domain.WebhookSubscriptionDelivery) error {
+			if len(deliveries) != 2 || deliveries[0].SubscriptionID != 1 || deliveries[1].SubscriptionID != 2 {
+				t.Fatalf("unexpected deliveries: %+v", deliveries)
+			}
+			for _, d := range deliveries {
+				if d.EventID != 17 || string(d.Payload) != `{"type":"PR_MERGED"}` {
+					t.Fatalf("delivery must carry the outbox message: %+v", d)
+				}
+				if d.Status != domain.WebhookDeliveryPending || !d.NextAttemptAt.Equal(webhookTestNow) {
+					t.Fatalf("delivery must be pending and due immediately: %+v", d)
+				}
+			}
+			return nil
+		})
+
+	if err := service.Publish(ctx, message); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestWebhookSubscriptionService_Publish_NoSubscriptions(t *testing.T) {
+	service, repo, _ := newWebhookSubscriptionService(t)
+
+	ctx := context.Background()
+
+	repo.
+		EXPECT().
+		ListByEventType(ctx, domain.EventUserDeactivated).
+		Return([]domain.WebhookSubscription{}, nil)
+
+	message := domain.OutboxMessage{ID: 1, EventType: domain.EventUserDeactivated, Payload: []byte(`{}`)}
+	if err := service.Publish(ctx, message); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestWebhookSubscriptionService_DeliverDue_SignsAndMarksSucceeded(t *testing.T) {
+	service, repo, sender := newWebhookSubscriptionService(t)
+
+	ctx := context.Background()
+	payload := []byte(`{"type":"PR_CREATED"}`)
+
+	repo.
+		EXPECT().
+		ClaimDueDeliveries(ctx, webhookTestNow, time.Minute, 10).
+		Return([]domain.WebhookSubscriptionDelivery{{
+			ID:        42,
+			EventID:   7,
+			EventType: domain.EventPullRequestCreated,
+			Payload:   payload,
+			Status:    domain.WebhookDeliveryPending,
+			URL:       "https://hooks.example.com",
+			Secret:    "topsecret",
+		}}, nil)
+
+	sender.
+		EXPECT().
+		Send(ctx, "https://hooks.example.com", payload, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ string, body []byte, headers map[string]string) (int, error) {
+			mac := hmac.New(sha256.New, []byte("topsecret"))
+			mac.Write(body)
+			if want := "sha256=" + hex.EncodeToString(mac.Sum(nil)); headers[WebhookSignatureHeader] != want {
+				t.Fatalf("expected signature %s, got %s", want, headers[WebhookSignatureHeader])
+			}
+			if headers[WebhookEventHeader] != "PR_CREATED" || headers[WebhookDeliveryHeader] != "42" ||
+				headers[WebhookEventIDHeader] != "7" {
+				t.Fatalf("unexpected headers: %v", headers)
+			}
+			return 200, nil
+		})
+
+	repo.
+		EXPECT().
+		UpdateDelivery(ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, d domain.WebhookSubscriptionDelivery) error {
+			if d.Status != domain.WebhookDeliverySucceeded || d.Attempts != 1 || d.LastStatusCode != 200 {
+				t.Fatalf("unexpected delivery after success: %+v", d)
+			}
+			if d.DeliveredAt == nil || !d.DeliveredAt.Equal(webhookTestNow) {
+				t.Fatalf("expected DeliveredAt %v, got %v", webhookTestNow, d.DeliveredAt)
+			}
+			return nil
+		})
+
+	sent, err := service.DeliverDue(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if sent != 1 {
+		t.Fatalf("expected 1 delivery attempted, got %d", sent)
+	}
+}
+
+func TestWebhookSubscriptionService_DeliverDue_RetriesWithBackoff(t *testing.T) {
+	tests := []struct {
+		name          string
+		attempts      int
+		wantStatus    domain.WebhookDeliveryStatus
+		wantNextDelay time.Duration
+	}{
+		{
+			name:          "first failure waits base backoff",
+			attempts:      0,
+			wantStatus:    domain.WebhookDeliveryPending,
+			wantNextDelay: 10 * time.Second,
+		},
+		{
+			name:          "backoff doubles up to max",
+			attempts:      1,
+			wantStatus:    domain.WebhookDeliveryPending,
+			wantNextDelay: 15 * time.Second,
+		},
+		{
+			name:       "last attempt marks failed",
+			attempts:   2,
+			wantStatus: domain.WebhookDeliveryFailed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, repo, sender := newWebhookSubscriptionService(t)
+
+			ctx := context.Background()
+
+			repo.
+				EXPECT().
+				ClaimDueDeliveries(ctx, webhookTestNow, time.Minute, 10).
+				Return([]domain.WebhookSubscriptionDelivery{{
+					ID:            1,
+					Status:        domain.WebhookDeliveryPending,
+					Attempts:      tt.attempts,
+					NextAttemptAt: webhookTestNow,
+				}}, nil)
+
+			sender.
+				EXPECT().
+				Send(ctx, gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(503, errors.New("unexpected status 503"))
+
+			repo.
+				EXPECT().
+				UpdateDelivery(ctx, gomock.Any()).
+				DoAndReturn(func(_ context.Context, d domain.WebhookSubscriptionDelivery) error {
+					if d.Status != tt.wantStatus || d.Attempts != tt.attempts+1 {
+						t.Fatalf("unexpected delivery after failure: %+v", d)
+					}
+					if d.LastStatusCode != 503 || d.LastError == "" || d.DeliveredAt != nil {
+						t.Fatalf("failure must be recorded: %+v", d)
+					}
+					if tt.wantNextDelay != 0 && !d.NextAttemptAt.Equal(webhookTestNow.Add(tt.wantNextDelay)) {
+						t.Fatalf("expected next attempt after %v, got %v", tt.wantNextDelay, d.NextAttemptAt)
+					}
+					return nil
+				})
+
+			if _, err := service.DeliverDue(ctx); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestWebhookSubscriptionService_ListDeliveries_UnknownSubscription(t *testing.T) {
+	service, repo, _ := newWebhookSubscriptionService(t)
+
+	ctx := context.Background()
+
+	repo.
+		EXPECT().
+		GetByID(ctx, domain.WebhookSubscriptionID(7)).
+		Return(nil, domain.ErrWebhookSubscriptionNotFound)
+
+	_, err := service.ListDeliveries(ctx, 7, 10)
+	if !errors.Is(err, domain.ErrWebhookSubscriptionNotFound) {
+		t.Fatalf("expected ErrWebhookSubscriptionNotFound, got %v", err)
+	}
+}
@@ -0,0 +1,104 @@
+package domain
+
+import (
+	"context"
+	"slices"
+	"strconv"
+)
+
+// Role is what a user signed in with a token may do.
+type Role string
+
+const (
+	RoleAdmin Role = "admin"
+	// RoleTeamLead manages the members of its own team on top of what members may do.
+	RoleTeamLead Role = "team-lead"
+	RoleMember   Role = "member"
+)
+
+func (r Role) IsValid() bool {
+	switch r {
+	case RoleAdmin, RoleTeamLead, RoleMember:
+		return true
+	default:
+		return false
+	}
+}
+
+// Scopes returns the scopes the role grants; finer rules, like merging only own pull requests,
+// are checked by the services.
+func (r Role) Scopes() []APIKeyScope {
+	switch r {
+	case RoleAdmin:
+		return []APIKeyScope{ScopeAdmin}
+	case RoleTeamLead:
+		return []APIKeyScope{
+			ScopePullRequestRead, ScopePullRequestWrite, ScopeTeamRead, ScopeUsersWrite, ScopeStatsRead,
+		}
+	case RoleMember:
+		return []APIKeyScope{ScopePullRequestRead, ScopePullRequestWrite, ScopeTeamRead, ScopeStatsRead}
+	default:
+		return nil
+	}
+}
+
+// Actor is who a request is made by: a user signed in with a token or a client with an API key.
+// API keys act on behalf of no user and are bound by their scopes only.
+type Actor struct {
+	UserID   UserID
+	Role     Role
+	APIKeyID APIKeyID
+	Scopes   []APIKeyScope
+}
+
+func NewUserActor(userID UserID, role Role) Actor {
+	return Actor{UserID: userID, Role: role, Scopes: role.Scopes()}
+}
+
+func NewAPIKeyActor(key APIKey) Actor {
+	return Actor{APIKeyID: key.ID, Scopes: key.Scopes}
+}
+
+func (a Actor) IsUser() bool {
+	return a.UserID != ""
+}
+
+func (a Actor) HasScope(scope APIKeyScope) bool {
+	return hasScope(a.Scopes, scope)
+}
+
+// CanActFor lets admins, API keys and the user themselves act on behalf of the user.
+func (a Actor) CanActFor(userID UserID) bool {
+	return !a.IsUser() || a.Role == RoleAdmin || a.UserID == userID
+}
+
+// CanMerge lets admins, API keys and the author merge a pull request.
+func (a Actor) CanMerge(pr PullRequest) bool {
+	return a.CanActFor(pr.AuthorID)
+}
+
+// String identifies the actor in logs.
+func (a Actor) String() string {
+	if a.IsUser() {
+		return "user:" + string(a.UserID)
+	}
+	return "api_key:" + strconv.FormatInt(int64(a.APIKeyID), 10)
+}
+
+type actorContextKey struct{}
+
+// ContextWithActor returns a copy of ctx carrying the authenticated actor.
+func ContextWithActor(ctx context.Context, actor Actor) context.Context {
+	return context.WithValue(ctx, actorContextKey{}, actor)
+}
+
+// ActorFromContext returns the actor the request was authenticated as. Background jobs and
+// requests authenticated otherwise, like incoming webhooks, have none.
+func ActorFromContext(ctx context.Context) (Actor, bool) {
+	actor, ok := ctx.Value(actorContextKey{}).(Actor)
+	return actor, ok
+}
+
+func hasScope(scopes []APIKeyScope, scope APIKeyScope) bool {
+	return slices.Contains(scopes, ScopeAdmin) || slices.Contains(scopes, scope)
+}
@@ -0,0 +1,71 @@
+package domain
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+)
+
+// APIKeySecretPrefix starts every issued secret, which makes leaked keys easy to spot and tells them apart
+// from other bearer tokens.
+const APIKeySecretPrefix = "prs_"
+
+type APIKeyID int64
+
+// APIKeyScope names a group of endpoints a key may call.
+type APIKeyScope string
+
+const (
+	ScopePullRequestRead  APIKeyScope = "pr:read"
+	ScopePullRequestWrite APIKeyScope = "pr:write"
+	ScopeTeamRead         APIKeyScope = "team:read"
+	ScopeTeamAdmin        APIKeyScope = "team:admin"
+	// ScopeUsersWrite changes users: their activity, linked accounts and contact details.
+	ScopeUsersWrite APIKeyScope = "users:write"
+	ScopeStatsRead  APIKeyScope = "stats:read"
+	// ScopeAdmin grants every other scope and lets the key manage API keys and webhook subscriptions.
+	ScopeAdmin APIKeyScope = "admin"
+)
+
+func (s APIKeyScope) IsValid() bool {
+	switch s {
+	case ScopePullRequestRead, ScopePullRequestWrite, ScopeTeamRead, ScopeTeamAdmin, ScopeUsersWrite, ScopeStatsRead,
+		ScopeAdmin:
+		return true
+	default:
+		return false
+	}
+}
+
+// APIKey authenticates a client. Only the hash of the secret is stored; Prefix is its first characters,
+// kept to tell keys apart in listings.
+type APIKey struct {
+	ID        APIKeyID
+	Name      string
+	Prefix    string
+	Hash      string
+	Scopes    []APIKeyScope
+	CreatedAt time.Time
+	RevokedAt *time.Time
+}
+
+func (k APIKey) HasScope(scope APIKeyScope) bool {
+	return hasScope(k.Scopes, scope)
+}
+
+func (k APIKey) IsRevoked() bool {
+	return k.RevokedAt != nil
+}
+
+// IssuedAPIKey is a key just created along with its secret, which cannot be recovered afterwards.
+type IssuedAPIKey struct {
+	Key    APIKey
+	Secret string
+}
+
+// HashAPIKey returns the hex SHA-256 of the secret. Secrets are random, so a plain hash is enough
+// to look them up without storing them.
+func HashAPIKey(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
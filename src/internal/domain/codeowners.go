@@ -0,0 +1,226 @@
+package domain
+
+import (
+	"bufio"
+	"regexp"
+	"strings"
+)
+
+type CodeOwnersMode string
+
+const (
+	// CodeOwnersModePrefer picks matching owners first when there are free slots.
+	CodeOwnersModePrefer CodeOwnersMode = "PREFER"
+	// CodeOwnersModeRequire reports an unfilled slot when no owner of a matching entry can be assigned.
+	CodeOwnersModeRequire CodeOwnersMode = "REQUIRE"
+)
+
+type FillStrategy string
+
+const (
+	FillStrategyRandom FillStrategy = "RANDOM"
+	// FillStrategyLoad prefers members with the fewest open review assignments.
+	FillStrategyLoad FillStrategy = "LOAD"
+)
+
+// TeamCodeOwners is a CODEOWNERS document of the team together with its assignment settings.
+type TeamCodeOwners struct {
+	TeamName     TeamName
+	Content      string
+	Mode         CodeOwnersMode
+	FillStrategy FillStrategy
+	Entries      []CodeOwnersEntry
+}
+
+// CodeOwnersEntry is a single "pattern owner..." line of a CODEOWNERS document.
+type CodeOwnersEntry struct {
+	Line    int
+	Pattern string
+	Owners  []string
+	regex   *regexp.Regexp
+}
+
+// ParseCodeOwners parses a document in GitHub CODEOWNERS syntax. Negation and
+// bracket patterns are not supported by GitHub and are rejected.
+func ParseCodeOwners(content string) ([]CodeOwnersEntry, error) {
+	var entries []CodeOwnersEntry
+
+	scanner := bufio.NewScanner(strings.NewReader(content))
+	line := 0
+	for scanner.Scan() {
+		line++
+
+		fields := splitCodeOwnersLine(scanner.Text())
+		if len(fields) == 0 {
+			continue
+		}
+
+		pattern := fields[0]
+		if strings.HasPrefix(pattern, "!") || strings.ContainsAny(pattern, "[]") {
+			return nil, &CodeOwnersSyntaxError{Line: line, Reason: "unsupported pattern " + pattern}
+		}
+
+		for _, owner := range fields[1:] {
+			if !strings.Contains(owner, "@") {
+				return nil, &CodeOwnersSyntaxError{Line: line, Reason: "invalid owner " + owner}
+			}
+		}
+
+		regex, err := compileCodeOwnersPattern(pattern)
+		if err != nil {
+			return nil, &CodeOwnersSyntaxError{Line: line, Reason: err.Error()}
+		}
+
+		entries = append(entries, CodeOwnersEntry{
+			Line:    line,
+			Pattern: pattern,
+			Owners:  fields[1:],
+			regex:   regex,
+		})
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, &CodeOwnersSyntaxError{Line: line, Reason: err.Error()}
+	}
+
+	return entries, nil
+}
+
+// Match returns the entry that owns the path. As in GitHub, the last matching entry wins.
+func (c TeamCodeOwners) Match(path string) (CodeOwnersEntry, bool) {
+	path = strings.TrimPrefix(path, "/")
+	for i := len(c.Entries) - 1; i >= 0; i-- {
+		if c.Entries[i].regex.MatchString(path) {
+			return c.Entries[i], true
+		}
+	}
+
+	return CodeOwnersEntry{}, false
+}
+
+// MatchAll returns the distinct owning entries of the paths in order of first match.
+// Entries without owners mark paths as unowned and are skipped.
+func (c TeamCodeOwners) MatchAll(paths []string) []CodeOwnersEntry {
+	var matched []CodeOwnersEntry
+	seen := make(map[int]bool)
+	for _, path := range paths {
+		entry, ok := c.Match(path)
+		if !ok || len(entry.Owners) == 0 || seen[entry.Line] {
+			continue
+		}
+
+		seen[entry.Line] = true
+		matched = append(matched, entry)
+	}
+
+	return matched
+}
+
+// OwnedBy reports whether the member of the team is an owner of the entry. "@login" matches
+// the member id or username, "@org/team" matches every member of the team with that name.
+func (e CodeOwnersEntry) OwnedBy(member TeamMember, teamName TeamName) bool {
+	for _, owner := range e.Owners {
+		handle, isHandle := strings.CutPrefix(owner, "@")
+		if !isHandle {
+			if owner == string(member.ID) {
+				return true
+			}
+			continue
+		}
+
+		if _, team, isTeam := strings.Cut(handle, "/"); isTeam {
+			if team == string(teamName) {
+				return true
+			}
+			continue
+		}
+
+		if handle == string(member.ID) || handle == member.Username {
+			return true
+		}
+	}
+
+	return false
+}
+
+func splitCodeOwnersLine(line string) []string {
+	var (
+		fields  []string
+		current strings.Builder
+		escaped bool
+	)
+	for _, r := range line {
+		switch {
+		case escaped:
+			current.WriteRune(r)
+			escaped = false
+		case r == '\\':
+			escaped = true
+		case r == '#':
+			return appendField(fields, current.String())
+		case r == ' ' || r == '\t':
+			fields = appendField(fields, current.String())
+			current.Reset()
+		default:
+			current.WriteRune(r)
+		}
+	}
+
+	return appendField(fields, current.String())
+}
+
+func appendField(fields []string, field string) []string {
+	if field == "" {
+		return fields
+	}
+
+	return append(fields, field)
+}
+
+// compileCodeOwnersPattern converts a gitignore-style pattern to a regular expression
+// matched against slash-separated paths relative to the repository root.
+func compileCodeOwnersPattern(pattern string) (*regexp.Regexp, error) {
+	dirOnly := strings.HasSuffix(pattern, "/")
+	p := strings.TrimSuffix(pattern, "/")
+	anchored := strings.Contains(p, "/")
+	p = strings.TrimPrefix(p, "/")
+
+	var sb strings.Builder
+	sb.WriteString("^")
+	if !anchored && !strings.HasPrefix(p, "**") {
+		sb.WriteString("(?:.*/)?")
+	}
+
+	for i := 0; i < len(p); i++ {
+		switch {
+		case strings.HasPrefix(p[i:], "**/"):
+			sb.WriteString("(?:.*/)?")
+			i += 2
+		case strings.HasPrefix(p[i:], "/**") && i+3 == len(p):
+			sb.WriteString("/.*")
+			i += 2
+		case strings.HasPrefix(p[i:], "**"):
+			sb.WriteString(".*")
+			i++
+		case p[i] == '*':
+			sb.WriteString("[^/]*")
+		case p[i] == '?':
+			sb.WriteString("[^/]")
+		default:
+			sb.WriteString(regexp.QuoteMeta(string(p[i])))
+		}
+	}
+
+	lastSegment := p[strings.LastIndex(p, "/")+1:]
+	switch {
+	case dirOnly:
+		// a directory pattern owns everything inside the directory
+		sb.WriteString("/.+")
+	case !strings.Contains(lastSegment, "*"):
+		// a pattern naming a directory also owns its contents
+		sb.WriteString("(?:/.*)?")
+	}
+	sb.WriteString("$")
+
+	return regexp.Compile(sb.String())
+}
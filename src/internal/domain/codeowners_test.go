@@ -0,0 +1,112 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParseCodeOwners_Match(t *testing.T) {
+	content := `# comment line
+*                    @global-owner
+*.js                 @js-owner # trailing comment
+/build/logs/         @doctocat
+docs/*               docs@example.com
+apps/                @octocat
+**/logs              @logs-owner
+/scripts/            @doctocat @octocat
+/docs/**/img         @images
+/apps/github
+file\ with\ space.md @spaces
+`
+
+	entries, err := ParseCodeOwners(content)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	codeOwners := TeamCodeOwners{Entries: entries}
+
+	tests := []struct {
+		path     string
+		wantLine int
+	}{
+		{path: "README.md", wantLine: 2},
+		{path: "src/app/index.js", wantLine: 3},
+		{path: "build/logs/today.log", wantLine: 7},
+		{path: "docs/getting-started.md", wantLine: 5},
+		{path: "docs/build-app/troubleshooting.md", wantLine: 2},
+		{path: "web/apps/main.go", wantLine: 6},
+		{path: "deep/path/logs/a.txt", wantLine: 7},
+		{path: "scripts/deploy.sh", wantLine: 8},
+		{path: "docs/guide/v1/img/logo.png", wantLine: 9},
+		{path: "docs/img/logo.png", wantLine: 9},
+		{path: "apps/github/main.go", wantLine: 10},
+		{path: "/file with space.md", wantLine: 11},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			entry, ok := codeOwners.Match(tt.path)
+			if !ok {
+				t.Fatalf("expected %s to match", tt.path)
+			}
+			if entry.Line != tt.wantLine {
+				t.Fatalf("expected line %d, got %d (%s)", tt.wantLine, entry.Line, entry.Pattern)
+			}
+		})
+	}
+
+	unowned := codeOwners.MatchAll([]string{"apps/github/main.go", "README.md", "src/a.js", "lib/b.js"})
+	if len(unowned) != 2 || unowned[0].Line != 2 || unowned[1].Line != 3 {
+		t.Fatalf("expected distinct owned entries for lines 2 and 3, got %+v", unowned)
+	}
+}
+
+func TestParseCodeOwners_Errors(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		wantLine int
+	}{
+		{name: "negation", content: "* @a\n!*.md @b", wantLine: 2},
+		{name: "brackets", content: "*.[ch] @a", wantLine: 1},
+		{name: "owner without at", content: "* owner", wantLine: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseCodeOwners(tt.content)
+			if !errors.Is(err, ErrInvalidCodeOwners) {
+				t.Fatalf("expected ErrInvalidCodeOwners, got %v", err)
+			}
+
+			var syntaxErr *CodeOwnersSyntaxError
+			if !errors.As(err, &syntaxErr) || syntaxErr.Line != tt.wantLine {
+				t.Fatalf("expected syntax error on line %d, got %v", tt.wantLine, err)
+			}
+		})
+	}
+}
+
+func TestCodeOwnersEntry_OwnedBy(t *testing.T) {
+	entry := CodeOwnersEntry{Owners: []string{"@alice", "@org/backend", "u9"}}
+
+	tests := []struct {
+		name     string
+		member   TeamMember
+		teamName TeamName
+		want     bool
+	}{
+		{name: "by username", member: TeamMember{ID: "u1", Username: "alice"}, teamName: "frontend", want: true},
+		{name: "by team handle", member: TeamMember{ID: "u2", Username: "bob"}, teamName: "backend", want: true},
+		{name: "by plain id", member: TeamMember{ID: "u9", Username: "zed"}, teamName: "frontend", want: true},
+		{name: "not an owner", member: TeamMember{ID: "u3", Username: "carol"}, teamName: "frontend", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := entry.OwnedBy(tt.member, tt.teamName); got != tt.want {
+				t.Fatalf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
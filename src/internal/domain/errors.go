@@ -1,14 +1,75 @@
 package domain
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+)
 
 var (
-	ErrTeamNotFound              = errors.New("team not found")
-	ErrTeamAlreadyExists         = errors.New("team already exists")
-	ErrUserNotFound              = errors.New("user not found")
-	ErrPullRequestNotFound       = errors.New("pull request not found")
-	ErrPullRequestExists         = errors.New("pull request already exists")
-	ErrReassignMergedPullRequest = errors.New("cannot reassign on merged PR")
-	ErrNoCandidate               = errors.New("cannot find candidate")
-	ErrReviewerIsNotAssigned     = errors.New("reviewer is not assigned")
+	ErrTeamNotFound                = errors.New("team not found")
+	ErrTeamAlreadyExists           = errors.New("team already exists")
+	ErrUserNotFound                = errors.New("user not found")
+	ErrUserAlreadyExists           = errors.New("user already exists")
+	ErrPullRequestNotFound         = errors.New("pull request not found")
+	ErrPullRequestExists           = errors.New("pull request already exists")
+	ErrReassignMergedPullRequest   = errors.New("cannot reassign on merged PR")
+	ErrNoCandidate                 = errors.New("cannot find candidate")
+	ErrReviewerIsNotAssigned       = errors.New("reviewer is not assigned")
+	ErrReviewerInactive            = errors.New("reviewer is inactive")
+	ErrReviewerIsAuthor            = errors.New("author cannot review own pull request")
+	ErrReviewerAlreadyAssigned     = errors.New("reviewer is already assigned")
+	ErrReviewerNotInTeam           = errors.New("reviewer is not a member of the eligible team")
+	ErrReviewersLimitExceeded      = errors.New("team reviewers limit exceeded")
+	ErrChangeMergedPullRequest     = errors.New("cannot change reviewers on merged PR")
+	ErrTeamRuleNotFound            = errors.New("team rule not found")
+	ErrInvalidTeamRule             = errors.New("invalid team rule")
+	ErrRuleViolation               = errors.New("team rule violation")
+	ErrInvalidCodeOwners           = errors.New("invalid CODEOWNERS document")
+	ErrCodeOwnersNotFound          = errors.New("CODEOWNERS document not found")
+	ErrPullRequestMerged           = errors.New("pull request is already merged")
+	ErrPullRequestClosed           = errors.New("pull request is closed")
+	ErrPullRequestNotOpen          = errors.New("pull request is not open")
+	ErrExternalAccountNotFound     = errors.New("external account not found")
+	ErrWebhookSubscriptionNotFound = errors.New("webhook subscription not found")
+	ErrInvalidWebhookSubscription  = errors.New("invalid webhook subscription")
+	ErrReviewSLANotFound           = errors.New("review SLA not found")
+	ErrInvalidReviewSLA            = errors.New("invalid review SLA")
+	ErrInvalidStatsWindow          = errors.New("invalid stats window")
+	ErrInvalidStatsInterval        = errors.New("invalid stats interval")
+	ErrInvalidPullRequestStatus    = errors.New("invalid pull request status")
+	ErrInvalidTeamImport           = errors.New("invalid team import")
+	ErrInvalidGroupMember          = errors.New("invalid group member")
+	ErrAPIKeyNotFound              = errors.New("API key not found")
+	ErrInvalidAPIKey               = errors.New("invalid API key")
+	ErrForbidden                   = errors.New("action is not allowed for the actor")
+	ErrInvalidStreamCursor         = errors.New("invalid event stream cursor")
 )
+
+// CodeOwnersSyntaxError reports the line of a CODEOWNERS document that could not be parsed.
+type CodeOwnersSyntaxError struct {
+	Line   int
+	Reason string
+}
+
+func (e *CodeOwnersSyntaxError) Error() string {
+	return fmt.Sprintf("%s: line %d: %s", ErrInvalidCodeOwners, e.Line, e.Reason)
+}
+
+func (e *CodeOwnersSyntaxError) Unwrap() error {
+	return ErrInvalidCodeOwners
+}
+
+// RuleViolationError reports the team rule that prevented a reviewer assignment.
+// It wraps ErrNoCandidate when no replacement could be found and ErrRuleViolation otherwise.
+type RuleViolationError struct {
+	Rule TeamRule
+	Err  error
+}
+
+func (e *RuleViolationError) Error() string {
+	return fmt.Sprintf("%s: rule %d (%s) of team %s", e.Err, e.Rule.ID, e.Rule.Kind, e.Rule.TeamName)
+}
+
+func (e *RuleViolationError) Unwrap() error {
+	return e.Err
+}
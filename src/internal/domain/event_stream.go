@@ -0,0 +1,67 @@
+package domain
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// StreamEventTypes are the events pushed to live event stream clients.
+var StreamEventTypes = []EventType{
+	EventPullRequestCreated,
+	EventReviewerAssigned,
+	EventReviewerReassigned,
+	EventReviewerRemoved,
+	EventPullRequestMerged,
+	EventPullRequestClosed,
+	EventPullRequestReopened,
+	EventPullRequestUpdated,
+	EventReviewOverdue,
+	EventReviewEscalated,
+}
+
+// EventStreamFilter narrows the event stream to events involving a user or a member of a team
+// as author or reviewer. Empty fields match every event.
+type EventStreamFilter struct {
+	UserID   UserID
+	TeamName TeamName
+}
+
+// EventStreamCursor is a position in the event stream. Events are ordered by the transaction that
+// wrote them and then by ID, and only events of transactions older than every running one are
+// streamed, so a transaction that commits late can never land behind a cursor.
+type EventStreamCursor struct {
+	TxID int64
+	ID   int64
+}
+
+// String encodes the cursor as the SSE event id "<tx id>-<id>".
+func (c EventStreamCursor) String() string {
+	return fmt.Sprintf("%d-%d", c.TxID, c.ID)
+}
+
+// ParseEventStreamCursor decodes a cursor produced by String.
+func ParseEventStreamCursor(s string) (EventStreamCursor, error) {
+	rawTxID, rawID, ok := strings.Cut(s, "-")
+	if !ok {
+		return EventStreamCursor{}, ErrInvalidStreamCursor
+	}
+
+	txID, err := strconv.ParseInt(rawTxID, 10, 64)
+	if err != nil || txID < 0 {
+		return EventStreamCursor{}, ErrInvalidStreamCursor
+	}
+	id, err := strconv.ParseInt(rawID, 10, 64)
+	if err != nil || id < 0 {
+		return EventStreamCursor{}, ErrInvalidStreamCursor
+	}
+
+	return EventStreamCursor{TxID: txID, ID: id}, nil
+}
+
+// EventStreamPage is a batch of stream events; Cursor is the position to continue from,
+// which moves past events that did not match the filter too.
+type EventStreamPage struct {
+	Events []OutboxMessage
+	Cursor EventStreamCursor
+}
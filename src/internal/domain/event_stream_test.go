@@ -0,0 +1,22 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParseEventStreamCursor(t *testing.T) {
+	cursor, err := ParseEventStreamCursor(EventStreamCursor{TxID: 812, ID: 45}.String())
+	if err != nil {
+		t.Fatalf("ParseEventStreamCursor failed: %v", err)
+	}
+	if cursor != (EventStreamCursor{TxID: 812, ID: 45}) {
+		t.Fatalf("unexpected cursor: %+v", cursor)
+	}
+
+	for _, raw := range []string{"", "45", "812-", "-45", "812-45-1", "a-1", "1--2"} {
+		if _, err := ParseEventStreamCursor(raw); !errors.Is(err, ErrInvalidStreamCursor) {
+			t.Fatalf("expected ErrInvalidStreamCursor for %q, got %v", raw, err)
+		}
+	}
+}
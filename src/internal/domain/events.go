@@ -0,0 +1,259 @@
+package domain
+
+import (
+	"encoding/json"
+	"time"
+)
+
+type EventType string
+
+const (
+	EventPullRequestCreated  EventType = "PR_CREATED"
+	EventReviewerAssigned    EventType = "REVIEWER_ASSIGNED"
+	EventReviewerReassigned  EventType = "REVIEWER_REASSIGNED"
+	EventReviewerRemoved     EventType = "REVIEWER_REMOVED"
+	EventPullRequestMerged   EventType = "PR_MERGED"
+	EventPullRequestClosed   EventType = "PR_CLOSED"
+	EventPullRequestReopened EventType = "PR_REOPENED"
+	// EventPullRequestUpdated reports a renamed pull request or one moved between DRAFT and OPEN.
+	EventPullRequestUpdated EventType = "PR_UPDATED"
+	EventReviewOverdue      EventType = "REVIEW_OVERDUE"
+	EventReviewEscalated    EventType = "REVIEW_ESCALATED"
+	EventUserDeactivated    EventType = "USER_DEACTIVATED"
+	EventTeamCreated        EventType = "TEAM_CREATED"
+	EventTeamUpdated        EventType = "TEAM_UPDATED"
+)
+
+func (t EventType) IsValid() bool {
+	switch t {
+	case EventPullRequestCreated, EventReviewerAssigned, EventReviewerReassigned, EventReviewerRemoved,
+		EventPullRequestMerged, EventPullRequestClosed, EventPullRequestReopened, EventPullRequestUpdated,
+		EventReviewOverdue, EventReviewEscalated, EventUserDeactivated, EventTeamCreated, EventTeamUpdated:
+		return true
+	default:
+		return false
+	}
+}
+
+// Event is a state change published to subscribers.
+// PullRequest is set for pull request events, User for user events and Team for team events.
+// ReviewerID is the reviewer an SLA event is about; EscalatedTo are the leads an overdue review was reported to.
+type Event struct {
+	Type          EventType
+	OccurredAt    time.Time
+	PullRequest   *PullRequest
+	OldReviewerID UserID
+	NewReviewerID UserID
+	ReviewerID    UserID
+	EscalatedTo   []UserID
+	User          *User
+	Team          *Team
+}
+
+// Aggregate returns the entity the event belongs to; events of one aggregate are relayed in order.
+func (e Event) Aggregate() (AggregateType, string) {
+	switch {
+	case e.PullRequest != nil:
+		return AggregatePullRequest, string(e.PullRequest.ID)
+	case e.User != nil:
+		return AggregateUser, string(e.User.ID)
+	case e.Team != nil:
+		return AggregateTeam, string(e.Team.Name)
+	default:
+		return "", ""
+	}
+}
+
+func NewPullRequestEvent(eventType EventType, pr PullRequest) Event {
+	return Event{Type: eventType, OccurredAt: time.Now().UTC(), PullRequest: &pr}
+}
+
+func NewReviewerAssignedEvent(pr PullRequest, reviewerID UserID) Event {
+	event := NewPullRequestEvent(EventReviewerAssigned, pr)
+	event.NewReviewerID = reviewerID
+
+	return event
+}
+
+func NewReviewerReassignedEvent(pr PullRequest, oldReviewerID, newReviewerID UserID) Event {
+	event := NewPullRequestEvent(EventReviewerReassigned, pr)
+	event.OldReviewerID = oldReviewerID
+	event.NewReviewerID = newReviewerID
+
+	return event
+}
+
+// NewReviewerRemovedEvent reports the removed reviewer as the old one.
+func NewReviewerRemovedEvent(pr PullRequest, reviewerID UserID) Event {
+	event := NewPullRequestEvent(EventReviewerRemoved, pr)
+	event.OldReviewerID = reviewerID
+
+	return event
+}
+
+func NewReviewOverdueEvent(pr PullRequest, reviewerID UserID) Event {
+	event := NewPullRequestEvent(EventReviewOverdue, pr)
+	event.ReviewerID = reviewerID
+
+	return event
+}
+
+func NewReviewEscalatedEvent(pr PullRequest, reviewerID UserID, leads []UserID) Event {
+	event := NewPullRequestEvent(EventReviewEscalated, pr)
+	event.ReviewerID = reviewerID
+	event.EscalatedTo = leads
+
+	return event
+}
+
+func NewUserEvent(eventType EventType, user User) Event {
+	return Event{Type: eventType, OccurredAt: time.Now().UTC(), User: &user}
+}
+
+func NewTeamEvent(eventType EventType, team Team) Event {
+	return Event{Type: eventType, OccurredAt: time.Now().UTC(), Team: &team}
+}
+
+type eventPayload struct {
+	Type          EventType           `json:"type"`
+	OccurredAt    time.Time           `json:"occurred_at"`
+	PullRequest   *pullRequestPayload `json:"pull_request,omitempty"`
+	OldReviewerID UserID              `json:"old_reviewer_id,omitempty"`
+	NewReviewerID UserID              `json:"new_reviewer_id,omitempty"`
+	ReviewerID    UserID              `json:"reviewer_id,omitempty"`
+	EscalatedTo   []UserID            `json:"escalated_to,omitempty"`
+	User          *userPayload        `json:"user,omitempty"`
+	Team          *teamPayload        `json:"team,omitempty"`
+}
+
+type pullRequestPayload struct {
+	ID                PullRequestID       `json:"pull_request_id"`
+	Name              string              `json:"pull_request_name"`
+	AuthorID          UserID              `json:"author_id"`
+	Status            PullRequestStatus   `json:"status"`
+	AssignedReviewers []UserID            `json:"assigned_reviewers"`
+	ReviewerTeams     map[UserID]TeamName `json:"reviewer_teams,omitempty"`
+	CreatedAt         *time.Time          `json:"created_at,omitempty"`
+	MergedAt          *time.Time          `json:"merged_at,omitempty"`
+}
+
+type userPayload struct {
+	ID       UserID   `json:"user_id"`
+	Username string   `json:"username"`
+	TeamName TeamName `json:"team_name"`
+	IsActive bool     `json:"is_active"`
+}
+
+type teamPayload struct {
+	Name         TeamName            `json:"team_name"`
+	MaxReviewers int                 `json:"max_reviewers,omitempty"`
+	Members      []teamMemberPayload `json:"members"`
+}
+
+type teamMemberPayload struct {
+	ID       UserID `json:"user_id"`
+	Username string `json:"username"`
+	IsActive bool   `json:"is_active"`
+}
+
+// EncodeEvent returns the JSON document the event is delivered and stored as.
+func EncodeEvent(event Event) ([]byte, error) {
+	payload := eventPayload{
+		Type:          event.Type,
+		OccurredAt:    event.OccurredAt,
+		OldReviewerID: event.OldReviewerID,
+		NewReviewerID: event.NewReviewerID,
+		ReviewerID:    event.ReviewerID,
+		EscalatedTo:   event.EscalatedTo,
+	}
+	if pr := event.PullRequest; pr != nil {
+		payload.PullRequest = &pullRequestPayload{
+			ID:                pr.ID,
+			Name:              pr.Name,
+			AuthorID:          pr.AuthorID,
+			Status:            pr.Status,
+			AssignedReviewers: pr.AssignedReviewers,
+			ReviewerTeams:     pr.ReviewerTeams,
+			CreatedAt:         pr.CreatedAt,
+			MergedAt:          pr.MergedAt,
+		}
+	}
+	if user := event.User; user != nil {
+		payload.User = &userPayload{
+			ID:       user.ID,
+			Username: user.Username,
+			TeamName: user.TeamName,
+			IsActive: user.IsActive,
+		}
+	}
+
+	if team := event.Team; team != nil {
+		payload.Team = &teamPayload{
+			Name:         team.Name,
+			MaxReviewers: team.MaxReviewers,
+			Members:      make([]teamMemberPayload, 0, len(team.Members)),
+		}
+		for _, member := range team.Members {
+			payload.Team.Members = append(payload.Team.Members, teamMemberPayload{
+				ID:       member.ID,
+				Username: member.Username,
+				IsActive: member.IsActive,
+			})
+		}
+	}
+
+	return json.Marshal(payload)
+}
+
+// DecodeEvent parses a document produced by EncodeEvent.
+func DecodeEvent(data []byte) (Event, error) {
+	var payload eventPayload
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return Event{}, err
+	}
+
+	event := Event{
+		Type:          payload.Type,
+		OccurredAt:    payload.OccurredAt,
+		OldReviewerID: payload.OldReviewerID,
+		NewReviewerID: payload.NewReviewerID,
+		ReviewerID:    payload.ReviewerID,
+		EscalatedTo:   payload.EscalatedTo,
+	}
+	if pr := payload.PullRequest; pr != nil {
+		event.PullRequest = &PullRequest{
+			ID:                pr.ID,
+			Name:              pr.Name,
+			AuthorID:          pr.AuthorID,
+			Status:            pr.Status,
+			AssignedReviewers: pr.AssignedReviewers,
+			ReviewerTeams:     pr.ReviewerTeams,
+			CreatedAt:         pr.CreatedAt,
+			MergedAt:          pr.MergedAt,
+		}
+	}
+	if user := payload.User; user != nil {
+		event.User = &User{
+			ID:       user.ID,
+			Username: user.Username,
+			TeamName: user.TeamName,
+			IsActive: user.IsActive,
+		}
+	}
+	if team := payload.Team; team != nil {
+		event.Team = &Team{
+			Name:         team.Name,
+			MaxReviewers: team.MaxReviewers,
+			Members:      make([]TeamMember, 0, len(team.Members)),
+		}
+		for _, member := range team.Members {
+			event.Team.Members = append(event.Team.Members, TeamMember{
+				ID:       member.ID,
+				Username: member.Username,
+				IsActive: member.IsActive,
+			})
+		}
+	}
+
+	return event, nil
+}
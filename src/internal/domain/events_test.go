@@ -0,0 +1,60 @@
+package domain
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestDecodeEvent_RoundTrip(t *testing.T) {
+	createdAt := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	event := NewReviewerReassignedEvent(PullRequest{
+		ID:                "pr-1",
+		Name:              "Add search",
+		AuthorID:          "u1",
+		Status:            PullRequestStatusOpen,
+		AssignedReviewers: []UserID{"u3", "u4"},
+		ReviewerTeams:     map[UserID]TeamName{"u3": "backend", "u4": "backend"},
+		CreatedAt:         &createdAt,
+	}, "u2", "u4")
+	event.OccurredAt = createdAt
+
+	data, err := EncodeEvent(event)
+	if err != nil {
+		t.Fatalf("EncodeEvent failed: %v", err)
+	}
+
+	decoded, err := DecodeEvent(data)
+	if err != nil {
+		t.Fatalf("DecodeEvent failed: %v", err)
+	}
+
+	if !reflect.DeepEqual(decoded, event) {
+		t.Fatalf("round trip mismatch:\n got %+v\nwant %+v", decoded, event)
+	}
+}
+
+func TestDecodeEvent_RoundTripEscalation(t *testing.T) {
+	event := NewReviewEscalatedEvent(PullRequest{
+		ID:                "pr-1",
+		Name:              "Add search",
+		AuthorID:          "u1",
+		Status:            PullRequestStatusOpen,
+		AssignedReviewers: []UserID{"u2"},
+	}, "u2", []UserID{"u5", "u6"})
+	event.OccurredAt = time.Date(2025, 3, 2, 12, 0, 0, 0, time.UTC)
+
+	data, err := EncodeEvent(event)
+	if err != nil {
+		t.Fatalf("EncodeEvent failed: %v", err)
+	}
+
+	decoded, err := DecodeEvent(data)
+	if err != nil {
+		t.Fatalf("DecodeEvent failed: %v", err)
+	}
+
+	if !reflect.DeepEqual(decoded, event) {
+		t.Fatalf("round trip mismatch:\n got %+v\nwant %+v", decoded, event)
+	}
+}
@@ -0,0 +1,55 @@
+package domain
+
+import "time"
+
+// PullRequestExportFilter narrows the pull request export. Empty fields are not applied;
+// TeamName matches the author's team and Window the creation time.
+type PullRequestExportFilter struct {
+	Status     PullRequestStatus
+	TeamName   TeamName
+	AuthorID   UserID
+	ReviewerID UserID
+	Window     StatsWindow
+}
+
+func (f PullRequestExportFilter) Validate() error {
+	if f.Status != "" && !f.Status.IsValid() {
+		return ErrInvalidPullRequestStatus
+	}
+
+	return f.Window.Validate()
+}
+
+// AssignmentExportFilter narrows the assignment export. Empty fields are not applied;
+// TeamName matches the team of the reviewer slot and Window the assignment time.
+type AssignmentExportFilter struct {
+	TeamName      TeamName
+	ReviewerID    UserID
+	PullRequestID PullRequestID
+	Window        StatsWindow
+}
+
+// PullRequestExportRow is a pull request as exported, with its currently assigned reviewers.
+type PullRequestExportRow struct {
+	ID        PullRequestID
+	Name      string
+	AuthorID  UserID
+	TeamName  TeamName
+	Status    PullRequestStatus
+	Tags      []string
+	Reviewers []UserID
+	CreatedAt *time.Time
+	MergedAt  *time.Time
+}
+
+// AssignmentExportRow is an entry of the review assignment ledger. UnassignedAt is nil while the
+// reviewer is still assigned; Reassigned tells whether the review was handed to another reviewer.
+type AssignmentExportRow struct {
+	PullRequestID     PullRequestID
+	PullRequestStatus PullRequestStatus
+	ReviewerID        UserID
+	TeamName          TeamName
+	AssignedAt        time.Time
+	UnassignedAt      *time.Time
+	Reassigned        bool
+}
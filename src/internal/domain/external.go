@@ -0,0 +1,50 @@
+package domain
+
+import "strings"
+
+// ExternalProvider is a code hosting platform whose accounts and events are mapped to the service.
+type ExternalProvider string
+
+const (
+	ExternalProviderGitHub ExternalProvider = "GITHUB"
+	ExternalProviderGitLab ExternalProvider = "GITLAB"
+)
+
+// ExternalAccount links a login on an external provider to a user.
+type ExternalAccount struct {
+	Provider ExternalProvider
+	Login    string
+	UserID   UserID
+}
+
+// NormalizeLogin returns the login in the form it is stored and looked up by; provider logins are case-insensitive.
+func NormalizeLogin(login string) string {
+	return strings.ToLower(strings.TrimSpace(login))
+}
+
+type PullRequestEventAction string
+
+const (
+	PullRequestEventOpened         PullRequestEventAction = "OPENED"
+	PullRequestEventMerged         PullRequestEventAction = "MERGED"
+	PullRequestEventClosed         PullRequestEventAction = "CLOSED"
+	PullRequestEventReadyForReview PullRequestEventAction = "READY_FOR_REVIEW"
+	PullRequestEventReopened       PullRequestEventAction = "REOPENED"
+	// PullRequestEventUpdated syncs the title and draft flag of the pull request.
+	PullRequestEventUpdated PullRequestEventAction = "UPDATED"
+	// PullRequestEventReviewed records that ReviewerLogin submitted a review, which ends their review SLA.
+	PullRequestEventReviewed PullRequestEventAction = "REVIEWED"
+)
+
+// PullRequestEvent is a provider-agnostic pull request lifecycle event received from a webhook.
+type PullRequestEvent struct {
+	Provider ExternalProvider
+	// DeliveryID identifies the webhook delivery; events with an already seen id are skipped.
+	DeliveryID    string
+	Action        PullRequestEventAction
+	PullRequestID PullRequestID
+	Title         string
+	AuthorLogin   string
+	ReviewerLogin string
+	Draft         bool
+}
@@ -1,6 +1,9 @@
 package domain
 
-import "time"
+import (
+	"slices"
+	"time"
+)
 
 type (
 	UserID        string
@@ -11,29 +14,182 @@ type (
 type PullRequestStatus string
 
 const (
+	PullRequestStatusDraft  PullRequestStatus = "DRAFT"
 	PullRequestStatusOpen   PullRequestStatus = "OPEN"
 	PullRequestStatusMerged PullRequestStatus = "MERGED"
+	PullRequestStatusClosed PullRequestStatus = "CLOSED"
 	PullRequestMaxReviewers                   = 2
 )
 
+func (s PullRequestStatus) IsValid() bool {
+	switch s {
+	case PullRequestStatusDraft, PullRequestStatusOpen, PullRequestStatusMerged, PullRequestStatusClosed:
+		return true
+	default:
+		return false
+	}
+}
+
+type Seniority string
+
+const (
+	SeniorityJunior Seniority = "JUNIOR"
+	SeniorityMiddle Seniority = "MIDDLE"
+	SenioritySenior Seniority = "SENIOR"
+	SeniorityLead   Seniority = "LEAD"
+)
+
+func (s Seniority) IsValid() bool {
+	switch s {
+	case SeniorityJunior, SeniorityMiddle, SenioritySenior, SeniorityLead:
+		return true
+	default:
+		return false
+	}
+}
+
 type TeamMember struct {
-	ID       UserID
-	Username string
-	IsActive bool
+	ID        UserID
+	Username  string
+	IsActive  bool
+	Seniority Seniority
+	Tags      []string
 }
 
 func (m TeamMember) ToUser(teamName TeamName) User {
 	return User{
-		ID:       m.ID,
-		Username: m.Username,
-		TeamName: teamName,
-		IsActive: m.IsActive,
+		ID:        m.ID,
+		Username:  m.Username,
+		TeamName:  teamName,
+		IsActive:  m.IsActive,
+		Seniority: m.Seniority,
+		Tags:      m.Tags,
 	}
 }
 
+// HasTag reports whether the member carries the tag.
+func (m TeamMember) HasTag(tag string) bool {
+	return slices.Contains(m.Tags, tag)
+}
+
 type Team struct {
-	Name    TeamName
-	Members []TeamMember
+	Name         TeamName
+	Members      []TeamMember
+	MaxReviewers int
+	Rules        []TeamRule
+	CodeOwners   *TeamCodeOwners
+}
+
+// ReviewersLimit returns how many reviewer slots of a pull request may be filled from the team.
+func (t Team) ReviewersLimit() int {
+	if t.MaxReviewers > 0 {
+		return t.MaxReviewers
+	}
+	return PullRequestMaxReviewers
+}
+
+type (
+	TeamRuleID   int64
+	TeamRuleKind string
+)
+
+const (
+	// TeamRuleKindExcludePair forbids assigning ReviewerID to pull requests authored by AuthorID.
+	TeamRuleKindExcludePair TeamRuleKind = "EXCLUDE_PAIR"
+	// TeamRuleKindRequireOneOf requires at least one reviewer from the group of the rule.
+	TeamRuleKindRequireOneOf TeamRuleKind = "REQUIRE_ONE_OF"
+	// TeamRuleKindMaxOf allows at most MaxCount reviewers from the group of the rule.
+	TeamRuleKindMaxOf TeamRuleKind = "MAX_OF"
+)
+
+// TeamRule constrains how reviewer slots of the team are filled. The group of REQUIRE_ONE_OF and
+// MAX_OF rules is either the listed Users or the team members of the given Seniority.
+type TeamRule struct {
+	ID         TeamRuleID
+	TeamName   TeamName
+	Kind       TeamRuleKind
+	ReviewerID UserID
+	AuthorID   UserID
+	Users      []UserID
+	Seniority  Seniority
+	MaxCount   int
+}
+
+// Group returns the users of the rule group among the team members.
+func (r TeamRule) Group(members []TeamMember) []UserID {
+	if r.Seniority == "" {
+		return r.Users
+	}
+
+	group := make([]UserID, 0)
+	for _, member := range members {
+		if member.Seniority == r.Seniority {
+			group = append(group, member.ID)
+		}
+	}
+
+	return group
+}
+
+func (r TeamRule) Validate() error {
+	switch r.Kind {
+	case TeamRuleKindExcludePair:
+		if r.ReviewerID == "" || r.AuthorID == "" {
+			return ErrInvalidTeamRule
+		}
+	case TeamRuleKindRequireOneOf:
+		if !r.hasGroup() {
+			return ErrInvalidTeamRule
+		}
+	case TeamRuleKindMaxOf:
+		if !r.hasGroup() || r.MaxCount < 0 {
+			return ErrInvalidTeamRule
+		}
+	default:
+		return ErrInvalidTeamRule
+	}
+
+	return nil
+}
+
+// hasGroup reports whether the rule names its group in exactly one way.
+func (r TeamRule) hasGroup() bool {
+	if r.Seniority != "" {
+		return r.Seniority.IsValid() && len(r.Users) == 0
+	}
+
+	return len(r.Users) > 0
+}
+
+// UnfilledSlot describes a reviewer slot of the team that could not be filled because of a rule,
+// a pull request tag that no assigned reviewer carries or a required CODEOWNERS entry.
+// Only the field describing the cause is set.
+type UnfilledSlot struct {
+	TeamName   TeamName
+	Rule       TeamRule
+	Tag        string
+	CodeOwners CodeOwnersEntry
+}
+
+type ReviewerReasonSource string
+
+const (
+	ReviewerReasonRequired    ReviewerReasonSource = "REQUIRED"
+	ReviewerReasonTeamRule    ReviewerReasonSource = "TEAM_RULE"
+	ReviewerReasonCodeOwners  ReviewerReasonSource = "CODEOWNERS"
+	ReviewerReasonTag         ReviewerReasonSource = "TAG"
+	ReviewerReasonRandom      ReviewerReasonSource = "RANDOM"
+	ReviewerReasonLeastLoaded ReviewerReasonSource = "LEAST_LOADED"
+)
+
+// ReviewerReason explains why a reviewer was picked. Fields other than Source
+// and TeamName are set only for the matching source.
+type ReviewerReason struct {
+	Source     ReviewerReasonSource
+	TeamName   TeamName
+	RuleID     TeamRuleID
+	Tag        string
+	CodeOwners CodeOwnersEntry
 }
 
 type TeamStats struct {
@@ -46,11 +202,37 @@ type TeamStats struct {
 	AvgTimeToMergeSec  int64
 }
 
+// User is a team member. ChatHandle and Email are where review notifications are sent;
+// they are empty when the user has not set them. EmailOptOut turns off every email, digests included.
 type User struct {
-	ID       UserID
-	Username string
-	TeamName TeamName
-	IsActive bool
+	ID          UserID
+	Username    string
+	TeamName    TeamName
+	IsActive    bool
+	Seniority   Seniority
+	Tags        []string
+	ChatHandle  string
+	Email       string
+	EmailOptOut bool
+}
+
+// UserFilter narrows user listing. Empty fields are not applied; a user must carry all Tags.
+type UserFilter struct {
+	TeamName  TeamName
+	Seniority Seniority
+	Tags      []string
+}
+
+// ReviewHandover lists the OPEN reviews of a deactivated user: those moved to another reviewer
+// and those left in place because no eligible replacement was found.
+type ReviewHandover struct {
+	Reassigned   []ReviewReassignment
+	Unreassigned []PullRequestID
+}
+
+type ReviewReassignment struct {
+	PullRequestID PullRequestID
+	NewReviewerID UserID
 }
 
 type PullRequest struct {
@@ -59,6 +241,22 @@ type PullRequest struct {
 	AuthorID          UserID
 	Status            PullRequestStatus
 	AssignedReviewers []UserID
+	ReviewerTeams     map[UserID]TeamName
+	Tags              []string
 	CreatedAt         *time.Time
 	MergedAt          *time.Time
+	// UnfilledSlots and AssignmentReasons are filled by reviewer assignment and are not persisted.
+	UnfilledSlots     []UnfilledSlot
+	AssignmentReasons map[UserID]ReviewerReason
+}
+
+type CreatePullRequestOptions struct {
+	ExtraTeams        []TeamName
+	RequiredReviewers []UserID
+	// Tags require at least one assigned reviewer carrying each tag.
+	Tags []string
+	// ChangedFiles are matched against CODEOWNERS of the teams filling reviewer slots.
+	ChangedFiles []string
+	// Draft creates the pull request in DRAFT status; reviewers are still assigned.
+	Draft bool
 }
@@ -0,0 +1,71 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+type AggregateType string
+
+const (
+	AggregatePullRequest AggregateType = "PULL_REQUEST"
+	AggregateUser        AggregateType = "USER"
+	AggregateTeam        AggregateType = "TEAM"
+)
+
+type OutboxStatus string
+
+const (
+	OutboxPending   OutboxStatus = "PENDING"
+	OutboxPublished OutboxStatus = "PUBLISHED"
+	// OutboxFailed marks a message the relay gave up on; later messages of its aggregate go ahead.
+	OutboxFailed OutboxStatus = "FAILED"
+)
+
+// OutboxMessage is an event stored with the state change that produced it and relayed to sinks afterwards.
+// Messages of one aggregate are relayed strictly in ID order.
+type OutboxMessage struct {
+	ID int64
+	// TxID is the transaction that wrote the message; it orders the event stream.
+	TxID          int64
+	AggregateType AggregateType
+	AggregateID   string
+	EventType     EventType
+	Payload       []byte
+	OccurredAt    time.Time
+	Status        OutboxStatus
+	// DeliveredSinks names the sinks that accepted the message; a retry skips them.
+	DeliveredSinks []string
+	Attempts       int
+	LastError      string
+	NextAttemptAt  time.Time
+	PublishedAt    *time.Time
+}
+
+// StreamCursor is the event stream position right after the message.
+func (m OutboxMessage) StreamCursor() EventStreamCursor {
+	return EventStreamCursor{TxID: m.TxID, ID: m.ID}
+}
+
+// NewOutboxMessage encodes the event for the outbox.
+func NewOutboxMessage(event Event) (OutboxMessage, error) {
+	aggregateType, aggregateID := event.Aggregate()
+	if aggregateType == "" {
+		return OutboxMessage{}, errors.New("event has no aggregate")
+	}
+
+	payload, err := EncodeEvent(event)
+	if err != nil {
+		return OutboxMessage{}, err
+	}
+
+	return OutboxMessage{
+		AggregateType: aggregateType,
+		AggregateID:   aggregateID,
+		EventType:     event.Type,
+		Payload:       payload,
+		OccurredAt:    event.OccurredAt,
+		Status:        OutboxPending,
+		NextAttemptAt: event.OccurredAt,
+	}, nil
+}
@@ -0,0 +1,86 @@
+package domain
+
+import (
+	"slices"
+	"strings"
+)
+
+// ProvisioningUserFilter narrows the users an identity provider looks up. Empty fields are not applied;
+// Username and Email match case-insensitively.
+type ProvisioningUserFilter struct {
+	ID       UserID
+	Username string
+	Email    string
+	TeamName TeamName
+	IsActive *bool
+}
+
+func (f ProvisioningUserFilter) Matches(user User) bool {
+	return (f.ID == "" || user.ID == f.ID) &&
+		(f.Username == "" || strings.EqualFold(user.Username, f.Username)) &&
+		(f.Email == "" || strings.EqualFold(user.Email, f.Email)) &&
+		(f.TeamName == "" || user.TeamName == f.TeamName) &&
+		(f.IsActive == nil || user.IsActive == *f.IsActive)
+}
+
+// ProvisioningGroupFilter narrows the teams an identity provider looks up; Name matches case-insensitively.
+type ProvisioningGroupFilter struct {
+	Name TeamName
+}
+
+func (f ProvisioningGroupFilter) Matches(team Team) bool {
+	return f.Name == "" || strings.EqualFold(string(team.Name), string(f.Name))
+}
+
+// GroupMembers returns the members of a team as provisioned: its active users.
+func GroupMembers(team Team) []TeamMember {
+	members := make([]TeamMember, 0, len(team.Members))
+	for _, member := range team.Members {
+		if member.IsActive {
+			members = append(members, member)
+		}
+	}
+
+	return members
+}
+
+type GroupMembersOpKind string
+
+const (
+	GroupMembersAdd     GroupMembersOpKind = "add"
+	GroupMembersRemove  GroupMembersOpKind = "remove"
+	GroupMembersReplace GroupMembersOpKind = "replace"
+)
+
+// GroupMembersOp is a change of the member list of a provisioned group.
+type GroupMembersOp struct {
+	Kind    GroupMembersOpKind
+	UserIDs []UserID
+}
+
+// ApplyGroupMembersOps applies the operations in order and returns the resulting member list
+// without duplicates, in the order the members were first added.
+func ApplyGroupMembersOps(members []UserID, ops []GroupMembersOp) []UserID {
+	result := slices.Clone(members)
+	for _, op := range ops {
+		switch op.Kind {
+		case GroupMembersAdd:
+			result = append(result, op.UserIDs...)
+		case GroupMembersRemove:
+			result = slices.DeleteFunc(result, func(id UserID) bool {
+				return slices.Contains(op.UserIDs, id)
+			})
+		case GroupMembersReplace:
+			result = slices.Clone(op.UserIDs)
+		}
+	}
+
+	seen := make(map[UserID]struct{}, len(result))
+	return slices.DeleteFunc(result, func(id UserID) bool {
+		if _, ok := seen[id]; ok {
+			return true
+		}
+		seen[id] = struct{}{}
+		return false
+	})
+}
@@ -0,0 +1,66 @@
+package domain
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestApplyGroupMembersOps(t *testing.T) {
+	tests := []struct {
+		name string
+		ops  []GroupMembersOp
+		want []UserID
+	}{
+		{name: "no ops", want: []UserID{"u1", "u2"}},
+		{
+			name: "add skips duplicates",
+			ops:  []GroupMembersOp{{Kind: GroupMembersAdd, UserIDs: []UserID{"u2", "u3", "u3"}}},
+			want: []UserID{"u1", "u2", "u3"},
+		},
+		{
+			name: "remove",
+			ops:  []GroupMembersOp{{Kind: GroupMembersRemove, UserIDs: []UserID{"u1", "u9"}}},
+			want: []UserID{"u2"},
+		},
+		{
+			name: "ops apply in order",
+			ops: []GroupMembersOp{
+				{Kind: GroupMembersReplace, UserIDs: []UserID{"u3"}},
+				{Kind: GroupMembersAdd, UserIDs: []UserID{"u1"}},
+				{Kind: GroupMembersRemove, UserIDs: []UserID{"u3"}},
+			},
+			want: []UserID{"u1"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			members := []UserID{"u1", "u2"}
+			got := ApplyGroupMembersOps(members, tt.ops)
+			if !slices.Equal(got, tt.want) {
+				t.Fatalf("expected %v, got %v", tt.want, got)
+			}
+			if !slices.Equal(members, []UserID{"u1", "u2"}) {
+				t.Fatalf("members were modified: %v", members)
+			}
+		})
+	}
+}
+
+func TestProvisioningUserFilter_Matches(t *testing.T) {
+	user := User{ID: "u1", Username: "Alice", Email: "alice@example.com", TeamName: "backend", IsActive: true}
+	inactive := false
+
+	if !(ProvisioningUserFilter{}).Matches(user) {
+		t.Fatalf("empty filter must match")
+	}
+	if !(ProvisioningUserFilter{Username: "alice", Email: "ALICE@example.com"}).Matches(user) {
+		t.Fatalf("username and email must match case-insensitively")
+	}
+	if (ProvisioningUserFilter{IsActive: &inactive}).Matches(user) {
+		t.Fatalf("active user must not match inactive filter")
+	}
+	if (ProvisioningUserFilter{ID: "u1", TeamName: "frontend"}).Matches(user) {
+		t.Fatalf("every field must match")
+	}
+}
@@ -1,17 +1,51 @@
 package domain
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
 type TeamRepository interface {
 	Create(ctx context.Context, name TeamName) error
 	GetByName(ctx context.Context, name TeamName) (*Team, error)
 	GetByUserID(ctx context.Context, userID UserID) (*Team, error)
+	// List returns every team with its members, ordered by name; rules and CODEOWNERS are not loaded.
+	List(ctx context.Context) ([]Team, error)
 	GetStats(ctx context.Context, name TeamName) (*TeamStats, error)
+	// GetStatsTimeSeries returns the UTC-aligned buckets of the interval covering the window, oldest first.
+	GetStatsTimeSeries(
+		ctx context.Context,
+		name TeamName,
+		interval StatsInterval,
+		window StatsWindow,
+	) (*TeamStatsTimeSeries, error)
+	SetMaxReviewers(ctx context.Context, name TeamName, maxReviewers int) error
 }
+
+type TeamRuleRepository interface {
+	Create(ctx context.Context, rule *TeamRule) error
+	Delete(ctx context.Context, name TeamName, id TeamRuleID) error
+}
+
+type CodeOwnersRepository interface {
+	Upsert(ctx context.Context, codeOwners *TeamCodeOwners) error
+}
+
 type UserRepository interface {
 	UpsertBatch(ctx context.Context, users []User) error
 	GetByID(ctx context.Context, id UserID) (*User, error)
 	Update(ctx context.Context, user *User) error
+	List(ctx context.Context, filter UserFilter) ([]User, error)
+}
+
+type ExternalAccountRepository interface {
+	Upsert(ctx context.Context, account ExternalAccount) error
+	GetUserID(ctx context.Context, provider ExternalProvider, login string) (UserID, error)
+}
+
+type WebhookDeliveryRepository interface {
+	// Register records the delivery and reports false when it was already recorded.
+	Register(ctx context.Context, provider ExternalProvider, deliveryID string) (bool, error)
 }
 
 type PullRequestRepository interface {
@@ -19,4 +53,87 @@ type PullRequestRepository interface {
 	GetByID(ctx context.Context, id PullRequestID) (*PullRequest, error)
 	ListByReviewer(ctx context.Context, reviewerID UserID) ([]PullRequest, error)
 	Update(ctx context.Context, pr *PullRequest) error
+	CountOpenReviews(ctx context.Context, reviewerIDs []UserID) (map[UserID]int, error)
+}
+
+type ReviewSLARepository interface {
+	Upsert(ctx context.Context, sla ReviewSLA) error
+	Get(ctx context.Context, name TeamName) (*ReviewSLA, error)
+	Delete(ctx context.Context, name TeamName) error
+	// ListOverdue returns up to limit assignments on OPEN pull requests whose next SLA step is due at now:
+	// PENDING ones past FirstReviewWithin and NOTIFIED ones past EscalateAfter since the reminder.
+	// ESCALATED and REVIEWED assignments are never returned.
+	ListOverdue(ctx context.Context, now time.Time, limit int) ([]OverdueReview, error)
+	// MarkReviewed moves the assignment to REVIEWED from any stage; it fails with ErrReviewerIsNotAssigned
+	// when the reviewer is not assigned to the pull request.
+	MarkReviewed(ctx context.Context, id PullRequestID, reviewerID UserID, at time.Time) error
+	// SetStage moves the assignment from one stage to another and reports false when it is no longer
+	// in the from stage, e.g. because another worker got to it first.
+	SetStage(
+		ctx context.Context,
+		id PullRequestID,
+		reviewerID UserID,
+		from, to ReviewSLAStage,
+		at time.Time,
+	) (bool, error)
+}
+
+type PullRequestHistoryRepository interface {
+	Append(ctx context.Context, entry *PullRequestHistoryEntry) error
+	List(ctx context.Context, id PullRequestID) ([]PullRequestHistoryEntry, error)
+}
+
+type WebhookSubscriptionRepository interface {
+	Create(ctx context.Context, subscription *WebhookSubscription) error
+	GetByID(ctx context.Context, id WebhookSubscriptionID) (*WebhookSubscription, error)
+	List(ctx context.Context) ([]WebhookSubscription, error)
+	ListByEventType(ctx context.Context, eventType EventType) ([]WebhookSubscription, error)
+	Delete(ctx context.Context, id WebhookSubscriptionID) error
+	EnqueueDeliveries(ctx context.Context, deliveries []WebhookSubscriptionDelivery) error
+	// ClaimDueDeliveries returns up to limit pending deliveries due at now and postpones them by lease,
+	// so concurrent workers do not send them twice.
+	ClaimDueDeliveries(
+		ctx context.Context,
+		now time.Time,
+		lease time.Duration,
+		limit int,
+	) ([]WebhookSubscriptionDelivery, error)
+	UpdateDelivery(ctx context.Context, delivery WebhookSubscriptionDelivery) error
+	ListDeliveries(ctx context.Context, id WebhookSubscriptionID, limit int) ([]WebhookSubscriptionDelivery, error)
+}
+
+type OutboxRepository interface {
+	Append(ctx context.Context, messages []OutboxMessage) error
+	// ClaimPending leases up to limit due messages that are the oldest pending message of their aggregate:
+	// their next attempt is moved to now+lease, so other relays skip them while sinks run.
+	ClaimPending(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]OutboxMessage, error)
+	Update(ctx context.Context, message OutboxMessage) error
+	// ListAfter returns up to limit messages of the given types past the cursor, in stream order,
+	// whether relayed or not. Messages of transactions that may still be running are held back.
+	ListAfter(ctx context.Context, after EventStreamCursor, eventTypes []EventType, limit int) ([]OutboxMessage, error)
+	// LatestCursor returns the position of the newest message ListAfter can return,
+	// or the zero cursor when there is none.
+	LatestCursor(ctx context.Context) (EventStreamCursor, error)
+}
+
+type StatsRepository interface {
+	UserReviewStats(ctx context.Context, filter UserStatsFilter) ([]UserReviewStats, error)
+	// Overview fills every field of the overview but GeneratedAt; lists hold at most limit entries.
+	Overview(ctx context.Context, limit int) (*StatsOverview, error)
+}
+
+// ExportRepository passes every matching row to fn without loading the result into memory.
+// An error returned by fn stops the export and is returned as is.
+type ExportRepository interface {
+	StreamPullRequests(ctx context.Context, filter PullRequestExportFilter, fn func(PullRequestExportRow) error) error
+	StreamAssignments(ctx context.Context, filter AssignmentExportFilter, fn func(AssignmentExportRow) error) error
+}
+
+type APIKeyRepository interface {
+	Create(ctx context.Context, key *APIKey) error
+	// GetByHash returns the key with the secret hash, revoked or not.
+	GetByHash(ctx context.Context, hash string) (*APIKey, error)
+	List(ctx context.Context) ([]APIKey, error)
+	// Revoke marks the key revoked at the given time; revoking a revoked key keeps the first time.
+	Revoke(ctx context.Context, id APIKeyID, at time.Time) (*APIKey, error)
 }
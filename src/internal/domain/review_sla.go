@@ -0,0 +1,78 @@
+package domain
+
+import "time"
+
+type ReviewSLAAction string
+
+const (
+	// ReviewSLAActionReassign hands an overdue review to another eligible member of the team.
+	ReviewSLAActionReassign ReviewSLAAction = "REASSIGN"
+	// ReviewSLAActionEscalate reports an overdue review to the active leads of the team.
+	ReviewSLAActionEscalate ReviewSLAAction = "ESCALATE"
+)
+
+// ReviewSLA is how long reviewers of the team may leave an assignment pending. A reviewer is reminded
+// FirstReviewWithin after the assignment and Action is taken EscalateAfter after the reminder.
+type ReviewSLA struct {
+	TeamName          TeamName
+	FirstReviewWithin time.Duration
+	EscalateAfter     time.Duration
+	Action            ReviewSLAAction
+}
+
+func (s ReviewSLA) Validate() error {
+	if s.FirstReviewWithin <= 0 || s.EscalateAfter < 0 {
+		return ErrInvalidReviewSLA
+	}
+
+	switch s.Action {
+	case ReviewSLAActionReassign, ReviewSLAActionEscalate:
+		return nil
+	default:
+		return ErrInvalidReviewSLA
+	}
+}
+
+// ReviewSLAStage is how far the SLA of one assignment has been enforced.
+type ReviewSLAStage string
+
+const (
+	ReviewSLAStagePending   ReviewSLAStage = "PENDING"
+	ReviewSLAStageNotified  ReviewSLAStage = "NOTIFIED"
+	ReviewSLAStageEscalated ReviewSLAStage = "ESCALATED"
+	// ReviewSLAStageReviewed ends the SLA of an assignment: its reviewer has submitted a review.
+	ReviewSLAStageReviewed ReviewSLAStage = "REVIEWED"
+)
+
+// OverdueReview is an assignment on an OPEN pull request whose next SLA step is due.
+type OverdueReview struct {
+	PullRequestID PullRequestID
+	ReviewerID    UserID
+	Stage         ReviewSLAStage
+	AssignedAt    time.Time
+	SLA           ReviewSLA
+}
+
+type PullRequestHistoryAction string
+
+const (
+	HistoryReviewSLANotified   PullRequestHistoryAction = "REVIEW_SLA_NOTIFIED"
+	HistoryReviewSLAReassigned PullRequestHistoryAction = "REVIEW_SLA_REASSIGNED"
+	HistoryReviewSLAEscalated  PullRequestHistoryAction = "REVIEW_SLA_ESCALATED"
+	// HistoryReviewerDeactivated records a review handed over because its reviewer was deactivated.
+	HistoryReviewerDeactivated PullRequestHistoryAction = "REVIEWER_DEACTIVATED"
+)
+
+// PullRequestHistoryEntry records an automatic action taken on a pull request.
+// TargetIDs are the users the action was handed to: the new reviewer or the notified leads.
+// Actor is the principal whose request caused the action, empty for background jobs like the SLA worker.
+type PullRequestHistoryEntry struct {
+	ID            int64
+	PullRequestID PullRequestID
+	Action        PullRequestHistoryAction
+	ReviewerID    UserID
+	TargetIDs     []UserID
+	Details       string
+	Actor         string
+	OccurredAt    time.Time
+}
@@ -3,18 +3,115 @@ package domain
 import "context"
 
 type PullRequestService interface {
-	Create(ctx context.Context, id PullRequestID, pullRequestName string, userID UserID) (*PullRequest, error)
+	Create(
+		ctx context.Context,
+		id PullRequestID,
+		pullRequestName string,
+		userID UserID,
+		opts CreatePullRequestOptions,
+	) (*PullRequest, error)
 	Merge(ctx context.Context, id PullRequestID) (*PullRequest, error)
-	Reassign(ctx context.Context, id PullRequestID, oldRevID UserID) (*PullRequest, UserID, error)
+	Close(ctx context.Context, id PullRequestID) (*PullRequest, error)
+	MarkReady(ctx context.Context, id PullRequestID) (*PullRequest, error)
+	Reopen(ctx context.Context, id PullRequestID) (*PullRequest, error)
+	UpdateDetails(ctx context.Context, id PullRequestID, name string, draft bool) (*PullRequest, error)
+	Reassign(ctx context.Context, id PullRequestID, oldRevID, newRevID UserID) (*PullRequest, UserID, error)
+	AddReviewer(ctx context.Context, id PullRequestID, reviewerID UserID) (*PullRequest, error)
+	RemoveReviewer(ctx context.Context, id PullRequestID, reviewerID UserID) (*PullRequest, error)
 }
 
 type TeamService interface {
 	Create(ctx context.Context, team *Team) (*Team, error)
 	Get(ctx context.Context, name TeamName) (*Team, error)
 	GetStats(ctx context.Context, name TeamName) (*TeamStats, error)
+	GetStatsTimeSeries(
+		ctx context.Context,
+		name TeamName,
+		interval StatsInterval,
+		window StatsWindow,
+	) (*TeamStatsTimeSeries, error)
+	SetMaxReviewers(ctx context.Context, name TeamName, maxReviewers int) (*Team, error)
+	Import(ctx context.Context, doc TeamImport, dryRun bool) (*TeamImportDiff, error)
+}
+
+type TeamRuleService interface {
+	Create(ctx context.Context, rule *TeamRule) (*TeamRule, error)
+	List(ctx context.Context, name TeamName) ([]TeamRule, error)
+	Delete(ctx context.Context, name TeamName, id TeamRuleID) error
+}
+
+type CodeOwnersService interface {
+	Upload(ctx context.Context, codeOwners *TeamCodeOwners) (*TeamCodeOwners, error)
+	Get(ctx context.Context, name TeamName) (*TeamCodeOwners, error)
 }
 
 type UserService interface {
-	SetIsActive(ctx context.Context, userID UserID, isActive bool) (*User, error)
+	SetIsActive(ctx context.Context, userID UserID, isActive, reassignReviews bool) (*User, *ReviewHandover, error)
+	HandOverReviews(ctx context.Context, userID UserID) (*ReviewHandover, error)
 	GetPrs(ctx context.Context, userID UserID) ([]PullRequest, error)
+	List(ctx context.Context, filter UserFilter) ([]User, error)
+	LinkExternalAccount(ctx context.Context, account ExternalAccount) (*ExternalAccount, error)
+	SetChatHandle(ctx context.Context, userID UserID, chatHandle string) (*User, error)
+	SetEmail(ctx context.Context, userID UserID, email string, optOut bool) (*User, error)
+}
+
+type ReviewSLAService interface {
+	Set(ctx context.Context, sla ReviewSLA) (*ReviewSLA, error)
+	Get(ctx context.Context, name TeamName) (*ReviewSLA, error)
+	Delete(ctx context.Context, name TeamName) error
+	History(ctx context.Context, id PullRequestID) ([]PullRequestHistoryEntry, error)
+	MarkReviewed(ctx context.Context, id PullRequestID, reviewerID UserID) (*PullRequest, error)
+}
+
+type PullRequestEventService interface {
+	Handle(ctx context.Context, event PullRequestEvent) (*PullRequest, error)
+}
+
+type WebhookSubscriptionService interface {
+	Create(ctx context.Context, subscription *WebhookSubscription) (*WebhookSubscription, error)
+	List(ctx context.Context) ([]WebhookSubscription, error)
+	Delete(ctx context.Context, id WebhookSubscriptionID) error
+	ListDeliveries(ctx context.Context, id WebhookSubscriptionID, limit int) ([]WebhookSubscriptionDelivery, error)
+}
+
+type EventStreamService interface {
+	LatestCursor(ctx context.Context) (EventStreamCursor, error)
+	ListAfter(ctx context.Context, filter EventStreamFilter, after EventStreamCursor) (*EventStreamPage, error)
+}
+
+type StatsService interface {
+	UserReviewStats(ctx context.Context, filter UserStatsFilter) ([]UserReviewStats, error)
+	Fairness(ctx context.Context, name TeamName, window StatsWindow) (*FairnessReport, error)
+	Overview(ctx context.Context) (*StatsOverview, error)
+}
+
+type ExportService interface {
+	ExportPullRequests(ctx context.Context, filter PullRequestExportFilter, fn func(PullRequestExportRow) error) error
+	ExportAssignments(ctx context.Context, filter AssignmentExportFilter, fn func(AssignmentExportRow) error) error
+}
+
+type TeamSyncService interface {
+	Sync(ctx context.Context, team Team) (*TeamSyncResult, error)
+}
+
+// ProvisioningService manages users and teams on behalf of an identity provider. A team is provisioned
+// as a group whose members are its active users.
+type ProvisioningService interface {
+	ListUsers(ctx context.Context, filter ProvisioningUserFilter) ([]User, error)
+	GetUser(ctx context.Context, id UserID) (*User, error)
+	CreateUser(ctx context.Context, user User) (*User, error)
+	ReplaceUser(ctx context.Context, user User) (*User, error)
+	DeactivateUser(ctx context.Context, id UserID) (*User, error)
+	ListGroups(ctx context.Context, filter ProvisioningGroupFilter) ([]Team, error)
+	GetGroup(ctx context.Context, name TeamName) (*Team, error)
+	CreateGroup(ctx context.Context, name TeamName, memberIDs []UserID) (*Team, error)
+	UpdateGroupMembers(ctx context.Context, name TeamName, ops []GroupMembersOp) (*Team, error)
+}
+
+type APIKeyService interface {
+	Create(ctx context.Context, name string, scopes []APIKeyScope) (*IssuedAPIKey, error)
+	List(ctx context.Context) ([]APIKey, error)
+	Revoke(ctx context.Context, id APIKeyID) (*APIKey, error)
+	// Authenticate returns the active key of the secret, or ErrAPIKeyNotFound.
+	Authenticate(ctx context.Context, secret string) (*APIKey, error)
 }
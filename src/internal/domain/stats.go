@@ -0,0 +1,238 @@
+package domain
+
+import (
+	"math"
+	"time"
+)
+
+// StatsWindow limits statistics to assignments made in [From, To). A zero bound is not applied.
+type StatsWindow struct {
+	From time.Time
+	To   time.Time
+}
+
+func (w StatsWindow) Validate() error {
+	if !w.From.IsZero() && !w.To.IsZero() && !w.From.Before(w.To) {
+		return ErrInvalidStatsWindow
+	}
+
+	return nil
+}
+
+// UserStatsFilter narrows per-user statistics to the members of a team; an empty TeamName covers every user.
+type UserStatsFilter struct {
+	TeamName TeamName
+	Window   StatsWindow
+}
+
+// UserReviewStats is the review load of a user over the assignments made in the window.
+// OpenReviews and CompletedReviews count assignments still held on OPEN (or DRAFT) and MERGED pull requests,
+// ReassignedAway those handed to another reviewer. MedianTimeToMergeSec is measured from the assignment
+// to the merge of completed reviews and is 0 when there are none.
+type UserReviewStats struct {
+	UserID               UserID
+	Username             string
+	TeamName             TeamName
+	IsActive             bool
+	TotalAssignments     int
+	OpenReviews          int
+	CompletedReviews     int
+	ReassignedAway       int
+	MedianTimeToMergeSec int64
+}
+
+// Members whose assignment count is outside [fairnessLowBound, fairnessHighBound] times the team mean
+// are reported as outliers.
+const (
+	fairnessLowBound  = 0.5
+	fairnessHighBound = 1.5
+)
+
+type FairnessOutlierKind string
+
+const (
+	FairnessOverloaded  FairnessOutlierKind = "OVERLOADED"
+	FairnessUnderloaded FairnessOutlierKind = "UNDERLOADED"
+)
+
+type MemberLoad struct {
+	UserID      UserID
+	Username    string
+	Assignments int
+}
+
+// FairnessOutlier is a member whose load is far from the team mean; Ratio is their count over the mean.
+type FairnessOutlier struct {
+	MemberLoad
+	Kind  FairnessOutlierKind
+	Ratio float64
+}
+
+// FairnessReport describes how evenly the assignments made in the window are spread over the active
+// members of a team. Gini is 0 for a perfectly even spread and approaches 1 when one member gets everything.
+// MaxMinRatio is nil when the least loaded member has no assignments.
+type FairnessReport struct {
+	TeamName         TeamName
+	Window           StatsWindow
+	Members          []MemberLoad
+	TotalAssignments int
+	MeanAssignments  float64
+	Gini             float64
+	MaxMinRatio      *float64
+	Outliers         []FairnessOutlier
+}
+
+// NewFairnessReport computes the fairness metrics over the loads of the active members.
+func NewFairnessReport(teamName TeamName, window StatsWindow, members []MemberLoad) FairnessReport {
+	report := FairnessReport{
+		TeamName: teamName,
+		Window:   window,
+		Members:  members,
+		Outliers: make([]FairnessOutlier, 0),
+	}
+	if len(members) == 0 {
+		return report
+	}
+
+	minCount, maxCount := members[0].Assignments, members[0].Assignments
+	for _, m := range members {
+		report.TotalAssignments += m.Assignments
+		minCount = min(minCount, m.Assignments)
+		maxCount = max(maxCount, m.Assignments)
+	}
+	if report.TotalAssignments == 0 {
+		return report
+	}
+
+	n := float64(len(members))
+	report.MeanAssignments = float64(report.TotalAssignments) / n
+
+	var absDiffs float64
+	for _, a := range members {
+		for _, b := range members {
+			absDiffs += math.Abs(float64(a.Assignments - b.Assignments))
+		}
+	}
+	report.Gini = absDiffs / (2 * n * n * report.MeanAssignments)
+
+	if minCount > 0 {
+		ratio := float64(maxCount) / float64(minCount)
+		report.MaxMinRatio = &ratio
+	}
+
+	for _, m := range members {
+		outlier := FairnessOutlier{MemberLoad: m, Ratio: float64(m.Assignments) / report.MeanAssignments}
+		switch {
+		case outlier.Ratio > fairnessHighBound:
+			outlier.Kind = FairnessOverloaded
+		case outlier.Ratio < fairnessLowBound:
+			outlier.Kind = FairnessUnderloaded
+		default:
+			continue
+		}
+		report.Outliers = append(report.Outliers, outlier)
+	}
+
+	return report
+}
+
+type StatsInterval string
+
+const (
+	StatsIntervalDay  StatsInterval = "day"
+	StatsIntervalWeek StatsInterval = "week"
+)
+
+// MaxStatsBuckets bounds how many buckets one time series may span.
+const MaxStatsBuckets = 366
+
+// defaultStatsBuckets is how many buckets a time series covers when the window has no start.
+const defaultStatsBuckets = 30
+
+// Duration returns the length of a bucket, or 0 for an unknown interval.
+func (i StatsInterval) Duration() time.Duration {
+	switch i {
+	case StatsIntervalDay:
+		return 24 * time.Hour
+	case StatsIntervalWeek:
+		return 7 * 24 * time.Hour
+	default:
+		return 0
+	}
+}
+
+// SeriesWindow fills in the bounds of a time series window: it ends now and spans
+// defaultStatsBuckets buckets unless set otherwise.
+func (i StatsInterval) SeriesWindow(window StatsWindow, now time.Time) (StatsWindow, error) {
+	step := i.Duration()
+	if step == 0 {
+		return StatsWindow{}, ErrInvalidStatsInterval
+	}
+
+	if window.To.IsZero() {
+		window.To = now
+	}
+	if window.From.IsZero() {
+		window.From = window.To.Add(-defaultStatsBuckets * step)
+	}
+	if err := window.Validate(); err != nil {
+		return StatsWindow{}, err
+	}
+	if window.To.Sub(window.From) > MaxStatsBuckets*step {
+		return StatsWindow{}, ErrInvalidStatsWindow
+	}
+
+	return window, nil
+}
+
+// TeamStatsBucket holds the throughput of a team over [Start, End). Pull requests are counted in the bucket
+// they were opened or merged in; time to merge is measured from opening and is 0 when nothing was merged.
+type TeamStatsBucket struct {
+	Start                time.Time
+	End                  time.Time
+	OpenedPRs            int
+	MergedPRs            int
+	MedianTimeToMergeSec int64
+	P90TimeToMergeSec    int64
+	Reassignments        int
+}
+
+type TeamStatsTimeSeries struct {
+	TeamName TeamName
+	Interval StatsInterval
+	Buckets  []TeamStatsBucket
+}
+
+// OverviewListLimit bounds the pull request lists of the stats overview.
+const OverviewListLimit = 10
+
+// PullRequestStaffing is an OPEN or DRAFT pull request with the number of its reviewers that are
+// active members of the author's team, against the limit of that team.
+type PullRequestStaffing struct {
+	ID           PullRequestID
+	Name         string
+	AuthorID     UserID
+	TeamName     TeamName
+	Status       PullRequestStatus
+	CreatedAt    *time.Time
+	Reviewers    int
+	MaxReviewers int
+}
+
+// StatsOverview aggregates the state of every team. TeamsWithoutReviewers are the teams with fewer than
+// two active members, whose authors cannot get a reviewer from their own team. UnderstaffedPRs lists the oldest
+// of the UnderstaffedPRsCount open pull requests with fewer reviewers than the author's team allows.
+type StatsOverview struct {
+	Teams                 int
+	ActiveUsers           int
+	InactiveUsers         int
+	OpenPRs               int
+	DraftPRs              int
+	MergedPRs             int
+	ClosedPRs             int
+	OldestOpenPRs         []PullRequestStaffing
+	TeamsWithoutReviewers []TeamName
+	UnderstaffedPRsCount  int
+	UnderstaffedPRs       []PullRequestStaffing
+	GeneratedAt           time.Time
+}
@@ -0,0 +1,56 @@
+package domain
+
+import (
+	"math"
+	"testing"
+)
+
+func TestNewFairnessReport_EvenSpread(t *testing.T) {
+	report := NewFairnessReport("backend", StatsWindow{}, []MemberLoad{
+		{UserID: "u1", Assignments: 4},
+		{UserID: "u2", Assignments: 4},
+		{UserID: "u3", Assignments: 4},
+	})
+
+	if report.Gini != 0 || report.MaxMinRatio == nil || *report.MaxMinRatio != 1 {
+		t.Fatalf("expected a perfectly fair report, got %+v", report)
+	}
+	if report.TotalAssignments != 12 || report.MeanAssignments != 4 || len(report.Outliers) != 0 {
+		t.Fatalf("unexpected report: %+v", report)
+	}
+}
+
+func TestNewFairnessReport_SkewedSpread(t *testing.T) {
+	report := NewFairnessReport("backend", StatsWindow{}, []MemberLoad{
+		{UserID: "u1", Assignments: 10},
+		{UserID: "u2", Assignments: 4},
+		{UserID: "u3", Assignments: 4},
+		{UserID: "u4", Assignments: 0},
+	})
+
+	// sum of |xi - xj| over ordered pairs is 2 * (6 + 6 + 10 + 0 + 4 + 4) = 60; 60 / (2 * 16 * 4.5)
+	if want := 60.0 / 144; math.Abs(report.Gini-want) > 1e-9 {
+		t.Fatalf("expected gini %f, got %f", want, report.Gini)
+	}
+	if report.MaxMinRatio != nil {
+		t.Fatalf("expected no max/min ratio with an idle member, got %f", *report.MaxMinRatio)
+	}
+
+	if len(report.Outliers) != 2 {
+		t.Fatalf("expected two outliers, got %+v", report.Outliers)
+	}
+	if o := report.Outliers[0]; o.UserID != "u1" || o.Kind != FairnessOverloaded {
+		t.Fatalf("unexpected first outlier: %+v", o)
+	}
+	if o := report.Outliers[1]; o.UserID != "u4" || o.Kind != FairnessUnderloaded || o.Ratio != 0 {
+		t.Fatalf("unexpected second outlier: %+v", o)
+	}
+}
+
+func TestNewFairnessReport_NoAssignments(t *testing.T) {
+	report := NewFairnessReport("backend", StatsWindow{}, []MemberLoad{{UserID: "u1"}, {UserID: "u2"}})
+
+	if report.Gini != 0 || report.MaxMinRatio != nil || len(report.Outliers) != 0 {
+		t.Fatalf("expected an empty report, got %+v", report)
+	}
+}
@@ -0,0 +1,166 @@
+package domain
+
+import (
+	"fmt"
+	"slices"
+)
+
+// TeamImport is the desired state of the teams listed in an import document. Members of a listed team
+// missing from the document are deactivated; teams that are not listed are left alone.
+// Team.MaxReviewers is applied only when positive.
+type TeamImport struct {
+	Teams []Team
+}
+
+// Validate reports the first problem of the document wrapped in ErrInvalidTeamImport.
+func (i TeamImport) Validate() error {
+	if len(i.Teams) == 0 {
+		return fmt.Errorf("%w: no teams", ErrInvalidTeamImport)
+	}
+
+	teams := make(map[TeamName]struct{}, len(i.Teams))
+	users := make(map[UserID]TeamName)
+	for _, team := range i.Teams {
+		if team.Name == "" {
+			return fmt.Errorf("%w: team without a name", ErrInvalidTeamImport)
+		}
+		if _, ok := teams[team.Name]; ok {
+			return fmt.Errorf("%w: team %s is listed twice", ErrInvalidTeamImport, team.Name)
+		}
+		teams[team.Name] = struct{}{}
+
+		if team.MaxReviewers < 0 {
+			return fmt.Errorf("%w: max reviewers of team %s must not be negative", ErrInvalidTeamImport, team.Name)
+		}
+
+		for _, member := range team.Members {
+			if member.ID == "" || member.Username == "" {
+				return fmt.Errorf("%w: member of team %s without an id or a username", ErrInvalidTeamImport, team.Name)
+			}
+			if member.Seniority != "" && !member.Seniority.IsValid() {
+				return fmt.Errorf("%w: unknown seniority %q of user %s",
+					ErrInvalidTeamImport, member.Seniority, member.ID)
+			}
+			if other, ok := users[member.ID]; ok {
+				return fmt.Errorf("%w: user %s is listed in teams %s and %s",
+					ErrInvalidTeamImport, member.ID, other, team.Name)
+			}
+			users[member.ID] = team.Name
+		}
+	}
+
+	return nil
+}
+
+// UserMove is a user that changes team; User holds the state after the import.
+type UserMove struct {
+	User     User
+	FromTeam TeamName
+}
+
+// TeamImportDiff lists the changes an import makes. Users hold their state after the import.
+// A user switched to inactive in its own team is a deactivation rather than an update, and
+// UpdatedTeams are existing teams whose reviewers limit changes.
+type TeamImportDiff struct {
+	CreatedTeams     []TeamName
+	UpdatedTeams     []TeamName
+	CreatedUsers     []User
+	UpdatedUsers     []User
+	MovedUsers       []UserMove
+	DeactivatedUsers []User
+}
+
+func (d TeamImportDiff) IsEmpty() bool {
+	return len(d.CreatedTeams) == 0 &&
+		len(d.UpdatedTeams) == 0 &&
+		len(d.CreatedUsers) == 0 &&
+		len(d.UpdatedUsers) == 0 &&
+		len(d.MovedUsers) == 0 &&
+		len(d.DeactivatedUsers) == 0
+}
+
+// ChangedUsers returns every user the import creates or changes, in their state after it.
+func (d TeamImportDiff) ChangedUsers() []User {
+	users := make([]User, 0, len(d.CreatedUsers)+len(d.UpdatedUsers)+len(d.MovedUsers)+len(d.DeactivatedUsers))
+	users = append(users, d.CreatedUsers...)
+	users = append(users, d.UpdatedUsers...)
+	for _, move := range d.MovedUsers {
+		users = append(users, move.User)
+	}
+
+	return append(users, d.DeactivatedUsers...)
+}
+
+// NewTeamImportDiff compares the document with the existing teams it lists and with every existing user.
+func NewTeamImportDiff(doc TeamImport, existingTeams map[TeamName]*Team, existingUsers []User) TeamImportDiff {
+	var diff TeamImportDiff
+
+	usersByID := make(map[UserID]User, len(existingUsers))
+	for _, user := range existingUsers {
+		usersByID[user.ID] = user
+	}
+
+	listed := make(map[UserID]struct{})
+	for _, team := range doc.Teams {
+		existing, ok := existingTeams[team.Name]
+		switch {
+		case !ok:
+			diff.CreatedTeams = append(diff.CreatedTeams, team.Name)
+		case team.MaxReviewers > 0 && team.MaxReviewers != existing.MaxReviewers:
+			diff.UpdatedTeams = append(diff.UpdatedTeams, team.Name)
+		}
+
+		for _, member := range team.Members {
+			listed[member.ID] = struct{}{}
+			desired := member.ToUser(team.Name)
+
+			current, ok := usersByID[member.ID]
+			switch {
+			case !ok:
+				diff.CreatedUsers = append(diff.CreatedUsers, desired)
+			case current.TeamName != team.Name:
+				diff.MovedUsers = append(diff.MovedUsers, UserMove{User: desired, FromTeam: current.TeamName})
+			case current.IsActive && !desired.IsActive:
+				diff.DeactivatedUsers = append(diff.DeactivatedUsers, desired)
+			case !sameTeamMember(current, desired):
+				diff.UpdatedUsers = append(diff.UpdatedUsers, desired)
+			}
+		}
+	}
+
+	for _, team := range doc.Teams {
+		for _, user := range existingUsers {
+			if user.TeamName != team.Name || !user.IsActive {
+				continue
+			}
+			if _, ok := listed[user.ID]; ok {
+				continue
+			}
+
+			user.IsActive = false
+			diff.DeactivatedUsers = append(diff.DeactivatedUsers, user)
+		}
+	}
+
+	return diff
+}
+
+// sameTeamMember compares the fields an import sets.
+func sameTeamMember(a, b User) bool {
+	return a.Username == b.Username &&
+		a.IsActive == b.IsActive &&
+		a.Seniority == b.Seniority &&
+		slices.Equal(a.Tags, b.Tags)
+}
+
+// UserReviewHandover is the outcome of reassigning the open reviews of a deactivated user.
+type UserReviewHandover struct {
+	UserID UserID
+	ReviewHandover
+}
+
+// TeamSyncResult describes what a team sync changed. Handovers follow DeactivatedUsers of Changes.
+type TeamSyncResult struct {
+	Changes   TeamImportDiff
+	Handovers []UserReviewHandover
+}
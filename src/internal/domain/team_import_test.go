@@ -0,0 +1,107 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestTeamImport_Validate(t *testing.T) {
+	member := TeamMember{ID: "u1", Username: "Alice", IsActive: true}
+
+	tests := []struct {
+		name string
+		doc  TeamImport
+	}{
+		{name: "no teams", doc: TeamImport{}},
+		{name: "team without name", doc: TeamImport{Teams: []Team{{Members: []TeamMember{member}}}}},
+		{name: "duplicate team", doc: TeamImport{Teams: []Team{{Name: "backend"}, {Name: "backend"}}}},
+		{name: "member without username", doc: TeamImport{Teams: []Team{
+			{Name: "backend", Members: []TeamMember{{ID: "u1"}}},
+		}}},
+		{name: "unknown seniority", doc: TeamImport{Teams: []Team{
+			{Name: "backend", Members: []TeamMember{{ID: "u1", Username: "Alice", Seniority: "GURU"}}},
+		}}},
+		{name: "user in two teams", doc: TeamImport{Teams: []Team{
+			{Name: "backend", Members: []TeamMember{member}},
+			{Name: "frontend", Members: []TeamMember{member}},
+		}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.doc.Validate(); !errors.Is(err, ErrInvalidTeamImport) {
+				t.Fatalf("expected ErrInvalidTeamImport, got %v", err)
+			}
+		})
+	}
+}
+
+func TestNewTeamImportDiff(t *testing.T) {
+	doc := TeamImport{Teams: []Team{
+		{
+			Name:         "backend",
+			MaxReviewers: 3,
+			Members: []TeamMember{
+				{ID: "u1", Username: "Alice", IsActive: true},
+				{ID: "u2", Username: "Bobby", IsActive: true},
+				{ID: "u3", Username: "Carol", IsActive: false},
+				{ID: "u5", Username: "Eve", IsActive: true},
+				{ID: "u6", Username: "Frank", IsActive: true},
+			},
+		},
+		{
+			Name:    "mobile",
+			Members: []TeamMember{{ID: "u7", Username: "Grace", IsActive: true}},
+		},
+	}}
+
+	existingTeams := map[TeamName]*Team{"backend": {Name: "backend", MaxReviewers: 2}}
+	existingUsers := []User{
+		{ID: "u1", Username: "Alice", TeamName: "backend", IsActive: true},
+		{ID: "u2", Username: "Bob", TeamName: "backend", IsActive: true},
+		{ID: "u3", Username: "Carol", TeamName: "backend", IsActive: true},
+		{ID: "u4", Username: "Dave", TeamName: "backend", IsActive: true},
+		{ID: "u5", Username: "Eve", TeamName: "frontend", IsActive: true},
+		{ID: "u8", Username: "Heidi", TeamName: "backend", IsActive: false},
+	}
+
+	diff := NewTeamImportDiff(doc, existingTeams, existingUsers)
+
+	if len(diff.CreatedTeams) != 1 || diff.CreatedTeams[0] != "mobile" {
+		t.Fatalf("expected mobile to be created, got %v", diff.CreatedTeams)
+	}
+	if len(diff.UpdatedTeams) != 1 || diff.UpdatedTeams[0] != "backend" {
+		t.Fatalf("expected backend to be updated, got %v", diff.UpdatedTeams)
+	}
+	if len(diff.CreatedUsers) != 2 || diff.CreatedUsers[0].ID != "u6" || diff.CreatedUsers[1].ID != "u7" ||
+		diff.CreatedUsers[1].TeamName != "mobile" {
+		t.Fatalf("expected u6 and u7 to be created, got %+v", diff.CreatedUsers)
+	}
+	if len(diff.UpdatedUsers) != 1 || diff.UpdatedUsers[0].ID != "u2" || diff.UpdatedUsers[0].Username != "Bobby" {
+		t.Fatalf("expected u2 to be renamed, got %+v", diff.UpdatedUsers)
+	}
+	if len(diff.MovedUsers) != 1 || diff.MovedUsers[0].User.ID != "u5" || diff.MovedUsers[0].FromTeam != "frontend" ||
+		diff.MovedUsers[0].User.TeamName != "backend" {
+		t.Fatalf("expected u5 to move from frontend, got %+v", diff.MovedUsers)
+	}
+	if len(diff.DeactivatedUsers) != 2 || diff.DeactivatedUsers[0].ID != "u3" || diff.DeactivatedUsers[1].ID != "u4" ||
+		diff.DeactivatedUsers[1].IsActive {
+		t.Fatalf("expected u3 and u4 to be deactivated, got %+v", diff.DeactivatedUsers)
+	}
+	if len(diff.ChangedUsers()) != 6 {
+		t.Fatalf("expected 6 changed users, got %+v", diff.ChangedUsers())
+	}
+}
+
+func TestNewTeamImportDiff_NoChanges(t *testing.T) {
+	doc := TeamImport{Teams: []Team{{
+		Name:    "backend",
+		Members: []TeamMember{{ID: "u1", Username: "Alice", IsActive: true, Tags: []string{"db"}}},
+	}}}
+	existingTeams := map[TeamName]*Team{"backend": {Name: "backend", MaxReviewers: 2}}
+	existingUsers := []User{{ID: "u1", Username: "Alice", TeamName: "backend", IsActive: true, Tags: []string{"db"}}}
+
+	if diff := NewTeamImportDiff(doc, existingTeams, existingUsers); !diff.IsEmpty() {
+		t.Fatalf("expected no changes, got %+v", diff)
+	}
+}
@@ -0,0 +1,49 @@
+package domain
+
+import (
+	"slices"
+	"time"
+)
+
+type WebhookSubscriptionID int64
+
+// WebhookSubscription receives signed POSTs of the subscribed event types at URL.
+type WebhookSubscription struct {
+	ID         WebhookSubscriptionID
+	URL        string
+	Secret     string
+	EventTypes []EventType
+	CreatedAt  time.Time
+}
+
+func (s WebhookSubscription) Subscribed(eventType EventType) bool {
+	return slices.Contains(s.EventTypes, eventType)
+}
+
+type WebhookDeliveryStatus string
+
+const (
+	WebhookDeliveryPending   WebhookDeliveryStatus = "PENDING"
+	WebhookDeliverySucceeded WebhookDeliveryStatus = "SUCCEEDED"
+	WebhookDeliveryFailed    WebhookDeliveryStatus = "FAILED"
+)
+
+// WebhookSubscriptionDelivery is an attempt log entry of sending one event to one subscription.
+type WebhookSubscriptionDelivery struct {
+	ID             int64
+	SubscriptionID WebhookSubscriptionID
+	// EventID is the outbox message the delivery was created from; a subscription gets each event once.
+	EventID        int64
+	EventType      EventType
+	Payload        []byte
+	Status         WebhookDeliveryStatus
+	Attempts       int
+	NextAttemptAt  time.Time
+	LastStatusCode int
+	LastError      string
+	CreatedAt      time.Time
+	DeliveredAt    *time.Time
+	// URL and Secret are loaded with claimed deliveries to sign and send them.
+	URL    string
+	Secret string
+}
@@ -0,0 +1,168 @@
+package controllers
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"PrService/src/internal/domain"
+	"PrService/src/internal/http_api/middlewares"
+	"PrService/src/internal/http_api/models"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-playground/validator/v10"
+)
+
+type APIKeyController struct {
+	baseController
+	apiKeyService domain.APIKeyService
+}
+
+func NewAPIKeyController(
+	apiKeyService domain.APIKeyService,
+	validate *validator.Validate,
+	logger *slog.Logger,
+) *APIKeyController {
+	return &APIKeyController{
+		baseController: newBaseController(validate, logger),
+		apiKeyService:  apiKeyService,
+	}
+}
+
+func (c *APIKeyController) UseHandlers(r chi.Router) {
+	r.With(middlewares.RequireScope(domain.ScopeAdmin)).Get("/admin/apiKeys", c.list)
+	r.With(middlewares.RequireScope(domain.ScopeAdmin)).Post("/admin/apiKeys", c.create)
+	r.With(middlewares.RequireScope(domain.ScopeAdmin)).Delete("/admin/apiKeys", c.revoke)
+}
+
+// list godoc
+//
+//	@Summary	Получить API-ключи
+//	@Tags		Admin
+//	@Produce	json
+//	@Success	200	{object}	models.APIKeysResponse	"Ключи, включая отозванные"
+//	@Failure	500	{object}	models.ErrorResponse	"Ошибка сервера"
+//	@Security	ApiKeyAuth
+//	@Security	BearerAuth
+//	@Router		/admin/apiKeys [get]
+func (c *APIKeyController) list(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	keys, err := c.apiKeyService.List(ctx)
+	if err != nil {
+		c.writeError(ctx, w, http.StatusInternalServerError,
+			models.ErrorCodeInternalServer,
+			"internal server error",
+			"failed to list API keys",
+			err,
+		)
+		return
+	}
+
+	c.writeJSON(ctx, w, http.StatusOK, models.MapToAPIKeysResponse(keys))
+}
+
+// create godoc
+//
+//	@Summary		Выпустить API-ключ
+//	@Description	Ключ возвращается только в этом ответе: в базе хранится лишь его хеш.
+//	@Tags			Admin
+//	@Accept			json
+//	@Produce		json
+//	@Param			request	body		models.CreateAPIKeyRequest		true	"Create API key body"
+//	@Success		201		{object}	models.CreatedAPIKeyResponse	"Ключ выпущен"
+//	@Failure		400		{object}	models.ErrorResponse			"Неверный запрос"
+//	@Failure		500		{object}	models.ErrorResponse			"Ошибка сервера"
+//	@Security		ApiKeyAuth
+//	@Router			/admin/apiKeys [post]
+func (c *APIKeyController) create(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req models.CreateAPIKeyRequest
+	if ok := c.decodeAndValidate(ctx, w, r, &req, "createAPIKeyRequest"); !ok {
+		return
+	}
+
+	issued, err := c.apiKeyService.Create(ctx, req.Name, req.MapScopesToDomain())
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidAPIKey) {
+			c.writeError(ctx, w, http.StatusBadRequest,
+				models.ErrorCodeValidationFailed,
+				"unknown scope",
+				"invalid API key",
+				err,
+				"scopes", req.Scopes,
+			)
+			return
+		}
+
+		c.writeError(ctx, w, http.StatusInternalServerError,
+			models.ErrorCodeInternalServer,
+			"internal server error",
+			"failed to create API key",
+			err,
+			"name", req.Name,
+		)
+		return
+	}
+
+	resp := models.CreatedAPIKeyResponse{
+		APIKey: models.MapToAPIKeyResponse(issued.Key),
+		Key:    issued.Secret,
+	}
+	c.writeJSON(ctx, w, http.StatusCreated, resp)
+}
+
+// revoke godoc
+//
+//	@Summary		Отозвать API-ключ
+//	@Description	Отозванный ключ остаётся в списке, но больше не принимается.
+//	@Tags			Admin
+//	@Produce		json
+//	@Param			api_key_id	query		int								true	"Идентификатор ключа"
+//	@Success		200			{object}	models.APIKeyEnvelopeResponse	"Ключ отозван"
+//	@Failure		400			{object}	models.ErrorResponse			"Неверный запрос"
+//	@Failure		404			{object}	models.ErrorResponse			"Ключ не найден"
+//	@Failure		500			{object}	models.ErrorResponse			"Ошибка сервера"
+//	@Security		ApiKeyAuth
+//	@Router			/admin/apiKeys [delete]
+func (c *APIKeyController) revoke(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id, err := strconv.ParseInt(r.URL.Query().Get("api_key_id"), 10, 64)
+	if err != nil {
+		c.writeError(ctx, w, http.StatusBadRequest,
+			models.ErrorCodeValidationFailed,
+			"numeric api_key_id is required",
+			"invalid api_key_id to revoke API key",
+			err,
+		)
+		return
+	}
+
+	key, err := c.apiKeyService.Revoke(ctx, domain.APIKeyID(id))
+	if err != nil {
+		if errors.Is(err, domain.ErrAPIKeyNotFound) {
+			c.writeError(ctx, w, http.StatusNotFound,
+				models.ErrorCodeNotFound,
+				"resource not found",
+				"API key not found",
+				err,
+				"api_key_id", id,
+			)
+			return
+		}
+
+		c.writeError(ctx, w, http.StatusInternalServerError,
+			models.ErrorCodeInternalServer,
+			"internal server error",
+			"failed to revoke API key",
+			err,
+			"api_key_id", id,
+		)
+		return
+	}
+
+	c.writeJSON(ctx, w, http.StatusOK, models.APIKeyEnvelopeResponse{APIKey: models.MapToAPIKeyResponse(*key)})
+}
@@ -0,0 +1,109 @@
+package controllers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"PrService/src/internal/domain"
+	"PrService/src/internal/http_api/mocks"
+	"PrService/src/internal/http_api/models"
+
+	"github.com/go-playground/validator/v10"
+	"go.uber.org/mock/gomock"
+)
+
+func newAPIKeyController(t *testing.T) (*APIKeyController, *mocks.MockAPIKeyService) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	svc := mocks.NewMockAPIKeyService(ctrl)
+
+	validate := validator.New()
+	logger := newTestLogger()
+
+	c := NewAPIKeyController(svc, validate, logger)
+
+	return c, svc
+}
+
+func TestAPIKeyController_Create_Success(t *testing.T) {
+	c, svc := newAPIKeyController(t)
+
+	svc.
+		EXPECT().
+		Create(gomock.Any(), "ci", []domain.APIKeyScope{domain.ScopePullRequestWrite, domain.ScopeStatsRead}).
+		Return(&domain.IssuedAPIKey{
+			Key: domain.APIKey{
+				ID:        4,
+				Name:      "ci",
+				Prefix:    "prs_abcdefgh",
+				Scopes:    []domain.APIKeyScope{domain.ScopePullRequestWrite, domain.ScopeStatsRead},
+				CreatedAt: time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+			},
+			Secret: "prs_abcdefghijklmnop",
+		}, nil)
+
+	body := `{"name": "ci", "scopes": ["pr:write", "stats:read"]}`
+
+	req := httptest.NewRequest(http.MethodPost, "/admin/apiKeys", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	rr := httptest.NewRecorder()
+
+	c.create(rr, req)
+
+	if rr.Code != http.StatusCreated {
+		t.Fatalf("expected status %d, got %d, body=%s", http.StatusCreated, rr.Code, rr.Body.String())
+	}
+
+	var resp models.CreatedAPIKeyResponse
+	if err := json.Unmarshal(rr.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("failed to unmarshal CreatedAPIKeyResponse: %v", err)
+	}
+
+	if resp.Key != "prs_abcdefghijklmnop" || resp.APIKey.APIKeyID != 4 || len(resp.APIKey.Scopes) != 2 {
+		t.Fatalf("unexpected response: %+v", resp)
+	}
+}
+
+func TestAPIKeyController_Create_UnknownScope(t *testing.T) {
+	c, svc := newAPIKeyController(t)
+
+	svc.
+		EXPECT().
+		Create(gomock.Any(), "ci", gomock.Any()).
+		Return(nil, fmt.Errorf("%w: unknown scope team:delete", domain.ErrInvalidAPIKey))
+
+	body := `{"name": "ci", "scopes": ["team:delete"]}`
+
+	req := httptest.NewRequest(http.MethodPost, "/admin/apiKeys", strings.NewReader(body))
+	rr := httptest.NewRecorder()
+
+	c.create(rr, req)
+
+	if rr.Code != http.StatusBadRequest {
+		t.Fatalf("expected status %d, got %d, body=%s", http.StatusBadRequest, rr.Code, rr.Body.String())
+	}
+}
+
+func TestAPIKeyController_Revoke_NotFound(t *testing.T) {
+	c, svc := newAPIKeyController(t)
+
+	svc.
+		EXPECT().
+		Revoke(gomock.Any(), domain.APIKeyID(9)).
+		Return(nil, domain.ErrAPIKeyNotFound)
+
+	req := httptest.NewRequest(http.MethodDelete, "/admin/apiKeys?api_key_id=9", nil)
+	rr := httptest.NewRecorder()
+
+	c.revoke(rr, req)
+
+	if rr.Code != http.StatusNotFound {
+		t.Fatalf("expected status %d, got %d, body=%s", http.StatusNotFound, rr.Code, rr.Body.String())
+	}
+}
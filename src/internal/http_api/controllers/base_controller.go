@@ -6,6 +6,7 @@ import (
 	"log/slog"
 	"net/http"
 
+	"PrService/src/internal/domain"
 	"PrService/src/internal/http_api/models"
 
 	"github.com/go-chi/chi/v5/middleware"
@@ -61,6 +62,9 @@ func (bc *baseController) writeError(
 	if err != nil {
 		logFields = append(logFields, "err", err)
 	}
+	if actor, ok := domain.ActorFromContext(ctx); ok {
+		logFields = append(logFields, "actor", actor.String())
+	}
 	logFields = append(logFields, fields...)
 
 	if status >= 500 {
@@ -0,0 +1,150 @@
+package controllers
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"PrService/src/internal/domain"
+	"PrService/src/internal/http_api/middlewares"
+	"PrService/src/internal/http_api/models"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-playground/validator/v10"
+)
+
+type CodeOwnersController struct {
+	baseController
+	codeOwnersService domain.CodeOwnersService
+}
+
+func NewCodeOwnersController(
+	codeOwnersService domain.CodeOwnersService,
+	validate *validator.Validate,
+	logger *slog.Logger,
+) *CodeOwnersController {
+	return &CodeOwnersController{
+		baseController:    newBaseController(validate, logger),
+		codeOwnersService: codeOwnersService,
+	}
+}
+
+func (c *CodeOwnersController) UseHandlers(r chi.Router) {
+	r.With(middlewares.RequireScope(domain.ScopeTeamRead)).Get("/team/codeowners", c.get)
+	r.With(middlewares.RequireScope(domain.ScopeTeamAdmin)).Post("/team/codeowners", c.upload)
+}
+
+// upload godoc
+//
+//	@Summary	Загрузить CODEOWNERS команды (синтаксис GitHub)
+//	@Tags		Teams
+//	@Accept		json
+//	@Produce	json
+//	@Param		request	body		models.UploadCodeOwnersRequest	true	"Upload CODEOWNERS body"
+//	@Success	200		{object}	models.CodeOwnersResponse		"Разобранный CODEOWNERS"
+//	@Failure	400		{object}	models.ErrorResponse			"Неверный запрос или синтаксис CODEOWNERS"
+//	@Failure	404		{object}	models.ErrorResponse			"Команда не найдена"
+//	@Failure	500		{object}	models.ErrorResponse			"Ошибка сервера"
+//	@Security	ApiKeyAuth
+//	@Security	BearerAuth
+//	@Router		/team/codeowners [post]
+func (c *CodeOwnersController) upload(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req models.UploadCodeOwnersRequest
+	if ok := c.decodeAndValidate(ctx, w, r, &req, "uploadCodeOwnersRequest"); !ok {
+		return
+	}
+
+	codeOwners := req.MapToDomain()
+	uploaded, err := c.codeOwnersService.Upload(ctx, &codeOwners)
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidCodeOwners) {
+			c.writeError(ctx, w, http.StatusBadRequest,
+				models.ErrorCodeInvalidCodeOwners,
+				err.Error(),
+				"invalid CODEOWNERS document",
+				err,
+				"team_name", req.TeamName,
+			)
+			return
+		}
+		if errors.Is(err, domain.ErrTeamNotFound) {
+			c.writeError(ctx, w, http.StatusNotFound,
+				models.ErrorCodeNotFound,
+				"resource not found",
+				"team not found to upload CODEOWNERS",
+				err,
+				"team_name", req.TeamName,
+			)
+			return
+		}
+
+		c.writeError(ctx, w, http.StatusInternalServerError,
+			models.ErrorCodeInternalServer,
+			"internal server error",
+			"failed to upload CODEOWNERS",
+			err,
+			"team_name", req.TeamName,
+		)
+		return
+	}
+
+	resp := models.MapToCodeOwnersResponse(*uploaded)
+	c.writeJSON(ctx, w, http.StatusOK, resp)
+}
+
+// get godoc
+//
+//	@Summary	Получить CODEOWNERS команды
+//	@Tags		Teams
+//	@Accept		json
+//	@Produce	json
+//	@Param		team_name	query		string						true	"Уникальное имя команды"
+//	@Success	200			{object}	models.CodeOwnersResponse	"CODEOWNERS команды"
+//	@Failure	400			{object}	models.ErrorResponse		"Неверный запрос"
+//	@Failure	404			{object}	models.ErrorResponse		"Команда или CODEOWNERS не найдены"
+//	@Failure	500			{object}	models.ErrorResponse		"Ошибка сервера"
+//	@Security	ApiKeyAuth
+//	@Security	BearerAuth
+//	@Router		/team/codeowners [get]
+func (c *CodeOwnersController) get(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	teamName := r.URL.Query().Get("team_name")
+
+	if teamName == "" {
+		c.writeError(ctx, w, http.StatusBadRequest,
+			models.ErrorCodeValidationFailed,
+			"team_name is required",
+			"missing team_name query param for CODEOWNERS",
+			nil,
+		)
+		return
+	}
+
+	codeOwners, err := c.codeOwnersService.Get(ctx, domain.TeamName(teamName))
+	if err != nil {
+		if errors.Is(err, domain.ErrTeamNotFound) || errors.Is(err, domain.ErrCodeOwnersNotFound) {
+			c.writeError(ctx, w, http.StatusNotFound,
+				models.ErrorCodeNotFound,
+				"resource not found",
+				"CODEOWNERS not found",
+				err,
+				"team_name", teamName,
+			)
+			return
+		}
+
+		c.writeError(ctx, w, http.StatusInternalServerError,
+			models.ErrorCodeInternalServer,
+			"internal server error",
+			"failed to get CODEOWNERS",
+			err,
+			"team_name", teamName,
+		)
+		return
+	}
+
+	resp := models.MapToCodeOwnersResponse(*codeOwners)
+	c.writeJSON(ctx, w, http.StatusOK, resp)
+}
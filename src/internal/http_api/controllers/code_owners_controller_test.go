@@ -0,0 +1,125 @@
+package controllers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"PrService/src/internal/domain"
+	"PrService/src/internal/http_api/mocks"
+	"PrService/src/internal/http_api/models"
+
+	"github.com/go-playground/validator/v10"
+	"go.uber.org/mock/gomock"
+)
+
+func newCodeOwnersController(
+	t *testing.T,
+) (*CodeOwnersController, *mocks.MockCodeOwnersService) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	svc := mocks.NewMockCodeOwnersService(ctrl)
+
+	validate := validator.New()
+	logger := newTestLogger()
+
+	c := NewCodeOwnersController(svc, validate, logger)
+
+	return c, svc
+}
+
+func TestCodeOwnersController_Upload_Success(t *testing.T) {
+	c, svc := newCodeOwnersController(t)
+
+	svc.
+		EXPECT().
+		Upload(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ any, codeOwners *domain.TeamCodeOwners) (*domain.TeamCodeOwners, error) {
+			entries, err := domain.ParseCodeOwners(codeOwners.Content)
+			if err != nil {
+				return nil, err
+			}
+			codeOwners.Entries = entries
+			return codeOwners, nil
+		})
+
+	body := `{
+		"team_name": "backend",
+		"content": "/db/ @dba @org/backend\n",
+		"mode": "REQUIRE",
+		"fill_strategy": "LOAD"
+	}`
+
+	req := httptest.NewRequest(http.MethodPost, "/team/codeowners", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	rr := httptest.NewRecorder()
+
+	c.upload(rr, req)
+
+	if rr.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d, body=%s", http.StatusOK, rr.Code, rr.Body.String())
+	}
+
+	var resp models.CodeOwnersResponse
+	if err := json.Unmarshal(rr.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("failed to unmarshal CodeOwnersResponse: %v", err)
+	}
+
+	if resp.Mode != "REQUIRE" || resp.FillStrategy != "LOAD" || len(resp.Entries) != 1 {
+		t.Fatalf("unexpected CODEOWNERS response: %+v", resp)
+	}
+	if resp.Entries[0].Pattern != "/db/" || len(resp.Entries[0].Owners) != 2 {
+		t.Fatalf("unexpected CODEOWNERS entry: %+v", resp.Entries[0])
+	}
+}
+
+func TestCodeOwnersController_Upload_SyntaxError(t *testing.T) {
+	c, svc := newCodeOwnersController(t)
+
+	svc.
+		EXPECT().
+		Upload(gomock.Any(), gomock.Any()).
+		Return(nil, &domain.CodeOwnersSyntaxError{Line: 3, Reason: "negated patterns are not supported"})
+
+	body := `{"team_name": "backend", "content": "!docs/ @alice"}`
+
+	req := httptest.NewRequest(http.MethodPost, "/team/codeowners", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	rr := httptest.NewRecorder()
+
+	c.upload(rr, req)
+
+	if rr.Code != http.StatusBadRequest {
+		t.Fatalf("expected status %d, got %d, body=%s", http.StatusBadRequest, rr.Code, rr.Body.String())
+	}
+
+	var resp models.ErrorResponse
+	if err := json.Unmarshal(rr.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("failed to unmarshal ErrorResponse: %v", err)
+	}
+
+	if resp.Error.ErrorCode != models.ErrorCodeInvalidCodeOwners || !strings.Contains(resp.Error.Message, "line 3") {
+		t.Fatalf("unexpected error response: %+v", resp.Error)
+	}
+}
+
+func TestCodeOwnersController_Get_NotFound(t *testing.T) {
+	c, svc := newCodeOwnersController(t)
+
+	svc.
+		EXPECT().
+		Get(gomock.Any(), domain.TeamName("backend")).
+		Return(nil, domain.ErrCodeOwnersNotFound)
+
+	req := httptest.NewRequest(http.MethodGet, "/team/codeowners?team_name=backend", nil)
+	rr := httptest.NewRecorder()
+
+	c.get(rr, req)
+
+	if rr.Code != http.StatusNotFound {
+		t.Fatalf("expected status %d, got %d, body=%s", http.StatusNotFound, rr.Code, rr.Body.String())
+	}
+}
@@ -0,0 +1,211 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"PrService/src/internal/domain"
+	"PrService/src/internal/http_api/middlewares"
+	"PrService/src/internal/http_api/models"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/go-playground/validator/v10"
+)
+
+const lastEventIDHeader = "Last-Event-ID"
+
+type EventStreamController struct {
+	baseController
+	streamService     domain.EventStreamService
+	pollInterval      time.Duration
+	heartbeatInterval time.Duration
+}
+
+func NewEventStreamController(
+	streamService domain.EventStreamService,
+	pollInterval time.Duration,
+	heartbeatInterval time.Duration,
+	validate *validator.Validate,
+	logger *slog.Logger,
+) *EventStreamController {
+	return &EventStreamController{
+		baseController:    newBaseController(validate, logger),
+		streamService:     streamService,
+		pollInterval:      pollInterval,
+		heartbeatInterval: heartbeatInterval,
+	}
+}
+
+func (c *EventStreamController) UseHandlers(r chi.Router) {
+	r.With(middlewares.RequireScope(domain.ScopePullRequestRead)).Get("/events/stream", c.stream)
+}
+
+// stream godoc
+//
+//	@Summary		Поток событий назначения ревьюверов (SSE)
+//	@Description	События о назначениях ревьюверов и статусе PR (PR_CREATED, REVIEWER_ASSIGNED, REVIEWER_REASSIGNED, REVIEWER_REMOVED, PR_MERGED, PR_CLOSED, PR_REOPENED, PR_UPDATED) в формате Server-Sent Events.
+//	@Description	id события — позиция в журнале вида "<транзакция>-<номер>"; после переподключения поток продолжается с заголовка Last-Event-ID.
+//	@Tags			Events
+//	@Produce		text/event-stream
+//	@Param			user_id			query		string					false	"Только события, где пользователь автор или ревьювер"
+//	@Param			team_name		query		string					false	"Только события с участием членов команды"
+//	@Param			last_event_id	query		string					false	"Продолжить после события (если нет заголовка Last-Event-ID)"
+//	@Param			Last-Event-ID	header		string					false	"Продолжить после события"
+//	@Success		200				{string}	string					"Поток событий"
+//	@Failure		400				{object}	models.ErrorResponse	"Неверный запрос"
+//	@Failure		404				{object}	models.ErrorResponse	"Команда не найдена"
+//	@Failure		500				{object}	models.ErrorResponse	"Ошибка сервера"
+//	@Security		ApiKeyAuth
+//	@Router			/events/stream [get]
+func (c *EventStreamController) stream(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	q := r.URL.Query()
+
+	filter := domain.EventStreamFilter{
+		UserID:   domain.UserID(q.Get("user_id")),
+		TeamName: domain.TeamName(q.Get("team_name")),
+	}
+
+	// EventSource sends Last-Event-ID itself on reconnect; the query parameter covers the first connection.
+	rawLastID := r.Header.Get(lastEventIDHeader)
+	if rawLastID == "" {
+		rawLastID = q.Get("last_event_id")
+	}
+
+	var (
+		cursor domain.EventStreamCursor
+		err    error
+	)
+	if rawLastID != "" {
+		cursor, err = domain.ParseEventStreamCursor(rawLastID)
+		if err != nil {
+			c.writeError(ctx, w, http.StatusBadRequest,
+				models.ErrorCodeValidationFailed,
+				"last event id must be an event id from this stream",
+				"invalid last event id to stream events",
+				err,
+				"last_event_id", rawLastID,
+			)
+			return
+		}
+	} else {
+		cursor, err = c.streamService.LatestCursor(ctx)
+		if err != nil {
+			c.writeError(ctx, w, http.StatusInternalServerError,
+				models.ErrorCodeInternalServer,
+				"internal server error",
+				"failed to get latest event stream cursor",
+				err,
+			)
+			return
+		}
+	}
+
+	// The first page is read before the response starts so that filter errors get a proper status.
+	page, err := c.streamService.ListAfter(ctx, filter, cursor)
+	if err != nil {
+		if errors.Is(err, domain.ErrTeamNotFound) {
+			c.writeError(ctx, w, http.StatusNotFound,
+				models.ErrorCodeNotFound,
+				"resource not found",
+				"team not found to stream events",
+				err,
+				"team_name", filter.TeamName,
+			)
+			return
+		}
+
+		c.writeError(ctx, w, http.StatusInternalServerError,
+			models.ErrorCodeInternalServer,
+			"internal server error",
+			"failed to list stream events",
+			err,
+			"last_event_id", cursor.String(),
+		)
+		return
+	}
+
+	rc := http.NewResponseController(w)
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+
+	if err := c.writeEvents(w, rc, page.Events); err != nil {
+		c.logStreamClosed(ctx, err)
+		return
+	}
+
+	err = c.pump(ctx, w, rc, filter, page.Cursor)
+	if err != nil && ctx.Err() == nil {
+		c.logStreamClosed(ctx, err)
+	}
+}
+
+// pump polls for new events and sends heartbeats until the client goes away or an error occurs.
+func (c *EventStreamController) pump(
+	ctx context.Context,
+	w http.ResponseWriter,
+	rc *http.ResponseController,
+	filter domain.EventStreamFilter,
+	cursor domain.EventStreamCursor,
+) error {
+	poll := time.NewTicker(c.pollInterval)
+	defer poll.Stop()
+	heartbeat := time.NewTicker(c.heartbeatInterval)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-heartbeat.C:
+			if _, err := fmt.Fprint(w, ": heartbeat\n\n"); err != nil {
+				return err
+			}
+			if err := rc.Flush(); err != nil {
+				return err
+			}
+		case <-poll.C:
+			page, err := c.streamService.ListAfter(ctx, filter, cursor)
+			if err != nil {
+				return err
+			}
+			cursor = page.Cursor
+
+			if err := c.writeEvents(w, rc, page.Events); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (c *EventStreamController) writeEvents(
+	w http.ResponseWriter,
+	rc *http.ResponseController,
+	events []domain.OutboxMessage,
+) error {
+	for _, event := range events {
+		_, err := fmt.Fprintf(w, "id: %s\nevent: %s\ndata: %s\n\n",
+			event.StreamCursor(), event.EventType, event.Payload)
+		if err != nil {
+			return err
+		}
+	}
+
+	return rc.Flush()
+}
+
+// logStreamClosed records why the server ended the stream; the client reconnects with Last-Event-ID.
+func (c *EventStreamController) logStreamClosed(ctx context.Context, err error) {
+	c.logger.WarnContext(ctx, "event stream closed",
+		"request_id", middleware.GetReqID(ctx),
+		"err", err,
+	)
+}
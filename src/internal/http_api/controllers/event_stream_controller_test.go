@@ -0,0 +1,160 @@
+package controllers
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"PrService/src/internal/domain"
+	"PrService/src/internal/http_api/mocks"
+
+	"github.com/go-playground/validator/v10"
+	"go.uber.org/mock/gomock"
+)
+
+func newEventStreamController(
+	t *testing.T,
+	pollInterval time.Duration,
+	heartbeatInterval time.Duration,
+) (*EventStreamController, *mocks.MockEventStreamService) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	svc := mocks.NewMockEventStreamService(ctrl)
+
+	c := NewEventStreamController(svc, pollInterval, heartbeatInterval, validator.New(), newTestLogger())
+
+	return c, svc
+}
+
+// heartbeatRecorder cancels the request once a heartbeat is written.
+type heartbeatRecorder struct {
+	*httptest.ResponseRecorder
+	cancel context.CancelFunc
+}
+
+func (r *heartbeatRecorder) Write(b []byte) (int, error) {
+	n, err := r.ResponseRecorder.Write(b)
+	if strings.HasPrefix(string(b), ": heartbeat") {
+		r.cancel()
+	}
+	return n, err
+}
+
+func TestEventStreamController_Stream_ResumesFromLastEventID(t *testing.T) {
+	c, svc := newEventStreamController(t, time.Millisecond, time.Hour)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	filter := domain.EventStreamFilter{UserID: "u2"}
+
+	svc.
+		EXPECT().
+		ListAfter(gomock.Any(), filter, domain.EventStreamCursor{TxID: 100, ID: 5}).
+		Return(&domain.EventStreamPage{
+			Events: []domain.OutboxMessage{
+				{ID: 6, TxID: 100, EventType: domain.EventPullRequestCreated, Payload: []byte(`{"type":"PR_CREATED"}`)},
+			},
+			Cursor: domain.EventStreamCursor{TxID: 101, ID: 4},
+		}, nil)
+
+	svc.
+		EXPECT().
+		ListAfter(gomock.Any(), filter, domain.EventStreamCursor{TxID: 101, ID: 4}).
+		DoAndReturn(func(
+			context.Context,
+			domain.EventStreamFilter,
+			domain.EventStreamCursor,
+		) (*domain.EventStreamPage, error) {
+			cancel()
+			return &domain.EventStreamPage{
+				Events: []domain.OutboxMessage{
+					{
+						ID:        8,
+						TxID:      102,
+						EventType: domain.EventPullRequestMerged,
+						Payload:   []byte(`{"type":"PR_MERGED"}`),
+					},
+				},
+				Cursor: domain.EventStreamCursor{TxID: 102, ID: 8},
+			}, nil
+		})
+
+	req := httptest.NewRequest(http.MethodGet, "/events/stream?user_id=u2", nil).WithContext(ctx)
+	req.Header.Set(lastEventIDHeader, "100-5")
+	rr := httptest.NewRecorder()
+
+	c.stream(rr, req)
+
+	if rr.Code != http.StatusOK || rr.Header().Get("Content-Type") != "text/event-stream" {
+		t.Fatalf("unexpected response: status=%d headers=%v", rr.Code, rr.Header())
+	}
+
+	want := "id: 100-6\nevent: PR_CREATED\ndata: {\"type\":\"PR_CREATED\"}\n\n" +
+		"id: 102-8\nevent: PR_MERGED\ndata: {\"type\":\"PR_MERGED\"}\n\n"
+	if rr.Body.String() != want {
+		t.Fatalf("unexpected stream:\n%s", rr.Body.String())
+	}
+}
+
+func TestEventStreamController_Stream_StartsFromLatestEvent(t *testing.T) {
+	c, svc := newEventStreamController(t, time.Hour, time.Millisecond)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	latest := domain.EventStreamCursor{TxID: 100, ID: 10}
+
+	svc.
+		EXPECT().
+		LatestCursor(gomock.Any()).
+		Return(latest, nil)
+
+	svc.
+		EXPECT().
+		ListAfter(gomock.Any(), domain.EventStreamFilter{TeamName: "backend"}, latest).
+		Return(&domain.EventStreamPage{Events: []domain.OutboxMessage{}, Cursor: latest}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/events/stream?team_name=backend", nil).WithContext(ctx)
+	rr := &heartbeatRecorder{ResponseRecorder: httptest.NewRecorder(), cancel: cancel}
+
+	c.stream(rr, req)
+
+	if rr.Body.String() != ": heartbeat\n\n" {
+		t.Fatalf("expected only a heartbeat, got %q", rr.Body.String())
+	}
+}
+
+func TestEventStreamController_Stream_UnknownTeam(t *testing.T) {
+	c, svc := newEventStreamController(t, time.Hour, time.Hour)
+
+	svc.
+		EXPECT().
+		ListAfter(gomock.Any(), domain.EventStreamFilter{TeamName: "ghost"}, domain.EventStreamCursor{}).
+		Return(nil, domain.ErrTeamNotFound)
+
+	req := httptest.NewRequest(http.MethodGet, "/events/stream?team_name=ghost&last_event_id=0-0", nil)
+	rr := httptest.NewRecorder()
+
+	c.stream(rr, req)
+
+	if rr.Code != http.StatusNotFound {
+		t.Fatalf("expected status %d, got %d, body=%s", http.StatusNotFound, rr.Code, rr.Body.String())
+	}
+}
+
+func TestEventStreamController_Stream_InvalidLastEventID(t *testing.T) {
+	c, _ := newEventStreamController(t, time.Hour, time.Hour)
+
+	req := httptest.NewRequest(http.MethodGet, "/events/stream", nil)
+	req.Header.Set(lastEventIDHeader, "abc")
+	rr := httptest.NewRecorder()
+
+	c.stream(rr, req)
+
+	if rr.Code != http.StatusBadRequest {
+		t.Fatalf("expected status %d, got %d, body=%s", http.StatusBadRequest, rr.Code, rr.Body.String())
+	}
+}
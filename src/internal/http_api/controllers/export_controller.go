@@ -0,0 +1,375 @@
+package controllers
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"PrService/src/internal/domain"
+	"PrService/src/internal/http_api/middlewares"
+	"PrService/src/internal/http_api/models"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/go-playground/validator/v10"
+)
+
+const (
+	contentTypeCSV    = "text/csv"
+	contentTypeNDJSON = "application/x-ndjson"
+	// exportFlushEvery is how many records are buffered before they are sent to the client.
+	exportFlushEvery = 500
+)
+
+type ExportController struct {
+	baseController
+	exportService domain.ExportService
+}
+
+func NewExportController(
+	exportService domain.ExportService,
+	validate *validator.Validate,
+	logger *slog.Logger,
+) *ExportController {
+	return &ExportController{
+		baseController: newBaseController(validate, logger),
+		exportService:  exportService,
+	}
+}
+
+func (c *ExportController) UseHandlers(r chi.Router) {
+	r.With(middlewares.RequireScope(domain.ScopeStatsRead)).Get("/export/pullRequests", c.pullRequests)
+	r.With(middlewares.RequireScope(domain.ScopeStatsRead)).Get("/export/assignments", c.assignments)
+}
+
+// pullRequests godoc
+//
+//	@Summary		Выгрузить PR в CSV или NDJSON
+//	@Description	Формат выбирается заголовком Accept: text/csv (по умолчанию) или application/x-ndjson.
+//	@Description	Строки передаются потоком по мере чтения из базы, самые старые PR первыми.
+//	@Tags			Export
+//	@Produce		text/csv
+//	@Produce		application/x-ndjson
+//	@Param			status		query		string					false	"Статус PR (DRAFT, OPEN, MERGED, CLOSED)"
+//	@Param			team_name	query		string					false	"Только PR авторов из команды"
+//	@Param			author_id	query		string					false	"Только PR автора"
+//	@Param			reviewer_id	query		string					false	"Только PR, где пользователь назначен ревьювером"
+//	@Param			from		query		string					false	"Начало окна создания PR (RFC3339 или YYYY-MM-DD)"
+//	@Param			to			query		string					false	"Конец окна создания PR, не включая (RFC3339 или YYYY-MM-DD)"
+//	@Success		200			{array}		models.PullRequestExportRecord	"Выгрузка PR"
+//	@Failure		400			{object}	models.ErrorResponse	"Неверный запрос"
+//	@Failure		404			{object}	models.ErrorResponse	"Команда не найдена"
+//	@Failure		406			{object}	models.ErrorResponse	"Неподдерживаемый формат"
+//	@Failure		500			{object}	models.ErrorResponse	"Ошибка сервера"
+//	@Security		ApiKeyAuth
+//	@Router			/export/pullRequests [get]
+func (c *ExportController) pullRequests(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	q := r.URL.Query()
+
+	contentType, ok := c.negotiate(w, r)
+	if !ok {
+		return
+	}
+
+	window, err := parseStatsWindow(q)
+	if err != nil {
+		c.writeError(ctx, w, http.StatusBadRequest,
+			models.ErrorCodeValidationFailed,
+			err.Error(),
+			"invalid window for pull request export",
+			err,
+		)
+		return
+	}
+
+	filter := domain.PullRequestExportFilter{
+		Status:     domain.PullRequestStatus(q.Get("status")),
+		TeamName:   domain.TeamName(q.Get("team_name")),
+		AuthorID:   domain.UserID(q.Get("author_id")),
+		ReviewerID: domain.UserID(q.Get("reviewer_id")),
+		Window:     window,
+	}
+
+	out := newExportWriter(w, contentType, "pull_requests", models.PullRequestExportHeader)
+	err = c.exportService.ExportPullRequests(ctx, filter, func(row domain.PullRequestExportRow) error {
+		return out.write(models.MapToPullRequestExportRecord(row))
+	})
+	c.finish(ctx, w, out, err, "pull requests", string(filter.TeamName))
+}
+
+// assignments godoc
+//
+//	@Summary		Выгрузить журнал назначений ревьюверов в CSV или NDJSON
+//	@Description	Формат выбирается заголовком Accept: text/csv (по умолчанию) или application/x-ndjson.
+//	@Description	Строки передаются потоком по мере чтения из базы в порядке назначения.
+//	@Tags			Export
+//	@Produce		text/csv
+//	@Produce		application/x-ndjson
+//	@Param			team_name		query		string					false	"Только назначения в слоты команды"
+//	@Param			reviewer_id		query		string					false	"Только назначения ревьювера"
+//	@Param			pull_request_id	query		string					false	"Только назначения на PR"
+//	@Param			from			query		string					false	"Начало окна назначений (RFC3339 или YYYY-MM-DD)"
+//	@Param			to				query		string					false	"Конец окна назначений, не включая (RFC3339 или YYYY-MM-DD)"
+//	@Success		200				{array}		models.AssignmentExportRecord	"Выгрузка назначений"
+//	@Failure		400				{object}	models.ErrorResponse	"Неверный запрос"
+//	@Failure		404				{object}	models.ErrorResponse	"Команда не найдена"
+//	@Failure		406				{object}	models.ErrorResponse	"Неподдерживаемый формат"
+//	@Failure		500				{object}	models.ErrorResponse	"Ошибка сервера"
+//	@Security		ApiKeyAuth
+//	@Router			/export/assignments [get]
+func (c *ExportController) assignments(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	q := r.URL.Query()
+
+	contentType, ok := c.negotiate(w, r)
+	if !ok {
+		return
+	}
+
+	window, err := parseStatsWindow(q)
+	if err != nil {
+		c.writeError(ctx, w, http.StatusBadRequest,
+			models.ErrorCodeValidationFailed,
+			err.Error(),
+			"invalid window for assignment export",
+			err,
+		)
+		return
+	}
+
+	filter := domain.AssignmentExportFilter{
+		TeamName:      domain.TeamName(q.Get("team_name")),
+		ReviewerID:    domain.UserID(q.Get("reviewer_id")),
+		PullRequestID: domain.PullRequestID(q.Get("pull_request_id")),
+		Window:        window,
+	}
+
+	out := newExportWriter(w, contentType, "assignments", models.AssignmentExportHeader)
+	err = c.exportService.ExportAssignments(ctx, filter, func(row domain.AssignmentExportRow) error {
+		return out.write(models.MapToAssignmentExportRecord(row))
+	})
+	c.finish(ctx, w, out, err, "assignments", string(filter.TeamName))
+}
+
+// negotiate picks the export format from the Accept header and answers 406 when none is acceptable.
+func (c *ExportController) negotiate(w http.ResponseWriter, r *http.Request) (string, bool) {
+	accept := r.Header.Get("Accept")
+
+	contentType, ok := negotiateExportContentType(accept)
+	if !ok {
+		c.writeError(r.Context(), w, http.StatusNotAcceptable,
+			models.ErrorCodeNotAcceptable,
+			"export is available as text/csv or application/x-ndjson",
+			"unsupported Accept header for export",
+			nil,
+			"accept", accept,
+		)
+	}
+
+	return contentType, ok
+}
+
+// finish reports the outcome of an export. Errors found before the first record get a JSON error
+// response; once rows have been sent the connection is aborted, so that the client does not take
+// a truncated export for a complete one.
+func (c *ExportController) finish(
+	ctx context.Context,
+	w http.ResponseWriter,
+	out *exportWriter,
+	err error,
+	what string,
+	teamName string,
+) {
+	if err == nil {
+		err = out.close()
+		if err == nil {
+			return
+		}
+	}
+
+	if !out.started {
+		c.writeExportError(ctx, w, err, what, teamName)
+		return
+	}
+
+	c.logger.WarnContext(ctx, "export of "+what+" aborted",
+		"request_id", middleware.GetReqID(ctx),
+		"err", err,
+	)
+	panic(http.ErrAbortHandler)
+}
+
+func (c *ExportController) writeExportError(
+	ctx context.Context,
+	w http.ResponseWriter,
+	err error,
+	what string,
+	teamName string,
+) {
+	if errors.Is(err, domain.ErrInvalidStatsWindow) {
+		c.writeError(ctx, w, http.StatusBadRequest,
+			models.ErrorCodeValidationFailed,
+			"from must be before to",
+			"invalid window for export of "+what,
+			err,
+		)
+		return
+	}
+	if errors.Is(err, domain.ErrInvalidPullRequestStatus) {
+		c.writeError(ctx, w, http.StatusBadRequest,
+			models.ErrorCodeValidationFailed,
+			"status must be one of DRAFT, OPEN, MERGED, CLOSED",
+			"invalid status for export of "+what,
+			err,
+		)
+		return
+	}
+	if errors.Is(err, domain.ErrTeamNotFound) {
+		c.writeError(ctx, w, http.StatusNotFound,
+			models.ErrorCodeNotFound,
+			"resource not found",
+			"team not found for export of "+what,
+			err,
+			"team_name", teamName,
+		)
+		return
+	}
+
+	c.writeError(ctx, w, http.StatusInternalServerError,
+		models.ErrorCodeInternalServer,
+		"internal server error",
+		"failed to export "+what,
+		err,
+		"team_name", teamName,
+	)
+}
+
+// negotiateExportContentType returns the first export format the Accept header allows, CSV when it is empty.
+// Quality values are not weighed; the listed order decides.
+func negotiateExportContentType(accept string) (string, bool) {
+	if strings.TrimSpace(accept) == "" {
+		return contentTypeCSV, true
+	}
+
+	for _, part := range strings.Split(accept, ",") {
+		mediaType, _, _ := strings.Cut(part, ";")
+		switch strings.ToLower(strings.TrimSpace(mediaType)) {
+		case contentTypeCSV, "text/*", "*/*":
+			return contentTypeCSV, true
+		case contentTypeNDJSON, "application/*":
+			return contentTypeNDJSON, true
+		}
+	}
+
+	return "", false
+}
+
+// exportRecord is a line of an export; it is encoded as is in NDJSON and by CSV in CSV.
+type exportRecord interface {
+	CSV() []string
+}
+
+// exportWriter writes export records in the negotiated format. The response starts with the first record,
+// so errors found before it can still get a proper status.
+type exportWriter struct {
+	w           http.ResponseWriter
+	rc          *http.ResponseController
+	contentType string
+	filename    string
+	csvHeader   []string
+	csv         *csv.Writer
+	json        *json.Encoder
+	started     bool
+	pending     int
+}
+
+func newExportWriter(w http.ResponseWriter, contentType, name string, csvHeader []string) *exportWriter {
+	extension := "csv"
+	if contentType == contentTypeNDJSON {
+		extension = "ndjson"
+	}
+
+	return &exportWriter{
+		w:           w,
+		rc:          http.NewResponseController(w),
+		contentType: contentType,
+		filename:    name + "." + extension,
+		csvHeader:   csvHeader,
+	}
+}
+
+func (e *exportWriter) write(record exportRecord) error {
+	if err := e.start(); err != nil {
+		return err
+	}
+
+	var err error
+	if e.csv != nil {
+		err = e.csv.Write(record.CSV())
+	} else {
+		err = e.json.Encode(record)
+	}
+	if err != nil {
+		return err
+	}
+
+	e.pending++
+	if e.pending < exportFlushEvery {
+		return nil
+	}
+
+	return e.flush()
+}
+
+func (e *exportWriter) start() error {
+	if e.started {
+		return nil
+	}
+	e.started = true
+
+	e.w.Header().Set("Content-Type", e.contentType+"; charset=utf-8")
+	e.w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", e.filename))
+	e.w.Header().Set("X-Accel-Buffering", "no")
+	e.w.WriteHeader(http.StatusOK)
+
+	if e.contentType == contentTypeNDJSON {
+		e.json = json.NewEncoder(e.w)
+		return nil
+	}
+
+	e.csv = csv.NewWriter(e.w)
+	return e.csv.Write(e.csvHeader)
+}
+
+func (e *exportWriter) flush() error {
+	e.pending = 0
+
+	if e.csv != nil {
+		e.csv.Flush()
+		if err := e.csv.Error(); err != nil {
+			return err
+		}
+	}
+
+	if err := e.rc.Flush(); err != nil && !errors.Is(err, http.ErrNotSupported) {
+		return err
+	}
+
+	return nil
+}
+
+// close starts the response of an empty export, so that a CSV one still carries its header, and
+// sends what is buffered.
+func (e *exportWriter) close() error {
+	if err := e.start(); err != nil {
+		return err
+	}
+
+	return e.flush()
+}
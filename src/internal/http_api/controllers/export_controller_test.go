@@ -0,0 +1,206 @@
+package controllers
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"PrService/src/internal/domain"
+	"PrService/src/internal/http_api/mocks"
+	"PrService/src/internal/http_api/models"
+
+	"github.com/go-playground/validator/v10"
+	"go.uber.org/mock/gomock"
+)
+
+func newExportController(t *testing.T) (*ExportController, *mocks.MockExportService) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	svc := mocks.NewMockExportService(ctrl)
+
+	c := NewExportController(svc, validator.New(), newTestLogger())
+
+	return c, svc
+}
+
+func TestExportController_PullRequests_CSV(t *testing.T) {
+	c, svc := newExportController(t)
+
+	createdAt := time.Date(2025, 3, 1, 9, 30, 0, 0, time.UTC)
+	filter := domain.PullRequestExportFilter{Status: domain.PullRequestStatusOpen, TeamName: "backend"}
+	svc.
+		EXPECT().
+		ExportPullRequests(gomock.Any(), filter, gomock.Any()).
+		DoAndReturn(func(
+			_ context.Context,
+			_ domain.PullRequestExportFilter,
+			fn func(domain.PullRequestExportRow) error,
+		) error {
+			return fn(domain.PullRequestExportRow{
+				ID:        "pr-1",
+				Name:      "Add search, filters",
+				AuthorID:  "u1",
+				TeamName:  "backend",
+				Status:    domain.PullRequestStatusOpen,
+				Tags:      []string{"db", "api"},
+				Reviewers: []domain.UserID{"u2", "u3"},
+				CreatedAt: &createdAt,
+			})
+		})
+
+	req := httptest.NewRequest(http.MethodGet, "/export/pullRequests?status=OPEN&team_name=backend", nil)
+	req.Header.Set("Accept", "text/csv")
+	rr := httptest.NewRecorder()
+
+	c.pullRequests(rr, req)
+
+	if rr.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d", rr.Code)
+	}
+	if ct := rr.Header().Get("Content-Type"); !strings.HasPrefix(ct, "text/csv") {
+		t.Fatalf("expected text/csv, got %q", ct)
+	}
+
+	records, err := csv.NewReader(rr.Body).ReadAll()
+	if err != nil {
+		t.Fatalf("failed to read CSV: %v", err)
+	}
+	if len(records) != 2 {
+		t.Fatalf("expected header and one row, got %v", records)
+	}
+	if strings.Join(records[0], ",") != strings.Join(models.PullRequestExportHeader, ",") {
+		t.Fatalf("unexpected header: %v", records[0])
+	}
+	want := []string{
+		"pr-1", "Add search, filters", "u1", "backend", "OPEN", "db;api", "u2;u3", "2025-03-01T09:30:00Z", "",
+	}
+	if strings.Join(records[1], "|") != strings.Join(want, "|") {
+		t.Fatalf("unexpected row: %v", records[1])
+	}
+}
+
+func TestExportController_Assignments_NDJSON(t *testing.T) {
+	c, svc := newExportController(t)
+
+	assignedAt := time.Date(2025, 3, 1, 10, 0, 0, 0, time.UTC)
+	unassignedAt := assignedAt.Add(2 * time.Hour)
+	svc.
+		EXPECT().
+		ExportAssignments(gomock.Any(), domain.AssignmentExportFilter{ReviewerID: "u2"}, gomock.Any()).
+		DoAndReturn(func(
+			_ context.Context,
+			_ domain.AssignmentExportFilter,
+			fn func(domain.AssignmentExportRow) error,
+		) error {
+			rows := []domain.AssignmentExportRow{
+				{PullRequestID: "pr-1", PullRequestStatus: "OPEN", ReviewerID: "u2", TeamName: "backend",
+					AssignedAt: assignedAt, UnassignedAt: &unassignedAt, Reassigned: true},
+				{PullRequestID: "pr-2", PullRequestStatus: "MERGED", ReviewerID: "u2", TeamName: "backend",
+					AssignedAt: assignedAt},
+			}
+			for _, row := range rows {
+				if err := fn(row); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+
+	req := httptest.NewRequest(http.MethodGet, "/export/assignments?reviewer_id=u2", nil)
+	req.Header.Set("Accept", "application/x-ndjson")
+	rr := httptest.NewRecorder()
+
+	c.assignments(rr, req)
+
+	if rr.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d", rr.Code)
+	}
+	if ct := rr.Header().Get("Content-Type"); !strings.HasPrefix(ct, "application/x-ndjson") {
+		t.Fatalf("expected application/x-ndjson, got %q", ct)
+	}
+
+	lines := strings.Split(strings.TrimSpace(rr.Body.String()), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("expected 2 lines, got %q", rr.Body.String())
+	}
+
+	var first, second models.AssignmentExportRecord
+	if err := json.Unmarshal([]byte(lines[0]), &first); err != nil {
+		t.Fatalf("failed to decode first line: %v", err)
+	}
+	if err := json.Unmarshal([]byte(lines[1]), &second); err != nil {
+		t.Fatalf("failed to decode second line: %v", err)
+	}
+	if first.PullRequestID != "pr-1" || !first.Reassigned || first.UnassignedAt == nil ||
+		*first.UnassignedAt != "2025-03-01T12:00:00Z" {
+		t.Fatalf("unexpected first record: %+v", first)
+	}
+	if second.PullRequestID != "pr-2" || second.UnassignedAt != nil || second.AssignedAt != "2025-03-01T10:00:00Z" {
+		t.Fatalf("unexpected second record: %+v", second)
+	}
+}
+
+func TestExportController_PullRequests_EmptyCSVHasHeader(t *testing.T) {
+	c, svc := newExportController(t)
+
+	svc.EXPECT().ExportPullRequests(gomock.Any(), domain.PullRequestExportFilter{}, gomock.Any()).Return(nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/export/pullRequests", nil)
+	rr := httptest.NewRecorder()
+
+	c.pullRequests(rr, req)
+
+	if rr.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d", rr.Code)
+	}
+	if got := strings.TrimSpace(rr.Body.String()); got != strings.Join(models.PullRequestExportHeader, ",") {
+		t.Fatalf("expected only the header, got %q", got)
+	}
+}
+
+func TestExportController_PullRequests_NotAcceptable(t *testing.T) {
+	c, _ := newExportController(t)
+
+	req := httptest.NewRequest(http.MethodGet, "/export/pullRequests", nil)
+	req.Header.Set("Accept", "application/xml")
+	rr := httptest.NewRecorder()
+
+	c.pullRequests(rr, req)
+
+	if rr.Code != http.StatusNotAcceptable {
+		t.Fatalf("expected status 406, got %d", rr.Code)
+	}
+}
+
+func TestExportController_PullRequests_TeamNotFound(t *testing.T) {
+	c, svc := newExportController(t)
+
+	svc.
+		EXPECT().
+		ExportPullRequests(gomock.Any(), domain.PullRequestExportFilter{TeamName: "ghost"}, gomock.Any()).
+		Return(domain.ErrTeamNotFound)
+
+	req := httptest.NewRequest(http.MethodGet, "/export/pullRequests?team_name=ghost", nil)
+	req.Header.Set("Accept", "application/x-ndjson")
+	rr := httptest.NewRecorder()
+
+	c.pullRequests(rr, req)
+
+	if rr.Code != http.StatusNotFound {
+		t.Fatalf("expected status 404, got %d", rr.Code)
+	}
+
+	var resp models.ErrorResponse
+	if err := json.NewDecoder(rr.Body).Decode(&resp); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if resp.Error.ErrorCode != models.ErrorCodeNotFound {
+		t.Fatalf("expected NOT_FOUND, got %s", resp.Error.ErrorCode)
+	}
+}
@@ -0,0 +1,155 @@
+package controllers
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"PrService/src/internal/domain"
+	"PrService/src/internal/http_api/models"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-playground/validator/v10"
+)
+
+type GitHubWebhookController struct {
+	baseController
+	eventService domain.PullRequestEventService
+	secret       []byte
+}
+
+func NewGitHubWebhookController(
+	eventService domain.PullRequestEventService,
+	secret string,
+	validate *validator.Validate,
+	logger *slog.Logger,
+) *GitHubWebhookController {
+	return &GitHubWebhookController{
+		baseController: newBaseController(validate, logger),
+		eventService:   eventService,
+		secret:         []byte(secret),
+	}
+}
+
+func (c *GitHubWebhookController) UseHandlers(r chi.Router) {
+	r.Post("/webhooks/github", c.handle)
+}
+
+// handle godoc
+//
+//	@Summary	Принять webhook GitHub (события pull_request и pull_request_review) и применить его к PR
+//	@Tags		Webhooks
+//	@Accept		json
+//	@Produce	json
+//	@Param		X-GitHub-Event		header		string					true	"Тип события GitHub"
+//	@Param		X-Hub-Signature-256	header		string					true	"HMAC-SHA256 подпись тела запроса"
+//	@Param		request				body		models.GitHubPullRequestEvent	true	"GitHub pull_request payload"
+//	@Success	200					{object}	models.WebhookResponse	"Событие применено или проигнорировано"
+//	@Failure	400					{object}	models.ErrorResponse	"Неверный payload"
+//	@Failure	401					{object}	models.ErrorResponse	"Неверная подпись"
+//	@Failure	404					{object}	models.ErrorResponse	"PR, пользователь или команда не найдены"
+//	@Failure	409					{object}	models.ErrorResponse	"Недопустимый переход статуса PR"
+//	@Failure	422					{object}	models.ErrorResponse	"Логин GitHub не связан с пользователем"
+//	@Failure	500					{object}	models.ErrorResponse	"Ошибка сервера"
+//	@Router		/webhooks/github [post]
+func (c *GitHubWebhookController) handle(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	eventType := r.Header.Get("X-GitHub-Event")
+	deliveryID := r.Header.Get("X-GitHub-Delivery")
+
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, webhookMaxPayloadBytes))
+	if err != nil {
+		c.writeError(ctx, w, http.StatusBadRequest,
+			models.ErrorCodeDecodeFailed,
+			"invalid request body",
+			"failed to read GitHub webhook body",
+			err,
+			"delivery_id", deliveryID,
+		)
+		return
+	}
+
+	if !validGitHubSignature(c.secret, body, r.Header.Get("X-Hub-Signature-256")) {
+		c.writeError(ctx, w, http.StatusUnauthorized,
+			models.ErrorCodeInvalidSignature,
+			"invalid signature",
+			"GitHub webhook signature mismatch",
+			nil,
+			"delivery_id", deliveryID,
+		)
+		return
+	}
+
+	event, ok, err := decodeGitHubEvent(eventType, deliveryID, body)
+	if err != nil {
+		c.writeError(ctx, w, http.StatusBadRequest,
+			models.ErrorCodeDecodeFailed,
+			"invalid request body",
+			"failed to decode GitHub webhook payload",
+			err,
+			"delivery_id", deliveryID,
+			"event", eventType,
+		)
+		return
+	}
+	if !ok {
+		c.writeJSON(ctx, w, http.StatusOK, models.MapToWebhookResponse(nil))
+		return
+	}
+
+	pr, err := c.eventService.Handle(ctx, event)
+	if err != nil {
+		c.writeEventError(ctx, w, err, event)
+		return
+	}
+
+	c.writeJSON(ctx, w, http.StatusOK, models.MapToWebhookResponse(pr))
+}
+
+// decodeGitHubEvent maps the payload of a pull_request or pull_request_review event to a pull request event.
+// It reports false for other event types and for actions the service does not track.
+func decodeGitHubEvent(eventType, deliveryID string, body []byte) (domain.PullRequestEvent, bool, error) {
+	switch eventType {
+	case "pull_request":
+		var payload models.GitHubPullRequestEvent
+		if err := json.Unmarshal(body, &payload); err != nil {
+			return domain.PullRequestEvent{}, false, err
+		}
+
+		event, ok := payload.MapToDomain(deliveryID)
+		return event, ok, nil
+	case "pull_request_review":
+		var payload models.GitHubPullRequestReviewEvent
+		if err := json.Unmarshal(body, &payload); err != nil {
+			return domain.PullRequestEvent{}, false, err
+		}
+
+		event, ok := payload.MapToDomain(deliveryID)
+		return event, ok, nil
+	default:
+		return domain.PullRequestEvent{}, false, nil
+	}
+}
+
+// validGitHubSignature checks the "sha256=<hex>" HMAC of the body sent in X-Hub-Signature-256.
+func validGitHubSignature(secret, body []byte, header string) bool {
+	signature, ok := strings.CutPrefix(header, "sha256=")
+	if !ok {
+		return false
+	}
+
+	got, err := hex.DecodeString(signature)
+	if err != nil {
+		return false
+	}
+
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(body)
+
+	return hmac.Equal(got, mac.Sum(nil))
+}
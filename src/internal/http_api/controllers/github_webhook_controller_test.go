@@ -0,0 +1,261 @@
+package controllers
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"PrService/src/internal/domain"
+	"PrService/src/internal/http_api/mocks"
+	"PrService/src/internal/http_api/models"
+
+	"github.com/go-playground/validator/v10"
+	"go.uber.org/mock/gomock"
+)
+
+const testGitHubSecret = "It's a Secret to Everybody"
+
+func newGitHubWebhookController(
+	t *testing.T,
+) (*GitHubWebhookController, *mocks.MockPullRequestEventService) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	svc := mocks.NewMockPullRequestEventService(ctrl)
+
+	validate := validator.New()
+	logger := newTestLogger()
+
+	c := NewGitHubWebhookController(svc, testGitHubSecret, validate, logger)
+
+	return c, svc
+}
+
+func newGitHubWebhookRequest(t *testing.T, eventType, fixture string) *http.Request {
+	t.Helper()
+
+	body, err := os.ReadFile(filepath.Join("testdata", "github", fixture))
+	if err != nil {
+		t.Fatalf("failed to read fixture %s: %v", fixture, err)
+	}
+
+	mac := hmac.New(sha256.New, []byte(testGitHubSecret))
+	mac.Write(body)
+
+	req := httptest.NewRequest(http.MethodPost, "/webhooks/github", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-GitHub-Event", eventType)
+	req.Header.Set("X-GitHub-Delivery", "72d3162e-cc78-11e3-81ab-4c9367dc0958")
+	req.Header.Set("X-Hub-Signature-256", "sha256="+hex.EncodeToString(mac.Sum(nil)))
+
+	return req
+}
+
+func TestGitHubWebhookController_Handle_PullRequestFixtures(t *testing.T) {
+	tests := []struct {
+		fixture    string
+		wantAction domain.PullRequestEventAction
+		wantDraft  bool
+		status     domain.PullRequestStatus
+	}{
+		{
+			fixture:    "pull_request_opened.json",
+			wantAction: domain.PullRequestEventOpened,
+			status:     domain.PullRequestStatusOpen,
+		},
+		{
+			fixture:    "pull_request_opened_draft.json",
+			wantAction: domain.PullRequestEventOpened,
+			wantDraft:  true,
+			status:     domain.PullRequestStatusDraft,
+		},
+		{
+			fixture:    "pull_request_ready_for_review.json",
+			wantAction: domain.PullRequestEventReadyForReview,
+			status:     domain.PullRequestStatusOpen,
+		},
+		{
+			fixture:    "pull_request_closed_merged.json",
+			wantAction: domain.PullRequestEventMerged,
+			status:     domain.PullRequestStatusMerged,
+		},
+		{
+			fixture:    "pull_request_closed.json",
+			wantAction: domain.PullRequestEventClosed,
+			status:     domain.PullRequestStatusClosed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.fixture, func(t *testing.T) {
+			c, svc := newGitHubWebhookController(t)
+
+			wantEvent := domain.PullRequestEvent{
+				Provider:      domain.ExternalProviderGitHub,
+				DeliveryID:    "72d3162e-cc78-11e3-81ab-4c9367dc0958",
+				Action:        tt.wantAction,
+				PullRequestID: "octo-org/pr-service#42",
+				Title:         "Add reviewer load balancing",
+				AuthorLogin:   "Octocat",
+				Draft:         tt.wantDraft,
+			}
+
+			svc.
+				EXPECT().
+				Handle(gomock.Any(), wantEvent).
+				Return(&domain.PullRequest{
+					ID:       wantEvent.PullRequestID,
+					Name:     wantEvent.Title,
+					AuthorID: "u1",
+					Status:   tt.status,
+				}, nil)
+
+			rr := httptest.NewRecorder()
+			c.handle(rr, newGitHubWebhookRequest(t, "pull_request", tt.fixture))
+
+			if rr.Code != http.StatusOK {
+				t.Fatalf("expected status %d, got %d, body=%s", http.StatusOK, rr.Code, rr.Body.String())
+			}
+
+			var resp models.WebhookResponse
+			if err := json.Unmarshal(rr.Body.Bytes(), &resp); err != nil {
+				t.Fatalf("failed to unmarshal WebhookResponse: %v", err)
+			}
+
+			if resp.Result != models.WebhookResultProcessed || resp.PR == nil || resp.PR.Status != string(tt.status) {
+				t.Fatalf("unexpected webhook response: %+v", resp)
+			}
+		})
+	}
+}
+
+func TestGitHubWebhookController_Handle_ReviewSubmitted(t *testing.T) {
+	c, svc := newGitHubWebhookController(t)
+
+	svc.
+		EXPECT().
+		Handle(gomock.Any(), domain.PullRequestEvent{
+			Provider:      domain.ExternalProviderGitHub,
+			DeliveryID:    "72d3162e-cc78-11e3-81ab-4c9367dc0958",
+			Action:        domain.PullRequestEventReviewed,
+			PullRequestID: "octo-org/pr-service#42",
+			Title:         "Add reviewer load balancing",
+			AuthorLogin:   "Octocat",
+			ReviewerLogin: "Hubot",
+		}).
+		Return(&domain.PullRequest{
+			ID:       "octo-org/pr-service#42",
+			AuthorID: "u1",
+			Status:   domain.PullRequestStatusOpen,
+		}, nil)
+
+	rr := httptest.NewRecorder()
+	c.handle(rr, newGitHubWebhookRequest(t, "pull_request_review", "pull_request_review_submitted.json"))
+
+	if rr.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d, body=%s", http.StatusOK, rr.Code, rr.Body.String())
+	}
+}
+
+func TestGitHubWebhookController_Handle_IgnoredEvents(t *testing.T) {
+	tests := []struct {
+		name      string
+		eventType string
+		fixture   string
+	}{
+		{name: "unsupported action", eventType: "pull_request", fixture: "pull_request_labeled.json"},
+		{name: "other event type", eventType: "push", fixture: "pull_request_opened.json"},
+		{name: "dismissed review", eventType: "pull_request_review", fixture: "pull_request_review_dismissed.json"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, _ := newGitHubWebhookController(t)
+
+			rr := httptest.NewRecorder()
+			c.handle(rr, newGitHubWebhookRequest(t, tt.eventType, tt.fixture))
+
+			if rr.Code != http.StatusOK {
+				t.Fatalf("expected status %d, got %d, body=%s", http.StatusOK, rr.Code, rr.Body.String())
+			}
+
+			var resp models.WebhookResponse
+			if err := json.Unmarshal(rr.Body.Bytes(), &resp); err != nil {
+				t.Fatalf("failed to unmarshal WebhookResponse: %v", err)
+			}
+
+			if resp.Result != models.WebhookResultIgnored || resp.PR != nil {
+				t.Fatalf("expected ignored result, got %+v", resp)
+			}
+		})
+	}
+}
+
+func TestGitHubWebhookController_Handle_InvalidSignature(t *testing.T) {
+	tests := []struct {
+		name      string
+		signature string
+	}{
+		{name: "missing", signature: ""},
+		{name: "wrong prefix", signature: "sha1=0123"},
+		{name: "not hex", signature: "sha256=zz"},
+		{name: "wrong secret", signature: "sha256=" + hex.EncodeToString(make([]byte, sha256.Size))},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, _ := newGitHubWebhookController(t)
+
+			req := newGitHubWebhookRequest(t, "pull_request", "pull_request_opened.json")
+			req.Header.Set("X-Hub-Signature-256", tt.signature)
+			rr := httptest.NewRecorder()
+
+			c.handle(rr, req)
+
+			if rr.Code != http.StatusUnauthorized {
+				t.Fatalf("expected status %d, got %d, body=%s", http.StatusUnauthorized, rr.Code, rr.Body.String())
+			}
+
+			var resp models.ErrorResponse
+			if err := json.Unmarshal(rr.Body.Bytes(), &resp); err != nil {
+				t.Fatalf("failed to unmarshal ErrorResponse: %v", err)
+			}
+
+			if resp.Error.ErrorCode != models.ErrorCodeInvalidSignature {
+				t.Fatalf("expected error code %s, got %s", models.ErrorCodeInvalidSignature, resp.Error.ErrorCode)
+			}
+		})
+	}
+}
+
+func TestGitHubWebhookController_Handle_UnknownAccount(t *testing.T) {
+	c, svc := newGitHubWebhookController(t)
+
+	svc.
+		EXPECT().
+		Handle(gomock.Any(), gomock.Any()).
+		Return(nil, domain.ErrExternalAccountNotFound)
+
+	rr := httptest.NewRecorder()
+	c.handle(rr, newGitHubWebhookRequest(t, "pull_request", "pull_request_opened.json"))
+
+	if rr.Code != http.StatusUnprocessableEntity {
+		t.Fatalf("expected status %d, got %d, body=%s", http.StatusUnprocessableEntity, rr.Code, rr.Body.String())
+	}
+
+	var resp models.ErrorResponse
+	if err := json.Unmarshal(rr.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("failed to unmarshal ErrorResponse: %v", err)
+	}
+
+	if resp.Error.ErrorCode != models.ErrorCodeUnknownAccount {
+		t.Fatalf("expected error code %s, got %s", models.ErrorCodeUnknownAccount, resp.Error.ErrorCode)
+	}
+}
@@ -0,0 +1,115 @@
+package controllers
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+
+	"PrService/src/internal/domain"
+	"PrService/src/internal/http_api/models"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-playground/validator/v10"
+)
+
+type GitLabWebhookController struct {
+	baseController
+	eventService domain.PullRequestEventService
+	token        []byte
+}
+
+func NewGitLabWebhookController(
+	eventService domain.PullRequestEventService,
+	token string,
+	validate *validator.Validate,
+	logger *slog.Logger,
+) *GitLabWebhookController {
+	return &GitLabWebhookController{
+		baseController: newBaseController(validate, logger),
+		eventService:   eventService,
+		token:          []byte(token),
+	}
+}
+
+func (c *GitLabWebhookController) UseHandlers(r chi.Router) {
+	r.Post("/webhooks/gitlab", c.handle)
+}
+
+// handle godoc
+//
+//	@Summary	Принять webhook GitLab (Merge Request Hook) и применить его к жизненному циклу PR
+//	@Tags		Webhooks
+//	@Accept		json
+//	@Produce	json
+//	@Param		X-Gitlab-Event		header		string							true	"Тип события GitLab"
+//	@Param		X-Gitlab-Token		header		string							true	"Секретный токен webhook'а"
+//	@Param		X-Gitlab-Event-UUID	header		string							false	"Идентификатор события для дедупликации"
+//	@Param		request				body		models.GitLabMergeRequestEvent	true	"GitLab merge request payload"
+//	@Success	200					{object}	models.WebhookResponse			"Событие применено или проигнорировано"
+//	@Failure	400					{object}	models.ErrorResponse			"Неверный payload"
+//	@Failure	401					{object}	models.ErrorResponse			"Неверный токен"
+//	@Failure	404					{object}	models.ErrorResponse			"PR, пользователь или команда не найдены"
+//	@Failure	409					{object}	models.ErrorResponse			"Недопустимый переход статуса PR"
+//	@Failure	422					{object}	models.ErrorResponse			"Логин GitLab не связан с пользователем"
+//	@Failure	500					{object}	models.ErrorResponse			"Ошибка сервера"
+//	@Router		/webhooks/gitlab [post]
+func (c *GitLabWebhookController) handle(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	deliveryID := r.Header.Get("X-Gitlab-Event-UUID")
+
+	if subtle.ConstantTimeCompare([]byte(r.Header.Get("X-Gitlab-Token")), c.token) != 1 {
+		c.writeError(ctx, w, http.StatusUnauthorized,
+			models.ErrorCodeInvalidToken,
+			"invalid token",
+			"GitLab webhook token mismatch",
+			nil,
+			"delivery_id", deliveryID,
+		)
+		return
+	}
+
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, webhookMaxPayloadBytes))
+	if err != nil {
+		c.writeError(ctx, w, http.StatusBadRequest,
+			models.ErrorCodeDecodeFailed,
+			"invalid request body",
+			"failed to read GitLab webhook body",
+			err,
+			"delivery_id", deliveryID,
+		)
+		return
+	}
+
+	if r.Header.Get("X-Gitlab-Event") != "Merge Request Hook" {
+		c.writeJSON(ctx, w, http.StatusOK, models.MapToWebhookResponse(nil))
+		return
+	}
+
+	var payload models.GitLabMergeRequestEvent
+	if err := json.Unmarshal(body, &payload); err != nil {
+		c.writeError(ctx, w, http.StatusBadRequest,
+			models.ErrorCodeDecodeFailed,
+			"invalid request body",
+			"failed to decode GitLab merge request payload",
+			err,
+			"delivery_id", deliveryID,
+		)
+		return
+	}
+
+	event, ok := payload.MapToDomain(deliveryID)
+	if !ok {
+		c.writeJSON(ctx, w, http.StatusOK, models.MapToWebhookResponse(nil))
+		return
+	}
+
+	pr, err := c.eventService.Handle(ctx, event)
+	if err != nil {
+		c.writeEventError(ctx, w, err, event)
+		return
+	}
+
+	c.writeJSON(ctx, w, http.StatusOK, models.MapToWebhookResponse(pr))
+}
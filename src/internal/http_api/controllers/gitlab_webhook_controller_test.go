@@ -0,0 +1,242 @@
+package controllers
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"PrService/src/internal/domain"
+	"PrService/src/internal/http_api/mocks"
+	"PrService/src/internal/http_api/models"
+
+	"github.com/go-playground/validator/v10"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	testGitLabToken     = "gitlab-webhook-token"
+	testGitLabEventUUID = "13792a34-cac6-4fda-95a8-c58e00a3954e"
+)
+
+func newGitLabWebhookController(
+	t *testing.T,
+) (*GitLabWebhookController, *mocks.MockPullRequestEventService) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	svc := mocks.NewMockPullRequestEventService(ctrl)
+
+	validate := validator.New()
+	logger := newTestLogger()
+
+	c := NewGitLabWebhookController(svc, testGitLabToken, validate, logger)
+
+	return c, svc
+}
+
+func newGitLabWebhookRequest(t *testing.T, eventType, fixture string) *http.Request {
+	t.Helper()
+
+	body, err := os.ReadFile(filepath.Join("testdata", "gitlab", fixture))
+	if err != nil {
+		t.Fatalf("failed to read fixture %s: %v", fixture, err)
+	}
+
+	req := httptest.NewRequest(http.MethodPost, "/webhooks/gitlab", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Gitlab-Event", eventType)
+	req.Header.Set("X-Gitlab-Event-UUID", testGitLabEventUUID)
+	req.Header.Set("X-Gitlab-Token", testGitLabToken)
+
+	return req
+}
+
+func TestGitLabWebhookController_Handle_MergeRequestFixtures(t *testing.T) {
+	tests := []struct {
+		fixture    string
+		wantAction domain.PullRequestEventAction
+		wantTitle  string
+		wantDraft  bool
+	}{
+		{
+			fixture:    "merge_request_open.json",
+			wantAction: domain.PullRequestEventOpened,
+			wantTitle:  "Draft: Add reviewer load balancing",
+			wantDraft:  true,
+		},
+		{
+			fixture:    "merge_request_update.json",
+			wantAction: domain.PullRequestEventUpdated,
+			wantTitle:  "Add reviewer load balancing",
+		},
+		{
+			fixture:    "merge_request_merge.json",
+			wantAction: domain.PullRequestEventMerged,
+			wantTitle:  "Add reviewer load balancing",
+		},
+		{
+			fixture:    "merge_request_close.json",
+			wantAction: domain.PullRequestEventClosed,
+			wantTitle:  "Add reviewer load balancing",
+		},
+		{
+			fixture:    "merge_request_reopen.json",
+			wantAction: domain.PullRequestEventReopened,
+			wantTitle:  "Add reviewer load balancing",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.fixture, func(t *testing.T) {
+			c, svc := newGitLabWebhookController(t)
+
+			wantEvent := domain.PullRequestEvent{
+				Provider:      domain.ExternalProviderGitLab,
+				DeliveryID:    testGitLabEventUUID,
+				Action:        tt.wantAction,
+				PullRequestID: "platform/pr-service!7",
+				Title:         tt.wantTitle,
+				AuthorLogin:   "JDoe",
+				Draft:         tt.wantDraft,
+			}
+
+			svc.
+				EXPECT().
+				Handle(gomock.Any(), wantEvent).
+				Return(&domain.PullRequest{
+					ID:       wantEvent.PullRequestID,
+					Name:     wantEvent.Title,
+					AuthorID: "u1",
+					Status:   domain.PullRequestStatusOpen,
+				}, nil)
+
+			rr := httptest.NewRecorder()
+			c.handle(rr, newGitLabWebhookRequest(t, "Merge Request Hook", tt.fixture))
+
+			if rr.Code != http.StatusOK {
+				t.Fatalf("expected status %d, got %d, body=%s", http.StatusOK, rr.Code, rr.Body.String())
+			}
+
+			var resp models.WebhookResponse
+			if err := json.Unmarshal(rr.Body.Bytes(), &resp); err != nil {
+				t.Fatalf("failed to unmarshal WebhookResponse: %v", err)
+			}
+
+			if resp.Result != models.WebhookResultProcessed || resp.PR == nil {
+				t.Fatalf("unexpected webhook response: %+v", resp)
+			}
+		})
+	}
+}
+
+func TestGitLabWebhookController_Handle_Approved(t *testing.T) {
+	c, svc := newGitLabWebhookController(t)
+
+	svc.
+		EXPECT().
+		Handle(gomock.Any(), domain.PullRequestEvent{
+			Provider:      domain.ExternalProviderGitLab,
+			DeliveryID:    testGitLabEventUUID,
+			Action:        domain.PullRequestEventReviewed,
+			PullRequestID: "platform/pr-service!7",
+			Title:         "Add reviewer load balancing",
+			ReviewerLogin: "JDoe",
+		}).
+		Return(&domain.PullRequest{
+			ID:       "platform/pr-service!7",
+			AuthorID: "u1",
+			Status:   domain.PullRequestStatusOpen,
+		}, nil)
+
+	rr := httptest.NewRecorder()
+	c.handle(rr, newGitLabWebhookRequest(t, "Merge Request Hook", "merge_request_approved.json"))
+
+	if rr.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d, body=%s", http.StatusOK, rr.Code, rr.Body.String())
+	}
+}
+
+func TestGitLabWebhookController_Handle_IgnoredEvents(t *testing.T) {
+	tests := []struct {
+		name      string
+		eventType string
+		fixture   string
+	}{
+		{name: "unsupported action", eventType: "Merge Request Hook", fixture: "merge_request_unapproved.json"},
+		{name: "other event type", eventType: "Push Hook", fixture: "merge_request_open.json"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, _ := newGitLabWebhookController(t)
+
+			rr := httptest.NewRecorder()
+			c.handle(rr, newGitLabWebhookRequest(t, tt.eventType, tt.fixture))
+
+			if rr.Code != http.StatusOK {
+				t.Fatalf("expected status %d, got %d, body=%s", http.StatusOK, rr.Code, rr.Body.String())
+			}
+
+			var resp models.WebhookResponse
+			if err := json.Unmarshal(rr.Body.Bytes(), &resp); err != nil {
+				t.Fatalf("failed to unmarshal WebhookResponse: %v", err)
+			}
+
+			if resp.Result != models.WebhookResultIgnored {
+				t.Fatalf("expected ignored result, got %+v", resp)
+			}
+		})
+	}
+}
+
+func TestGitLabWebhookController_Handle_DuplicateDelivery(t *testing.T) {
+	c, svc := newGitLabWebhookController(t)
+
+	svc.
+		EXPECT().
+		Handle(gomock.Any(), gomock.Any()).
+		Return(nil, nil)
+
+	rr := httptest.NewRecorder()
+	c.handle(rr, newGitLabWebhookRequest(t, "Merge Request Hook", "merge_request_merge.json"))
+
+	if rr.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d, body=%s", http.StatusOK, rr.Code, rr.Body.String())
+	}
+
+	var resp models.WebhookResponse
+	if err := json.Unmarshal(rr.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("failed to unmarshal WebhookResponse: %v", err)
+	}
+
+	if resp.Result != models.WebhookResultIgnored {
+		t.Fatalf("expected already applied delivery to be ignored, got %+v", resp)
+	}
+}
+
+func TestGitLabWebhookController_Handle_InvalidToken(t *testing.T) {
+	c, _ := newGitLabWebhookController(t)
+
+	req := newGitLabWebhookRequest(t, "Merge Request Hook", "merge_request_open.json")
+	req.Header.Set("X-Gitlab-Token", "wrong")
+	rr := httptest.NewRecorder()
+
+	c.handle(rr, req)
+
+	if rr.Code != http.StatusUnauthorized {
+		t.Fatalf("expected status %d, got %d, body=%s", http.StatusUnauthorized, rr.Code, rr.Body.String())
+	}
+
+	var resp models.ErrorResponse
+	if err := json.Unmarshal(rr.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("failed to unmarshal ErrorResponse: %v", err)
+	}
+
+	if resp.Error.ErrorCode != models.ErrorCodeInvalidToken {
+		t.Fatalf("expected error code %s, got %s", models.ErrorCodeInvalidToken, resp.Error.ErrorCode)
+	}
+}
@@ -1,17 +1,23 @@
 package controllers
 
 import (
+	"context"
 	"errors"
+	"fmt"
 	"log/slog"
 	"net/http"
 
 	"PrService/src/internal/domain"
+	"PrService/src/internal/http_api/middlewares"
 	"PrService/src/internal/http_api/models"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/go-playground/validator/v10"
 )
 
+const reviewerChangeForbiddenMessage = "only the author, a lead of the reviewer's or the author's team " +
+	"or an admin can change the reviewers of the PR"
+
 type PullRequestController struct {
 	baseController
 	pullRequestService domain.PullRequestService
@@ -29,23 +35,28 @@ func NewPullRequestController(
 }
 
 func (c *PullRequestController) UseHandlers(r chi.Router) {
-	r.Post("/pullRequest/create", c.create)
-	r.Post("/pullRequest/merge", c.merge)
-	r.Post("/pullRequest/reassign", c.reassign)
+	r.With(middlewares.RequireScope(domain.ScopePullRequestWrite)).Post("/pullRequest/create", c.create)
+	r.With(middlewares.RequireScope(domain.ScopePullRequestWrite)).Post("/pullRequest/merge", c.merge)
+	r.With(middlewares.RequireScope(domain.ScopePullRequestWrite)).Post("/pullRequest/reassign", c.reassign)
+	r.With(middlewares.RequireScope(domain.ScopePullRequestWrite)).Post("/pullRequest/addReviewer", c.addReviewer)
+	r.With(middlewares.RequireScope(domain.ScopePullRequestWrite)).Post("/pullRequest/removeReviewer", c.removeReviewer)
 }
 
 // create godoc
 //
-//	@Summary	Создать PR и автоматически назначить до 2 ревьюверов из команды автора
+//	@Summary	Создать PR и назначить ревьюверов из команды автора, extra_teams и required_reviewers
 //	@Tags		PullRequests
 //	@Accept		json
 //	@Produce	json
 //	@Param		request	body		models.CreatePullRequestRequest		true	"Create pull request body"
 //	@Success	201		{object}	models.PullRequestEnvelopeResponse	"PR создан"
 //	@Failure	400		{object}	models.ErrorResponse				"Неверный запрос"
-//	@Failure	404		{object}	models.ErrorResponse				"Автор/команда не найдены"
-//	@Failure	409		{object}	models.ErrorResponse				"PR уже существует"
+//	@Failure	403		{object}	models.ErrorResponse				"PR от имени другого пользователя"
+//	@Failure	404		{object}	models.ErrorResponse				"Автор/команда/ревьювер не найдены"
+//	@Failure	409		{object}	models.ErrorResponse				"PR уже существует или ревьювер недоступен"
 //	@Failure	500		{object}	models.ErrorResponse				"Ошибка сервера"
+//	@Security	ApiKeyAuth
+//	@Security	BearerAuth
 //	@Router		/pullRequest/create [post]
 func (c *PullRequestController) create(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -59,8 +70,20 @@ func (c *PullRequestController) create(w http.ResponseWriter, r *http.Request) {
 		domain.PullRequestID(req.PullRequestID),
 		req.PullRequestName,
 		domain.UserID(req.AuthorID),
+		req.MapToDomainOptions(),
 	)
 	if err != nil {
+		if errors.Is(err, domain.ErrForbidden) {
+			c.writeError(ctx, w, http.StatusForbidden,
+				models.ErrorCodeForbidden,
+				"only an admin can create a PR on behalf of another user",
+				"create forbidden for the actor",
+				err,
+				"pr_id", req.PullRequestID,
+				"author_id", req.AuthorID,
+			)
+			return
+		}
 		if errors.Is(err, domain.ErrUserNotFound) {
 			c.writeError(ctx, w, http.StatusNotFound,
 				models.ErrorCodeNotFound,
@@ -91,6 +114,36 @@ func (c *PullRequestController) create(w http.ResponseWriter, r *http.Request) {
 			)
 			return
 		}
+		if errors.Is(err, domain.ErrReviewerIsAuthor) {
+			c.writeError(ctx, w, http.StatusConflict,
+				models.ErrorCodeReviewerIsAuthor,
+				"author cannot review own PR",
+				"required reviewer is the author",
+				err,
+				"pr_id", req.PullRequestID,
+			)
+			return
+		}
+		if errors.Is(err, domain.ErrReviewerInactive) {
+			c.writeError(ctx, w, http.StatusConflict,
+				models.ErrorCodeReviewerInactive,
+				"reviewer is inactive",
+				"required reviewer is inactive",
+				err,
+				"pr_id", req.PullRequestID,
+			)
+			return
+		}
+		if errors.Is(err, domain.ErrRuleViolation) {
+			c.writeError(ctx, w, http.StatusConflict,
+				models.ErrorCodeRuleViolation,
+				ruleViolationMessage(err, "required reviewer violates team rule"),
+				"required reviewer violates team rule",
+				err,
+				"pr_id", req.PullRequestID,
+			)
+			return
+		}
 
 		c.writeError(ctx, w, http.StatusInternalServerError,
 			models.ErrorCodeInternalServer,
@@ -115,8 +168,12 @@ func (c *PullRequestController) create(w http.ResponseWriter, r *http.Request) {
 //	@Param		request	body		models.MergePullRequestRequest		true	"Merge pull request body"
 //	@Success	200		{object}	models.PullRequestEnvelopeResponse	"PR в состоянии MERGED"
 //	@Failure	400		{object}	models.ErrorResponse				"Неверный запрос"
+//	@Failure	403		{object}	models.ErrorResponse				"Мержить может только автор PR или администратор"
 //	@Failure	404		{object}	models.ErrorResponse				"PR не найден"
+//	@Failure	409		{object}	models.ErrorResponse				"PR закрыт без merge"
 //	@Failure	500		{object}	models.ErrorResponse				"Ошибка сервера"
+//	@Security	ApiKeyAuth
+//	@Security	BearerAuth
 //	@Router		/pullRequest/merge [post]
 func (c *PullRequestController) merge(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -138,6 +195,26 @@ func (c *PullRequestController) merge(w http.ResponseWriter, r *http.Request) {
 			)
 			return
 		}
+		if errors.Is(err, domain.ErrPullRequestClosed) {
+			c.writeError(ctx, w, http.StatusConflict,
+				models.ErrorCodePRClosed,
+				"cannot merge closed PR",
+				"pull request is closed",
+				err,
+				"pr_id", req.PullRequestID,
+			)
+			return
+		}
+		if errors.Is(err, domain.ErrForbidden) {
+			c.writeError(ctx, w, http.StatusForbidden,
+				models.ErrorCodeForbidden,
+				"only the author or an admin can merge the PR",
+				"merge forbidden for the actor",
+				err,
+				"pr_id", req.PullRequestID,
+			)
+			return
+		}
 
 		c.writeError(ctx, w, http.StatusInternalServerError,
 			models.ErrorCodeInternalServer,
@@ -155,16 +232,19 @@ func (c *PullRequestController) merge(w http.ResponseWriter, r *http.Request) {
 
 // reassign godoc
 //
-//	@Summary	Переназначить конкретного ревьювера на другого из его команды
+//	@Summary	Переназначить ревьювера на случайного или указанного (new_reviewer_id) участника его команды
 //	@Tags		PullRequests
 //	@Accept		json
 //	@Produce	json
 //	@Param		request	body		models.ReassignPullRequestRequest	true	"Reassign pull request reviewer body"
 //	@Success	200		{object}	models.ReassignPullRequestResponse	"Переназначение выполнено"
 //	@Failure	400		{object}	models.ErrorResponse				"Неверный запрос"
+//	@Failure	403		{object}	models.ErrorResponse				"Нет прав менять ревьюверов PR"
 //	@Failure	404		{object}	models.ErrorResponse				"PR или пользователь не найден"
 //	@Failure	409		{object}	models.ErrorResponse				"Нарушение доменных правил переназначения"
 //	@Failure	500		{object}	models.ErrorResponse				"Ошибка сервера"
+//	@Security	ApiKeyAuth
+//	@Security	BearerAuth
 //	@Router		/pullRequest/reassign [post]
 func (c *PullRequestController) reassign(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -177,8 +257,19 @@ func (c *PullRequestController) reassign(w http.ResponseWriter, r *http.Request)
 	pr, replacedBy, err := c.pullRequestService.Reassign(ctx,
 		domain.PullRequestID(req.PullRequestID),
 		domain.UserID(req.OldUserID),
+		domain.UserID(req.NewUserID),
 	)
 	if err != nil {
+		if errors.Is(err, domain.ErrForbidden) {
+			c.writeError(ctx, w, http.StatusForbidden,
+				models.ErrorCodeForbidden,
+				reviewerChangeForbiddenMessage,
+				"reassign forbidden for the actor",
+				err,
+				"pr_id", req.PullRequestID,
+			)
+			return
+		}
 		if errors.Is(err, domain.ErrPullRequestNotFound) {
 			c.writeError(ctx, w, http.StatusNotFound,
 				models.ErrorCodeNotFound,
@@ -211,6 +302,16 @@ func (c *PullRequestController) reassign(w http.ResponseWriter, r *http.Request)
 			)
 			return
 		}
+		if errors.Is(err, domain.ErrPullRequestClosed) {
+			c.writeError(ctx, w, http.StatusConflict,
+				models.ErrorCodePRClosed,
+				"cannot reassign on closed PR",
+				"pull request is closed",
+				err,
+				"pr_id", req.PullRequestID,
+			)
+			return
+		}
 		if errors.Is(err, domain.ErrReviewerIsNotAssigned) {
 			c.writeError(ctx, w, http.StatusConflict,
 				models.ErrorCodeNotAssigned,
@@ -222,16 +323,71 @@ func (c *PullRequestController) reassign(w http.ResponseWriter, r *http.Request)
 			)
 			return
 		}
+		if errors.Is(err, domain.ErrRuleViolation) {
+			c.writeError(ctx, w, http.StatusConflict,
+				models.ErrorCodeRuleViolation,
+				ruleViolationMessage(err, "new reviewer violates team rule"),
+				"new reviewer violates team rule",
+				err,
+				"pr_id", req.PullRequestID,
+				"new_user_id", req.NewUserID,
+			)
+			return
+		}
 		if errors.Is(err, domain.ErrNoCandidate) {
 			c.writeError(ctx, w, http.StatusConflict,
 				models.ErrorCodeNoCandidate,
+				ruleViolationMessage(err, "no candidate to reassign review"),
 				"no candidate to reassign review",
-				"no candidate to reassign review",
 				err,
 				"pr_id", req.PullRequestID,
 			)
 			return
 		}
+		if errors.Is(err, domain.ErrReviewerIsAuthor) {
+			c.writeError(ctx, w, http.StatusConflict,
+				models.ErrorCodeReviewerIsAuthor,
+				"author cannot review own PR",
+				"new reviewer is the author",
+				err,
+				"pr_id", req.PullRequestID,
+				"new_user_id", req.NewUserID,
+			)
+			return
+		}
+		if errors.Is(err, domain.ErrReviewerAlreadyAssigned) {
+			c.writeError(ctx, w, http.StatusConflict,
+				models.ErrorCodeAlreadyAssigned,
+				"user is already assigned on PR",
+				"new reviewer is already assigned",
+				err,
+				"pr_id", req.PullRequestID,
+				"new_user_id", req.NewUserID,
+			)
+			return
+		}
+		if errors.Is(err, domain.ErrReviewerNotInTeam) {
+			c.writeError(ctx, w, http.StatusConflict,
+				models.ErrorCodeNotInTeam,
+				"user is not a member of the reviewer's team",
+				"new reviewer is not in eligible team",
+				err,
+				"pr_id", req.PullRequestID,
+				"new_user_id", req.NewUserID,
+			)
+			return
+		}
+		if errors.Is(err, domain.ErrReviewerInactive) {
+			c.writeError(ctx, w, http.StatusConflict,
+				models.ErrorCodeReviewerInactive,
+				"reviewer is inactive",
+				"new reviewer is inactive",
+				err,
+				"pr_id", req.PullRequestID,
+				"new_user_id", req.NewUserID,
+			)
+			return
+		}
 
 		c.writeError(ctx, w, http.StatusInternalServerError,
 			models.ErrorCodeInternalServer,
@@ -246,3 +402,164 @@ func (c *PullRequestController) reassign(w http.ResponseWriter, r *http.Request)
 	resp := models.MapToReassignPullRequestResponse(*pr, replacedBy)
 	c.writeJSON(ctx, w, http.StatusOK, resp)
 }
+
+// addReviewer godoc
+//
+//	@Summary	Вручную добавить ревьювера на PR
+//	@Tags		PullRequests
+//	@Accept		json
+//	@Produce	json
+//	@Param		request	body		models.PullRequestReviewerRequest	true	"Add reviewer body"
+//	@Success	200		{object}	models.PullRequestEnvelopeResponse	"Ревьювер добавлен"
+//	@Failure	400		{object}	models.ErrorResponse				"Неверный запрос"
+//	@Failure	403		{object}	models.ErrorResponse				"Нет прав менять ревьюверов PR"
+//	@Failure	404		{object}	models.ErrorResponse				"PR или пользователь не найден"
+//	@Failure	409		{object}	models.ErrorResponse				"PR не открыт или нарушены доменные правила назначения"
+//	@Failure	500		{object}	models.ErrorResponse				"Ошибка сервера"
+//	@Security	ApiKeyAuth
+//	@Security	BearerAuth
+//	@Router		/pullRequest/addReviewer [post]
+func (c *PullRequestController) addReviewer(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req models.PullRequestReviewerRequest
+	if ok := c.decodeAndValidate(ctx, w, r, &req, "addReviewerRequest"); !ok {
+		return
+	}
+
+	pr, err := c.pullRequestService.AddReviewer(ctx,
+		domain.PullRequestID(req.PullRequestID),
+		domain.UserID(req.ReviewerID),
+	)
+	if err != nil {
+		if c.writeReviewerChangeError(ctx, w, err, req) {
+			return
+		}
+
+		c.writeError(ctx, w, http.StatusInternalServerError,
+			models.ErrorCodeInternalServer,
+			"internal server error",
+			"failed to add reviewer",
+			err,
+			"pr_id", req.PullRequestID,
+			"user_id", req.ReviewerID,
+		)
+		return
+	}
+
+	resp := models.MapToPullRequestEnvelopeResponse(*pr)
+	c.writeJSON(ctx, w, http.StatusOK, resp)
+}
+
+// removeReviewer godoc
+//
+//	@Summary	Вручную снять ревьювера с PR
+//	@Tags		PullRequests
+//	@Accept		json
+//	@Produce	json
+//	@Param		request	body		models.PullRequestReviewerRequest	true	"Remove reviewer body"
+//	@Success	200		{object}	models.PullRequestEnvelopeResponse	"Ревьювер снят"
+//	@Failure	400		{object}	models.ErrorResponse				"Неверный запрос"
+//	@Failure	403		{object}	models.ErrorResponse				"Нет прав менять ревьюверов PR"
+//	@Failure	404		{object}	models.ErrorResponse				"PR не найден"
+//	@Failure	409		{object}	models.ErrorResponse				"PR уже смержен или ревьювер не назначен"
+//	@Failure	500		{object}	models.ErrorResponse				"Ошибка сервера"
+//	@Security	ApiKeyAuth
+//	@Security	BearerAuth
+//	@Router		/pullRequest/removeReviewer [post]
+func (c *PullRequestController) removeReviewer(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req models.PullRequestReviewerRequest
+	if ok := c.decodeAndValidate(ctx, w, r, &req, "removeReviewerRequest"); !ok {
+		return
+	}
+
+	pr, err := c.pullRequestService.RemoveReviewer(ctx,
+		domain.PullRequestID(req.PullRequestID),
+		domain.UserID(req.ReviewerID),
+	)
+	if err != nil {
+		if c.writeReviewerChangeError(ctx, w, err, req) {
+			return
+		}
+
+		c.writeError(ctx, w, http.StatusInternalServerError,
+			models.ErrorCodeInternalServer,
+			"internal server error",
+			"failed to remove reviewer",
+			err,
+			"pr_id", req.PullRequestID,
+			"user_id", req.ReviewerID,
+		)
+		return
+	}
+
+	resp := models.MapToPullRequestEnvelopeResponse(*pr)
+	c.writeJSON(ctx, w, http.StatusOK, resp)
+}
+
+// writeReviewerChangeError maps domain errors of manual reviewer changes to responses.
+// It reports whether the error was handled.
+func (c *PullRequestController) writeReviewerChangeError(
+	ctx context.Context,
+	w http.ResponseWriter,
+	err error,
+	req models.PullRequestReviewerRequest,
+) bool {
+	var (
+		status  int
+		code    models.ErrorCode
+		message string
+	)
+
+	switch {
+	case errors.Is(err, domain.ErrForbidden):
+		status, code = http.StatusForbidden, models.ErrorCodeForbidden
+		message = reviewerChangeForbiddenMessage
+	case errors.Is(err, domain.ErrPullRequestNotFound), errors.Is(err, domain.ErrUserNotFound):
+		status, code, message = http.StatusNotFound, models.ErrorCodeNotFound, "resource not found"
+	case errors.Is(err, domain.ErrChangeMergedPullRequest):
+		status, code, message = http.StatusConflict, models.ErrorCodePRMerged, "cannot change reviewers on merged PR"
+	case errors.Is(err, domain.ErrPullRequestClosed):
+		status, code, message = http.StatusConflict, models.ErrorCodePRClosed, "cannot change reviewers on closed PR"
+	case errors.Is(err, domain.ErrPullRequestNotOpen):
+		status, code, message = http.StatusConflict, models.ErrorCodePRNotOpen, "reviewers can be added to open PR only"
+	case errors.Is(err, domain.ErrReviewerIsNotAssigned):
+		status, code, message = http.StatusConflict, models.ErrorCodeNotAssigned, "user is not assigned on PR"
+	case errors.Is(err, domain.ErrReviewerAlreadyAssigned):
+		status, code, message = http.StatusConflict, models.ErrorCodeAlreadyAssigned, "user is already assigned on PR"
+	case errors.Is(err, domain.ErrReviewerIsAuthor):
+		status, code, message = http.StatusConflict, models.ErrorCodeReviewerIsAuthor, "author cannot review own PR"
+	case errors.Is(err, domain.ErrReviewerInactive):
+		status, code, message = http.StatusConflict, models.ErrorCodeReviewerInactive, "reviewer is inactive"
+	case errors.Is(err, domain.ErrReviewersLimitExceeded):
+		status, code, message = http.StatusConflict, models.ErrorCodeReviewersLimit, "team reviewers limit exceeded"
+	case errors.Is(err, domain.ErrRuleViolation):
+		status, code = http.StatusConflict, models.ErrorCodeRuleViolation
+		message = ruleViolationMessage(err, "reviewer violates team rule")
+	default:
+		return false
+	}
+
+	c.writeError(ctx, w, status, code, message,
+		"failed to change pull request reviewers",
+		err,
+		"pr_id", req.PullRequestID,
+		"user_id", req.ReviewerID,
+	)
+
+	return true
+}
+
+// ruleViolationMessage appends the team rule that caused the error to the message, if any.
+func ruleViolationMessage(err error, message string) string {
+	var ruleErr *domain.RuleViolationError
+	if !errors.As(err, &ruleErr) {
+		return message
+	}
+
+	rule := ruleErr.Rule
+
+	return fmt.Sprintf("%s: rule %d (%s) of team %s", message, rule.ID, rule.Kind, rule.TeamName)
+}
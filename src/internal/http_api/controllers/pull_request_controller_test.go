@@ -37,7 +37,7 @@ func TestPullRequestController_Create_Success(t *testing.T) {
 
 	svc.
 		EXPECT().
-		Create(gomock.Any(), prID, "Test PR", authorID).
+		Create(gomock.Any(), prID, "Test PR", authorID, gomock.Any()).
 		Return(&domain.PullRequest{
 			ID:                prID,
 			Name:              "Test PR",
@@ -78,12 +78,97 @@ func TestPullRequestController_Create_Success(t *testing.T) {
 	}
 }
 
+func TestPullRequestController_Create_WithExtraTeamsAndRequiredReviewers(t *testing.T) {
+	c, svc := newPullRequestController(t)
+
+	prID := domain.PullRequestID("pr-1")
+	authorID := domain.UserID("u1")
+	opts := domain.CreatePullRequestOptions{
+		ExtraTeams:        []domain.TeamName{"security"},
+		RequiredReviewers: []domain.UserID{"u9"},
+	}
+
+	svc.
+		EXPECT().
+		Create(gomock.Any(), prID, "Test PR", authorID, opts).
+		Return(&domain.PullRequest{
+			ID:                prID,
+			Name:              "Test PR",
+			AuthorID:          authorID,
+			Status:            domain.PullRequestStatusOpen,
+			AssignedReviewers: []domain.UserID{"u9", "s1"},
+			ReviewerTeams:     map[domain.UserID]domain.TeamName{"u9": "frontend", "s1": "security"},
+		}, nil)
+
+	body := `{
+		"pull_request_id": "pr-1",
+		"pull_request_name": "Test PR",
+		"author_id": "u1",
+		"extra_teams": ["security"],
+		"required_reviewers": ["u9"]
+	}`
+
+	req := httptest.NewRequest(http.MethodPost, "/pullRequest/create", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	rr := httptest.NewRecorder()
+
+	c.create(rr, req)
+
+	if rr.Code != http.StatusCreated {
+		t.Fatalf("expected status %d, got %d, body=%s", http.StatusCreated, rr.Code, rr.Body.String())
+	}
+
+	var resp models.PullRequestEnvelopeResponse
+	if err := json.Unmarshal(rr.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("failed to unmarshal response: %v", err)
+	}
+
+	if resp.PR.ReviewerTeams["s1"] != "security" {
+		t.Fatalf("expected reviewer s1 from security, got %v", resp.PR.ReviewerTeams)
+	}
+}
+
+func TestPullRequestController_Create_RequiredReviewerInactive(t *testing.T) {
+	c, svc := newPullRequestController(t)
+
+	svc.
+		EXPECT().
+		Create(gomock.Any(), domain.PullRequestID("pr-5"), "Test", domain.UserID("u1"), gomock.Any()).
+		Return(nil, domain.ErrReviewerInactive)
+
+	body := `{
+		"pull_request_id": "pr-5",
+		"pull_request_name": "Test",
+		"author_id": "u1",
+		"required_reviewers": ["u2"]
+	}`
+
+	req := httptest.NewRequest(http.MethodPost, "/pullRequest/create", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	rr := httptest.NewRecorder()
+
+	c.create(rr, req)
+
+	if rr.Code != http.StatusConflict {
+		t.Fatalf("expected status %d, got %d, body=%s", http.StatusConflict, rr.Code, rr.Body.String())
+	}
+
+	var errResp models.ErrorResponse
+	if err := json.Unmarshal(rr.Body.Bytes(), &errResp); err != nil {
+		t.Fatalf("failed to unmarshal error response: %v", err)
+	}
+
+	if errResp.Error.ErrorCode != models.ErrorCodeReviewerInactive {
+		t.Fatalf("expected error code %s, got %s", models.ErrorCodeReviewerInactive, errResp.Error.ErrorCode)
+	}
+}
+
 func TestPullRequestController_Create_UserNotFound(t *testing.T) {
 	c, svc := newPullRequestController(t)
 
 	svc.
 		EXPECT().
-		Create(gomock.Any(), domain.PullRequestID("pr-2"), "Feature", domain.UserID("ghost")).
+		Create(gomock.Any(), domain.PullRequestID("pr-2"), "Feature", domain.UserID("ghost"), gomock.Any()).
 		Return(nil, domain.ErrUserNotFound)
 
 	body := `{
@@ -112,12 +197,37 @@ func TestPullRequestController_Create_UserNotFound(t *testing.T) {
 	}
 }
 
+func TestPullRequestController_Create_Forbidden(t *testing.T) {
+	c, svc := newPullRequestController(t)
+
+	svc.
+		EXPECT().
+		Create(gomock.Any(), domain.PullRequestID("pr-2"), "Feature", domain.UserID("u1"), gomock.Any()).
+		Return(nil, domain.ErrForbidden)
+
+	body := `{
+		"pull_request_id": "pr-2",
+		"pull_request_name": "Feature",
+		"author_id": "u1"
+	}`
+
+	req := httptest.NewRequest(http.MethodPost, "/pullRequest/create", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	rr := httptest.NewRecorder()
+
+	c.create(rr, req)
+
+	if rr.Code != http.StatusForbidden {
+		t.Fatalf("expected status %d, got %d, body=%s", http.StatusForbidden, rr.Code, rr.Body.String())
+	}
+}
+
 func TestPullRequestController_Create_TeamNotFound(t *testing.T) {
 	c, svc := newPullRequestController(t)
 
 	svc.
 		EXPECT().
-		Create(gomock.Any(), domain.PullRequestID("pr-3"), "Test", domain.UserID("u1")).
+		Create(gomock.Any(), domain.PullRequestID("pr-3"), "Test", domain.UserID("u1"), gomock.Any()).
 		Return(nil, domain.ErrTeamNotFound)
 
 	body := `{
@@ -142,7 +252,7 @@ func TestPullRequestController_Create_AlreadyExists(t *testing.T) {
 
 	svc.
 		EXPECT().
-		Create(gomock.Any(), domain.PullRequestID("pr-4"), "Test", domain.UserID("u1")).
+		Create(gomock.Any(), domain.PullRequestID("pr-4"), "Test", domain.UserID("u1"), gomock.Any()).
 		Return(nil, domain.ErrPullRequestExists)
 
 	body := `{
@@ -201,6 +311,36 @@ func TestPullRequestController_Merge_NotFound(t *testing.T) {
 	}
 }
 
+func TestPullRequestController_Merge_Forbidden(t *testing.T) {
+	c, svc := newPullRequestController(t)
+
+	svc.
+		EXPECT().
+		Merge(gomock.Any(), domain.PullRequestID("pr-1")).
+		Return(nil, domain.ErrForbidden)
+
+	body := `{"pull_request_id":"pr-1"}`
+
+	req := httptest.NewRequest(http.MethodPost, "/pullRequest/merge", strings.NewReader(body))
+	req = req.WithContext(domain.ContextWithActor(req.Context(), domain.NewUserActor("u2", domain.RoleMember)))
+	rr := httptest.NewRecorder()
+
+	c.merge(rr, req)
+
+	if rr.Code != http.StatusForbidden {
+		t.Fatalf("expected status %d, got %d, body=%s", http.StatusForbidden, rr.Code, rr.Body.String())
+	}
+
+	var errResp models.ErrorResponse
+	if err := json.Unmarshal(rr.Body.Bytes(), &errResp); err != nil {
+		t.Fatalf("failed to unmarshal error response: %v", err)
+	}
+
+	if errResp.Error.ErrorCode != models.ErrorCodeForbidden {
+		t.Fatalf("expected error code %s, got %s", models.ErrorCodeForbidden, errResp.Error.ErrorCode)
+	}
+}
+
 func TestPullRequestController_Reassign_NoCandidate(t *testing.T) {
 	c, svc := newPullRequestController(t)
 
@@ -209,7 +349,7 @@ func TestPullRequestController_Reassign_NoCandidate(t *testing.T) {
 
 	svc.
 		EXPECT().
-		Reassign(gomock.Any(), prID, oldReviewer).
+		Reassign(gomock.Any(), prID, oldReviewer, domain.UserID("")).
 		Return(nil, domain.UserID(""), domain.ErrNoCandidate)
 
 	body := `{
@@ -236,3 +376,269 @@ func TestPullRequestController_Reassign_NoCandidate(t *testing.T) {
 		t.Fatalf("expected error code %s, got %s", models.ErrorCodeNoCandidate, errResp.Error.ErrorCode)
 	}
 }
+
+func TestPullRequestController_AddReviewer_Success(t *testing.T) {
+	c, svc := newPullRequestController(t)
+
+	prID := domain.PullRequestID("pr-6")
+	reviewerID := domain.UserID("u3")
+
+	svc.
+		EXPECT().
+		AddReviewer(gomock.Any(), prID, reviewerID).
+		Return(&domain.PullRequest{
+			ID:                prID,
+			Name:              "Test",
+			AuthorID:          "u1",
+			Status:            domain.PullRequestStatusOpen,
+			AssignedReviewers: []domain.UserID{"u2", reviewerID},
+		}, nil)
+
+	body := `{
+		"pull_request_id": "pr-6",
+		"reviewer_id": "u3"
+	}`
+
+	req := httptest.NewRequest(http.MethodPost, "/pullRequest/addReviewer", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	rr := httptest.NewRecorder()
+
+	c.addReviewer(rr, req)
+
+	if rr.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d, body=%s", http.StatusOK, rr.Code, rr.Body.String())
+	}
+
+	var resp models.PullRequestEnvelopeResponse
+	if err := json.Unmarshal(rr.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("failed to unmarshal response: %v", err)
+	}
+
+	if len(resp.PR.AssignedReviewers) != 2 || resp.PR.AssignedReviewers[1] != string(reviewerID) {
+		t.Fatalf("unexpected assigned_reviewers: %v", resp.PR.AssignedReviewers)
+	}
+}
+
+func TestPullRequestController_AddReviewer_LimitExceeded(t *testing.T) {
+	c, svc := newPullRequestController(t)
+
+	svc.
+		EXPECT().
+		AddReviewer(gomock.Any(), domain.PullRequestID("pr-6"), domain.UserID("u3")).
+		Return(nil, domain.ErrReviewersLimitExceeded)
+
+	body := `{
+		"pull_request_id": "pr-6",
+		"reviewer_id": "u3"
+	}`
+
+	req := httptest.NewRequest(http.MethodPost, "/pullRequest/addReviewer", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	rr := httptest.NewRecorder()
+
+	c.addReviewer(rr, req)
+
+	if rr.Code != http.StatusConflict {
+		t.Fatalf("expected status %d, got %d, body=%s", http.StatusConflict, rr.Code, rr.Body.String())
+	}
+
+	var errResp models.ErrorResponse
+	if err := json.Unmarshal(rr.Body.Bytes(), &errResp); err != nil {
+		t.Fatalf("failed to unmarshal error response: %v", err)
+	}
+
+	if errResp.Error.ErrorCode != models.ErrorCodeReviewersLimit {
+		t.Fatalf("expected error code %s, got %s", models.ErrorCodeReviewersLimit, errResp.Error.ErrorCode)
+	}
+}
+
+func TestPullRequestController_RemoveReviewer_NotAssigned(t *testing.T) {
+	c, svc := newPullRequestController(t)
+
+	svc.
+		EXPECT().
+		RemoveReviewer(gomock.Any(), domain.PullRequestID("pr-7"), domain.UserID("u9")).
+		Return(nil, domain.ErrReviewerIsNotAssigned)
+
+	body := `{
+		"pull_request_id": "pr-7",
+		"reviewer_id": "u9"
+	}`
+
+	req := httptest.NewRequest(http.MethodPost, "/pullRequest/removeReviewer", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	rr := httptest.NewRecorder()
+
+	c.removeReviewer(rr, req)
+
+	if rr.Code != http.StatusConflict {
+		t.Fatalf("expected status %d, got %d, body=%s", http.StatusConflict, rr.Code, rr.Body.String())
+	}
+
+	var errResp models.ErrorResponse
+	if err := json.Unmarshal(rr.Body.Bytes(), &errResp); err != nil {
+		t.Fatalf("failed to unmarshal error response: %v", err)
+	}
+
+	if errResp.Error.ErrorCode != models.ErrorCodeNotAssigned {
+		t.Fatalf("expected error code %s, got %s", models.ErrorCodeNotAssigned, errResp.Error.ErrorCode)
+	}
+}
+
+func TestPullRequestController_Reassign_ToChosenReviewer(t *testing.T) {
+	c, svc := newPullRequestController(t)
+
+	prID := domain.PullRequestID("pr-8")
+
+	svc.
+		EXPECT().
+		Reassign(gomock.Any(), prID, domain.UserID("u2"), domain.UserID("u4")).
+		Return(&domain.PullRequest{
+			ID:                prID,
+			Name:              "Test",
+			AuthorID:          "u1",
+			Status:            domain.PullRequestStatusOpen,
+			AssignedReviewers: []domain.UserID{"u3", "u4"},
+		}, domain.UserID("u4"), nil)
+
+	body := `{
+		"pull_request_id": "pr-8",
+		"old_reviewer_id": "u2",
+		"new_reviewer_id": "u4"
+	}`
+
+	req := httptest.NewRequest(http.MethodPost, "/pullRequest/reassign", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	rr := httptest.NewRecorder()
+
+	c.reassign(rr, req)
+
+	if rr.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d, body=%s", http.StatusOK, rr.Code, rr.Body.String())
+	}
+
+	var resp models.ReassignPullRequestResponse
+	if err := json.Unmarshal(rr.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("failed to unmarshal response: %v", err)
+	}
+
+	if resp.ReplacedBy != "u4" {
+		t.Fatalf("expected replaced_by=u4, got %s", resp.ReplacedBy)
+	}
+}
+
+func TestPullRequestController_Reassign_ChosenReviewerNotInTeam(t *testing.T) {
+	c, svc := newPullRequestController(t)
+
+	svc.
+		EXPECT().
+		Reassign(gomock.Any(), domain.PullRequestID("pr-8"), domain.UserID("u2"), domain.UserID("x1")).
+		Return(nil, domain.UserID(""), domain.ErrReviewerNotInTeam)
+
+	body := `{
+		"pull_request_id": "pr-8",
+		"old_reviewer_id": "u2",
+		"new_reviewer_id": "x1"
+	}`
+
+	req := httptest.NewRequest(http.MethodPost, "/pullRequest/reassign", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	rr := httptest.NewRecorder()
+
+	c.reassign(rr, req)
+
+	if rr.Code != http.StatusConflict {
+		t.Fatalf("expected status %d, got %d, body=%s", http.StatusConflict, rr.Code, rr.Body.String())
+	}
+
+	var errResp models.ErrorResponse
+	if err := json.Unmarshal(rr.Body.Bytes(), &errResp); err != nil {
+		t.Fatalf("failed to unmarshal error response: %v", err)
+	}
+
+	if errResp.Error.ErrorCode != models.ErrorCodeNotInTeam {
+		t.Fatalf("expected error code %s, got %s", models.ErrorCodeNotInTeam, errResp.Error.ErrorCode)
+	}
+}
+
+func TestPullRequestController_Reassign_NoCandidateBecauseOfRule(t *testing.T) {
+	c, svc := newPullRequestController(t)
+
+	ruleErr := &domain.RuleViolationError{
+		Rule: domain.TeamRule{ID: 4, TeamName: "backend", Kind: domain.TeamRuleKindExcludePair},
+		Err:  domain.ErrNoCandidate,
+	}
+	svc.
+		EXPECT().
+		Reassign(gomock.Any(), domain.PullRequestID("pr-9"), domain.UserID("u2"), domain.UserID("")).
+		Return(nil, domain.UserID(""), ruleErr)
+
+	body := `{
+		"pull_request_id": "pr-9",
+		"old_reviewer_id": "u2"
+	}`
+
+	req := httptest.NewRequest(http.MethodPost, "/pullRequest/reassign", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	rr := httptest.NewRecorder()
+
+	c.reassign(rr, req)
+
+	if rr.Code != http.StatusConflict {
+		t.Fatalf("expected status %d, got %d, body=%s", http.StatusConflict, rr.Code, rr.Body.String())
+	}
+
+	var errResp models.ErrorResponse
+	if err := json.Unmarshal(rr.Body.Bytes(), &errResp); err != nil {
+		t.Fatalf("failed to unmarshal error response: %v", err)
+	}
+
+	if errResp.Error.ErrorCode != models.ErrorCodeNoCandidate {
+		t.Fatalf("expected error code %s, got %s", models.ErrorCodeNoCandidate, errResp.Error.ErrorCode)
+	}
+	if !strings.Contains(errResp.Error.Message, "rule 4 (EXCLUDE_PAIR)") {
+		t.Fatalf("expected message to name the rule, got %q", errResp.Error.Message)
+	}
+}
+
+func TestPullRequestController_Reassign_Forbidden(t *testing.T) {
+	c, svc := newPullRequestController(t)
+
+	svc.
+		EXPECT().
+		Reassign(gomock.Any(), domain.PullRequestID("pr-1"), domain.UserID("u2"), domain.UserID("")).
+		Return(nil, domain.UserID(""), domain.ErrForbidden)
+
+	body := `{"pull_request_id":"pr-1","old_reviewer_id":"u2"}`
+
+	req := httptest.NewRequest(http.MethodPost, "/pullRequest/reassign", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	rr := httptest.NewRecorder()
+
+	c.reassign(rr, req)
+
+	if rr.Code != http.StatusForbidden {
+		t.Fatalf("expected status %d, got %d, body=%s", http.StatusForbidden, rr.Code, rr.Body.String())
+	}
+}
+
+func TestPullRequestController_RemoveReviewer_Forbidden(t *testing.T) {
+	c, svc := newPullRequestController(t)
+
+	svc.
+		EXPECT().
+		RemoveReviewer(gomock.Any(), domain.PullRequestID("pr-1"), domain.UserID("u2")).
+		Return(nil, domain.ErrForbidden)
+
+	body := `{"pull_request_id":"pr-1","reviewer_id":"u2"}`
+
+	req := httptest.NewRequest(http.MethodPost, "/pullRequest/removeReviewer", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	rr := httptest.NewRecorder()
+
+	c.removeReviewer(rr, req)
+
+	if rr.Code != http.StatusForbidden {
+		t.Fatalf("expected status %d, got %d, body=%s", http.StatusForbidden, rr.Code, rr.Body.String())
+	}
+}
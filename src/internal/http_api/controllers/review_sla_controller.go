@@ -0,0 +1,323 @@
+package controllers
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"PrService/src/internal/domain"
+	"PrService/src/internal/http_api/middlewares"
+	"PrService/src/internal/http_api/models"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-playground/validator/v10"
+)
+
+type ReviewSLAController struct {
+	baseController
+	reviewSLAService domain.ReviewSLAService
+}
+
+func NewReviewSLAController(
+	reviewSLAService domain.ReviewSLAService,
+	validate *validator.Validate,
+	logger *slog.Logger,
+) *ReviewSLAController {
+	return &ReviewSLAController{
+		baseController:   newBaseController(validate, logger),
+		reviewSLAService: reviewSLAService,
+	}
+}
+
+func (c *ReviewSLAController) UseHandlers(r chi.Router) {
+	r.With(middlewares.RequireScope(domain.ScopeTeamRead)).Get("/team/sla", c.get)
+	r.With(middlewares.RequireScope(domain.ScopeTeamAdmin)).Post("/team/sla", c.set)
+	r.With(middlewares.RequireScope(domain.ScopeTeamAdmin)).Delete("/team/sla", c.delete)
+	r.With(middlewares.RequireScope(domain.ScopePullRequestRead)).Get("/pullRequest/history", c.history)
+	r.With(middlewares.RequireScope(domain.ScopePullRequestWrite)).Post("/pullRequest/markReviewed", c.markReviewed)
+}
+
+// set godoc
+//
+//	@Summary	Задать SLA ревью команды
+//	@Tags		Teams
+//	@Accept		json
+//	@Produce	json
+//	@Param		request	body		models.SetReviewSLARequest			true	"Set review SLA body"
+//	@Success	200		{object}	models.ReviewSLAEnvelopeResponse	"SLA сохранён"
+//	@Failure	400		{object}	models.ErrorResponse				"Неверный запрос"
+//	@Failure	404		{object}	models.ErrorResponse				"Команда не найдена"
+//	@Failure	500		{object}	models.ErrorResponse				"Ошибка сервера"
+//	@Security	ApiKeyAuth
+//	@Security	BearerAuth
+//	@Router		/team/sla [post]
+func (c *ReviewSLAController) set(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req models.SetReviewSLARequest
+	if ok := c.decodeAndValidate(ctx, w, r, &req, "setReviewSLARequest"); !ok {
+		return
+	}
+
+	sla, err := c.reviewSLAService.Set(ctx, req.MapToDomain())
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidReviewSLA) {
+			c.writeError(ctx, w, http.StatusBadRequest,
+				models.ErrorCodeValidationFailed,
+				"invalid review SLA",
+				"invalid review SLA",
+				err,
+				"team_name", req.TeamName,
+			)
+			return
+		}
+		if errors.Is(err, domain.ErrTeamNotFound) {
+			c.writeError(ctx, w, http.StatusNotFound,
+				models.ErrorCodeNotFound,
+				"resource not found",
+				"team not found to set review SLA",
+				err,
+				"team_name", req.TeamName,
+			)
+			return
+		}
+
+		c.writeError(ctx, w, http.StatusInternalServerError,
+			models.ErrorCodeInternalServer,
+			"internal server error",
+			"failed to set review SLA",
+			err,
+			"team_name", req.TeamName,
+		)
+		return
+	}
+
+	resp := models.ReviewSLAEnvelopeResponse{SLA: models.MapToReviewSLAResponse(*sla)}
+	c.writeJSON(ctx, w, http.StatusOK, resp)
+}
+
+// get godoc
+//
+//	@Summary	Получить SLA ревью команды
+//	@Tags		Teams
+//	@Accept		json
+//	@Produce	json
+//	@Param		team_name	query		string								true	"Уникальное имя команды"
+//	@Success	200			{object}	models.ReviewSLAEnvelopeResponse	"SLA команды"
+//	@Failure	400			{object}	models.ErrorResponse				"Неверный запрос"
+//	@Failure	404			{object}	models.ErrorResponse				"Команда или SLA не найдены"
+//	@Failure	500			{object}	models.ErrorResponse				"Ошибка сервера"
+//	@Security	ApiKeyAuth
+//	@Security	BearerAuth
+//	@Router		/team/sla [get]
+func (c *ReviewSLAController) get(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	teamName := r.URL.Query().Get("team_name")
+
+	if teamName == "" {
+		c.writeError(ctx, w, http.StatusBadRequest,
+			models.ErrorCodeValidationFailed,
+			"team_name is required",
+			"missing team_name query param for review SLA",
+			nil,
+		)
+		return
+	}
+
+	sla, err := c.reviewSLAService.Get(ctx, domain.TeamName(teamName))
+	if err != nil {
+		if errors.Is(err, domain.ErrTeamNotFound) || errors.Is(err, domain.ErrReviewSLANotFound) {
+			c.writeError(ctx, w, http.StatusNotFound,
+				models.ErrorCodeNotFound,
+				"resource not found",
+				"review SLA not found",
+				err,
+				"team_name", teamName,
+			)
+			return
+		}
+
+		c.writeError(ctx, w, http.StatusInternalServerError,
+			models.ErrorCodeInternalServer,
+			"internal server error",
+			"failed to get review SLA",
+			err,
+			"team_name", teamName,
+		)
+		return
+	}
+
+	resp := models.ReviewSLAEnvelopeResponse{SLA: models.MapToReviewSLAResponse(*sla)}
+	c.writeJSON(ctx, w, http.StatusOK, resp)
+}
+
+// delete godoc
+//
+//	@Summary	Отключить SLA ревью команды
+//	@Tags		Teams
+//	@Accept		json
+//	@Produce	json
+//	@Param		team_name	query	string	true	"Уникальное имя команды"
+//	@Success	204			"SLA удалён"
+//	@Failure	400			{object}	models.ErrorResponse	"Неверный запрос"
+//	@Failure	404			{object}	models.ErrorResponse	"SLA не найден"
+//	@Failure	500			{object}	models.ErrorResponse	"Ошибка сервера"
+//	@Security	ApiKeyAuth
+//	@Security	BearerAuth
+//	@Router		/team/sla [delete]
+func (c *ReviewSLAController) delete(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	teamName := r.URL.Query().Get("team_name")
+
+	if teamName == "" {
+		c.writeError(ctx, w, http.StatusBadRequest,
+			models.ErrorCodeValidationFailed,
+			"team_name is required",
+			"missing team_name query param to delete review SLA",
+			nil,
+		)
+		return
+	}
+
+	if err := c.reviewSLAService.Delete(ctx, domain.TeamName(teamName)); err != nil {
+		if errors.Is(err, domain.ErrReviewSLANotFound) {
+			c.writeError(ctx, w, http.StatusNotFound,
+				models.ErrorCodeNotFound,
+				"resource not found",
+				"review SLA not found",
+				err,
+				"team_name", teamName,
+			)
+			return
+		}
+
+		c.writeError(ctx, w, http.StatusInternalServerError,
+			models.ErrorCodeInternalServer,
+			"internal server error",
+			"failed to delete review SLA",
+			err,
+			"team_name", teamName,
+		)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// history godoc
+//
+//	@Summary	Получить историю автоматических действий над PR
+//	@Tags		PullRequests
+//	@Accept		json
+//	@Produce	json
+//	@Param		pull_request_id	query		string								true	"Идентификатор PR"
+//	@Success	200				{object}	models.PullRequestHistoryResponse	"История PR"
+//	@Failure	400				{object}	models.ErrorResponse				"Неверный запрос"
+//	@Failure	404				{object}	models.ErrorResponse				"PR не найден"
+//	@Failure	500				{object}	models.ErrorResponse				"Ошибка сервера"
+//	@Security	ApiKeyAuth
+//	@Security	BearerAuth
+//	@Router		/pullRequest/history [get]
+func (c *ReviewSLAController) history(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	pullRequestID := r.URL.Query().Get("pull_request_id")
+
+	if pullRequestID == "" {
+		c.writeError(ctx, w, http.StatusBadRequest,
+			models.ErrorCodeValidationFailed,
+			"pull_request_id is required",
+			"missing pull_request_id query param for history",
+			nil,
+		)
+		return
+	}
+
+	entries, err := c.reviewSLAService.History(ctx, domain.PullRequestID(pullRequestID))
+	if err != nil {
+		if errors.Is(err, domain.ErrPullRequestNotFound) {
+			c.writeError(ctx, w, http.StatusNotFound,
+				models.ErrorCodeNotFound,
+				"resource not found",
+				"pull request not found for history",
+				err,
+				"pull_request_id", pullRequestID,
+			)
+			return
+		}
+
+		c.writeError(ctx, w, http.StatusInternalServerError,
+			models.ErrorCodeInternalServer,
+			"internal server error",
+			"failed to get pull request history",
+			err,
+			"pull_request_id", pullRequestID,
+		)
+		return
+	}
+
+	resp := models.MapToPullRequestHistoryResponse(domain.PullRequestID(pullRequestID), entries)
+	c.writeJSON(ctx, w, http.StatusOK, resp)
+}
+
+// markReviewed godoc
+//
+//	@Summary		Отметить, что ревьювер оставил ревью
+//	@Description	Завершает SLA назначения: ревьюверу больше не отправляются напоминания и ревью не эскалируется.
+//	@Description	Пользователь может отметить только своё ревью.
+//	@Tags			PullRequests
+//	@Accept			json
+//	@Produce		json
+//	@Param			request	body		models.PullRequestReviewerRequest	true	"Mark reviewed body"
+//	@Success		200		{object}	models.PullRequestEnvelopeResponse	"Ревью отмечено"
+//	@Failure		400		{object}	models.ErrorResponse				"Неверный запрос"
+//	@Failure		403		{object}	models.ErrorResponse				"Чужое ревью"
+//	@Failure		404		{object}	models.ErrorResponse				"PR не найден"
+//	@Failure		409		{object}	models.ErrorResponse				"Ревьювер не назначен"
+//	@Failure		500		{object}	models.ErrorResponse				"Ошибка сервера"
+//	@Security		ApiKeyAuth
+//	@Security		BearerAuth
+//	@Router			/pullRequest/markReviewed [post]
+func (c *ReviewSLAController) markReviewed(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req models.PullRequestReviewerRequest
+	if ok := c.decodeAndValidate(ctx, w, r, &req, "markReviewedRequest"); !ok {
+		return
+	}
+
+	pr, err := c.reviewSLAService.MarkReviewed(ctx,
+		domain.PullRequestID(req.PullRequestID),
+		domain.UserID(req.ReviewerID),
+	)
+	if err != nil {
+		var (
+			status  int
+			code    models.ErrorCode
+			message string
+		)
+
+		switch {
+		case errors.Is(err, domain.ErrPullRequestNotFound):
+			status, code, message = http.StatusNotFound, models.ErrorCodeNotFound, "resource not found"
+		case errors.Is(err, domain.ErrReviewerIsNotAssigned):
+			status, code, message = http.StatusConflict, models.ErrorCodeNotAssigned, "user is not assigned on PR"
+		case errors.Is(err, domain.ErrForbidden):
+			status, code = http.StatusForbidden, models.ErrorCodeForbidden
+			message = "users can only mark their own reviews"
+		default:
+			status, code = http.StatusInternalServerError, models.ErrorCodeInternalServer
+			message = "internal server error"
+		}
+
+		c.writeError(ctx, w, status, code, message,
+			"failed to mark review",
+			err,
+			"pr_id", req.PullRequestID,
+			"user_id", req.ReviewerID,
+		)
+		return
+	}
+
+	resp := models.MapToPullRequestEnvelopeResponse(*pr)
+	c.writeJSON(ctx, w, http.StatusOK, resp)
+}
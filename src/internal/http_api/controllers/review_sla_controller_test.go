@@ -0,0 +1,220 @@
+package controllers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"PrService/src/internal/domain"
+	"PrService/src/internal/http_api/mocks"
+	"PrService/src/internal/http_api/models"
+
+	"github.com/go-playground/validator/v10"
+	"go.uber.org/mock/gomock"
+)
+
+func newReviewSLAController(t *testing.T) (*ReviewSLAController, *mocks.MockReviewSLAService) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	svc := mocks.NewMockReviewSLAService(ctrl)
+
+	validate := validator.New()
+	logger := newTestLogger()
+
+	c := NewReviewSLAController(svc, validate, logger)
+
+	return c, svc
+}
+
+func TestReviewSLAController_Set_Success(t *testing.T) {
+	c, svc := newReviewSLAController(t)
+
+	svc.
+		EXPECT().
+		Set(gomock.Any(), domain.ReviewSLA{
+			TeamName:          "backend",
+			FirstReviewWithin: 24 * time.Hour,
+			EscalateAfter:     4 * time.Hour,
+			Action:            domain.ReviewSLAActionEscalate,
+		}).
+		DoAndReturn(func(_ any, sla domain.ReviewSLA) (*domain.ReviewSLA, error) {
+			return &sla, nil
+		})
+
+	body := `{
+		"team_name": "backend",
+		"first_review_within_sec": 86400,
+		"escalate_after_sec": 14400,
+		"action": "ESCALATE"
+	}`
+
+	req := httptest.NewRequest(http.MethodPost, "/team/sla", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	rr := httptest.NewRecorder()
+
+	c.set(rr, req)
+
+	if rr.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d, body=%s", http.StatusOK, rr.Code, rr.Body.String())
+	}
+
+	var resp models.ReviewSLAEnvelopeResponse
+	if err := json.Unmarshal(rr.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("failed to unmarshal ReviewSLAEnvelopeResponse: %v", err)
+	}
+	if resp.SLA.FirstReviewWithinSec != 86400 || resp.SLA.EscalateAfterSec != 14400 || resp.SLA.Action != "ESCALATE" {
+		t.Fatalf("unexpected SLA response: %+v", resp.SLA)
+	}
+}
+
+func TestReviewSLAController_Set_InvalidBody(t *testing.T) {
+	c, _ := newReviewSLAController(t)
+
+	body := `{"team_name": "backend", "first_review_within_sec": 0, "action": "PING"}`
+
+	req := httptest.NewRequest(http.MethodPost, "/team/sla", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	rr := httptest.NewRecorder()
+
+	c.set(rr, req)
+
+	if rr.Code != http.StatusBadRequest {
+		t.Fatalf("expected status %d, got %d, body=%s", http.StatusBadRequest, rr.Code, rr.Body.String())
+	}
+}
+
+func TestReviewSLAController_Get_NotFound(t *testing.T) {
+	c, svc := newReviewSLAController(t)
+
+	svc.
+		EXPECT().
+		Get(gomock.Any(), domain.TeamName("backend")).
+		Return(nil, domain.ErrReviewSLANotFound)
+
+	req := httptest.NewRequest(http.MethodGet, "/team/sla?team_name=backend", nil)
+	rr := httptest.NewRecorder()
+
+	c.get(rr, req)
+
+	if rr.Code != http.StatusNotFound {
+		t.Fatalf("expected status %d, got %d, body=%s", http.StatusNotFound, rr.Code, rr.Body.String())
+	}
+}
+
+func TestReviewSLAController_History_Success(t *testing.T) {
+	c, svc := newReviewSLAController(t)
+
+	svc.
+		EXPECT().
+		History(gomock.Any(), domain.PullRequestID("pr-1")).
+		Return([]domain.PullRequestHistoryEntry{
+			{
+				ID:            1,
+				PullRequestID: "pr-1",
+				Action:        domain.HistoryReviewSLANotified,
+				ReviewerID:    "u2",
+				Details:       "no review within 24h0m0s of the assignment",
+				OccurredAt:    time.Date(2025, 3, 4, 10, 0, 0, 0, time.UTC),
+			},
+			{
+				ID:            2,
+				PullRequestID: "pr-1",
+				Action:        domain.HistoryReviewSLAReassigned,
+				ReviewerID:    "u2",
+				TargetIDs:     []domain.UserID{"u3"},
+				OccurredAt:    time.Date(2025, 3, 4, 14, 0, 0, 0, time.UTC),
+			},
+		}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/pullRequest/history?pull_request_id=pr-1", nil)
+	rr := httptest.NewRecorder()
+
+	c.history(rr, req)
+
+	if rr.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d, body=%s", http.StatusOK, rr.Code, rr.Body.String())
+	}
+
+	var resp models.PullRequestHistoryResponse
+	if err := json.Unmarshal(rr.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("failed to unmarshal PullRequestHistoryResponse: %v", err)
+	}
+	if len(resp.History) != 2 {
+		t.Fatalf("expected 2 history entries, got %+v", resp.History)
+	}
+	if e := resp.History[1]; e.Action != "REVIEW_SLA_REASSIGNED" || len(e.TargetIDs) != 1 ||
+		e.TargetIDs[0] != "u3" || e.OccurredAt != "2025-03-04T14:00:00Z" {
+		t.Fatalf("unexpected history entry: %+v", e)
+	}
+}
+
+func TestReviewSLAController_History_MissingID(t *testing.T) {
+	c, _ := newReviewSLAController(t)
+
+	req := httptest.NewRequest(http.MethodGet, "/pullRequest/history", nil)
+	rr := httptest.NewRecorder()
+
+	c.history(rr, req)
+
+	if rr.Code != http.StatusBadRequest {
+		t.Fatalf("expected status %d, got %d, body=%s", http.StatusBadRequest, rr.Code, rr.Body.String())
+	}
+}
+
+func TestReviewSLAController_MarkReviewed_Errors(t *testing.T) {
+	tests := []struct {
+		name       string
+		err        error
+		wantStatus int
+		wantCode   models.ErrorCode
+	}{
+		{name: "success", wantStatus: http.StatusOK},
+		{name: "pull request not found", err: domain.ErrPullRequestNotFound,
+			wantStatus: http.StatusNotFound, wantCode: models.ErrorCodeNotFound},
+		{name: "not assigned", err: domain.ErrReviewerIsNotAssigned,
+			wantStatus: http.StatusConflict, wantCode: models.ErrorCodeNotAssigned},
+		{name: "review of another user", err: domain.ErrForbidden,
+			wantStatus: http.StatusForbidden, wantCode: models.ErrorCodeForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, svc := newReviewSLAController(t)
+
+			var pr *domain.PullRequest
+			if tt.err == nil {
+				pr = &domain.PullRequest{ID: "pr-1", AuthorID: "u1", Status: domain.PullRequestStatusOpen}
+			}
+			svc.
+				EXPECT().
+				MarkReviewed(gomock.Any(), domain.PullRequestID("pr-1"), domain.UserID("u2")).
+				Return(pr, tt.err)
+
+			body := `{"pull_request_id": "pr-1", "reviewer_id": "u2"}`
+			req := httptest.NewRequest(http.MethodPost, "/pullRequest/markReviewed", strings.NewReader(body))
+			req.Header.Set("Content-Type", "application/json")
+			rr := httptest.NewRecorder()
+
+			c.markReviewed(rr, req)
+
+			if rr.Code != tt.wantStatus {
+				t.Fatalf("expected status %d, got %d, body=%s", tt.wantStatus, rr.Code, rr.Body.String())
+			}
+			if tt.err == nil {
+				return
+			}
+
+			var resp models.ErrorResponse
+			if err := json.Unmarshal(rr.Body.Bytes(), &resp); err != nil {
+				t.Fatalf("failed to unmarshal ErrorResponse: %v", err)
+			}
+			if resp.Error.ErrorCode != tt.wantCode {
+				t.Fatalf("expected error code %s, got %s", tt.wantCode, resp.Error.ErrorCode)
+			}
+		})
+	}
+}
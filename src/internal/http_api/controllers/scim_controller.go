@@ -0,0 +1,623 @@
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"PrService/src/internal/domain"
+	"PrService/src/internal/http_api/middlewares"
+	"PrService/src/internal/http_api/models"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/go-playground/validator/v10"
+)
+
+const contentTypeSCIM = "application/scim+json"
+
+// ScimController serves the SCIM 2.0 subset identity providers use to provision reviewers:
+// users map to users and groups to teams.
+type ScimController struct {
+	baseController
+	provisioningService domain.ProvisioningService
+	token               string
+}
+
+func NewScimController(
+	provisioningService domain.ProvisioningService,
+	token string,
+	validate *validator.Validate,
+	logger *slog.Logger,
+) *ScimController {
+	return &ScimController{
+		baseController:      newBaseController(validate, logger),
+		provisioningService: provisioningService,
+		token:               token,
+	}
+}
+
+func (c *ScimController) UseHandlers(r chi.Router) {
+	r.Route(models.ScimBasePath, func(r chi.Router) {
+		r.Use(middlewares.RequireBearerToken(c.token, c.unauthorized))
+
+		r.Get("/ServiceProviderConfig", c.serviceProviderConfig)
+
+		r.Get("/Users", c.listUsers)
+		r.Post("/Users", c.createUser)
+		r.Get("/Users/{id}", c.getUser)
+		r.Put("/Users/{id}", c.replaceUser)
+		r.Patch("/Users/{id}", c.patchUser)
+		r.Delete("/Users/{id}", c.deleteUser)
+
+		r.Get("/Groups", c.listGroups)
+		r.Post("/Groups", c.createGroup)
+		r.Get("/Groups/{id}", c.getGroup)
+		r.Put("/Groups/{id}", c.replaceGroup)
+		r.Patch("/Groups/{id}", c.patchGroup)
+	})
+}
+
+// serviceProviderConfig godoc
+//
+//	@Summary		Возможности SCIM-сервера
+//	@Tags			SCIM
+//	@Produce		json
+//	@Param			Authorization	header		string										true	"Bearer <SCIM_BEARER_TOKEN>"
+//	@Success		200				{object}	models.ScimServiceProviderConfigResponse	"Поддерживаемые возможности"
+//	@Failure		401				{object}	models.ScimErrorResponse					"Неверный токен"
+//	@Router			/scim/v2/ServiceProviderConfig [get]
+func (c *ScimController) serviceProviderConfig(w http.ResponseWriter, r *http.Request) {
+	c.writeSCIM(r.Context(), w, http.StatusOK, models.NewScimServiceProviderConfigResponse())
+}
+
+// listUsers godoc
+//
+//	@Summary		Список пользователей SCIM
+//	@Description	filter поддерживает сравнения eq, объединённые and, по id, externalId, userName, active,
+//	@Description	emails и groups. Нумерация startIndex начинается с 1, count — не больше 100.
+//	@Tags			SCIM
+//	@Produce		json
+//	@Param			Authorization	header		string						true	"Bearer <SCIM_BEARER_TOKEN>"
+//	@Param			filter			query		string						false	"Например, userName eq \"alice\""
+//	@Param			startIndex		query		int							false	"Номер первого результата"
+//	@Param			count			query		int							false	"Размер страницы"
+//	@Success		200				{object}	models.ScimUserListResponse	"Пользователи"
+//	@Failure		400				{object}	models.ScimErrorResponse	"Неверный фильтр"
+//	@Failure		401				{object}	models.ScimErrorResponse	"Неверный токен"
+//	@Failure		500				{object}	models.ScimErrorResponse	"Ошибка сервера"
+//	@Router			/scim/v2/Users [get]
+func (c *ScimController) listUsers(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var filter domain.ProvisioningUserFilter
+	if raw := r.URL.Query().Get("filter"); raw != "" {
+		clauses, err := models.ParseScimFilter(raw)
+		if err == nil {
+			filter, err = models.MapScimUserFilter(clauses)
+		}
+		if err != nil {
+			c.writeSCIMFailure(ctx, w, err, "invalid SCIM users filter", "filter", raw)
+			return
+		}
+	}
+
+	startIndex, count, ok := c.scimPage(w, r)
+	if !ok {
+		return
+	}
+
+	users, err := c.provisioningService.ListUsers(ctx, filter)
+	if err != nil {
+		c.writeSCIMFailure(ctx, w, err, "failed to list SCIM users")
+		return
+	}
+
+	from, to := scimPageBounds(len(users), startIndex, count)
+	resp := models.MapToScimUserListResponse(users[from:to], len(users), startIndex)
+	c.writeSCIM(ctx, w, http.StatusOK, resp)
+}
+
+// createUser godoc
+//
+//	@Summary		Создать пользователя SCIM
+//	@Description	id пользователя — externalId, а без него — userName. Пользователь попадает в команду
+//	@Description	SCIM_DEFAULT_TEAM, пока его не добавят в группу.
+//	@Tags			SCIM
+//	@Accept			json
+//	@Produce		json
+//	@Param			Authorization	header		string					true	"Bearer <SCIM_BEARER_TOKEN>"
+//	@Param			request			body		models.ScimUserRequest	true	"SCIM user"
+//	@Success		201				{object}	models.ScimUserResponse		"Пользователь создан"
+//	@Failure		400				{object}	models.ScimErrorResponse	"Неверный запрос"
+//	@Failure		401				{object}	models.ScimErrorResponse	"Неверный токен"
+//	@Failure		409				{object}	models.ScimErrorResponse	"Пользователь уже существует"
+//	@Failure		500				{object}	models.ScimErrorResponse	"Ошибка сервера"
+//	@Router			/scim/v2/Users [post]
+func (c *ScimController) createUser(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req models.ScimUserRequest
+	if ok := c.decodeSCIM(w, r, &req, "scimUserRequest"); !ok {
+		return
+	}
+
+	user, err := c.provisioningService.CreateUser(ctx, req.MapToDomain(""))
+	if err != nil {
+		c.writeSCIMFailure(ctx, w, err, "failed to create SCIM user", "user_name", req.UserName)
+		return
+	}
+
+	resp := models.MapToScimUserResponse(*user)
+	w.Header().Set("Location", resp.Meta.Location)
+	c.writeSCIM(ctx, w, http.StatusCreated, resp)
+}
+
+// getUser godoc
+//
+//	@Summary	Получить пользователя SCIM
+//	@Tags		SCIM
+//	@Produce	json
+//	@Param		Authorization	header		string						true	"Bearer <SCIM_BEARER_TOKEN>"
+//	@Param		id				path		string						true	"Идентификатор пользователя"
+//	@Success	200				{object}	models.ScimUserResponse		"Пользователь"
+//	@Failure	401				{object}	models.ScimErrorResponse	"Неверный токен"
+//	@Failure	404				{object}	models.ScimErrorResponse	"Пользователь не найден"
+//	@Failure	500				{object}	models.ScimErrorResponse	"Ошибка сервера"
+//	@Router		/scim/v2/Users/{id} [get]
+func (c *ScimController) getUser(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	id := domain.UserID(chi.URLParam(r, "id"))
+
+	user, err := c.provisioningService.GetUser(ctx, id)
+	if err != nil {
+		c.writeSCIMFailure(ctx, w, err, "failed to get SCIM user", "user_id", id)
+		return
+	}
+
+	c.writeSCIM(ctx, w, http.StatusOK, models.MapToScimUserResponse(*user))
+}
+
+// replaceUser godoc
+//
+//	@Summary		Заменить пользователя SCIM
+//	@Description	Меняет userName, active и email; команда меняется только через группы.
+//	@Description	При деактивации открытые ревью пользователя переназначаются.
+//	@Tags			SCIM
+//	@Accept			json
+//	@Produce		json
+//	@Param			Authorization	header		string					true	"Bearer <SCIM_BEARER_TOKEN>"
+//	@Param			id				path		string					true	"Идентификатор пользователя"
+//	@Param			request			body		models.ScimUserRequest	true	"SCIM user"
+//	@Success		200				{object}	models.ScimUserResponse		"Пользователь обновлён"
+//	@Failure		400				{object}	models.ScimErrorResponse	"Неверный запрос"
+//	@Failure		401				{object}	models.ScimErrorResponse	"Неверный токен"
+//	@Failure		404				{object}	models.ScimErrorResponse	"Пользователь не найден"
+//	@Failure		500				{object}	models.ScimErrorResponse	"Ошибка сервера"
+//	@Router			/scim/v2/Users/{id} [put]
+func (c *ScimController) replaceUser(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	id := domain.UserID(chi.URLParam(r, "id"))
+
+	var req models.ScimUserRequest
+	if ok := c.decodeSCIM(w, r, &req, "scimUserRequest"); !ok {
+		return
+	}
+
+	user, err := c.provisioningService.ReplaceUser(ctx, req.MapToDomain(id))
+	if err != nil {
+		c.writeSCIMFailure(ctx, w, err, "failed to replace SCIM user", "user_id", id)
+		return
+	}
+
+	c.writeSCIM(ctx, w, http.StatusOK, models.MapToScimUserResponse(*user))
+}
+
+// patchUser godoc
+//
+//	@Summary		Изменить пользователя SCIM
+//	@Description	Поддерживаются операции add, replace и remove над userName, active и emails.
+//	@Tags			SCIM
+//	@Accept			json
+//	@Produce		json
+//	@Param			Authorization	header		string					true	"Bearer <SCIM_BEARER_TOKEN>"
+//	@Param			id				path		string					true	"Идентификатор пользователя"
+//	@Param			request			body		models.ScimPatchRequest	true	"SCIM PatchOp"
+//	@Success		200				{object}	models.ScimUserResponse		"Пользователь обновлён"
+//	@Failure		400				{object}	models.ScimErrorResponse	"Неверный запрос"
+//	@Failure		401				{object}	models.ScimErrorResponse	"Неверный токен"
+//	@Failure		404				{object}	models.ScimErrorResponse	"Пользователь не найден"
+//	@Failure		500				{object}	models.ScimErrorResponse	"Ошибка сервера"
+//	@Router			/scim/v2/Users/{id} [patch]
+func (c *ScimController) patchUser(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	id := domain.UserID(chi.URLParam(r, "id"))
+
+	var req models.ScimPatchRequest
+	if ok := c.decodeSCIM(w, r, &req, "scimPatchRequest"); !ok {
+		return
+	}
+
+	user, err := c.provisioningService.GetUser(ctx, id)
+	if err != nil {
+		c.writeSCIMFailure(ctx, w, err, "failed to get SCIM user", "user_id", id)
+		return
+	}
+
+	patched, err := models.ApplyScimUserPatch(*user, req.Operations)
+	if err != nil {
+		c.writeSCIMFailure(ctx, w, err, "invalid SCIM user patch", "user_id", id)
+		return
+	}
+
+	user, err = c.provisioningService.ReplaceUser(ctx, patched)
+	if err != nil {
+		c.writeSCIMFailure(ctx, w, err, "failed to patch SCIM user", "user_id", id)
+		return
+	}
+
+	c.writeSCIM(ctx, w, http.StatusOK, models.MapToScimUserResponse(*user))
+}
+
+// deleteUser godoc
+//
+//	@Summary		Удалить пользователя SCIM
+//	@Description	Пользователь деактивируется, его открытые ревью переназначаются; история ревью сохраняется.
+//	@Tags			SCIM
+//	@Param			Authorization	header	string	true	"Bearer <SCIM_BEARER_TOKEN>"
+//	@Param			id				path	string	true	"Идентификатор пользователя"
+//	@Success		204				"Пользователь деактивирован"
+//	@Failure		401				{object}	models.ScimErrorResponse	"Неверный токен"
+//	@Failure		404				{object}	models.ScimErrorResponse	"Пользователь не найден"
+//	@Failure		500				{object}	models.ScimErrorResponse	"Ошибка сервера"
+//	@Router			/scim/v2/Users/{id} [delete]
+func (c *ScimController) deleteUser(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	id := domain.UserID(chi.URLParam(r, "id"))
+
+	if _, err := c.provisioningService.DeactivateUser(ctx, id); err != nil {
+		c.writeSCIMFailure(ctx, w, err, "failed to delete SCIM user", "user_id", id)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// listGroups godoc
+//
+//	@Summary		Список групп SCIM
+//	@Description	Группа — это команда, её участники — активные пользователи команды.
+//	@Description	filter поддерживает сравнения eq по id и displayName.
+//	@Tags			SCIM
+//	@Produce		json
+//	@Param			Authorization	header		string						true	"Bearer <SCIM_BEARER_TOKEN>"
+//	@Param			filter			query		string						false	"Например, displayName eq \"backend\""
+//	@Param			startIndex		query		int							false	"Номер первого результата"
+//	@Param			count			query		int							false	"Размер страницы"
+//	@Success		200				{object}	models.ScimGroupListResponse	"Группы"
+//	@Failure		400				{object}	models.ScimErrorResponse	"Неверный фильтр"
+//	@Failure		401				{object}	models.ScimErrorResponse	"Неверный токен"
+//	@Failure		500				{object}	models.ScimErrorResponse	"Ошибка сервера"
+//	@Router			/scim/v2/Groups [get]
+func (c *ScimController) listGroups(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var filter domain.ProvisioningGroupFilter
+	if raw := r.URL.Query().Get("filter"); raw != "" {
+		clauses, err := models.ParseScimFilter(raw)
+		if err == nil {
+			filter, err = models.MapScimGroupFilter(clauses)
+		}
+		if err != nil {
+			c.writeSCIMFailure(ctx, w, err, "invalid SCIM groups filter", "filter", raw)
+			return
+		}
+	}
+
+	startIndex, count, ok := c.scimPage(w, r)
+	if !ok {
+		return
+	}
+
+	teams, err := c.provisioningService.ListGroups(ctx, filter)
+	if err != nil {
+		c.writeSCIMFailure(ctx, w, err, "failed to list SCIM groups")
+		return
+	}
+
+	from, to := scimPageBounds(len(teams), startIndex, count)
+	resp := models.MapToScimGroupListResponse(teams[from:to], len(teams), startIndex)
+	c.writeSCIM(ctx, w, http.StatusOK, resp)
+}
+
+// createGroup godoc
+//
+//	@Summary		Создать группу SCIM
+//	@Description	Создаёт команду с именем displayName; участники переносятся в неё и активируются.
+//	@Tags			SCIM
+//	@Accept			json
+//	@Produce		json
+//	@Param			Authorization	header		string						true	"Bearer <SCIM_BEARER_TOKEN>"
+//	@Param			request			body		models.ScimGroupRequest		true	"SCIM group"
+//	@Success		201				{object}	models.ScimGroupResponse	"Группа создана"
+//	@Failure		400				{object}	models.ScimErrorResponse	"Неверный запрос"
+//	@Failure		401				{object}	models.ScimErrorResponse	"Неверный токен"
+//	@Failure		409				{object}	models.ScimErrorResponse	"Команда уже существует"
+//	@Failure		500				{object}	models.ScimErrorResponse	"Ошибка сервера"
+//	@Router			/scim/v2/Groups [post]
+func (c *ScimController) createGroup(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req models.ScimGroupRequest
+	if ok := c.decodeSCIM(w, r, &req, "scimGroupRequest"); !ok {
+		return
+	}
+
+	team, err := c.provisioningService.CreateGroup(ctx, domain.TeamName(req.DisplayName), req.MemberIDs())
+	if err != nil {
+		c.writeSCIMFailure(ctx, w, err, "failed to create SCIM group", "team_name", req.DisplayName)
+		return
+	}
+
+	resp := models.MapToScimGroupResponse(*team)
+	w.Header().Set("Location", resp.Meta.Location)
+	c.writeSCIM(ctx, w, http.StatusCreated, resp)
+}
+
+// getGroup godoc
+//
+//	@Summary	Получить группу SCIM
+//	@Tags		SCIM
+//	@Produce	json
+//	@Param		Authorization	header		string						true	"Bearer <SCIM_BEARER_TOKEN>"
+//	@Param		id				path		string						true	"Имя команды"
+//	@Success	200				{object}	models.ScimGroupResponse	"Группа"
+//	@Failure	401				{object}	models.ScimErrorResponse	"Неверный токен"
+//	@Failure	404				{object}	models.ScimErrorResponse	"Команда не найдена"
+//	@Failure	500				{object}	models.ScimErrorResponse	"Ошибка сервера"
+//	@Router		/scim/v2/Groups/{id} [get]
+func (c *ScimController) getGroup(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	name := domain.TeamName(chi.URLParam(r, "id"))
+
+	team, err := c.provisioningService.GetGroup(ctx, name)
+	if err != nil {
+		c.writeSCIMFailure(ctx, w, err, "failed to get SCIM group", "team_name", name)
+		return
+	}
+
+	c.writeSCIM(ctx, w, http.StatusOK, models.MapToScimGroupResponse(*team))
+}
+
+// replaceGroup godoc
+//
+//	@Summary		Заменить участников группы SCIM
+//	@Description	Новые участники переносятся в команду и активируются, отсутствующие в списке деактивируются,
+//	@Description	а их открытые ревью переназначаются. displayName менять нельзя.
+//	@Tags			SCIM
+//	@Accept			json
+//	@Produce		json
+//	@Param			Authorization	header		string						true	"Bearer <SCIM_BEARER_TOKEN>"
+//	@Param			id				path		string						true	"Имя команды"
+//	@Param			request			body		models.ScimGroupRequest		true	"SCIM group"
+//	@Success		200				{object}	models.ScimGroupResponse	"Группа обновлена"
+//	@Failure		400				{object}	models.ScimErrorResponse	"Неверный запрос"
+//	@Failure		401				{object}	models.ScimErrorResponse	"Неверный токен"
+//	@Failure		404				{object}	models.ScimErrorResponse	"Команда не найдена"
+//	@Failure		500				{object}	models.ScimErrorResponse	"Ошибка сервера"
+//	@Router			/scim/v2/Groups/{id} [put]
+func (c *ScimController) replaceGroup(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	name := domain.TeamName(chi.URLParam(r, "id"))
+
+	var req models.ScimGroupRequest
+	if ok := c.decodeSCIM(w, r, &req, "scimGroupRequest"); !ok {
+		return
+	}
+	if req.DisplayName != string(name) {
+		c.writeSCIMError(ctx, w, http.StatusBadRequest,
+			models.ScimTypeMutability,
+			"displayName of a group cannot change",
+			"SCIM group rename rejected",
+			nil,
+			"team_name", name,
+		)
+		return
+	}
+
+	ops := []domain.GroupMembersOp{{Kind: domain.GroupMembersReplace, UserIDs: req.MemberIDs()}}
+	team, err := c.provisioningService.UpdateGroupMembers(ctx, name, ops)
+	if err != nil {
+		c.writeSCIMFailure(ctx, w, err, "failed to replace SCIM group", "team_name", name)
+		return
+	}
+
+	c.writeSCIM(ctx, w, http.StatusOK, models.MapToScimGroupResponse(*team))
+}
+
+// patchGroup godoc
+//
+//	@Summary		Изменить участников группы SCIM
+//	@Description	Поддерживаются add, replace и remove над members, включая путь members[value eq "id"].
+//	@Description	Удалённые участники деактивируются, а их открытые ревью переназначаются.
+//	@Tags			SCIM
+//	@Accept			json
+//	@Produce		json
+//	@Param			Authorization	header		string						true	"Bearer <SCIM_BEARER_TOKEN>"
+//	@Param			id				path		string						true	"Имя команды"
+//	@Param			request			body		models.ScimPatchRequest		true	"SCIM PatchOp"
+//	@Success		200				{object}	models.ScimGroupResponse	"Группа обновлена"
+//	@Failure		400				{object}	models.ScimErrorResponse	"Неверный запрос"
+//	@Failure		401				{object}	models.ScimErrorResponse	"Неверный токен"
+//	@Failure		404				{object}	models.ScimErrorResponse	"Команда не найдена"
+//	@Failure		500				{object}	models.ScimErrorResponse	"Ошибка сервера"
+//	@Router			/scim/v2/Groups/{id} [patch]
+func (c *ScimController) patchGroup(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	name := domain.TeamName(chi.URLParam(r, "id"))
+
+	var req models.ScimPatchRequest
+	if ok := c.decodeSCIM(w, r, &req, "scimPatchRequest"); !ok {
+		return
+	}
+
+	ops, err := models.MapScimGroupPatch(name, req.Operations)
+	if err != nil {
+		c.writeSCIMFailure(ctx, w, err, "invalid SCIM group patch", "team_name", name)
+		return
+	}
+
+	team, err := c.provisioningService.UpdateGroupMembers(ctx, name, ops)
+	if err != nil {
+		c.writeSCIMFailure(ctx, w, err, "failed to patch SCIM group", "team_name", name)
+		return
+	}
+
+	c.writeSCIM(ctx, w, http.StatusOK, models.MapToScimGroupResponse(*team))
+}
+
+func (c *ScimController) unauthorized(w http.ResponseWriter, r *http.Request) {
+	c.writeSCIMError(r.Context(), w, http.StatusUnauthorized,
+		"",
+		"invalid bearer token",
+		"SCIM bearer token mismatch",
+		nil,
+	)
+}
+
+// scimPage reads startIndex and count; out of range values are clamped as RFC 7644 asks.
+func (c *ScimController) scimPage(w http.ResponseWriter, r *http.Request) (int, int, bool) {
+	startIndex, count := 1, models.ScimMaxResults
+
+	params := []struct {
+		name string
+		dst  *int
+	}{
+		{name: "startIndex", dst: &startIndex},
+		{name: "count", dst: &count},
+	}
+	for _, param := range params {
+		raw := r.URL.Query().Get(param.name)
+		if raw == "" {
+			continue
+		}
+
+		v, err := strconv.Atoi(raw)
+		if err != nil {
+			c.writeSCIMError(r.Context(), w, http.StatusBadRequest,
+				models.ScimTypeInvalidValue,
+				param.name+" must be an integer",
+				"invalid SCIM paging query param",
+				err,
+				param.name, raw,
+			)
+			return 0, 0, false
+		}
+		*param.dst = v
+	}
+
+	return max(startIndex, 1), min(max(count, 0), models.ScimMaxResults), true
+}
+
+// scimPageBounds returns the slice bounds of the page starting at the 1-based startIndex.
+func scimPageBounds(total, startIndex, count int) (int, int) {
+	from := min(startIndex-1, total)
+	return from, min(from+count, total)
+}
+
+func (c *ScimController) decodeSCIM(w http.ResponseWriter, r *http.Request, dst any, reqName string) bool {
+	ctx := r.Context()
+
+	if err := json.NewDecoder(r.Body).Decode(dst); err != nil {
+		c.writeSCIMError(ctx, w, http.StatusBadRequest,
+			models.ScimTypeInvalidSyntax,
+			"invalid request body",
+			"invalid "+reqName+" body",
+			err,
+		)
+		return false
+	}
+
+	if err := c.validate.StructCtx(ctx, dst); err != nil {
+		c.writeSCIMError(ctx, w, http.StatusBadRequest,
+			models.ScimTypeInvalidValue,
+			"validation failed: "+err.Error(),
+			"validation failed for "+reqName,
+			err,
+		)
+		return false
+	}
+
+	return true
+}
+
+// writeSCIMFailure maps errors of the SCIM models and the provisioning service to SCIM error responses.
+func (c *ScimController) writeSCIMFailure(
+	ctx context.Context,
+	w http.ResponseWriter,
+	err error,
+	logMsg string,
+	fields ...any,
+) {
+	status, scimType, detail := http.StatusInternalServerError, models.ScimType(""), "internal server error"
+
+	var scimErr *models.ScimError
+	switch {
+	case errors.As(err, &scimErr):
+		status, scimType, detail = http.StatusBadRequest, scimErr.ScimType, scimErr.Detail
+	case errors.Is(err, domain.ErrUserAlreadyExists), errors.Is(err, domain.ErrTeamAlreadyExists):
+		status, scimType, detail = http.StatusConflict, models.ScimTypeUniqueness, err.Error()
+	case errors.Is(err, domain.ErrInvalidGroupMember), errors.Is(err, domain.ErrInvalidTeamImport):
+		status, scimType, detail = http.StatusBadRequest, models.ScimTypeInvalidValue, err.Error()
+	case errors.Is(err, domain.ErrUserNotFound), errors.Is(err, domain.ErrTeamNotFound):
+		status, detail = http.StatusNotFound, err.Error()
+	}
+
+	c.writeSCIMError(ctx, w, status, scimType, detail, logMsg, err, fields...)
+}
+
+func (c *ScimController) writeSCIMError(
+	ctx context.Context,
+	w http.ResponseWriter,
+	status int,
+	scimType models.ScimType,
+	detail string,
+	logMsg string,
+	err error,
+	fields ...any,
+) {
+	logFields := []any{
+		"request_id", middleware.GetReqID(ctx),
+		"status", status,
+	}
+	if scimType != "" {
+		logFields = append(logFields, "scim_type", scimType)
+	}
+	if err != nil {
+		logFields = append(logFields, "err", err)
+	}
+	logFields = append(logFields, fields...)
+
+	if status >= 500 {
+		c.logger.ErrorContext(ctx, logMsg, logFields...)
+	} else {
+		c.logger.InfoContext(ctx, logMsg, logFields...)
+	}
+
+	c.writeSCIM(ctx, w, status, models.CreateScimErrorResponse(status, scimType, detail))
+}
+
+func (c *ScimController) writeSCIM(ctx context.Context, w http.ResponseWriter, status int, payload any) {
+	w.Header().Set("Content-Type", contentTypeSCIM)
+	w.WriteHeader(status)
+
+	if err := json.NewEncoder(w).Encode(payload); err != nil {
+		c.logger.ErrorContext(ctx, "failed to write SCIM response",
+			"request_id", middleware.GetReqID(ctx),
+			"err", err,
+		)
+	}
+}
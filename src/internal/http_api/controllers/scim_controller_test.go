@@ -0,0 +1,310 @@
+package controllers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"PrService/src/internal/domain"
+	"PrService/src/internal/http_api/mocks"
+	"PrService/src/internal/http_api/models"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-playground/validator/v10"
+	"go.uber.org/mock/gomock"
+)
+
+const testScimToken = "scim-token"
+
+func newScimRouter(t *testing.T) (http.Handler, *mocks.MockProvisioningService) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	svc := mocks.NewMockProvisioningService(ctrl)
+
+	r := chi.NewRouter()
+	NewScimController(svc, testScimToken, validator.New(), newTestLogger()).UseHandlers(r)
+
+	return r, svc
+}
+
+func serveScim(h http.Handler, method, target, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, target, strings.NewReader(body))
+	req.Header.Set("Authorization", "Bearer "+testScimToken)
+	req.Header.Set("Content-Type", contentTypeSCIM)
+	rr := httptest.NewRecorder()
+
+	h.ServeHTTP(rr, req)
+
+	return rr
+}
+
+func decodeScimError(t *testing.T, rr *httptest.ResponseRecorder) models.ScimErrorResponse {
+	t.Helper()
+
+	var resp models.ScimErrorResponse
+	if err := json.NewDecoder(rr.Body).Decode(&resp); err != nil {
+		t.Fatalf("failed to decode error response: %v", err)
+	}
+
+	return resp
+}
+
+func TestScimController_RejectsInvalidToken(t *testing.T) {
+	h, _ := newScimRouter(t)
+
+	for _, auth := range []string{"", "Bearer wrong", "Basic " + testScimToken} {
+		req := httptest.NewRequest(http.MethodGet, "/scim/v2/Users", nil)
+		if auth != "" {
+			req.Header.Set("Authorization", auth)
+		}
+		rr := httptest.NewRecorder()
+
+		h.ServeHTTP(rr, req)
+
+		if rr.Code != http.StatusUnauthorized {
+			t.Fatalf("%q: expected status 401, got %d", auth, rr.Code)
+		}
+		if resp := decodeScimError(t, rr); resp.Status != "401" || resp.Schemas[0] != models.ScimErrorSchema {
+			t.Fatalf("%q: unexpected error response: %+v", auth, resp)
+		}
+	}
+}
+
+func TestScimController_ListUsers_FilterAndPaging(t *testing.T) {
+	h, svc := newScimRouter(t)
+
+	active := true
+	svc.
+		EXPECT().
+		ListUsers(gomock.Any(), domain.ProvisioningUserFilter{Username: "alice", IsActive: &active}).
+		Return([]domain.User{
+			{ID: "u1", Username: "alice", TeamName: "backend", IsActive: true},
+			{ID: "u2", Username: "alice", TeamName: "frontend", IsActive: true},
+			{ID: "u3", Username: "alice", TeamName: "frontend", IsActive: true},
+		}, nil)
+
+	filter := `userName%20eq%20%22alice%22%20and%20active%20eq%20true`
+	rr := serveScim(h, http.MethodGet, "/scim/v2/Users?startIndex=2&count=1&filter="+filter, "")
+
+	if rr.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d: %s", rr.Code, rr.Body.String())
+	}
+	if ct := rr.Header().Get("Content-Type"); ct != contentTypeSCIM {
+		t.Fatalf("expected SCIM content type, got %q", ct)
+	}
+
+	var resp models.ScimUserListResponse
+	if err := json.NewDecoder(rr.Body).Decode(&resp); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if resp.TotalResults != 3 || resp.StartIndex != 2 || resp.ItemsPerPage != 1 || resp.Resources[0].ID != "u2" {
+		t.Fatalf("unexpected page: %+v", resp)
+	}
+	if groups := resp.Resources[0].Groups; len(groups) != 1 || groups[0].Value != "frontend" {
+		t.Fatalf("expected the team as the group of the user, got %+v", groups)
+	}
+}
+
+func TestScimController_ListUsers_InvalidFilter(t *testing.T) {
+	h, _ := newScimRouter(t)
+
+	for _, filter := range []string{`userName%20co%20%22al%22`, `title%20eq%20%22x%22`, `userName%20eq`} {
+		rr := serveScim(h, http.MethodGet, "/scim/v2/Users?filter="+filter, "")
+
+		if rr.Code != http.StatusBadRequest {
+			t.Fatalf("%s: expected status 400, got %d", filter, rr.Code)
+		}
+		if resp := decodeScimError(t, rr); resp.ScimType != models.ScimTypeInvalidFilter {
+			t.Fatalf("%s: expected invalidFilter, got %+v", filter, resp)
+		}
+	}
+}
+
+func TestScimController_CreateUser(t *testing.T) {
+	h, svc := newScimRouter(t)
+
+	svc.
+		EXPECT().
+		CreateUser(gomock.Any(), domain.User{
+			ID:       "00u1",
+			Username: "alice",
+			IsActive: true,
+			Email:    "alice@example.com",
+		}).
+		Return(&domain.User{
+			ID:       "00u1",
+			Username: "alice",
+			TeamName: "unassigned",
+			IsActive: true,
+			Email:    "alice@example.com",
+		}, nil)
+
+	body := `{
+		"schemas": ["urn:ietf:params:scim:schemas:core:2.0:User"],
+		"externalId": "00u1",
+		"userName": "alice",
+		"name": {"givenName": "Alice"},
+		"emails": [{"value": "alice@old.example.com"}, {"value": "alice@example.com", "primary": true}]
+	}`
+	rr := serveScim(h, http.MethodPost, "/scim/v2/Users", body)
+
+	if rr.Code != http.StatusCreated {
+		t.Fatalf("expected status 201, got %d: %s", rr.Code, rr.Body.String())
+	}
+	if loc := rr.Header().Get("Location"); loc != "/scim/v2/Users/00u1" {
+		t.Fatalf("unexpected Location: %q", loc)
+	}
+}
+
+func TestScimController_CreateUser_Conflict(t *testing.T) {
+	h, svc := newScimRouter(t)
+
+	svc.EXPECT().CreateUser(gomock.Any(), gomock.Any()).Return(nil, domain.ErrUserAlreadyExists)
+
+	rr := serveScim(h, http.MethodPost, "/scim/v2/Users", `{"userName": "alice"}`)
+
+	if rr.Code != http.StatusConflict {
+		t.Fatalf("expected status 409, got %d", rr.Code)
+	}
+	if resp := decodeScimError(t, rr); resp.ScimType != models.ScimTypeUniqueness {
+		t.Fatalf("expected uniqueness, got %+v", resp)
+	}
+}
+
+func TestScimController_PatchUser_Deactivates(t *testing.T) {
+	h, svc := newScimRouter(t)
+
+	user := domain.User{ID: "u1", Username: "alice", TeamName: "backend", IsActive: true}
+	patched := user
+	patched.IsActive = false
+	patched.Email = "alice@example.com"
+
+	gomock.InOrder(
+		svc.EXPECT().GetUser(gomock.Any(), domain.UserID("u1")).Return(&user, nil),
+		svc.EXPECT().ReplaceUser(gomock.Any(), patched).Return(&patched, nil),
+	)
+
+	body := `{
+		"schemas": ["urn:ietf:params:scim:api:messages:2.0:PatchOp"],
+		"Operations": [
+			{"op": "Replace", "path": "active", "value": "False"},
+			{"op": "add", "path": "emails[type eq \"work\"].value", "value": "alice@example.com"},
+			{"op": "replace", "value": {"displayName": "Alice"}}
+		]
+	}`
+	rr := serveScim(h, http.MethodPatch, "/scim/v2/Users/u1", body)
+
+	if rr.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d: %s", rr.Code, rr.Body.String())
+	}
+
+	var resp models.ScimUserResponse
+	if err := json.NewDecoder(rr.Body).Decode(&resp); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if resp.Active || len(resp.Groups) != 0 {
+		t.Fatalf("expected an inactive user without groups, got %+v", resp)
+	}
+}
+
+func TestScimController_DeleteUser(t *testing.T) {
+	h, svc := newScimRouter(t)
+
+	svc.EXPECT().DeactivateUser(gomock.Any(), domain.UserID("u1")).Return(&domain.User{ID: "u1"}, nil)
+
+	rr := serveScim(h, http.MethodDelete, "/scim/v2/Users/u1", "")
+
+	if rr.Code != http.StatusNoContent {
+		t.Fatalf("expected status 204, got %d", rr.Code)
+	}
+}
+
+func TestScimController_GetUser_NotFound(t *testing.T) {
+	h, svc := newScimRouter(t)
+
+	svc.EXPECT().GetUser(gomock.Any(), domain.UserID("ghost")).Return(nil, domain.ErrUserNotFound)
+
+	rr := serveScim(h, http.MethodGet, "/scim/v2/Users/ghost", "")
+
+	if rr.Code != http.StatusNotFound {
+		t.Fatalf("expected status 404, got %d", rr.Code)
+	}
+}
+
+func TestScimController_PatchGroup_Members(t *testing.T) {
+	h, svc := newScimRouter(t)
+
+	svc.
+		EXPECT().
+		UpdateGroupMembers(gomock.Any(), domain.TeamName("backend"), []domain.GroupMembersOp{
+			{Kind: domain.GroupMembersAdd, UserIDs: []domain.UserID{"u3"}},
+			{Kind: domain.GroupMembersRemove, UserIDs: []domain.UserID{"u2"}},
+			{Kind: domain.GroupMembersRemove, UserIDs: []domain.UserID{"u4"}},
+		}).
+		Return(&domain.Team{Name: "backend", Members: []domain.TeamMember{
+			{ID: "u1", Username: "alice", IsActive: true},
+			{ID: "u2", Username: "bob", IsActive: false},
+			{ID: "u3", Username: "carol", IsActive: true},
+		}}, nil)
+
+	body := `{
+		"schemas": ["urn:ietf:params:scim:api:messages:2.0:PatchOp"],
+		"Operations": [
+			{"op": "add", "path": "members", "value": [{"value": "u3"}]},
+			{"op": "remove", "path": "members[value eq \"u2\"]"},
+			{"op": "Remove", "path": "members", "value": [{"value": "u4"}]},
+			{"op": "replace", "path": "displayName", "value": "backend"}
+		]
+	}`
+	rr := serveScim(h, http.MethodPatch, "/scim/v2/Groups/backend", body)
+
+	if rr.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d: %s", rr.Code, rr.Body.String())
+	}
+
+	var resp models.ScimGroupResponse
+	if err := json.NewDecoder(rr.Body).Decode(&resp); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if len(resp.Members) != 2 || resp.Members[0].Value != "u1" || resp.Members[1].Value != "u3" {
+		t.Fatalf("expected the active users as members, got %+v", resp.Members)
+	}
+}
+
+func TestScimController_PatchGroup_Rename(t *testing.T) {
+	h, _ := newScimRouter(t)
+
+	body := `{"Operations": [{"op": "replace", "value": {"displayName": "platform"}}]}`
+	rr := serveScim(h, http.MethodPatch, "/scim/v2/Groups/backend", body)
+
+	if rr.Code != http.StatusBadRequest {
+		t.Fatalf("expected status 400, got %d", rr.Code)
+	}
+	if resp := decodeScimError(t, rr); resp.ScimType != models.ScimTypeMutability {
+		t.Fatalf("expected mutability, got %+v", resp)
+	}
+}
+
+func TestScimController_ReplaceGroup_UnknownMember(t *testing.T) {
+	h, svc := newScimRouter(t)
+
+	svc.
+		EXPECT().
+		UpdateGroupMembers(gomock.Any(), domain.TeamName("backend"), []domain.GroupMembersOp{
+			{Kind: domain.GroupMembersReplace, UserIDs: []domain.UserID{"ghost"}},
+		}).
+		Return(nil, domain.ErrInvalidGroupMember)
+
+	rr := serveScim(h, http.MethodPut, "/scim/v2/Groups/backend",
+		`{"displayName": "backend", "members": [{"value": "ghost"}]}`)
+
+	if rr.Code != http.StatusBadRequest {
+		t.Fatalf("expected status 400, got %d", rr.Code)
+	}
+	if resp := decodeScimError(t, rr); resp.ScimType != models.ScimTypeInvalidValue {
+		t.Fatalf("expected invalidValue, got %+v", resp)
+	}
+}
@@ -0,0 +1,229 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"time"
+
+	"PrService/src/internal/domain"
+	"PrService/src/internal/http_api/middlewares"
+	"PrService/src/internal/http_api/models"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-playground/validator/v10"
+)
+
+type StatsController struct {
+	baseController
+	statsService domain.StatsService
+}
+
+func NewStatsController(
+	statsService domain.StatsService,
+	validate *validator.Validate,
+	logger *slog.Logger,
+) *StatsController {
+	return &StatsController{
+		baseController: newBaseController(validate, logger),
+		statsService:   statsService,
+	}
+}
+
+func (c *StatsController) UseHandlers(r chi.Router) {
+	r.With(middlewares.RequireScope(domain.ScopeStatsRead)).Get("/stats/users", c.users)
+	r.With(middlewares.RequireScope(domain.ScopeStatsRead)).Get("/stats/fairness", c.fairness)
+	r.With(middlewares.RequireScope(domain.ScopeStatsRead)).Get("/stats/overview", c.overview)
+}
+
+// users godoc
+//
+//	@Summary	Получить статистику назначений по пользователям
+//	@Tags		Stats
+//	@Accept		json
+//	@Produce	json
+//	@Param		team_name	query		string						false	"Только участники команды"
+//	@Param		from		query		string						false	"Начало окна назначений (RFC3339 или YYYY-MM-DD)"
+//	@Param		to			query		string						false	"Конец окна назначений, не включая (RFC3339 или YYYY-MM-DD)"
+//	@Success	200			{object}	models.UserStatsResponse	"Статистика пользователей, самые загруженные первыми"
+//	@Failure	400			{object}	models.ErrorResponse		"Неверный запрос"
+//	@Failure	404			{object}	models.ErrorResponse		"Команда не найдена"
+//	@Failure	500			{object}	models.ErrorResponse		"Ошибка сервера"
+//	@Security	ApiKeyAuth
+//	@Security	BearerAuth
+//	@Router		/stats/users [get]
+func (c *StatsController) users(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	q := r.URL.Query()
+	teamName := q.Get("team_name")
+
+	window, err := parseStatsWindow(q)
+	if err != nil {
+		c.writeError(ctx, w, http.StatusBadRequest,
+			models.ErrorCodeValidationFailed,
+			err.Error(),
+			"invalid window for user stats",
+			err,
+		)
+		return
+	}
+
+	filter := domain.UserStatsFilter{TeamName: domain.TeamName(teamName), Window: window}
+	stats, err := c.statsService.UserReviewStats(ctx, filter)
+	if err != nil {
+		c.writeStatsError(ctx, w, err, "failed to get user stats", teamName)
+		return
+	}
+
+	resp := models.MapToUserStatsResponse(filter, stats)
+	c.writeJSON(ctx, w, http.StatusOK, resp)
+}
+
+// fairness godoc
+//
+//	@Summary	Получить отчёт о равномерности назначений в команде
+//	@Tags		Stats
+//	@Accept		json
+//	@Produce	json
+//	@Param		team_name	query		string					true	"Уникальное имя команды"
+//	@Param		from		query		string					false	"Начало окна назначений (RFC3339 или YYYY-MM-DD)"
+//	@Param		to			query		string					false	"Конец окна назначений, не включая (RFC3339 или YYYY-MM-DD)"
+//	@Success	200			{object}	models.FairnessResponse	"Распределение назначений по активным участникам"
+//	@Failure	400			{object}	models.ErrorResponse	"Неверный запрос"
+//	@Failure	404			{object}	models.ErrorResponse	"Команда не найдена"
+//	@Failure	500			{object}	models.ErrorResponse	"Ошибка сервера"
+//	@Security	ApiKeyAuth
+//	@Security	BearerAuth
+//	@Router		/stats/fairness [get]
+func (c *StatsController) fairness(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	q := r.URL.Query()
+	teamName := q.Get("team_name")
+
+	if teamName == "" {
+		c.writeError(ctx, w, http.StatusBadRequest,
+			models.ErrorCodeValidationFailed,
+			"team_name is required",
+			"missing team_name query param for fairness report",
+			nil,
+		)
+		return
+	}
+
+	window, err := parseStatsWindow(q)
+	if err != nil {
+		c.writeError(ctx, w, http.StatusBadRequest,
+			models.ErrorCodeValidationFailed,
+			err.Error(),
+			"invalid window for fairness report",
+			err,
+		)
+		return
+	}
+
+	report, err := c.statsService.Fairness(ctx, domain.TeamName(teamName), window)
+	if err != nil {
+		c.writeStatsError(ctx, w, err, "failed to get fairness report", teamName)
+		return
+	}
+
+	resp := models.MapToFairnessResponse(*report)
+	c.writeJSON(ctx, w, http.StatusOK, resp)
+}
+
+// overview godoc
+//
+//	@Summary	Получить сводную статистику по всем командам
+//	@Tags		Stats
+//	@Accept		json
+//	@Produce	json
+//	@Success	200	{object}	models.StatsOverviewResponse	"Сводная статистика"
+//	@Failure	500	{object}	models.ErrorResponse			"Ошибка сервера"
+//	@Security	ApiKeyAuth
+//	@Security	BearerAuth
+//	@Router		/stats/overview [get]
+func (c *StatsController) overview(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	overview, err := c.statsService.Overview(ctx)
+	if err != nil {
+		c.writeError(ctx, w, http.StatusInternalServerError,
+			models.ErrorCodeInternalServer,
+			"internal server error",
+			"failed to get stats overview",
+			err,
+		)
+		return
+	}
+
+	resp := models.MapToStatsOverviewResponse(*overview)
+	c.writeJSON(ctx, w, http.StatusOK, resp)
+}
+
+func (c *StatsController) writeStatsError(
+	ctx context.Context,
+	w http.ResponseWriter,
+	err error,
+	logMsg string,
+	teamName string,
+) {
+	if errors.Is(err, domain.ErrInvalidStatsWindow) {
+		c.writeError(ctx, w, http.StatusBadRequest,
+			models.ErrorCodeValidationFailed,
+			"from must be before to",
+			"invalid stats window",
+			err,
+		)
+		return
+	}
+	if errors.Is(err, domain.ErrTeamNotFound) {
+		c.writeError(ctx, w, http.StatusNotFound,
+			models.ErrorCodeNotFound,
+			"resource not found",
+			"team not found for stats",
+			err,
+			"team_name", teamName,
+		)
+		return
+	}
+
+	c.writeError(ctx, w, http.StatusInternalServerError,
+		models.ErrorCodeInternalServer,
+		"internal server error",
+		logMsg,
+		err,
+		"team_name", teamName,
+	)
+}
+
+// parseStatsWindow reads the optional from and to query params as RFC3339 timestamps or dates.
+func parseStatsWindow(q url.Values) (domain.StatsWindow, error) {
+	var window domain.StatsWindow
+
+	for _, bound := range []struct {
+		name   string
+		target *time.Time
+	}{
+		{name: "from", target: &window.From},
+		{name: "to", target: &window.To},
+	} {
+		raw := q.Get(bound.name)
+		if raw == "" {
+			continue
+		}
+
+		t, err := time.Parse(time.RFC3339, raw)
+		if err != nil {
+			t, err = time.Parse(time.DateOnly, raw)
+		}
+		if err != nil {
+			return domain.StatsWindow{}, fmt.Errorf("%s must be an RFC3339 timestamp or a YYYY-MM-DD date", bound.name)
+		}
+		*bound.target = t
+	}
+
+	return window, nil
+}
@@ -0,0 +1,204 @@
+package controllers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"PrService/src/internal/domain"
+	"PrService/src/internal/http_api/mocks"
+	"PrService/src/internal/http_api/models"
+
+	"github.com/go-playground/validator/v10"
+	"go.uber.org/mock/gomock"
+)
+
+func newStatsController(t *testing.T) (*StatsController, *mocks.MockStatsService) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	svc := mocks.NewMockStatsService(ctrl)
+
+	c := NewStatsController(svc, validator.New(), newTestLogger())
+
+	return c, svc
+}
+
+func TestStatsController_Users_Success(t *testing.T) {
+	c, svc := newStatsController(t)
+
+	filter := domain.UserStatsFilter{
+		TeamName: "backend",
+		Window: domain.StatsWindow{
+			From: time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC),
+			To:   time.Date(2025, 3, 8, 12, 0, 0, 0, time.UTC),
+		},
+	}
+	svc.
+		EXPECT().
+		UserReviewStats(gomock.Any(), filter).
+		Return([]domain.UserReviewStats{{
+			UserID:               "u2",
+			Username:             "Bob",
+			TeamName:             "backend",
+			IsActive:             true,
+			TotalAssignments:     4,
+			OpenReviews:          1,
+			CompletedReviews:     2,
+			ReassignedAway:       1,
+			MedianTimeToMergeSec: 3600,
+		}}, nil)
+
+	req := httptest.NewRequest(http.MethodGet,
+		"/stats/users?team_name=backend&from=2025-03-01&to=2025-03-08T12:00:00Z", nil)
+	rr := httptest.NewRecorder()
+
+	c.users(rr, req)
+
+	if rr.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d, body=%s", http.StatusOK, rr.Code, rr.Body.String())
+	}
+
+	var resp models.UserStatsResponse
+	if err := json.Unmarshal(rr.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("failed to unmarshal UserStatsResponse: %v", err)
+	}
+
+	if resp.From != "2025-03-01T00:00:00Z" || len(resp.Users) != 1 {
+		t.Fatalf("unexpected response: %+v", resp)
+	}
+	if u := resp.Users[0]; u.TotalAssignments != 4 || u.ReassignedAway != 1 || u.MedianTimeToMergeSec != 3600 {
+		t.Fatalf("unexpected user stats: %+v", u)
+	}
+}
+
+func TestStatsController_Users_InvalidWindow(t *testing.T) {
+	c, _ := newStatsController(t)
+
+	req := httptest.NewRequest(http.MethodGet, "/stats/users?from=yesterday", nil)
+	rr := httptest.NewRecorder()
+
+	c.users(rr, req)
+
+	if rr.Code != http.StatusBadRequest {
+		t.Fatalf("expected status %d, got %d, body=%s", http.StatusBadRequest, rr.Code, rr.Body.String())
+	}
+}
+
+func TestStatsController_Users_TeamNotFound(t *testing.T) {
+	c, svc := newStatsController(t)
+
+	svc.
+		EXPECT().
+		UserReviewStats(gomock.Any(), domain.UserStatsFilter{TeamName: "ghost"}).
+		Return(nil, domain.ErrTeamNotFound)
+
+	req := httptest.NewRequest(http.MethodGet, "/stats/users?team_name=ghost", nil)
+	rr := httptest.NewRecorder()
+
+	c.users(rr, req)
+
+	if rr.Code != http.StatusNotFound {
+		t.Fatalf("expected status %d, got %d, body=%s", http.StatusNotFound, rr.Code, rr.Body.String())
+	}
+}
+
+func TestStatsController_Fairness_Success(t *testing.T) {
+	c, svc := newStatsController(t)
+
+	ratio := 2.0
+	svc.
+		EXPECT().
+		Fairness(gomock.Any(), domain.TeamName("backend"), domain.StatsWindow{}).
+		Return(&domain.FairnessReport{
+			TeamName:         "backend",
+			Members:          []domain.MemberLoad{{UserID: "u1", Assignments: 6}, {UserID: "u2", Assignments: 3}},
+			TotalAssignments: 9,
+			MeanAssignments:  4.5,
+			Gini:             1.0 / 6,
+			MaxMinRatio:      &ratio,
+			Outliers:         []domain.FairnessOutlier{},
+		}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/stats/fairness?team_name=backend", nil)
+	rr := httptest.NewRecorder()
+
+	c.fairness(rr, req)
+
+	if rr.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d, body=%s", http.StatusOK, rr.Code, rr.Body.String())
+	}
+
+	var resp models.FairnessResponse
+	if err := json.Unmarshal(rr.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("failed to unmarshal FairnessResponse: %v", err)
+	}
+
+	if len(resp.Members) != 2 || resp.MaxMinRatio == nil || *resp.MaxMinRatio != 2 || resp.Outliers == nil {
+		t.Fatalf("unexpected response: %+v", resp)
+	}
+}
+
+func TestStatsController_Fairness_MissingTeam(t *testing.T) {
+	c, _ := newStatsController(t)
+
+	req := httptest.NewRequest(http.MethodGet, "/stats/fairness", nil)
+	rr := httptest.NewRecorder()
+
+	c.fairness(rr, req)
+
+	if rr.Code != http.StatusBadRequest {
+		t.Fatalf("expected status %d, got %d, body=%s", http.StatusBadRequest, rr.Code, rr.Body.String())
+	}
+}
+
+func TestStatsController_Overview_Success(t *testing.T) {
+	c, svc := newStatsController(t)
+
+	createdAt := time.Date(2025, 2, 1, 9, 0, 0, 0, time.UTC)
+	stale := domain.PullRequestStaffing{
+		ID: "pr-1", Name: "Old", AuthorID: "u1", TeamName: "backend", Status: domain.PullRequestStatusOpen,
+		CreatedAt: &createdAt, Reviewers: 1, MaxReviewers: 2,
+	}
+	svc.
+		EXPECT().
+		Overview(gomock.Any()).
+		Return(&domain.StatsOverview{
+			Teams:                 2,
+			ActiveUsers:           5,
+			InactiveUsers:         1,
+			OpenPRs:               1,
+			OldestOpenPRs:         []domain.PullRequestStaffing{stale},
+			TeamsWithoutReviewers: []domain.TeamName{"frontend"},
+			UnderstaffedPRsCount:  1,
+			UnderstaffedPRs:       []domain.PullRequestStaffing{stale},
+			GeneratedAt:           time.Date(2025, 3, 4, 10, 0, 0, 0, time.UTC),
+		}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/stats/overview", nil)
+	rr := httptest.NewRecorder()
+
+	c.overview(rr, req)
+
+	if rr.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d, body=%s", http.StatusOK, rr.Code, rr.Body.String())
+	}
+
+	var resp models.StatsOverviewResponse
+	if err := json.Unmarshal(rr.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("failed to unmarshal StatsOverviewResponse: %v", err)
+	}
+
+	if resp.Teams != 2 || resp.ActiveUsers != 5 || len(resp.TeamsWithoutReviewers) != 1 {
+		t.Fatalf("unexpected response: %+v", resp)
+	}
+	if len(resp.UnderstaffedPRs) != 1 || resp.UnderstaffedPRs[0].CreatedAt != "2025-02-01T09:00:00Z" ||
+		resp.UnderstaffedPRs[0].MaxReviewers != 2 {
+		t.Fatalf("unexpected understaffed PRs: %+v", resp.UnderstaffedPRs)
+	}
+	if resp.GeneratedAt != "2025-03-04T10:00:00Z" {
+		t.Fatalf("unexpected generated_at: %s", resp.GeneratedAt)
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"net/http"
 
 	"PrService/src/internal/domain"
+	"PrService/src/internal/http_api/middlewares"
 	"PrService/src/internal/http_api/models"
 
 	"github.com/go-chi/chi/v5"
@@ -30,9 +31,11 @@ func NewTeamController(
 }
 
 func (c *TeamController) UseHandlers(r chi.Router) {
-	r.Post("/team/add", c.add)
-	r.Get("/team/get", c.get)
-	r.Get("/team/stats", c.stats)
+	r.With(middlewares.RequireScope(domain.ScopeTeamAdmin)).Post("/team/add", c.add)
+	r.With(middlewares.RequireScope(domain.ScopeTeamRead)).Get("/team/get", c.get)
+	r.With(middlewares.RequireScope(domain.ScopeStatsRead)).Get("/team/stats", c.stats)
+	r.With(middlewares.RequireScope(domain.ScopeStatsRead)).Get("/team/stats/timeseries", c.statsTimeSeries)
+	r.With(middlewares.RequireScope(domain.ScopeTeamAdmin)).Post("/team/setMaxReviewers", c.setMaxReviewers)
 }
 
 // add godoc
@@ -45,6 +48,8 @@ func (c *TeamController) UseHandlers(r chi.Router) {
 //	@Success	201		{object}	models.AddTeamResponse	"Команда создана"
 //	@Failure	400		{object}	models.ErrorResponse	"Команда уже существует или неверный запрос"
 //	@Failure	500		{object}	models.ErrorResponse	"Ошибка сервера"
+//	@Security	ApiKeyAuth
+//	@Security	BearerAuth
 //	@Router		/team/add [post]
 func (c *TeamController) add(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -92,6 +97,8 @@ func (c *TeamController) add(w http.ResponseWriter, r *http.Request) {
 //	@Success	200			{object}	models.TeamResponse		"Объект команды"
 //	@Failure	404			{object}	models.ErrorResponse	"Команда не найдена"
 //	@Failure	500			{object}	models.ErrorResponse	"Ошибка сервера"
+//	@Security	ApiKeyAuth
+//	@Security	BearerAuth
 //	@Router		/team/get [get]
 func (c *TeamController) get(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -136,6 +143,8 @@ func (c *TeamController) get(w http.ResponseWriter, r *http.Request) {
 //	@Failure	400			{object}	models.ErrorResponse		"Неверный запрос"
 //	@Failure	404			{object}	models.ErrorResponse		"Команда не найдена"
 //	@Failure	500			{object}	models.ErrorResponse		"Ошибка сервера"
+//	@Security	ApiKeyAuth
+//	@Security	BearerAuth
 //	@Router		/team/stats [get]
 func (c *TeamController) stats(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -180,3 +189,148 @@ func (c *TeamController) stats(w http.ResponseWriter, r *http.Request) {
 	resp := models.MapToTeamStatsResponse(*stats)
 	c.writeJSON(ctx, w, http.StatusOK, resp)
 }
+
+// statsTimeSeries godoc
+//
+//	@Summary	Получить динамику PR команды по интервалам
+//	@Tags		Teams
+//	@Accept		json
+//	@Produce	json
+//	@Param		team_name	query		string								true	"Уникальное имя команды"
+//	@Param		interval	query		string								false	"Интервал: day (по умолчанию) или week"
+//	@Param		from		query		string								false	"Начало окна (RFC3339 или YYYY-MM-DD), по умолчанию 30 интервалов до to"
+//	@Param		to			query		string								false	"Конец окна, не включая (RFC3339 или YYYY-MM-DD), по умолчанию сейчас"
+//	@Success	200			{object}	models.TeamStatsTimeSeriesResponse	"Показатели по интервалам"
+//	@Failure	400			{object}	models.ErrorResponse				"Неверный запрос"
+//	@Failure	404			{object}	models.ErrorResponse				"Команда не найдена"
+//	@Failure	500			{object}	models.ErrorResponse				"Ошибка сервера"
+//	@Security	ApiKeyAuth
+//	@Security	BearerAuth
+//	@Router		/team/stats/timeseries [get]
+func (c *TeamController) statsTimeSeries(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	q := r.URL.Query()
+	teamName := q.Get("team_name")
+
+	if teamName == "" {
+		c.writeError(ctx, w,
+			http.StatusBadRequest,
+			models.ErrorCodeValidationFailed,
+			"team_name is required",
+			"missing team_name query param for team stats time series",
+			nil,
+		)
+		return
+	}
+
+	interval := domain.StatsIntervalDay
+	if raw := q.Get("interval"); raw != "" {
+		interval = domain.StatsInterval(raw)
+	}
+
+	window, err := parseStatsWindow(q)
+	if err != nil {
+		c.writeError(ctx, w,
+			http.StatusBadRequest,
+			models.ErrorCodeValidationFailed,
+			err.Error(),
+			"invalid window for team stats time series",
+			err,
+		)
+		return
+	}
+
+	series, err := c.teamService.GetStatsTimeSeries(ctx, domain.TeamName(teamName), interval, window)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrInvalidStatsInterval):
+			c.writeError(ctx, w,
+				http.StatusBadRequest,
+				models.ErrorCodeValidationFailed,
+				"interval must be day or week",
+				"invalid interval for team stats time series",
+				err,
+				"interval", interval,
+			)
+		case errors.Is(err, domain.ErrInvalidStatsWindow):
+			c.writeError(ctx, w,
+				http.StatusBadRequest,
+				models.ErrorCodeValidationFailed,
+				fmt.Sprintf("from must be before to and span at most %d intervals", domain.MaxStatsBuckets),
+				"invalid window for team stats time series",
+				err,
+			)
+		case errors.Is(err, domain.ErrTeamNotFound):
+			c.writeError(ctx, w,
+				http.StatusNotFound,
+				models.ErrorCodeNotFound,
+				"resource not found",
+				"team not found in stats time series",
+				err,
+				"team_name", teamName,
+			)
+		default:
+			c.writeError(ctx, w,
+				http.StatusInternalServerError,
+				models.ErrorCodeInternalServer,
+				"internal server error",
+				"failed to get team stats time series",
+				err,
+				"team_name", teamName,
+			)
+		}
+		return
+	}
+
+	resp := models.MapToTeamStatsTimeSeriesResponse(*series)
+	c.writeJSON(ctx, w, http.StatusOK, resp)
+}
+
+// setMaxReviewers godoc
+//
+//	@Summary	Установить максимальное число ревьюверов на PR из команды
+//	@Tags		Teams
+//	@Accept		json
+//	@Produce	json
+//	@Param		request	body		models.SetTeamMaxReviewersRequest	true	"Set max reviewers body"
+//	@Success	200		{object}	models.SetTeamMaxReviewersResponse	"Обновлённая команда"
+//	@Failure	400		{object}	models.ErrorResponse				"Неверный запрос"
+//	@Failure	404		{object}	models.ErrorResponse				"Команда не найдена"
+//	@Failure	500		{object}	models.ErrorResponse				"Ошибка сервера"
+//	@Security	ApiKeyAuth
+//	@Security	BearerAuth
+//	@Router		/team/setMaxReviewers [post]
+func (c *TeamController) setMaxReviewers(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req models.SetTeamMaxReviewersRequest
+	if ok := c.decodeAndValidate(ctx, w, r, &req, "setTeamMaxReviewersRequest"); !ok {
+		return
+	}
+
+	team, err := c.teamService.SetMaxReviewers(ctx, domain.TeamName(req.TeamName), req.MaxReviewers)
+	if err != nil {
+		if errors.Is(err, domain.ErrTeamNotFound) {
+			c.writeError(ctx, w, http.StatusNotFound,
+				models.ErrorCodeNotFound,
+				"resource not found",
+				"team not found to set max reviewers",
+				err,
+				"team_name", req.TeamName,
+			)
+			return
+		}
+
+		c.writeError(ctx, w, http.StatusInternalServerError,
+			models.ErrorCodeInternalServer,
+			"internal server error",
+			"failed to set team max reviewers",
+			err,
+			"team_name", req.TeamName,
+		)
+		return
+	}
+
+	resp := models.MapToSetTeamMaxReviewersResponse(*team)
+	c.writeJSON(ctx, w, http.StatusOK, resp)
+}
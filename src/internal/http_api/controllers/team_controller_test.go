@@ -7,6 +7,7 @@ import (
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
 	"PrService/src/internal/domain"
 	"PrService/src/internal/http_api/mocks"
@@ -231,6 +232,65 @@ func TestTeamController_Stats_Success(t *testing.T) {
 	}
 }
 
+func TestTeamController_StatsTimeSeries_Success(t *testing.T) {
+	c, svc := newTeamController(t)
+
+	start := time.Date(2025, 3, 3, 0, 0, 0, 0, time.UTC)
+	window := domain.StatsWindow{From: start, To: start.AddDate(0, 0, 14)}
+
+	svc.
+		EXPECT().
+		GetStatsTimeSeries(gomock.Any(), domain.TeamName("backend"), domain.StatsIntervalWeek, window).
+		Return(&domain.TeamStatsTimeSeries{
+			TeamName: "backend",
+			Interval: domain.StatsIntervalWeek,
+			Buckets: []domain.TeamStatsBucket{
+				{Start: start, End: start.AddDate(0, 0, 7), OpenedPRs: 4, MergedPRs: 2, P90TimeToMergeSec: 7200},
+				{Start: start.AddDate(0, 0, 7), End: start.AddDate(0, 0, 14), Reassignments: 1},
+			},
+		}, nil)
+
+	req := httptest.NewRequest(http.MethodGet,
+		"/team/stats/timeseries?team_name=backend&interval=week&from=2025-03-03&to=2025-03-17", nil)
+	rr := httptest.NewRecorder()
+
+	c.statsTimeSeries(rr, req)
+
+	if rr.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d, body=%s", http.StatusOK, rr.Code, rr.Body.String())
+	}
+
+	var resp models.TeamStatsTimeSeriesResponse
+	if err := json.Unmarshal(rr.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("failed to unmarshal response: %v", err)
+	}
+
+	if resp.Interval != "week" || len(resp.Buckets) != 2 {
+		t.Fatalf("unexpected response: %+v", resp)
+	}
+	if b := resp.Buckets[0]; b.Start != "2025-03-03T00:00:00Z" || b.OpenedPRs != 4 || b.P90TimeToMergeSec != 7200 {
+		t.Fatalf("unexpected first bucket: %+v", b)
+	}
+}
+
+func TestTeamController_StatsTimeSeries_InvalidInterval(t *testing.T) {
+	c, svc := newTeamController(t)
+
+	svc.
+		EXPECT().
+		GetStatsTimeSeries(gomock.Any(), domain.TeamName("backend"), domain.StatsInterval("month"), gomock.Any()).
+		Return(nil, domain.ErrInvalidStatsInterval)
+
+	req := httptest.NewRequest(http.MethodGet, "/team/stats/timeseries?team_name=backend&interval=month", nil)
+	rr := httptest.NewRecorder()
+
+	c.statsTimeSeries(rr, req)
+
+	if rr.Code != http.StatusBadRequest {
+		t.Fatalf("expected status %d, got %d, body=%s", http.StatusBadRequest, rr.Code, rr.Body.String())
+	}
+}
+
 func TestTeamController_Stats_MissingTeamName(t *testing.T) {
 	c, svc := newTeamController(t)
 
@@ -287,3 +347,77 @@ func TestTeamController_Stats_TeamNotFound(t *testing.T) {
 		t.Fatalf("expected error code %s, got %s", models.ErrorCodeNotFound, errResp.Error.ErrorCode)
 	}
 }
+
+func TestTeamController_SetMaxReviewers_Success(t *testing.T) {
+	c, svc := newTeamController(t)
+
+	teamName := domain.TeamName("backend")
+
+	svc.
+		EXPECT().
+		SetMaxReviewers(gomock.Any(), teamName, 3).
+		Return(&domain.Team{Name: teamName, MaxReviewers: 3}, nil)
+
+	body := `{ "team_name": "backend", "max_reviewers": 3 }`
+
+	req := httptest.NewRequest(http.MethodPost, "/team/setMaxReviewers", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	rr := httptest.NewRecorder()
+
+	c.setMaxReviewers(rr, req)
+
+	if rr.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d, body=%s", http.StatusOK, rr.Code, rr.Body.String())
+	}
+
+	var resp models.SetTeamMaxReviewersResponse
+	if err := json.Unmarshal(rr.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("failed to unmarshal response: %v", err)
+	}
+
+	if resp.Team.MaxReviewers != 3 {
+		t.Fatalf("expected max_reviewers=3, got %d", resp.Team.MaxReviewers)
+	}
+}
+
+func TestTeamController_SetMaxReviewers_ValidationFailed(t *testing.T) {
+	c, svc := newTeamController(t)
+
+	svc.
+		EXPECT().
+		SetMaxReviewers(gomock.Any(), gomock.Any(), gomock.Any()).
+		Times(0)
+
+	body := `{ "team_name": "backend", "max_reviewers": 0 }`
+
+	req := httptest.NewRequest(http.MethodPost, "/team/setMaxReviewers", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	rr := httptest.NewRecorder()
+
+	c.setMaxReviewers(rr, req)
+
+	if rr.Code != http.StatusBadRequest {
+		t.Fatalf("expected status %d, got %d, body=%s", http.StatusBadRequest, rr.Code, rr.Body.String())
+	}
+}
+
+func TestTeamController_Add_InvalidSeniority(t *testing.T) {
+	c, _ := newTeamController(t)
+
+	body := `{
+		"team_name": "backend",
+		"members": [
+			{ "user_id": "u1", "username": "Alice", "is_active": true, "seniority": "GURU", "tags": ["db"] }
+		]
+	}`
+
+	req := httptest.NewRequest(http.MethodPost, "/team/add", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	rr := httptest.NewRecorder()
+
+	c.add(rr, req)
+
+	if rr.Code != http.StatusBadRequest {
+		t.Fatalf("expected status %d, got %d, body=%s", http.StatusBadRequest, rr.Code, rr.Body.String())
+	}
+}
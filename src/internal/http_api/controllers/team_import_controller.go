@@ -0,0 +1,143 @@
+package controllers
+
+import (
+	"errors"
+	"io"
+	"log/slog"
+	"mime"
+	"net/http"
+	"strconv"
+
+	"PrService/src/internal/domain"
+	"PrService/src/internal/http_api/middlewares"
+	"PrService/src/internal/http_api/models"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-playground/validator/v10"
+)
+
+// teamImportMaxDocumentBytes bounds the size of an import document.
+const teamImportMaxDocumentBytes = 5 << 20
+
+type TeamImportController struct {
+	baseController
+	teamService domain.TeamService
+}
+
+func NewTeamImportController(
+	teamService domain.TeamService,
+	validate *validator.Validate,
+	logger *slog.Logger,
+) *TeamImportController {
+	return &TeamImportController{
+		baseController: newBaseController(validate, logger),
+		teamService:    teamService,
+	}
+}
+
+func (c *TeamImportController) UseHandlers(r chi.Router) {
+	r.With(middlewares.RequireScope(domain.ScopeTeamAdmin)).Post("/import/teams", c.importTeams)
+}
+
+// importTeams godoc
+//
+//	@Summary		Массово создать и обновить команды и пользователей из YAML или CSV
+//	@Description	Формат определяется заголовком Content-Type: application/yaml или text/csv.
+//	@Description	Участники перечисленных команд, которых нет в документе, деактивируются; остальные команды не меняются.
+//	@Description	С dry_run=true изменения только рассчитываются, иначе применяются в одной транзакции.
+//	@Tags			Teams
+//	@Accept			application/yaml
+//	@Accept			text/csv
+//	@Produce		json
+//	@Param			dry_run	query		bool						false	"Только показать изменения"
+//	@Param			request	body		string						true	"Документ с командами и участниками"
+//	@Success		200		{object}	models.TeamImportResponse	"Изменения импорта"
+//	@Failure		400		{object}	models.ErrorResponse		"Неверный документ"
+//	@Failure		415		{object}	models.ErrorResponse		"Неподдерживаемый формат"
+//	@Failure		500		{object}	models.ErrorResponse		"Ошибка сервера"
+//	@Security		ApiKeyAuth
+//	@Router			/import/teams [post]
+func (c *TeamImportController) importTeams(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	dryRun := false
+	if raw := r.URL.Query().Get("dry_run"); raw != "" {
+		var err error
+		if dryRun, err = strconv.ParseBool(raw); err != nil {
+			c.writeError(ctx, w, http.StatusBadRequest,
+				models.ErrorCodeValidationFailed,
+				"dry_run must be true or false",
+				"invalid dry_run query param for team import",
+				err,
+				"dry_run", raw,
+			)
+			return
+		}
+	}
+
+	contentType := r.Header.Get("Content-Type")
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+
+	var parse func(io.Reader) (models.TeamImportDocument, error)
+	switch {
+	case isYAMLMediaType(mediaType):
+		parse = models.ParseTeamImportYAML
+	case mediaType == contentTypeCSV:
+		parse = models.ParseTeamImportCSV
+	default:
+		c.writeError(ctx, w, http.StatusUnsupportedMediaType,
+			models.ErrorCodeUnsupportedMedia,
+			"import document must be application/yaml or text/csv",
+			"unsupported Content-Type for team import",
+			nil,
+			"content_type", contentType,
+		)
+		return
+	}
+
+	doc, err := parse(http.MaxBytesReader(w, r.Body, teamImportMaxDocumentBytes))
+	if err != nil {
+		c.writeError(ctx, w, http.StatusBadRequest,
+			models.ErrorCodeDecodeFailed,
+			"invalid import document: "+err.Error(),
+			"failed to parse team import document",
+			err,
+		)
+		return
+	}
+
+	diff, err := c.teamService.Import(ctx, doc.MapToDomain(), dryRun)
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidTeamImport) {
+			c.writeError(ctx, w, http.StatusBadRequest,
+				models.ErrorCodeValidationFailed,
+				err.Error(),
+				"invalid team import",
+				err,
+			)
+			return
+		}
+
+		c.writeError(ctx, w, http.StatusInternalServerError,
+			models.ErrorCodeInternalServer,
+			"internal server error",
+			"failed to import teams",
+			err,
+			"dry_run", dryRun,
+		)
+		return
+	}
+
+	resp := models.MapToTeamImportResponse(*diff, dryRun)
+	c.writeJSON(ctx, w, http.StatusOK, resp)
+}
+
+// isYAMLMediaType reports whether the media type is one of those in use for YAML.
+func isYAMLMediaType(mediaType string) bool {
+	switch mediaType {
+	case "application/yaml", "application/x-yaml", "text/yaml", "text/x-yaml":
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,173 @@
+package controllers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"PrService/src/internal/domain"
+	"PrService/src/internal/http_api/mocks"
+	"PrService/src/internal/http_api/models"
+
+	"github.com/go-playground/validator/v10"
+	"go.uber.org/mock/gomock"
+)
+
+func newTeamImportController(t *testing.T) (*TeamImportController, *mocks.MockTeamService) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	svc := mocks.NewMockTeamService(ctrl)
+
+	c := NewTeamImportController(svc, validator.New(), newTestLogger())
+
+	return c, svc
+}
+
+func TestTeamImportController_YAMLDryRun(t *testing.T) {
+	c, svc := newTeamImportController(t)
+
+	body := `
+teams:
+  - team_name: backend
+    max_reviewers: 3
+    members:
+      - user_id: u1
+        username: Alice
+        seniority: SENIOR
+        tags: [db]
+      - user_id: u2
+        username: Bob
+        is_active: false
+`
+	want := domain.TeamImport{Teams: []domain.Team{{
+		Name:         "backend",
+		MaxReviewers: 3,
+		Members: []domain.TeamMember{
+			{ID: "u1", Username: "Alice", IsActive: true, Seniority: domain.SenioritySenior, Tags: []string{"db"}},
+			{ID: "u2", Username: "Bob", IsActive: false},
+		},
+	}}}
+	svc.
+		EXPECT().
+		Import(gomock.Any(), want, true).
+		Return(&domain.TeamImportDiff{
+			UpdatedTeams: []domain.TeamName{"backend"},
+			MovedUsers: []domain.UserMove{{
+				User:     domain.User{ID: "u1", Username: "Alice", TeamName: "backend", IsActive: true},
+				FromTeam: "frontend",
+			}},
+			DeactivatedUsers: []domain.User{{ID: "u2", Username: "Bob", TeamName: "backend"}},
+		}, nil)
+
+	req := httptest.NewRequest(http.MethodPost, "/import/teams?dry_run=true", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/yaml")
+	rr := httptest.NewRecorder()
+
+	c.importTeams(rr, req)
+
+	if rr.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d: %s", rr.Code, rr.Body.String())
+	}
+
+	var resp models.TeamImportResponse
+	if err := json.NewDecoder(rr.Body).Decode(&resp); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if !resp.DryRun || len(resp.Diff.UpdatedTeams) != 1 || len(resp.Diff.CreatedUsers) != 0 {
+		t.Fatalf("unexpected response: %+v", resp)
+	}
+	if len(resp.Diff.MovedUsers) != 1 || resp.Diff.MovedUsers[0].FromTeamName != "frontend" ||
+		resp.Diff.MovedUsers[0].User.TeamName != "backend" {
+		t.Fatalf("unexpected moves: %+v", resp.Diff.MovedUsers)
+	}
+	if len(resp.Diff.DeactivatedUsers) != 1 || resp.Diff.DeactivatedUsers[0].UserID != "u2" {
+		t.Fatalf("unexpected deactivations: %+v", resp.Diff.DeactivatedUsers)
+	}
+}
+
+func TestTeamImportController_CSVApply(t *testing.T) {
+	c, svc := newTeamImportController(t)
+
+	body := "team_name,user_id,username,is_active,tags,max_reviewers\n" +
+		"backend,u1,Alice,,db;api,2\n" +
+		"mobile,u3,Carol,true,,\n" +
+		"backend,u2,Bob,false,,2\n"
+	want := domain.TeamImport{Teams: []domain.Team{
+		{
+			Name:         "backend",
+			MaxReviewers: 2,
+			Members: []domain.TeamMember{
+				{ID: "u1", Username: "Alice", IsActive: true, Tags: []string{"db", "api"}},
+				{ID: "u2", Username: "Bob", IsActive: false},
+			},
+		},
+		{
+			Name:    "mobile",
+			Members: []domain.TeamMember{{ID: "u3", Username: "Carol", IsActive: true}},
+		},
+	}}
+	svc.EXPECT().Import(gomock.Any(), want, false).Return(&domain.TeamImportDiff{}, nil)
+
+	req := httptest.NewRequest(http.MethodPost, "/import/teams", strings.NewReader(body))
+	req.Header.Set("Content-Type", "text/csv; charset=utf-8")
+	rr := httptest.NewRecorder()
+
+	c.importTeams(rr, req)
+
+	if rr.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d: %s", rr.Code, rr.Body.String())
+	}
+}
+
+func TestTeamImportController_RejectsBadInput(t *testing.T) {
+	tests := []struct {
+		name        string
+		contentType string
+		body        string
+		status      int
+	}{
+		{name: "json", contentType: "application/json", body: `{}`, status: http.StatusUnsupportedMediaType},
+		{name: "unknown yaml field", contentType: "application/yaml", body: "teams:\n  - name: x\n",
+			status: http.StatusBadRequest},
+		{name: "csv without username", contentType: "text/csv", body: "team_name,user_id\nbackend,u1\n",
+			status: http.StatusBadRequest},
+		{name: "csv conflicting limit", contentType: "text/csv",
+			body:   "team_name,user_id,username,max_reviewers\nbackend,u1,A,2\nbackend,u2,B,3\n",
+			status: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, _ := newTeamImportController(t)
+
+			req := httptest.NewRequest(http.MethodPost, "/import/teams", strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", tt.contentType)
+			rr := httptest.NewRecorder()
+
+			c.importTeams(rr, req)
+
+			if rr.Code != tt.status {
+				t.Fatalf("expected status %d, got %d: %s", tt.status, rr.Code, rr.Body.String())
+			}
+		})
+	}
+}
+
+func TestTeamImportController_InvalidImport(t *testing.T) {
+	c, svc := newTeamImportController(t)
+
+	svc.EXPECT().Import(gomock.Any(), gomock.Any(), false).Return(nil, domain.ErrInvalidTeamImport)
+
+	req := httptest.NewRequest(http.MethodPost, "/import/teams", strings.NewReader("teams: []\n"))
+	req.Header.Set("Content-Type", "application/yaml")
+	rr := httptest.NewRecorder()
+
+	c.importTeams(rr, req)
+
+	if rr.Code != http.StatusBadRequest {
+		t.Fatalf("expected status 400, got %d", rr.Code)
+	}
+}
@@ -0,0 +1,212 @@
+package controllers
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"PrService/src/internal/domain"
+	"PrService/src/internal/http_api/middlewares"
+	"PrService/src/internal/http_api/models"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-playground/validator/v10"
+)
+
+type TeamRuleController struct {
+	baseController
+	teamRuleService domain.TeamRuleService
+}
+
+func NewTeamRuleController(
+	teamRuleService domain.TeamRuleService,
+	validate *validator.Validate,
+	logger *slog.Logger,
+) *TeamRuleController {
+	return &TeamRuleController{
+		baseController:  newBaseController(validate, logger),
+		teamRuleService: teamRuleService,
+	}
+}
+
+func (c *TeamRuleController) UseHandlers(r chi.Router) {
+	r.With(middlewares.RequireScope(domain.ScopeTeamRead)).Get("/team/rules", c.list)
+	r.With(middlewares.RequireScope(domain.ScopeTeamAdmin)).Post("/team/rules", c.create)
+	r.With(middlewares.RequireScope(domain.ScopeTeamAdmin)).Delete("/team/rules", c.delete)
+}
+
+// list godoc
+//
+//	@Summary	Получить правила назначения ревьюверов команды
+//	@Tags		Teams
+//	@Accept		json
+//	@Produce	json
+//	@Param		team_name	query		string					true	"Уникальное имя команды"
+//	@Success	200			{object}	models.TeamRulesResponse	"Правила команды"
+//	@Failure	400			{object}	models.ErrorResponse	"Неверный запрос"
+//	@Failure	404			{object}	models.ErrorResponse	"Команда не найдена"
+//	@Failure	500			{object}	models.ErrorResponse	"Ошибка сервера"
+//	@Security	ApiKeyAuth
+//	@Security	BearerAuth
+//	@Router		/team/rules [get]
+func (c *TeamRuleController) list(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	teamName := r.URL.Query().Get("team_name")
+
+	if teamName == "" {
+		c.writeError(ctx, w, http.StatusBadRequest,
+			models.ErrorCodeValidationFailed,
+			"team_name is required",
+			"missing team_name query param for team rules",
+			nil,
+		)
+		return
+	}
+
+	rules, err := c.teamRuleService.List(ctx, domain.TeamName(teamName))
+	if err != nil {
+		if errors.Is(err, domain.ErrTeamNotFound) {
+			c.writeError(ctx, w, http.StatusNotFound,
+				models.ErrorCodeNotFound,
+				"resource not found",
+				"team not found to list rules",
+				err,
+				"team_name", teamName,
+			)
+			return
+		}
+
+		c.writeError(ctx, w, http.StatusInternalServerError,
+			models.ErrorCodeInternalServer,
+			"internal server error",
+			"failed to list team rules",
+			err,
+			"team_name", teamName,
+		)
+		return
+	}
+
+	resp := models.MapToTeamRulesResponse(domain.TeamName(teamName), rules)
+	c.writeJSON(ctx, w, http.StatusOK, resp)
+}
+
+// create godoc
+//
+//	@Summary	Добавить правило назначения ревьюверов команды
+//	@Tags		Teams
+//	@Accept		json
+//	@Produce	json
+//	@Param		request	body		models.CreateTeamRuleRequest	true	"Create team rule body"
+//	@Success	201		{object}	models.TeamRuleEnvelopeResponse	"Правило создано"
+//	@Failure	400		{object}	models.ErrorResponse			"Неверный запрос или правило"
+//	@Failure	404		{object}	models.ErrorResponse			"Команда не найдена"
+//	@Failure	500		{object}	models.ErrorResponse			"Ошибка сервера"
+//	@Security	ApiKeyAuth
+//	@Security	BearerAuth
+//	@Router		/team/rules [post]
+func (c *TeamRuleController) create(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req models.CreateTeamRuleRequest
+	if ok := c.decodeAndValidate(ctx, w, r, &req, "createTeamRuleRequest"); !ok {
+		return
+	}
+
+	rule := req.MapToDomain()
+	createdRule, err := c.teamRuleService.Create(ctx, &rule)
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidTeamRule) {
+			c.writeError(ctx, w, http.StatusBadRequest,
+				models.ErrorCodeValidationFailed,
+				"rule fields do not match its kind",
+				"invalid team rule",
+				err,
+				"team_name", req.TeamName,
+				"kind", req.Kind,
+			)
+			return
+		}
+		if errors.Is(err, domain.ErrTeamNotFound) {
+			c.writeError(ctx, w, http.StatusNotFound,
+				models.ErrorCodeNotFound,
+				"resource not found",
+				"team not found to create rule",
+				err,
+				"team_name", req.TeamName,
+			)
+			return
+		}
+
+		c.writeError(ctx, w, http.StatusInternalServerError,
+			models.ErrorCodeInternalServer,
+			"internal server error",
+			"failed to create team rule",
+			err,
+			"team_name", req.TeamName,
+		)
+		return
+	}
+
+	resp := models.TeamRuleEnvelopeResponse{Rule: models.MapToTeamRuleResponse(*createdRule)}
+	c.writeJSON(ctx, w, http.StatusCreated, resp)
+}
+
+// delete godoc
+//
+//	@Summary	Удалить правило назначения ревьюверов команды
+//	@Tags		Teams
+//	@Accept		json
+//	@Produce	json
+//	@Param		team_name	query	string	true	"Уникальное имя команды"
+//	@Param		rule_id		query	int		true	"Идентификатор правила"
+//	@Success	204			"Правило удалено"
+//	@Failure	400			{object}	models.ErrorResponse	"Неверный запрос"
+//	@Failure	404			{object}	models.ErrorResponse	"Правило не найдено"
+//	@Failure	500			{object}	models.ErrorResponse	"Ошибка сервера"
+//	@Security	ApiKeyAuth
+//	@Security	BearerAuth
+//	@Router		/team/rules [delete]
+func (c *TeamRuleController) delete(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	q := r.URL.Query()
+	teamName := q.Get("team_name")
+
+	ruleID, err := strconv.ParseInt(q.Get("rule_id"), 10, 64)
+	if teamName == "" || err != nil {
+		c.writeError(ctx, w, http.StatusBadRequest,
+			models.ErrorCodeValidationFailed,
+			"team_name and numeric rule_id are required",
+			"invalid query params to delete team rule",
+			err,
+			"team_name", teamName,
+		)
+		return
+	}
+
+	if err := c.teamRuleService.Delete(ctx, domain.TeamName(teamName), domain.TeamRuleID(ruleID)); err != nil {
+		if errors.Is(err, domain.ErrTeamRuleNotFound) {
+			c.writeError(ctx, w, http.StatusNotFound,
+				models.ErrorCodeNotFound,
+				"resource not found",
+				"team rule not found",
+				err,
+				"team_name", teamName,
+				"rule_id", ruleID,
+			)
+			return
+		}
+
+		c.writeError(ctx, w, http.StatusInternalServerError,
+			models.ErrorCodeInternalServer,
+			"internal server error",
+			"failed to delete team rule",
+			err,
+			"team_name", teamName,
+			"rule_id", ruleID,
+		)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
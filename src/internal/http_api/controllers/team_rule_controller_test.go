@@ -0,0 +1,178 @@
+package controllers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"PrService/src/internal/domain"
+	"PrService/src/internal/http_api/mocks"
+	"PrService/src/internal/http_api/models"
+
+	"github.com/go-playground/validator/v10"
+	"go.uber.org/mock/gomock"
+)
+
+func newTeamRuleController(
+	t *testing.T,
+) (*TeamRuleController, *mocks.MockTeamRuleService) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	svc := mocks.NewMockTeamRuleService(ctrl)
+
+	validate := validator.New()
+	logger := newTestLogger()
+
+	c := NewTeamRuleController(svc, validate, logger)
+
+	return c, svc
+}
+
+func TestTeamRuleController_Create_Success(t *testing.T) {
+	c, svc := newTeamRuleController(t)
+
+	svc.
+		EXPECT().
+		Create(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ any, rule *domain.TeamRule) (*domain.TeamRule, error) {
+			rule.ID = 5
+			return rule, nil
+		})
+
+	body := `{
+		"team_name": "backend",
+		"kind": "MAX_OF",
+		"users": ["u1", "u2"],
+		"max_count": 1
+	}`
+
+	req := httptest.NewRequest(http.MethodPost, "/team/rules", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	rr := httptest.NewRecorder()
+
+	c.create(rr, req)
+
+	if rr.Code != http.StatusCreated {
+		t.Fatalf("expected status %d, got %d, body=%s", http.StatusCreated, rr.Code, rr.Body.String())
+	}
+
+	var resp models.TeamRuleEnvelopeResponse
+	if err := json.Unmarshal(rr.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("failed to unmarshal TeamRuleEnvelopeResponse: %v", err)
+	}
+
+	if resp.Rule.RuleID != 5 || resp.Rule.Kind != "MAX_OF" || len(resp.Rule.Users) != 2 || resp.Rule.MaxCount != 1 {
+		t.Fatalf("unexpected rule response: %+v", resp.Rule)
+	}
+}
+
+func TestTeamRuleController_Create_InvalidRule(t *testing.T) {
+	c, svc := newTeamRuleController(t)
+
+	svc.
+		EXPECT().
+		Create(gomock.Any(), gomock.Any()).
+		Return(nil, domain.ErrInvalidTeamRule)
+
+	body := `{"team_name": "backend", "kind": "EXCLUDE_PAIR", "reviewer_id": "u1"}`
+
+	req := httptest.NewRequest(http.MethodPost, "/team/rules", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	rr := httptest.NewRecorder()
+
+	c.create(rr, req)
+
+	if rr.Code != http.StatusBadRequest {
+		t.Fatalf("expected status %d, got %d, body=%s", http.StatusBadRequest, rr.Code, rr.Body.String())
+	}
+}
+
+func TestTeamRuleController_Create_UnknownKind(t *testing.T) {
+	c, _ := newTeamRuleController(t)
+
+	body := `{"team_name": "backend", "kind": "ANYTHING"}`
+
+	req := httptest.NewRequest(http.MethodPost, "/team/rules", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	rr := httptest.NewRecorder()
+
+	c.create(rr, req)
+
+	if rr.Code != http.StatusBadRequest {
+		t.Fatalf("expected status %d, got %d, body=%s", http.StatusBadRequest, rr.Code, rr.Body.String())
+	}
+}
+
+func TestTeamRuleController_List_Success(t *testing.T) {
+	c, svc := newTeamRuleController(t)
+
+	svc.
+		EXPECT().
+		List(gomock.Any(), domain.TeamName("backend")).
+		Return([]domain.TeamRule{
+			{ID: 1, TeamName: "backend", Kind: domain.TeamRuleKindExcludePair, ReviewerID: "u2", AuthorID: "u1"},
+		}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/team/rules?team_name=backend", nil)
+	rr := httptest.NewRecorder()
+
+	c.list(rr, req)
+
+	if rr.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d, body=%s", http.StatusOK, rr.Code, rr.Body.String())
+	}
+
+	var resp models.TeamRulesResponse
+	if err := json.Unmarshal(rr.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("failed to unmarshal TeamRulesResponse: %v", err)
+	}
+
+	if resp.TeamName != "backend" || len(resp.Rules) != 1 || resp.Rules[0].ReviewerID != "u2" {
+		t.Fatalf("unexpected rules response: %+v", resp)
+	}
+}
+
+func TestTeamRuleController_Delete(t *testing.T) {
+	tests := []struct {
+		name       string
+		query      string
+		serviceErr error
+		callsSvc   bool
+		wantStatus int
+	}{
+		{name: "deleted", query: "team_name=backend&rule_id=3", callsSvc: true, wantStatus: http.StatusNoContent},
+		{
+			name:       "not found",
+			query:      "team_name=backend&rule_id=3",
+			serviceErr: domain.ErrTeamRuleNotFound,
+			callsSvc:   true,
+			wantStatus: http.StatusNotFound,
+		},
+		{name: "invalid rule id", query: "team_name=backend&rule_id=abc", wantStatus: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, svc := newTeamRuleController(t)
+
+			if tt.callsSvc {
+				svc.
+					EXPECT().
+					Delete(gomock.Any(), domain.TeamName("backend"), domain.TeamRuleID(3)).
+					Return(tt.serviceErr)
+			}
+
+			req := httptest.NewRequest(http.MethodDelete, "/team/rules?"+tt.query, nil)
+			rr := httptest.NewRecorder()
+
+			c.delete(rr, req)
+
+			if rr.Code != tt.wantStatus {
+				t.Fatalf("expected status %d, got %d, body=%s", tt.wantStatus, rr.Code, rr.Body.String())
+			}
+		})
+	}
+}
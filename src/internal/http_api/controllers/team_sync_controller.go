@@ -0,0 +1,130 @@
+package controllers
+
+import (
+	"errors"
+	"log/slog"
+	"mime"
+	"net/http"
+
+	"PrService/src/internal/domain"
+	"PrService/src/internal/http_api/middlewares"
+	"PrService/src/internal/http_api/models"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/go-playground/validator/v10"
+	"gopkg.in/yaml.v3"
+)
+
+type TeamSyncController struct {
+	baseController
+	teamSyncService domain.TeamSyncService
+}
+
+func NewTeamSyncController(
+	teamSyncService domain.TeamSyncService,
+	validate *validator.Validate,
+	logger *slog.Logger,
+) *TeamSyncController {
+	return &TeamSyncController{
+		baseController:  newBaseController(validate, logger),
+		teamSyncService: teamSyncService,
+	}
+}
+
+func (c *TeamSyncController) UseHandlers(r chi.Router) {
+	r.With(middlewares.RequireScope(domain.ScopeTeamAdmin)).Put("/team/sync", c.sync)
+}
+
+// sync godoc
+//
+//	@Summary		Привести состав команды к желаемому (идемпотентно)
+//	@Description	Тело — JSON или YAML (Content-Type: application/yaml) с полным списком участников.
+//	@Description	Новые участники добавляются, изменённые обновляются, отсутствующие в списке деактивируются,
+//	@Description	а их открытые ревью переназначаются. Повторный вызов с тем же списком ничего не меняет.
+//	@Tags			Teams
+//	@Accept			json
+//	@Accept			application/yaml
+//	@Produce		json
+//	@Param			request	body		models.SyncTeamRequest	true	"Sync team body"
+//	@Success		200		{object}	models.SyncTeamResponse	"Применённые изменения"
+//	@Failure		400		{object}	models.ErrorResponse	"Неверный запрос"
+//	@Failure		500		{object}	models.ErrorResponse	"Ошибка сервера"
+//	@Security		ApiKeyAuth
+//	@Router			/team/sync [put]
+func (c *TeamSyncController) sync(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req models.SyncTeamRequest
+	if ok := c.decodeSyncRequest(w, r, &req); !ok {
+		return
+	}
+
+	result, err := c.teamSyncService.Sync(ctx, req.MapToDomain())
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidTeamImport) {
+			c.writeError(ctx, w, http.StatusBadRequest,
+				models.ErrorCodeValidationFailed,
+				err.Error(),
+				"invalid team sync",
+				err,
+				"team_name", req.TeamName,
+			)
+			return
+		}
+
+		c.writeError(ctx, w, http.StatusInternalServerError,
+			models.ErrorCodeInternalServer,
+			"internal server error",
+			"failed to sync team",
+			err,
+			"team_name", req.TeamName,
+		)
+		return
+	}
+
+	resp := models.MapToSyncTeamResponse(domain.TeamName(req.TeamName), *result)
+	c.writeJSON(ctx, w, http.StatusOK, resp)
+}
+
+// decodeSyncRequest reads a YAML body when the Content-Type says so and JSON otherwise.
+func (c *TeamSyncController) decodeSyncRequest(
+	w http.ResponseWriter,
+	r *http.Request,
+	req *models.SyncTeamRequest,
+) bool {
+	ctx := r.Context()
+
+	mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if !isYAMLMediaType(mediaType) {
+		return c.decodeAndValidate(ctx, w, r, req, "syncTeamRequest")
+	}
+
+	dec := yaml.NewDecoder(r.Body)
+	dec.KnownFields(true)
+	if err := dec.Decode(req); err != nil {
+		c.logger.WarnContext(ctx, "failed to decode syncTeamRequest",
+			"request_id", middleware.GetReqID(ctx),
+			"err", err,
+		)
+		c.writeError(ctx, w, http.StatusBadRequest,
+			models.ErrorCodeDecodeFailed,
+			"invalid request body",
+			"invalid syncTeamRequest body",
+			err,
+		)
+		return false
+	}
+
+	if err := c.validate.StructCtx(ctx, req); err != nil {
+		c.writeError(ctx, w, http.StatusBadRequest,
+			models.ErrorCodeValidationFailed,
+			"validation failed",
+			"validation failed for syncTeamRequest",
+			err,
+		)
+		return false
+	}
+
+	return true
+}
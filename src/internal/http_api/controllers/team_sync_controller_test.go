@@ -0,0 +1,143 @@
+package controllers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"PrService/src/internal/domain"
+	"PrService/src/internal/http_api/mocks"
+	"PrService/src/internal/http_api/models"
+
+	"github.com/go-playground/validator/v10"
+	"go.uber.org/mock/gomock"
+)
+
+func newTeamSyncController(t *testing.T) (*TeamSyncController, *mocks.MockTeamSyncService) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	svc := mocks.NewMockTeamSyncService(ctrl)
+
+	c := NewTeamSyncController(svc, validator.New(), newTestLogger())
+
+	return c, svc
+}
+
+func TestTeamSyncController_JSON(t *testing.T) {
+	c, svc := newTeamSyncController(t)
+
+	body := `{"team_name":"backend","members":[{"user_id":"u1","username":"Alice","seniority":"SENIOR"}]}`
+	want := domain.Team{
+		Name:    "backend",
+		Members: []domain.TeamMember{{ID: "u1", Username: "Alice", IsActive: true, Seniority: domain.SenioritySenior}},
+	}
+	svc.
+		EXPECT().
+		Sync(gomock.Any(), want).
+		Return(&domain.TeamSyncResult{
+			Changes: domain.TeamImportDiff{
+				DeactivatedUsers: []domain.User{{ID: "u2", Username: "Bob", TeamName: "backend"}},
+			},
+			Handovers: []domain.UserReviewHandover{{
+				UserID: "u2",
+				ReviewHandover: domain.ReviewHandover{
+					Reassigned: []domain.ReviewReassignment{{PullRequestID: "pr-1", NewReviewerID: "u1"}},
+				},
+			}},
+		}, nil)
+
+	req := httptest.NewRequest(http.MethodPut, "/team/sync", strings.NewReader(body))
+	rr := httptest.NewRecorder()
+
+	c.sync(rr, req)
+
+	if rr.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d: %s", rr.Code, rr.Body.String())
+	}
+
+	var resp models.SyncTeamResponse
+	if err := json.NewDecoder(rr.Body).Decode(&resp); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if resp.TeamName != "backend" || len(resp.Changes.DeactivatedUsers) != 1 {
+		t.Fatalf("unexpected response: %+v", resp)
+	}
+	if len(resp.ReviewHandovers) != 1 || resp.ReviewHandovers[0].UserID != "u2" ||
+		len(resp.ReviewHandovers[0].Reassigned) != 1 {
+		t.Fatalf("unexpected review handovers: %+v", resp.ReviewHandovers)
+	}
+}
+
+func TestTeamSyncController_YAML(t *testing.T) {
+	c, svc := newTeamSyncController(t)
+
+	body := `
+team_name: backend
+max_reviewers: 3
+members:
+  - user_id: u1
+    username: Alice
+  - user_id: u2
+    username: Bob
+    is_active: false
+`
+	want := domain.Team{
+		Name:         "backend",
+		MaxReviewers: 3,
+		Members: []domain.TeamMember{
+			{ID: "u1", Username: "Alice", IsActive: true},
+			{ID: "u2", Username: "Bob", IsActive: false},
+		},
+	}
+	svc.
+		EXPECT().
+		Sync(gomock.Any(), want).
+		Return(&domain.TeamSyncResult{}, nil)
+
+	req := httptest.NewRequest(http.MethodPut, "/team/sync", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/yaml")
+	rr := httptest.NewRecorder()
+
+	c.sync(rr, req)
+
+	if rr.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d: %s", rr.Code, rr.Body.String())
+	}
+}
+
+func TestTeamSyncController_YAMLUnknownField(t *testing.T) {
+	c, _ := newTeamSyncController(t)
+
+	req := httptest.NewRequest(http.MethodPut, "/team/sync", strings.NewReader("team_name: backend\nowner: u1\n"))
+	req.Header.Set("Content-Type", "application/yaml")
+	rr := httptest.NewRecorder()
+
+	c.sync(rr, req)
+
+	if rr.Code != http.StatusBadRequest {
+		t.Fatalf("expected status 400, got %d", rr.Code)
+	}
+}
+
+func TestTeamSyncController_InvalidSync(t *testing.T) {
+	c, svc := newTeamSyncController(t)
+
+	svc.
+		EXPECT().
+		Sync(gomock.Any(), gomock.Any()).
+		Return(nil, errors.Join(domain.ErrInvalidTeamImport, errors.New("duplicate member")))
+
+	body := `{"team_name":"backend","members":[{"user_id":"u1","username":"Alice"}]}`
+	req := httptest.NewRequest(http.MethodPut, "/team/sync", strings.NewReader(body))
+	rr := httptest.NewRecorder()
+
+	c.sync(rr, req)
+
+	if rr.Code != http.StatusBadRequest {
+		t.Fatalf("expected status 400, got %d", rr.Code)
+	}
+}
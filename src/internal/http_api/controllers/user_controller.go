@@ -6,6 +6,7 @@ import (
 	"net/http"
 
 	"PrService/src/internal/domain"
+	"PrService/src/internal/http_api/middlewares"
 	"PrService/src/internal/http_api/models"
 
 	"github.com/go-chi/chi/v5"
@@ -29,8 +30,12 @@ func NewUserController(
 }
 
 func (c *UserController) UseHandlers(r chi.Router) {
-	r.Post("/users/setIsActive", c.setIsActive)
-	r.Get("/users/getReview", c.getReview)
+	r.With(middlewares.RequireScope(domain.ScopeUsersWrite)).Post("/users/setIsActive", c.setIsActive)
+	r.With(middlewares.RequireScope(domain.ScopePullRequestRead)).Get("/users/getReview", c.getReview)
+	r.With(middlewares.RequireScope(domain.ScopeTeamRead)).Get("/users/list", c.list)
+	r.With(middlewares.RequireScope(domain.ScopeUsersWrite)).Post("/users/linkAccount", c.linkAccount)
+	r.With(middlewares.RequireScope(domain.ScopeUsersWrite)).Post("/users/setChatHandle", c.setChatHandle)
+	r.With(middlewares.RequireScope(domain.ScopeUsersWrite)).Post("/users/setEmail", c.setEmail)
 }
 
 // setIsActive godoc
@@ -40,10 +45,13 @@ func (c *UserController) UseHandlers(r chi.Router) {
 //	@Accept		json
 //	@Produce	json
 //	@Param		request	body		models.SetUserIsActiveRequest	true "Set is active body"
-//	@Success	200		{object}	models.SetUserIsActiveResponse	"Обновлённый пользователь"
+//	@Success	200		{object}	models.SetUserIsActiveResponse	"Обновлённый пользователь и переназначенные ревью"
 //	@Failure	400		{object}	models.ErrorResponse			"неверный запрос"
+//	@Failure	403		{object}	models.ErrorResponse			"Тимлид может менять только участников своей команды"
 //	@Failure	404		{object}	models.ErrorResponse			"Пользователь не найден"
 //	@Failure	500		{object}	models.ErrorResponse			"Ошибка сервера"
+//	@Security	ApiKeyAuth
+//	@Security	BearerAuth
 //	@Router		/users/setIsActive [post]
 func (c *UserController) setIsActive(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -53,7 +61,7 @@ func (c *UserController) setIsActive(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	user, err := c.userService.SetIsActive(ctx, domain.UserID(req.UserID), req.IsActive)
+	user, handover, err := c.userService.SetIsActive(ctx, domain.UserID(req.UserID), req.IsActive, req.ReassignReviews)
 	if err != nil {
 		if errors.Is(err, domain.ErrUserNotFound) {
 			c.writeError(ctx, w, http.StatusNotFound,
@@ -65,6 +73,16 @@ func (c *UserController) setIsActive(w http.ResponseWriter, r *http.Request) {
 			)
 			return
 		}
+		if errors.Is(err, domain.ErrForbidden) {
+			c.writeError(ctx, w, http.StatusForbidden,
+				models.ErrorCodeForbidden,
+				"only a lead of the user's team or an admin can change its activity",
+				"set active status forbidden for the actor",
+				err,
+				"user_id", req.UserID,
+			)
+			return
+		}
 
 		c.writeError(ctx, w, http.StatusInternalServerError,
 			models.ErrorCodeInternalServer,
@@ -76,7 +94,7 @@ func (c *UserController) setIsActive(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	resp := models.MapToSetUserIsActiveResponse(*user)
+	resp := models.MapToSetUserIsActiveResponse(*user, handover)
 	c.writeJSON(ctx, w, http.StatusOK, resp)
 }
 
@@ -90,6 +108,8 @@ func (c *UserController) setIsActive(w http.ResponseWriter, r *http.Request) {
 //	@Success	200		{object}	models.GetUserReviewsResponse	"Список PR'ов пользователя"
 //	@Failure	400		{object}	models.ErrorResponse			"отсутствующий или неверный user_id"
 //	@Failure	500		{object}	models.ErrorResponse			"Ошибка сервера"
+//	@Security	ApiKeyAuth
+//	@Security	BearerAuth
 //	@Router		/users/getReview [get]
 func (c *UserController) getReview(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -123,3 +143,238 @@ func (c *UserController) getReview(w http.ResponseWriter, r *http.Request) {
 	resp := models.MapToGetUserReviewsResponse(domain.UserID(userIDStr), prs)
 	c.writeJSON(ctx, w, http.StatusOK, resp)
 }
+
+// list godoc
+//
+//	@Summary	Получить список пользователей с фильтрами по команде, грейду и тегам
+//	@Tags		Users
+//	@Accept		json
+//	@Produce	json
+//	@Param		team_name	query		string					false	"Имя команды"
+//	@Param		seniority	query		string					false	"Грейд: JUNIOR, MIDDLE, SENIOR, LEAD"
+//	@Param		tag			query		[]string				false	"Теги, которые должны быть у пользователя (все)"	collectionFormat(multi)
+//	@Success	200			{object}	models.ListUsersResponse	"Список пользователей"
+//	@Failure	400			{object}	models.ErrorResponse	"Неверный грейд"
+//	@Failure	500			{object}	models.ErrorResponse	"Ошибка сервера"
+//	@Security	ApiKeyAuth
+//	@Security	BearerAuth
+//	@Router		/users/list [get]
+func (c *UserController) list(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	q := r.URL.Query()
+
+	filter := domain.UserFilter{
+		TeamName:  domain.TeamName(q.Get("team_name")),
+		Seniority: domain.Seniority(q.Get("seniority")),
+		Tags:      q["tag"],
+	}
+
+	if filter.Seniority != "" && !filter.Seniority.IsValid() {
+		c.writeError(ctx, w, http.StatusBadRequest,
+			models.ErrorCodeValidationFailed,
+			"seniority must be one of JUNIOR, MIDDLE, SENIOR, LEAD",
+			"invalid seniority in users list query",
+			nil,
+			"seniority", filter.Seniority,
+		)
+		return
+	}
+
+	users, err := c.userService.List(ctx, filter)
+	if err != nil {
+		c.writeError(ctx, w, http.StatusInternalServerError,
+			models.ErrorCodeInternalServer,
+			"internal server error",
+			"failed to list users",
+			err,
+			"team_name", filter.TeamName,
+		)
+		return
+	}
+
+	resp := models.MapToListUsersResponse(users)
+	c.writeJSON(ctx, w, http.StatusOK, resp)
+}
+
+// linkAccount godoc
+//
+//	@Summary	Связать логин внешней платформы (GitHub, GitLab) с пользователем
+//	@Tags		Users
+//	@Accept		json
+//	@Produce	json
+//	@Param		request	body		models.LinkExternalAccountRequest	true	"Link external account body"
+//	@Success	200		{object}	models.LinkExternalAccountResponse	"Связанный аккаунт"
+//	@Failure	400		{object}	models.ErrorResponse				"Неверный запрос"
+//	@Failure	403		{object}	models.ErrorResponse				"Пользователь из чужой команды"
+//	@Failure	404		{object}	models.ErrorResponse				"Пользователь не найден"
+//	@Failure	500		{object}	models.ErrorResponse				"Ошибка сервера"
+//	@Security	ApiKeyAuth
+//	@Security	BearerAuth
+//	@Router		/users/linkAccount [post]
+func (c *UserController) linkAccount(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req models.LinkExternalAccountRequest
+	if ok := c.decodeAndValidate(ctx, w, r, &req, "linkExternalAccountRequest"); !ok {
+		return
+	}
+
+	account, err := c.userService.LinkExternalAccount(ctx, req.MapToDomain())
+	if err != nil {
+		if errors.Is(err, domain.ErrUserNotFound) {
+			c.writeError(ctx, w, http.StatusNotFound,
+				models.ErrorCodeNotFound,
+				"resource not found",
+				"user not found to link external account",
+				err,
+				"user_id", req.UserID,
+			)
+			return
+		}
+		if errors.Is(err, domain.ErrForbidden) {
+			c.writeError(ctx, w, http.StatusForbidden,
+				models.ErrorCodeForbidden,
+				"only a lead of the user's team or an admin can change the user",
+				"link external account forbidden for the actor",
+				err,
+				"user_id", req.UserID,
+			)
+			return
+		}
+
+		c.writeError(ctx, w, http.StatusInternalServerError,
+			models.ErrorCodeInternalServer,
+			"internal server error",
+			"failed to link external account",
+			err,
+			"user_id", req.UserID,
+			"provider", req.Provider,
+		)
+		return
+	}
+
+	resp := models.MapToLinkExternalAccountResponse(*account)
+	c.writeJSON(ctx, w, http.StatusOK, resp)
+}
+
+// setChatHandle godoc
+//
+//	@Summary		Указать ник пользователя в чате для уведомлений
+//	@Description	Пустой chat_handle отключает уведомления в чат.
+//	@Tags			Users
+//	@Accept			json
+//	@Produce		json
+//	@Param			request	body		models.SetUserChatHandleRequest		true	"Set chat handle body"
+//	@Success		200		{object}	models.SetUserChatHandleResponse	"Обновлённый пользователь"
+//	@Failure		400		{object}	models.ErrorResponse				"Неверный запрос"
+//	@Failure		403		{object}	models.ErrorResponse				"Пользователь из чужой команды"
+//	@Failure		404		{object}	models.ErrorResponse				"Пользователь не найден"
+//	@Failure		500		{object}	models.ErrorResponse				"Ошибка сервера"
+//	@Security		ApiKeyAuth
+//	@Security		BearerAuth
+//	@Router			/users/setChatHandle [post]
+func (c *UserController) setChatHandle(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req models.SetUserChatHandleRequest
+	if ok := c.decodeAndValidate(ctx, w, r, &req, "setUserChatHandleRequest"); !ok {
+		return
+	}
+
+	user, err := c.userService.SetChatHandle(ctx, domain.UserID(req.UserID), req.ChatHandle)
+	if err != nil {
+		if errors.Is(err, domain.ErrUserNotFound) {
+			c.writeError(ctx, w, http.StatusNotFound,
+				models.ErrorCodeNotFound,
+				"resource not found",
+				"user not found in SetChatHandle",
+				err,
+				"user_id", req.UserID,
+			)
+			return
+		}
+		if errors.Is(err, domain.ErrForbidden) {
+			c.writeError(ctx, w, http.StatusForbidden,
+				models.ErrorCodeForbidden,
+				"only a lead of the user's team or an admin can change the user",
+				"set chat handle forbidden for the actor",
+				err,
+				"user_id", req.UserID,
+			)
+			return
+		}
+
+		c.writeError(ctx, w, http.StatusInternalServerError,
+			models.ErrorCodeInternalServer,
+			"internal server error",
+			"failed to set chat handle",
+			err,
+			"user_id", req.UserID,
+		)
+		return
+	}
+
+	resp := models.MapToSetUserChatHandleResponse(*user)
+	c.writeJSON(ctx, w, http.StatusOK, resp)
+}
+
+// setEmail godoc
+//
+//	@Summary		Указать email пользователя для уведомлений и дайджеста
+//	@Description	email_opt_out=true отключает все письма пользователю, включая ежедневный дайджест.
+//	@Tags			Users
+//	@Accept			json
+//	@Produce		json
+//	@Param			request	body		models.SetUserEmailRequest	true	"Set email body"
+//	@Success		200		{object}	models.SetUserEmailResponse	"Обновлённый пользователь"
+//	@Failure		400		{object}	models.ErrorResponse		"Неверный запрос"
+//	@Failure		403		{object}	models.ErrorResponse		"Пользователь из чужой команды"
+//	@Failure		404		{object}	models.ErrorResponse		"Пользователь не найден"
+//	@Failure		500		{object}	models.ErrorResponse		"Ошибка сервера"
+//	@Security		ApiKeyAuth
+//	@Security		BearerAuth
+//	@Router			/users/setEmail [post]
+func (c *UserController) setEmail(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req models.SetUserEmailRequest
+	if ok := c.decodeAndValidate(ctx, w, r, &req, "setUserEmailRequest"); !ok {
+		return
+	}
+
+	user, err := c.userService.SetEmail(ctx, domain.UserID(req.UserID), req.Email, req.EmailOptOut)
+	if err != nil {
+		if errors.Is(err, domain.ErrUserNotFound) {
+			c.writeError(ctx, w, http.StatusNotFound,
+				models.ErrorCodeNotFound,
+				"resource not found",
+				"user not found in SetEmail",
+				err,
+				"user_id", req.UserID,
+			)
+			return
+		}
+		if errors.Is(err, domain.ErrForbidden) {
+			c.writeError(ctx, w, http.StatusForbidden,
+				models.ErrorCodeForbidden,
+				"only a lead of the user's team or an admin can change the user",
+				"set email forbidden for the actor",
+				err,
+				"user_id", req.UserID,
+			)
+			return
+		}
+
+		c.writeError(ctx, w, http.StatusInternalServerError,
+			models.ErrorCodeInternalServer,
+			"internal server error",
+			"failed to set email",
+			err,
+			"user_id", req.UserID,
+		)
+		return
+	}
+
+	resp := models.MapToSetUserEmailResponse(*user)
+	c.writeJSON(ctx, w, http.StatusOK, resp)
+}
@@ -40,13 +40,13 @@ func TestUserController_SetIsActive_Success(t *testing.T) {
 
 	svc.
 		EXPECT().
-		SetIsActive(gomock.Any(), userID, false).
+		SetIsActive(gomock.Any(), userID, false, false).
 		Return(&domain.User{
 			ID:       userID,
 			Username: "Alice",
 			TeamName: "backend",
 			IsActive: false,
-		}, nil)
+		}, nil, nil)
 
 	body := `{"user_id":"u1","is_active":false}`
 
@@ -73,13 +73,52 @@ func TestUserController_SetIsActive_Success(t *testing.T) {
 	}
 }
 
+func TestUserController_SetIsActive_ReassignReviews(t *testing.T) {
+	c, svc := newUserController(t)
+
+	userID := domain.UserID("u1")
+
+	svc.
+		EXPECT().
+		SetIsActive(gomock.Any(), userID, false, true).
+		Return(&domain.User{ID: userID, TeamName: "backend"}, &domain.ReviewHandover{
+			Reassigned:   []domain.ReviewReassignment{{PullRequestID: "pr-1", NewReviewerID: "u3"}},
+			Unreassigned: []domain.PullRequestID{"pr-2"},
+		}, nil)
+
+	body := `{"user_id":"u1","is_active":false,"reassign_reviews":true}`
+
+	req := httptest.NewRequest(http.MethodPost, "/users/setIsActive", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	rr := httptest.NewRecorder()
+
+	c.setIsActive(rr, req)
+
+	if rr.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d, body=%s", http.StatusOK, rr.Code, rr.Body.String())
+	}
+
+	var resp models.SetUserIsActiveResponse
+	if err := json.Unmarshal(rr.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("failed to unmarshal SetUserIsActiveResponse: %v", err)
+	}
+
+	handover := resp.ReviewHandover
+	if handover == nil || len(handover.Reassigned) != 1 || handover.Reassigned[0].NewReviewerID != "u3" {
+		t.Fatalf("unexpected review handover: %+v", handover)
+	}
+	if len(handover.NotReassigned) != 1 || handover.NotReassigned[0] != "pr-2" {
+		t.Fatalf("unexpected not reassigned reviews: %v", handover.NotReassigned)
+	}
+}
+
 func TestUserController_SetIsActive_UserNotFound(t *testing.T) {
 	c, svc := newUserController(t)
 
 	svc.
 		EXPECT().
-		SetIsActive(gomock.Any(), domain.UserID("ghost"), true).
-		Return(nil, domain.ErrUserNotFound)
+		SetIsActive(gomock.Any(), domain.UserID("ghost"), true, false).
+		Return(nil, nil, domain.ErrUserNotFound)
 
 	body := `{"user_id":"ghost","is_active":true}`
 
@@ -103,6 +142,26 @@ func TestUserController_SetIsActive_UserNotFound(t *testing.T) {
 	}
 }
 
+func TestUserController_SetIsActive_Forbidden(t *testing.T) {
+	c, svc := newUserController(t)
+
+	svc.
+		EXPECT().
+		SetIsActive(gomock.Any(), domain.UserID("u1"), false, true).
+		Return(nil, nil, domain.ErrForbidden)
+
+	body := `{"user_id":"u1","is_active":false,"reassign_reviews":true}`
+
+	req := httptest.NewRequest(http.MethodPost, "/users/setIsActive", strings.NewReader(body))
+	rr := httptest.NewRecorder()
+
+	c.setIsActive(rr, req)
+
+	if rr.Code != http.StatusForbidden {
+		t.Fatalf("expected status %d, got %d, body=%s", http.StatusForbidden, rr.Code, rr.Body.String())
+	}
+}
+
 func TestUserController_GetReview_MissingUserID(t *testing.T) {
 	c, _ := newUserController(t)
 
@@ -186,3 +245,172 @@ func TestUserController_GetReview_ServiceError(t *testing.T) {
 		t.Fatalf("expected status %d, got %d, body=%s", http.StatusInternalServerError, rr.Code, rr.Body.String())
 	}
 }
+
+func TestUserController_List_Success(t *testing.T) {
+	c, svc := newUserController(t)
+
+	filter := domain.UserFilter{
+		TeamName:  "backend",
+		Seniority: domain.SenioritySenior,
+		Tags:      []string{"db", "go"},
+	}
+
+	svc.
+		EXPECT().
+		List(gomock.Any(), filter).
+		Return([]domain.User{{
+			ID:        "u1",
+			Username:  "Alice",
+			TeamName:  "backend",
+			IsActive:  true,
+			Seniority: domain.SenioritySenior,
+			Tags:      []string{"db", "go"},
+		}}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/users/list?team_name=backend&seniority=SENIOR&tag=db&tag=go", nil)
+	rr := httptest.NewRecorder()
+
+	c.list(rr, req)
+
+	if rr.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d, body=%s", http.StatusOK, rr.Code, rr.Body.String())
+	}
+
+	var resp models.ListUsersResponse
+	if err := json.Unmarshal(rr.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("failed to unmarshal ListUsersResponse: %v", err)
+	}
+
+	if len(resp.Users) != 1 || resp.Users[0].Seniority != "SENIOR" || len(resp.Users[0].Tags) != 2 {
+		t.Fatalf("unexpected users response: %+v", resp.Users)
+	}
+}
+
+func TestUserController_List_InvalidSeniority(t *testing.T) {
+	c, _ := newUserController(t)
+
+	req := httptest.NewRequest(http.MethodGet, "/users/list?seniority=GURU", nil)
+	rr := httptest.NewRecorder()
+
+	c.list(rr, req)
+
+	if rr.Code != http.StatusBadRequest {
+		t.Fatalf("expected status %d, got %d, body=%s", http.StatusBadRequest, rr.Code, rr.Body.String())
+	}
+}
+
+func TestUserController_SetChatHandle_Success(t *testing.T) {
+	c, svc := newUserController(t)
+
+	svc.
+		EXPECT().
+		SetChatHandle(gomock.Any(), domain.UserID("u1"), "@alice").
+		Return(&domain.User{ID: "u1", Username: "Alice", TeamName: "backend", IsActive: true, ChatHandle: "alice"}, nil)
+
+	body := `{"user_id":"u1","chat_handle":"@alice"}`
+
+	req := httptest.NewRequest(http.MethodPost, "/users/setChatHandle", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	rr := httptest.NewRecorder()
+
+	c.setChatHandle(rr, req)
+
+	if rr.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d, body=%s", http.StatusOK, rr.Code, rr.Body.String())
+	}
+
+	var resp models.SetUserChatHandleResponse
+	if err := json.Unmarshal(rr.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("failed to unmarshal SetUserChatHandleResponse: %v", err)
+	}
+	if resp.User.ChatHandle != "alice" {
+		t.Fatalf("expected chat_handle=alice, got %q", resp.User.ChatHandle)
+	}
+}
+
+func TestUserController_SetChatHandle_UserNotFound(t *testing.T) {
+	c, svc := newUserController(t)
+
+	svc.
+		EXPECT().
+		SetChatHandle(gomock.Any(), domain.UserID("ghost"), "").
+		Return(nil, domain.ErrUserNotFound)
+
+	body := `{"user_id":"ghost","chat_handle":""}`
+
+	req := httptest.NewRequest(http.MethodPost, "/users/setChatHandle", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	rr := httptest.NewRecorder()
+
+	c.setChatHandle(rr, req)
+
+	if rr.Code != http.StatusNotFound {
+		t.Fatalf("expected status %d, got %d, body=%s", http.StatusNotFound, rr.Code, rr.Body.String())
+	}
+}
+
+func TestUserController_SetChatHandle_Forbidden(t *testing.T) {
+	c, svc := newUserController(t)
+
+	svc.
+		EXPECT().
+		SetChatHandle(gomock.Any(), domain.UserID("u1"), "alice").
+		Return(nil, domain.ErrForbidden)
+
+	body := `{"user_id":"u1","chat_handle":"alice"}`
+
+	req := httptest.NewRequest(http.MethodPost, "/users/setChatHandle", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	rr := httptest.NewRecorder()
+
+	c.setChatHandle(rr, req)
+
+	if rr.Code != http.StatusForbidden {
+		t.Fatalf("expected status %d, got %d, body=%s", http.StatusForbidden, rr.Code, rr.Body.String())
+	}
+}
+
+func TestUserController_SetEmail_Success(t *testing.T) {
+	c, svc := newUserController(t)
+
+	svc.
+		EXPECT().
+		SetEmail(gomock.Any(), domain.UserID("u1"), "alice@example.com", true).
+		Return(&domain.User{ID: "u1", Email: "alice@example.com", EmailOptOut: true}, nil)
+
+	body := `{"user_id":"u1","email":"alice@example.com","email_opt_out":true}`
+
+	req := httptest.NewRequest(http.MethodPost, "/users/setEmail", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	rr := httptest.NewRecorder()
+
+	c.setEmail(rr, req)
+
+	if rr.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d, body=%s", http.StatusOK, rr.Code, rr.Body.String())
+	}
+
+	var resp models.SetUserEmailResponse
+	if err := json.Unmarshal(rr.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("failed to unmarshal SetUserEmailResponse: %v", err)
+	}
+	if resp.User.Email != "alice@example.com" || !resp.User.EmailOptOut {
+		t.Fatalf("unexpected user: %+v", resp.User)
+	}
+}
+
+func TestUserController_SetEmail_InvalidEmail(t *testing.T) {
+	c, _ := newUserController(t)
+
+	body := `{"user_id":"u1","email":"not-an-email"}`
+
+	req := httptest.NewRequest(http.MethodPost, "/users/setEmail", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	rr := httptest.NewRecorder()
+
+	c.setEmail(rr, req)
+
+	if rr.Code != http.StatusBadRequest {
+		t.Fatalf("expected status %d, got %d, body=%s", http.StatusBadRequest, rr.Code, rr.Body.String())
+	}
+}
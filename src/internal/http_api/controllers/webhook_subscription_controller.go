@@ -0,0 +1,237 @@
+package controllers
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"PrService/src/internal/domain"
+	"PrService/src/internal/http_api/middlewares"
+	"PrService/src/internal/http_api/models"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-playground/validator/v10"
+)
+
+const (
+	defaultDeliveriesLimit = 50
+	maxDeliveriesLimit     = 500
+)
+
+type WebhookSubscriptionController struct {
+	baseController
+	subscriptionService domain.WebhookSubscriptionService
+}
+
+func NewWebhookSubscriptionController(
+	subscriptionService domain.WebhookSubscriptionService,
+	validate *validator.Validate,
+	logger *slog.Logger,
+) *WebhookSubscriptionController {
+	return &WebhookSubscriptionController{
+		baseController:      newBaseController(validate, logger),
+		subscriptionService: subscriptionService,
+	}
+}
+
+func (c *WebhookSubscriptionController) UseHandlers(r chi.Router) {
+	r.With(middlewares.RequireScope(domain.ScopeAdmin)).Get("/webhooks/subscriptions", c.list)
+	r.With(middlewares.RequireScope(domain.ScopeAdmin)).Post("/webhooks/subscriptions", c.create)
+	r.With(middlewares.RequireScope(domain.ScopeAdmin)).Delete("/webhooks/subscriptions", c.delete)
+	r.With(middlewares.RequireScope(domain.ScopeAdmin)).Get("/webhooks/subscriptions/deliveries", c.listDeliveries)
+}
+
+// list godoc
+//
+//	@Summary	Получить подписки на исходящие вебхуки
+//	@Tags		Webhooks
+//	@Produce	json
+//	@Success	200	{object}	models.WebhookSubscriptionsResponse	"Подписки"
+//	@Failure	500	{object}	models.ErrorResponse				"Ошибка сервера"
+//	@Security	ApiKeyAuth
+//	@Security	BearerAuth
+//	@Router		/webhooks/subscriptions [get]
+func (c *WebhookSubscriptionController) list(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	subscriptions, err := c.subscriptionService.List(ctx)
+	if err != nil {
+		c.writeError(ctx, w, http.StatusInternalServerError,
+			models.ErrorCodeInternalServer,
+			"internal server error",
+			"failed to list webhook subscriptions",
+			err,
+		)
+		return
+	}
+
+	c.writeJSON(ctx, w, http.StatusOK, models.MapToWebhookSubscriptionsResponse(subscriptions))
+}
+
+// create godoc
+//
+//	@Summary		Подписаться на события сервиса
+//	@Description	События доставляются POST-запросом с JSON-телом и подписью HMAC-SHA256 в X-PRService-Signature-256.
+//	@Tags			Webhooks
+//	@Accept			json
+//	@Produce		json
+//	@Param			request	body		models.CreateWebhookSubscriptionRequest		true	"Create subscription body"
+//	@Success		201		{object}	models.WebhookSubscriptionEnvelopeResponse	"Подписка создана"
+//	@Failure		400		{object}	models.ErrorResponse						"Неверный запрос"
+//	@Failure		500		{object}	models.ErrorResponse						"Ошибка сервера"
+//	@Security		ApiKeyAuth
+//	@Router			/webhooks/subscriptions [post]
+func (c *WebhookSubscriptionController) create(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req models.CreateWebhookSubscriptionRequest
+	if ok := c.decodeAndValidate(ctx, w, r, &req, "createWebhookSubscriptionRequest"); !ok {
+		return
+	}
+
+	subscription := req.MapToDomain()
+	created, err := c.subscriptionService.Create(ctx, &subscription)
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidWebhookSubscription) {
+			c.writeError(ctx, w, http.StatusBadRequest,
+				models.ErrorCodeValidationFailed,
+				"unknown event type",
+				"invalid webhook subscription",
+				err,
+				"event_types", req.EventTypes,
+			)
+			return
+		}
+
+		c.writeError(ctx, w, http.StatusInternalServerError,
+			models.ErrorCodeInternalServer,
+			"internal server error",
+			"failed to create webhook subscription",
+			err,
+			"url", req.URL,
+		)
+		return
+	}
+
+	resp := models.WebhookSubscriptionEnvelopeResponse{
+		Subscription: models.MapToWebhookSubscriptionResponse(*created),
+	}
+	c.writeJSON(ctx, w, http.StatusCreated, resp)
+}
+
+// delete godoc
+//
+//	@Summary	Удалить подписку на вебхуки
+//	@Tags		Webhooks
+//	@Param		subscription_id	query	int	true	"Идентификатор подписки"
+//	@Success	204				"Подписка удалена"
+//	@Failure	400				{object}	models.ErrorResponse	"Неверный запрос"
+//	@Failure	404				{object}	models.ErrorResponse	"Подписка не найдена"
+//	@Failure	500				{object}	models.ErrorResponse	"Ошибка сервера"
+//	@Security	ApiKeyAuth
+//	@Security	BearerAuth
+//	@Router		/webhooks/subscriptions [delete]
+func (c *WebhookSubscriptionController) delete(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id, err := strconv.ParseInt(r.URL.Query().Get("subscription_id"), 10, 64)
+	if err != nil {
+		c.writeError(ctx, w, http.StatusBadRequest,
+			models.ErrorCodeValidationFailed,
+			"numeric subscription_id is required",
+			"invalid subscription_id to delete webhook subscription",
+			err,
+		)
+		return
+	}
+
+	if err := c.subscriptionService.Delete(ctx, domain.WebhookSubscriptionID(id)); err != nil {
+		c.writeSubscriptionError(w, r, err, "failed to delete webhook subscription", id)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// listDeliveries godoc
+//
+//	@Summary	Получить журнал доставок подписки
+//	@Tags		Webhooks
+//	@Produce	json
+//	@Param		subscription_id	query		int								true	"Идентификатор подписки"
+//	@Param		limit			query		int								false	"Количество последних доставок (по умолчанию 50, максимум 500)"
+//	@Success	200				{object}	models.WebhookDeliveriesResponse	"Доставки, новые первыми"
+//	@Failure	400				{object}	models.ErrorResponse			"Неверный запрос"
+//	@Failure	404				{object}	models.ErrorResponse			"Подписка не найдена"
+//	@Failure	500				{object}	models.ErrorResponse			"Ошибка сервера"
+//	@Security	ApiKeyAuth
+//	@Security	BearerAuth
+//	@Router		/webhooks/subscriptions/deliveries [get]
+func (c *WebhookSubscriptionController) listDeliveries(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	q := r.URL.Query()
+
+	id, err := strconv.ParseInt(q.Get("subscription_id"), 10, 64)
+	if err != nil {
+		c.writeError(ctx, w, http.StatusBadRequest,
+			models.ErrorCodeValidationFailed,
+			"numeric subscription_id is required",
+			"invalid subscription_id to list webhook deliveries",
+			err,
+		)
+		return
+	}
+
+	limit := defaultDeliveriesLimit
+	if raw := q.Get("limit"); raw != "" {
+		limit, err = strconv.Atoi(raw)
+		if err != nil || limit < 1 || limit > maxDeliveriesLimit {
+			c.writeError(ctx, w, http.StatusBadRequest,
+				models.ErrorCodeValidationFailed,
+				"limit must be between 1 and 500",
+				"invalid limit to list webhook deliveries",
+				err,
+				"limit", raw,
+			)
+			return
+		}
+	}
+
+	deliveries, err := c.subscriptionService.ListDeliveries(ctx, domain.WebhookSubscriptionID(id), limit)
+	if err != nil {
+		c.writeSubscriptionError(w, r, err, "failed to list webhook deliveries", id)
+		return
+	}
+
+	resp := models.MapToWebhookDeliveriesResponse(domain.WebhookSubscriptionID(id), deliveries)
+	c.writeJSON(ctx, w, http.StatusOK, resp)
+}
+
+func (c *WebhookSubscriptionController) writeSubscriptionError(
+	w http.ResponseWriter,
+	r *http.Request,
+	err error,
+	logMsg string,
+	id int64,
+) {
+	ctx := r.Context()
+	if errors.Is(err, domain.ErrWebhookSubscriptionNotFound) {
+		c.writeError(ctx, w, http.StatusNotFound,
+			models.ErrorCodeNotFound,
+			"resource not found",
+			"webhook subscription not found",
+			err,
+			"subscription_id", id,
+		)
+		return
+	}
+
+	c.writeError(ctx, w, http.StatusInternalServerError,
+		models.ErrorCodeInternalServer,
+		"internal server error",
+		logMsg,
+		err,
+		"subscription_id", id,
+	)
+}
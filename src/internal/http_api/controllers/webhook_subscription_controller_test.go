@@ -0,0 +1,206 @@
+package controllers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"PrService/src/internal/domain"
+	"PrService/src/internal/http_api/mocks"
+	"PrService/src/internal/http_api/models"
+
+	"github.com/go-playground/validator/v10"
+	"go.uber.org/mock/gomock"
+)
+
+func newWebhookSubscriptionController(
+	t *testing.T,
+) (*WebhookSubscriptionController, *mocks.MockWebhookSubscriptionService) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	svc := mocks.NewMockWebhookSubscriptionService(ctrl)
+
+	validate := validator.New()
+	logger := newTestLogger()
+
+	c := NewWebhookSubscriptionController(svc, validate, logger)
+
+	return c, svc
+}
+
+func TestWebhookSubscriptionController_Create_Success(t *testing.T) {
+	c, svc := newWebhookSubscriptionController(t)
+
+	svc.
+		EXPECT().
+		Create(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ any, s *domain.WebhookSubscription) (*domain.WebhookSubscription, error) {
+			if s.Secret != "0123456789abcdef" || len(s.EventTypes) != 2 ||
+				s.EventTypes[1] != domain.EventPullRequestMerged {
+				t.Fatalf("unexpected subscription passed to service: %+v", s)
+			}
+			s.ID = 3
+			s.CreatedAt = time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+			return s, nil
+		})
+
+	body := `{
+		"url": "https://hooks.example.com/pr",
+		"secret": "0123456789abcdef",
+		"event_types": ["PR_CREATED", "PR_MERGED"]
+	}`
+
+	req := httptest.NewRequest(http.MethodPost, "/webhooks/subscriptions", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	rr := httptest.NewRecorder()
+
+	c.create(rr, req)
+
+	if rr.Code != http.StatusCreated {
+		t.Fatalf("expected status %d, got %d, body=%s", http.StatusCreated, rr.Code, rr.Body.String())
+	}
+	if strings.Contains(rr.Body.String(), "0123456789abcdef") {
+		t.Fatalf("secret must not be echoed, body=%s", rr.Body.String())
+	}
+
+	var resp models.WebhookSubscriptionEnvelopeResponse
+	if err := json.Unmarshal(rr.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("failed to unmarshal WebhookSubscriptionEnvelopeResponse: %v", err)
+	}
+
+	if resp.Subscription.SubscriptionID != 3 || resp.Subscription.CreatedAt != "2025-01-02T03:04:05Z" {
+		t.Fatalf("unexpected subscription response: %+v", resp.Subscription)
+	}
+}
+
+func TestWebhookSubscriptionController_Create_InvalidBody(t *testing.T) {
+	c, _ := newWebhookSubscriptionController(t)
+
+	body := `{"url": "not a url", "secret": "short", "event_types": []}`
+
+	req := httptest.NewRequest(http.MethodPost, "/webhooks/subscriptions", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	rr := httptest.NewRecorder()
+
+	c.create(rr, req)
+
+	if rr.Code != http.StatusBadRequest {
+		t.Fatalf("expected status %d, got %d, body=%s", http.StatusBadRequest, rr.Code, rr.Body.String())
+	}
+}
+
+func TestWebhookSubscriptionController_Create_UnknownEventType(t *testing.T) {
+	c, svc := newWebhookSubscriptionController(t)
+
+	svc.
+		EXPECT().
+		Create(gomock.Any(), gomock.Any()).
+		Return(nil, domain.ErrInvalidWebhookSubscription)
+
+	body := `{"url": "https://hooks.example.com", "secret": "0123456789abcdef", "event_types": ["PR_OPENED"]}`
+
+	req := httptest.NewRequest(http.MethodPost, "/webhooks/subscriptions", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	rr := httptest.NewRecorder()
+
+	c.create(rr, req)
+
+	if rr.Code != http.StatusBadRequest {
+		t.Fatalf("expected status %d, got %d, body=%s", http.StatusBadRequest, rr.Code, rr.Body.String())
+	}
+}
+
+func TestWebhookSubscriptionController_Delete_NotFound(t *testing.T) {
+	c, svc := newWebhookSubscriptionController(t)
+
+	svc.
+		EXPECT().
+		Delete(gomock.Any(), domain.WebhookSubscriptionID(9)).
+		Return(domain.ErrWebhookSubscriptionNotFound)
+
+	req := httptest.NewRequest(http.MethodDelete, "/webhooks/subscriptions?subscription_id=9", nil)
+	rr := httptest.NewRecorder()
+
+	c.delete(rr, req)
+
+	if rr.Code != http.StatusNotFound {
+		t.Fatalf("expected status %d, got %d, body=%s", http.StatusNotFound, rr.Code, rr.Body.String())
+	}
+
+	var resp models.ErrorResponse
+	if err := json.Unmarshal(rr.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("failed to unmarshal ErrorResponse: %v", err)
+	}
+	if resp.Error.ErrorCode != models.ErrorCodeNotFound {
+		t.Fatalf("expected error code %s, got %s", models.ErrorCodeNotFound, resp.Error.ErrorCode)
+	}
+}
+
+func TestWebhookSubscriptionController_ListDeliveries_Success(t *testing.T) {
+	c, svc := newWebhookSubscriptionController(t)
+
+	deliveredAt := time.Date(2025, 1, 2, 3, 4, 6, 0, time.UTC)
+	svc.
+		EXPECT().
+		ListDeliveries(gomock.Any(), domain.WebhookSubscriptionID(3), 10).
+		Return([]domain.WebhookSubscriptionDelivery{
+			{
+				ID:             8,
+				EventType:      domain.EventPullRequestMerged,
+				Status:         domain.WebhookDeliverySucceeded,
+				Attempts:       2,
+				LastStatusCode: http.StatusOK,
+				DeliveredAt:    &deliveredAt,
+			},
+			{
+				ID:             7,
+				EventType:      domain.EventPullRequestCreated,
+				Status:         domain.WebhookDeliveryPending,
+				Attempts:       1,
+				LastStatusCode: http.StatusBadGateway,
+				LastError:      "unexpected status 502",
+				NextAttemptAt:  deliveredAt,
+			},
+		}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/webhooks/subscriptions/deliveries?subscription_id=3&limit=10", nil)
+	rr := httptest.NewRecorder()
+
+	c.listDeliveries(rr, req)
+
+	if rr.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d, body=%s", http.StatusOK, rr.Code, rr.Body.String())
+	}
+
+	var resp models.WebhookDeliveriesResponse
+	if err := json.Unmarshal(rr.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("failed to unmarshal WebhookDeliveriesResponse: %v", err)
+	}
+
+	if len(resp.Deliveries) != 2 {
+		t.Fatalf("expected 2 deliveries, got %+v", resp.Deliveries)
+	}
+	if d := resp.Deliveries[0]; d.DeliveredAt == nil || d.NextAttemptAt != nil || d.Status != "SUCCEEDED" {
+		t.Fatalf("unexpected succeeded delivery: %+v", d)
+	}
+	if d := resp.Deliveries[1]; d.NextAttemptAt == nil || d.LastError == "" || d.Status != "PENDING" {
+		t.Fatalf("unexpected pending delivery: %+v", d)
+	}
+}
+
+func TestWebhookSubscriptionController_ListDeliveries_InvalidLimit(t *testing.T) {
+	c, _ := newWebhookSubscriptionController(t)
+
+	req := httptest.NewRequest(http.MethodGet, "/webhooks/subscriptions/deliveries?subscription_id=3&limit=0", nil)
+	rr := httptest.NewRecorder()
+
+	c.listDeliveries(rr, req)
+
+	if rr.Code != http.StatusBadRequest {
+		t.Fatalf("expected status %d, got %d, body=%s", http.StatusBadRequest, rr.Code, rr.Body.String())
+	}
+}
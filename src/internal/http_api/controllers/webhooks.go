@@ -0,0 +1,52 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"PrService/src/internal/domain"
+	"PrService/src/internal/http_api/models"
+)
+
+// webhookMaxPayloadBytes caps webhook bodies at the 25 MB GitHub applies to its deliveries.
+const webhookMaxPayloadBytes = 25 << 20
+
+// writeEventError maps errors of applying a pull request event to responses.
+func (bc *baseController) writeEventError(
+	ctx context.Context,
+	w http.ResponseWriter,
+	err error,
+	event domain.PullRequestEvent,
+) {
+	var (
+		status  int
+		code    models.ErrorCode
+		message string
+	)
+
+	switch {
+	case errors.Is(err, domain.ErrExternalAccountNotFound):
+		status, code = http.StatusUnprocessableEntity, models.ErrorCodeUnknownAccount
+		message = "login " + event.AuthorLogin + " is not linked to a user"
+	case errors.Is(err, domain.ErrPullRequestNotFound),
+		errors.Is(err, domain.ErrUserNotFound),
+		errors.Is(err, domain.ErrTeamNotFound):
+		status, code, message = http.StatusNotFound, models.ErrorCodeNotFound, "resource not found"
+	case errors.Is(err, domain.ErrPullRequestMerged):
+		status, code, message = http.StatusConflict, models.ErrorCodePRMerged, "pull request is already merged"
+	case errors.Is(err, domain.ErrPullRequestClosed):
+		status, code, message = http.StatusConflict, models.ErrorCodePRClosed, "pull request is closed"
+	default:
+		status, code, message = http.StatusInternalServerError, models.ErrorCodeInternalServer, "internal server error"
+	}
+
+	bc.writeError(ctx, w, status, code, message,
+		"failed to apply pull request event",
+		err,
+		"provider", event.Provider,
+		"delivery_id", event.DeliveryID,
+		"pr_id", event.PullRequestID,
+		"action", event.Action,
+	)
+}
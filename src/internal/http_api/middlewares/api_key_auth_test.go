@@ -0,0 +1,87 @@
+package middlewares
+
+import (
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"PrService/src/internal/domain"
+	"PrService/src/internal/http_api/mocks"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/mock/gomock"
+)
+
+func TestAPIKeyAuth_RequireScope(t *testing.T) {
+	statsKey := &domain.APIKey{ID: 1, Scopes: []domain.APIKeyScope{domain.ScopeStatsRead}}
+	adminKey := &domain.APIKey{ID: 2, Scopes: []domain.APIKeyScope{domain.ScopeAdmin}}
+
+	tests := []struct {
+		name       string
+		header     string
+		value      string
+		key        *domain.APIKey
+		authErr    error
+		wantStatus int
+	}{
+		{
+			name:       "no key",
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:       "unknown key",
+			header:     APIKeyHeader,
+			value:      "prs_unknown",
+			authErr:    domain.ErrAPIKeyNotFound,
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:       "missing scope",
+			header:     APIKeyHeader,
+			value:      "prs_stats",
+			key:        statsKey,
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name:       "admin key as bearer token",
+			header:     "Authorization",
+			value:      "Bearer prs_admin",
+			key:        adminKey,
+			wantStatus: http.StatusNoContent,
+		},
+		{
+			name:       "foreign bearer token is not looked up",
+			header:     "Authorization",
+			value:      "Bearer scim-token",
+			wantStatus: http.StatusUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			service := mocks.NewMockAPIKeyService(ctrl)
+			if tt.key != nil || tt.authErr != nil {
+				service.EXPECT().Authenticate(gomock.Any(), gomock.Any()).Return(tt.key, tt.authErr)
+			}
+
+			r := chi.NewRouter()
+			r.Use(NewAPIKeyAuth(service, slog.New(slog.DiscardHandler)).Authenticate)
+			r.With(RequireScope(domain.ScopePullRequestWrite)).Post("/pullRequest/merge",
+				func(w http.ResponseWriter, _ *http.Request) { w.WriteHeader(http.StatusNoContent) })
+
+			req := httptest.NewRequest(http.MethodPost, "/pullRequest/merge", nil)
+			if tt.header != "" {
+				req.Header.Set(tt.header, tt.value)
+			}
+			rr := httptest.NewRecorder()
+
+			r.ServeHTTP(rr, req)
+
+			if rr.Code != tt.wantStatus {
+				t.Fatalf("expected status %d, got %d: %s", tt.wantStatus, rr.Code, rr.Body.String())
+			}
+		})
+	}
+}
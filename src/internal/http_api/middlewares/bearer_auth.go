@@ -0,0 +1,27 @@
+package middlewares
+
+import (
+	"crypto/subtle"
+	"net/http"
+	"strings"
+)
+
+// RequireBearerToken lets through requests whose Authorization header carries the token
+// and passes the rest to unauthorized.
+func RequireBearerToken(token string, unauthorized http.HandlerFunc) func(http.Handler) http.Handler {
+	expected := []byte(token)
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			scheme, credentials, _ := strings.Cut(r.Header.Get("Authorization"), " ")
+			if !strings.EqualFold(scheme, "Bearer") ||
+				subtle.ConstantTimeCompare([]byte(strings.TrimSpace(credentials)), expected) != 1 {
+				w.Header().Set("WWW-Authenticate", `Bearer realm="PrService"`)
+				unauthorized(w, r)
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
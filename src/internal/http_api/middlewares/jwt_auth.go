@@ -0,0 +1,121 @@
+package middlewares
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"slices"
+	"strings"
+
+	"PrService/src/internal/domain"
+
+	"github.com/go-chi/chi/v5/middleware"
+)
+
+// TokenVerifier checks a bearer token and returns its claims.
+type TokenVerifier interface {
+	Verify(ctx context.Context, token string) (map[string]any, error)
+}
+
+// JWTClaims names the claims the user ID and the role are read from. A dotted name reaches
+// into nested claims, like realm_access.roles of Keycloak.
+type JWTClaims struct {
+	UserID string
+	Role   string
+}
+
+type JWTAuth struct {
+	verifier TokenVerifier
+	claims   JWTClaims
+	logger   *slog.Logger
+}
+
+func NewJWTAuth(verifier TokenVerifier, claims JWTClaims, logger *slog.Logger) *JWTAuth {
+	return &JWTAuth{
+		verifier: verifier,
+		claims:   claims,
+		logger:   logger,
+	}
+}
+
+// Authenticate puts the user of a valid bearer JWT into the request context. Requests already
+// authenticated with an API key are left alone. A token that does not verify leaves the request
+// unauthenticated rather than rejecting it, as some routes check bearer tokens of their own, like SCIM;
+// RequireScope turns it away everywhere else.
+func (a *JWTAuth) Authenticate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		token := bearerToken(r)
+		if _, ok := domain.ActorFromContext(ctx); ok || token == "" ||
+			strings.HasPrefix(token, domain.APIKeySecretPrefix) {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		claims, err := a.verifier.Verify(ctx, token)
+		if err == nil {
+			var actor domain.Actor
+			if actor, err = a.actorFromClaims(claims); err == nil {
+				next.ServeHTTP(w, r.WithContext(domain.ContextWithActor(ctx, actor)))
+				return
+			}
+		}
+
+		a.logger.InfoContext(ctx, "bearer token rejected",
+			"request_id", middleware.GetReqID(ctx),
+			"http_path", r.URL.Path,
+			"err", err,
+		)
+		next.ServeHTTP(w, r)
+	})
+}
+
+// actorFromClaims takes the most privileged known role of the role claim, which may be a string or a list;
+// users without one are members.
+func (a *JWTAuth) actorFromClaims(claims map[string]any) (domain.Actor, error) {
+	userID, _ := claimValue(claims, a.claims.UserID).(string)
+	if userID == "" {
+		return domain.Actor{}, fmt.Errorf("claim %s is missing", a.claims.UserID)
+	}
+
+	var roles []string
+	switch value := claimValue(claims, a.claims.Role).(type) {
+	case string:
+		roles = []string{value}
+	case []any:
+		for _, v := range value {
+			if role, ok := v.(string); ok {
+				roles = append(roles, role)
+			}
+		}
+	case nil:
+	default:
+		return domain.Actor{}, errors.New("role claim is neither a string nor a list")
+	}
+
+	role := domain.RoleMember
+	for _, candidate := range []domain.Role{domain.RoleAdmin, domain.RoleTeamLead} {
+		if slices.Contains(roles, string(candidate)) {
+			role = candidate
+			break
+		}
+	}
+
+	return domain.NewUserActor(domain.UserID(userID), role), nil
+}
+
+func claimValue(claims map[string]any, name string) any {
+	var value any = claims
+	for _, part := range strings.Split(name, ".") {
+		nested, ok := value.(map[string]any)
+		if !ok {
+			return nil
+		}
+		value = nested[part]
+	}
+
+	return value
+}
@@ -0,0 +1,121 @@
+package middlewares
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"PrService/src/internal/domain"
+
+	"github.com/go-chi/chi/v5"
+)
+
+type tokenVerifierFunc func(ctx context.Context, token string) (map[string]any, error)
+
+func (f tokenVerifierFunc) Verify(ctx context.Context, token string) (map[string]any, error) {
+	return f(ctx, token)
+}
+
+func TestJWTAuth_Authenticate(t *testing.T) {
+	tokens := map[string]map[string]any{
+		"admin": {"sub": "u1", "realm_access": map[string]any{"roles": []any{"offline_access", "admin"}}},
+		"lead":  {"sub": "u2", "realm_access": map[string]any{"roles": []any{"team-lead"}}},
+		"plain": {"sub": "u3"},
+		"nosub": {"realm_access": map[string]any{"roles": []any{"admin"}}},
+	}
+	verifier := tokenVerifierFunc(func(_ context.Context, token string) (map[string]any, error) {
+		if claims, ok := tokens[token]; ok {
+			return claims, nil
+		}
+		return nil, errors.New("invalid token")
+	})
+
+	tests := []struct {
+		name       string
+		token      string
+		wantStatus int
+		wantActor  domain.Actor
+	}{
+		{
+			name:       "role from a nested list",
+			token:      "admin",
+			wantStatus: http.StatusOK,
+			wantActor:  domain.NewUserActor("u1", domain.RoleAdmin),
+		},
+		{
+			name:       "team lead may change users",
+			token:      "lead",
+			wantStatus: http.StatusOK,
+			wantActor:  domain.NewUserActor("u2", domain.RoleTeamLead),
+		},
+		{
+			name:       "user without a role is a member",
+			token:      "plain",
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name:       "token without a user ID",
+			token:      "nosub",
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:       "invalid token",
+			token:      "forged",
+			wantStatus: http.StatusUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			claims := JWTClaims{UserID: "sub", Role: "realm_access.roles"}
+			auth := NewJWTAuth(verifier, claims, slog.New(slog.DiscardHandler))
+
+			var actor domain.Actor
+			r := chi.NewRouter()
+			r.Use(auth.Authenticate)
+			r.With(RequireScope(domain.ScopeUsersWrite)).Post("/users/setIsActive",
+				func(w http.ResponseWriter, r *http.Request) {
+					actor, _ = domain.ActorFromContext(r.Context())
+					w.WriteHeader(http.StatusOK)
+				})
+
+			req := httptest.NewRequest(http.MethodPost, "/users/setIsActive", nil)
+			req.Header.Set("Authorization", "Bearer "+tt.token)
+			rr := httptest.NewRecorder()
+
+			r.ServeHTTP(rr, req)
+
+			if rr.Code != tt.wantStatus {
+				t.Fatalf("expected status %d, got %d: %s", tt.wantStatus, rr.Code, rr.Body.String())
+			}
+			if tt.wantStatus != http.StatusOK {
+				return
+			}
+			if actor.UserID != tt.wantActor.UserID || actor.Role != tt.wantActor.Role {
+				t.Fatalf("expected actor %+v, got %+v", tt.wantActor, actor)
+			}
+		})
+	}
+}
+
+func TestJWTAuth_Authenticate_LeavesAPIKeysAlone(t *testing.T) {
+	verifier := tokenVerifierFunc(func(context.Context, string) (map[string]any, error) {
+		t.Fatal("API keys must not be verified as JWTs")
+		return nil, nil
+	})
+	auth := NewJWTAuth(verifier, JWTClaims{UserID: "sub", Role: "role"}, slog.New(slog.DiscardHandler))
+
+	called := false
+	handler := auth.Authenticate(http.HandlerFunc(func(http.ResponseWriter, *http.Request) { called = true }))
+
+	req := httptest.NewRequest(http.MethodGet, "/team/get", nil)
+	req.Header.Set("Authorization", "Bearer "+domain.APIKeySecretPrefix+"secret")
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+
+	if !called {
+		t.Fatal("expected the request to be passed on")
+	}
+}
@@ -6,6 +6,8 @@ import (
 	"strconv"
 	"time"
 
+	"PrService/src/internal/domain"
+
 	"github.com/go-chi/chi/v5/middleware"
 )
 
@@ -42,12 +44,16 @@ func (l *RequestLogger) LogRequest(next http.Handler) http.Handler {
 			durationStr = strconv.FormatInt(duration.Milliseconds(), 10) + "ms"
 		}
 
-		l.logger.Info(
-			"request finished",
+		fields := []any{
 			"duration", durationStr,
 			"http_status", ww.status,
 			"request_id", requestID,
-		)
+		}
+		// The actor is known when authentication ran before the logger.
+		if actor, ok := domain.ActorFromContext(r.Context()); ok {
+			fields = append(fields, "actor", actor.String())
+		}
+		l.logger.Info("request finished", fields...)
 	})
 
 	return fn
@@ -69,3 +75,8 @@ func (rw *responseWriter) WriteHeader(statusCode int) {
 	rw.status = statusCode
 	rw.ResponseWriter.WriteHeader(statusCode)
 }
+
+// Unwrap lets http.ResponseController reach the underlying writer, e.g. to flush event streams.
+func (rw *responseWriter) Unwrap() http.ResponseWriter {
+	return rw.ResponseWriter
+}
@@ -41,19 +41,64 @@ func (m *MockPullRequestService) EXPECT() *MockPullRequestServiceMockRecorder {
 	return m.recorder
 }
 
+// AddReviewer mocks base method.
+func (m *MockPullRequestService) AddReviewer(ctx context.Context, id domain.PullRequestID, reviewerID domain.UserID) (*domain.PullRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddReviewer", ctx, id, reviewerID)
+	ret0, _ := ret[0].(*domain.PullRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddReviewer indicates an expected call of AddReviewer.
+func (mr *MockPullRequestServiceMockRecorder) AddReviewer(ctx, id, reviewerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddReviewer", reflect.TypeOf((*MockPullRequestService)(nil).AddReviewer), ctx, id, reviewerID)
+}
+
+// Close mocks base method.
+func (m *MockPullRequestService) Close(ctx context.Context, id domain.PullRequestID) (*domain.PullRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Close", ctx, id)
+	ret0, _ := ret[0].(*domain.PullRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockPullRequestServiceMockRecorder) Close(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockPullRequestService)(nil).Close), ctx, id)
+}
+
 // Create mocks base method.
-func (m *MockPullRequestService) Create(ctx context.Context, id domain.PullRequestID, pullRequestName string, userID domain.UserID) (*domain.PullRequest, error) {
+func (m *MockPullRequestService) Create(ctx context.Context, id domain.PullRequestID, pullRequestName string, userID domain.UserID, opts domain.CreatePullRequestOptions) (*domain.PullRequest, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create", ctx, id, pullRequestName, userID)
+	ret := m.ctrl.Call(m, "Create", ctx, id, pullRequestName, userID, opts)
 	ret0, _ := ret[0].(*domain.PullRequest)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Create indicates an expected call of Create.
-func (mr *MockPullRequestServiceMockRecorder) Create(ctx, id, pullRequestName, userID any) *gomock.Call {
+func (mr *MockPullRequestServiceMockRecorder) Create(ctx, id, pullRequestName, userID, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockPullRequestService)(nil).Create), ctx, id, pullRequestName, userID, opts)
+}
+
+// MarkReady mocks base method.
+func (m *MockPullRequestService) MarkReady(ctx context.Context, id domain.PullRequestID) (*domain.PullRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkReady", ctx, id)
+	ret0, _ := ret[0].(*domain.PullRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkReady indicates an expected call of MarkReady.
+func (mr *MockPullRequestServiceMockRecorder) MarkReady(ctx, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockPullRequestService)(nil).Create), ctx, id, pullRequestName, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkReady", reflect.TypeOf((*MockPullRequestService)(nil).MarkReady), ctx, id)
 }
 
 // Merge mocks base method.
@@ -72,9 +117,9 @@ func (mr *MockPullRequestServiceMockRecorder) Merge(ctx, id any) *gomock.Call {
 }
 
 // Reassign mocks base method.
-func (m *MockPullRequestService) Reassign(ctx context.Context, id domain.PullRequestID, oldRevID domain.UserID) (*domain.PullRequest, domain.UserID, error) {
+func (m *MockPullRequestService) Reassign(ctx context.Context, id domain.PullRequestID, oldRevID, newRevID domain.UserID) (*domain.PullRequest, domain.UserID, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Reassign", ctx, id, oldRevID)
+	ret := m.ctrl.Call(m, "Reassign", ctx, id, oldRevID, newRevID)
 	ret0, _ := ret[0].(*domain.PullRequest)
 	ret1, _ := ret[1].(domain.UserID)
 	ret2, _ := ret[2].(error)
@@ -82,9 +127,54 @@ func (m *MockPullRequestService) Reassign(ctx context.Context, id domain.PullReq
 }
 
 // Reassign indicates an expected call of Reassign.
-func (mr *MockPullRequestServiceMockRecorder) Reassign(ctx, id, oldRevID any) *gomock.Call {
+func (mr *MockPullRequestServiceMockRecorder) Reassign(ctx, id, oldRevID, newRevID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reassign", reflect.TypeOf((*MockPullRequestService)(nil).Reassign), ctx, id, oldRevID, newRevID)
+}
+
+// RemoveReviewer mocks base method.
+func (m *MockPullRequestService) RemoveReviewer(ctx context.Context, id domain.PullRequestID, reviewerID domain.UserID) (*domain.PullRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveReviewer", ctx, id, reviewerID)
+	ret0, _ := ret[0].(*domain.PullRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveReviewer indicates an expected call of RemoveReviewer.
+func (mr *MockPullRequestServiceMockRecorder) RemoveReviewer(ctx, id, reviewerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveReviewer", reflect.TypeOf((*MockPullRequestService)(nil).RemoveReviewer), ctx, id, reviewerID)
+}
+
+// Reopen mocks base method.
+func (m *MockPullRequestService) Reopen(ctx context.Context, id domain.PullRequestID) (*domain.PullRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reopen", ctx, id)
+	ret0, _ := ret[0].(*domain.PullRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Reopen indicates an expected call of Reopen.
+func (mr *MockPullRequestServiceMockRecorder) Reopen(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reopen", reflect.TypeOf((*MockPullRequestService)(nil).Reopen), ctx, id)
+}
+
+// UpdateDetails mocks base method.
+func (m *MockPullRequestService) UpdateDetails(ctx context.Context, id domain.PullRequestID, name string, draft bool) (*domain.PullRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateDetails", ctx, id, name, draft)
+	ret0, _ := ret[0].(*domain.PullRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateDetails indicates an expected call of UpdateDetails.
+func (mr *MockPullRequestServiceMockRecorder) UpdateDetails(ctx, id, name, draft any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reassign", reflect.TypeOf((*MockPullRequestService)(nil).Reassign), ctx, id, oldRevID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDetails", reflect.TypeOf((*MockPullRequestService)(nil).UpdateDetails), ctx, id, name, draft)
 }
 
 // MockTeamService is a mock of TeamService interface.
@@ -156,6 +246,173 @@ func (mr *MockTeamServiceMockRecorder) GetStats(ctx, name any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStats", reflect.TypeOf((*MockTeamService)(nil).GetStats), ctx, name)
 }
 
+// GetStatsTimeSeries mocks base method.
+func (m *MockTeamService) GetStatsTimeSeries(ctx context.Context, name domain.TeamName, interval domain.StatsInterval, window domain.StatsWindow) (*domain.TeamStatsTimeSeries, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStatsTimeSeries", ctx, name, interval, window)
+	ret0, _ := ret[0].(*domain.TeamStatsTimeSeries)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStatsTimeSeries indicates an expected call of GetStatsTimeSeries.
+func (mr *MockTeamServiceMockRecorder) GetStatsTimeSeries(ctx, name, interval, window any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStatsTimeSeries", reflect.TypeOf((*MockTeamService)(nil).GetStatsTimeSeries), ctx, name, interval, window)
+}
+
+// Import mocks base method.
+func (m *MockTeamService) Import(ctx context.Context, doc domain.TeamImport, dryRun bool) (*domain.TeamImportDiff, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Import", ctx, doc, dryRun)
+	ret0, _ := ret[0].(*domain.TeamImportDiff)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Import indicates an expected call of Import.
+func (mr *MockTeamServiceMockRecorder) Import(ctx, doc, dryRun any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Import", reflect.TypeOf((*MockTeamService)(nil).Import), ctx, doc, dryRun)
+}
+
+// SetMaxReviewers mocks base method.
+func (m *MockTeamService) SetMaxReviewers(ctx context.Context, name domain.TeamName, maxReviewers int) (*domain.Team, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetMaxReviewers", ctx, name, maxReviewers)
+	ret0, _ := ret[0].(*domain.Team)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetMaxReviewers indicates an expected call of SetMaxReviewers.
+func (mr *MockTeamServiceMockRecorder) SetMaxReviewers(ctx, name, maxReviewers any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetMaxReviewers", reflect.TypeOf((*MockTeamService)(nil).SetMaxReviewers), ctx, name, maxReviewers)
+}
+
+// MockTeamRuleService is a mock of TeamRuleService interface.
+type MockTeamRuleService struct {
+	ctrl     *gomock.Controller
+	recorder *MockTeamRuleServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockTeamRuleServiceMockRecorder is the mock recorder for MockTeamRuleService.
+type MockTeamRuleServiceMockRecorder struct {
+	mock *MockTeamRuleService
+}
+
+// NewMockTeamRuleService creates a new mock instance.
+func NewMockTeamRuleService(ctrl *gomock.Controller) *MockTeamRuleService {
+	mock := &MockTeamRuleService{ctrl: ctrl}
+	mock.recorder = &MockTeamRuleServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTeamRuleService) EXPECT() *MockTeamRuleServiceMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockTeamRuleService) Create(ctx context.Context, rule *domain.TeamRule) (*domain.TeamRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, rule)
+	ret0, _ := ret[0].(*domain.TeamRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockTeamRuleServiceMockRecorder) Create(ctx, rule any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockTeamRuleService)(nil).Create), ctx, rule)
+}
+
+// Delete mocks base method.
+func (m *MockTeamRuleService) Delete(ctx context.Context, name domain.TeamName, id domain.TeamRuleID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, name, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockTeamRuleServiceMockRecorder) Delete(ctx, name, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockTeamRuleService)(nil).Delete), ctx, name, id)
+}
+
+// List mocks base method.
+func (m *MockTeamRuleService) List(ctx context.Context, name domain.TeamName) ([]domain.TeamRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, name)
+	ret0, _ := ret[0].([]domain.TeamRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockTeamRuleServiceMockRecorder) List(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockTeamRuleService)(nil).List), ctx, name)
+}
+
+// MockCodeOwnersService is a mock of CodeOwnersService interface.
+type MockCodeOwnersService struct {
+	ctrl     *gomock.Controller
+	recorder *MockCodeOwnersServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockCodeOwnersServiceMockRecorder is the mock recorder for MockCodeOwnersService.
+type MockCodeOwnersServiceMockRecorder struct {
+	mock *MockCodeOwnersService
+}
+
+// NewMockCodeOwnersService creates a new mock instance.
+func NewMockCodeOwnersService(ctrl *gomock.Controller) *MockCodeOwnersService {
+	mock := &MockCodeOwnersService{ctrl: ctrl}
+	mock.recorder = &MockCodeOwnersServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCodeOwnersService) EXPECT() *MockCodeOwnersServiceMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockCodeOwnersService) Get(ctx context.Context, name domain.TeamName) (*domain.TeamCodeOwners, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, name)
+	ret0, _ := ret[0].(*domain.TeamCodeOwners)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockCodeOwnersServiceMockRecorder) Get(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockCodeOwnersService)(nil).Get), ctx, name)
+}
+
+// Upload mocks base method.
+func (m *MockCodeOwnersService) Upload(ctx context.Context, codeOwners *domain.TeamCodeOwners) (*domain.TeamCodeOwners, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upload", ctx, codeOwners)
+	ret0, _ := ret[0].(*domain.TeamCodeOwners)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Upload indicates an expected call of Upload.
+func (mr *MockCodeOwnersServiceMockRecorder) Upload(ctx, codeOwners any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upload", reflect.TypeOf((*MockCodeOwnersService)(nil).Upload), ctx, codeOwners)
+}
+
 // MockUserService is a mock of UserService interface.
 type MockUserService struct {
 	ctrl     *gomock.Controller
@@ -195,17 +452,770 @@ func (mr *MockUserServiceMockRecorder) GetPrs(ctx, userID any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPrs", reflect.TypeOf((*MockUserService)(nil).GetPrs), ctx, userID)
 }
 
-// SetIsActive mocks base method.
-func (m *MockUserService) SetIsActive(ctx context.Context, userID domain.UserID, isActive bool) (*domain.User, error) {
+// HandOverReviews mocks base method.
+func (m *MockUserService) HandOverReviews(ctx context.Context, userID domain.UserID) (*domain.ReviewHandover, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HandOverReviews", ctx, userID)
+	ret0, _ := ret[0].(*domain.ReviewHandover)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HandOverReviews indicates an expected call of HandOverReviews.
+func (mr *MockUserServiceMockRecorder) HandOverReviews(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandOverReviews", reflect.TypeOf((*MockUserService)(nil).HandOverReviews), ctx, userID)
+}
+
+// LinkExternalAccount mocks base method.
+func (m *MockUserService) LinkExternalAccount(ctx context.Context, account domain.ExternalAccount) (*domain.ExternalAccount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LinkExternalAccount", ctx, account)
+	ret0, _ := ret[0].(*domain.ExternalAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LinkExternalAccount indicates an expected call of LinkExternalAccount.
+func (mr *MockUserServiceMockRecorder) LinkExternalAccount(ctx, account any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LinkExternalAccount", reflect.TypeOf((*MockUserService)(nil).LinkExternalAccount), ctx, account)
+}
+
+// List mocks base method.
+func (m *MockUserService) List(ctx context.Context, filter domain.UserFilter) ([]domain.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, filter)
+	ret0, _ := ret[0].([]domain.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockUserServiceMockRecorder) List(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockUserService)(nil).List), ctx, filter)
+}
+
+// SetChatHandle mocks base method.
+func (m *MockUserService) SetChatHandle(ctx context.Context, userID domain.UserID, chatHandle string) (*domain.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetChatHandle", ctx, userID, chatHandle)
+	ret0, _ := ret[0].(*domain.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetChatHandle indicates an expected call of SetChatHandle.
+func (mr *MockUserServiceMockRecorder) SetChatHandle(ctx, userID, chatHandle any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetChatHandle", reflect.TypeOf((*MockUserService)(nil).SetChatHandle), ctx, userID, chatHandle)
+}
+
+// SetEmail mocks base method.
+func (m *MockUserService) SetEmail(ctx context.Context, userID domain.UserID, email string, optOut bool) (*domain.User, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SetIsActive", ctx, userID, isActive)
+	ret := m.ctrl.Call(m, "SetEmail", ctx, userID, email, optOut)
 	ret0, _ := ret[0].(*domain.User)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
+// SetEmail indicates an expected call of SetEmail.
+func (mr *MockUserServiceMockRecorder) SetEmail(ctx, userID, email, optOut any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetEmail", reflect.TypeOf((*MockUserService)(nil).SetEmail), ctx, userID, email, optOut)
+}
+
+// SetIsActive mocks base method.
+func (m *MockUserService) SetIsActive(ctx context.Context, userID domain.UserID, isActive, reassignReviews bool) (*domain.User, *domain.ReviewHandover, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetIsActive", ctx, userID, isActive, reassignReviews)
+	ret0, _ := ret[0].(*domain.User)
+	ret1, _ := ret[1].(*domain.ReviewHandover)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
 // SetIsActive indicates an expected call of SetIsActive.
-func (mr *MockUserServiceMockRecorder) SetIsActive(ctx, userID, isActive any) *gomock.Call {
+func (mr *MockUserServiceMockRecorder) SetIsActive(ctx, userID, isActive, reassignReviews any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetIsActive", reflect.TypeOf((*MockUserService)(nil).SetIsActive), ctx, userID, isActive, reassignReviews)
+}
+
+// MockReviewSLAService is a mock of ReviewSLAService interface.
+type MockReviewSLAService struct {
+	ctrl     *gomock.Controller
+	recorder *MockReviewSLAServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockReviewSLAServiceMockRecorder is the mock recorder for MockReviewSLAService.
+type MockReviewSLAServiceMockRecorder struct {
+	mock *MockReviewSLAService
+}
+
+// NewMockReviewSLAService creates a new mock instance.
+func NewMockReviewSLAService(ctrl *gomock.Controller) *MockReviewSLAService {
+	mock := &MockReviewSLAService{ctrl: ctrl}
+	mock.recorder = &MockReviewSLAServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReviewSLAService) EXPECT() *MockReviewSLAServiceMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockReviewSLAService) Delete(ctx context.Context, name domain.TeamName) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockReviewSLAServiceMockRecorder) Delete(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockReviewSLAService)(nil).Delete), ctx, name)
+}
+
+// Get mocks base method.
+func (m *MockReviewSLAService) Get(ctx context.Context, name domain.TeamName) (*domain.ReviewSLA, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, name)
+	ret0, _ := ret[0].(*domain.ReviewSLA)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockReviewSLAServiceMockRecorder) Get(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockReviewSLAService)(nil).Get), ctx, name)
+}
+
+// History mocks base method.
+func (m *MockReviewSLAService) History(ctx context.Context, id domain.PullRequestID) ([]domain.PullRequestHistoryEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "History", ctx, id)
+	ret0, _ := ret[0].([]domain.PullRequestHistoryEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// History indicates an expected call of History.
+func (mr *MockReviewSLAServiceMockRecorder) History(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "History", reflect.TypeOf((*MockReviewSLAService)(nil).History), ctx, id)
+}
+
+// MarkReviewed mocks base method.
+func (m *MockReviewSLAService) MarkReviewed(ctx context.Context, id domain.PullRequestID, reviewerID domain.UserID) (*domain.PullRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkReviewed", ctx, id, reviewerID)
+	ret0, _ := ret[0].(*domain.PullRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkReviewed indicates an expected call of MarkReviewed.
+func (mr *MockReviewSLAServiceMockRecorder) MarkReviewed(ctx, id, reviewerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkReviewed", reflect.TypeOf((*MockReviewSLAService)(nil).MarkReviewed), ctx, id, reviewerID)
+}
+
+// Set mocks base method.
+func (m *MockReviewSLAService) Set(ctx context.Context, sla domain.ReviewSLA) (*domain.ReviewSLA, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Set", ctx, sla)
+	ret0, _ := ret[0].(*domain.ReviewSLA)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Set indicates an expected call of Set.
+func (mr *MockReviewSLAServiceMockRecorder) Set(ctx, sla any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Set", reflect.TypeOf((*MockReviewSLAService)(nil).Set), ctx, sla)
+}
+
+// MockPullRequestEventService is a mock of PullRequestEventService interface.
+type MockPullRequestEventService struct {
+	ctrl     *gomock.Controller
+	recorder *MockPullRequestEventServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockPullRequestEventServiceMockRecorder is the mock recorder for MockPullRequestEventService.
+type MockPullRequestEventServiceMockRecorder struct {
+	mock *MockPullRequestEventService
+}
+
+// NewMockPullRequestEventService creates a new mock instance.
+func NewMockPullRequestEventService(ctrl *gomock.Controller) *MockPullRequestEventService {
+	mock := &MockPullRequestEventService{ctrl: ctrl}
+	mock.recorder = &MockPullRequestEventServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPullRequestEventService) EXPECT() *MockPullRequestEventServiceMockRecorder {
+	return m.recorder
+}
+
+// Handle mocks base method.
+func (m *MockPullRequestEventService) Handle(ctx context.Context, event domain.PullRequestEvent) (*domain.PullRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Handle", ctx, event)
+	ret0, _ := ret[0].(*domain.PullRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Handle indicates an expected call of Handle.
+func (mr *MockPullRequestEventServiceMockRecorder) Handle(ctx, event any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Handle", reflect.TypeOf((*MockPullRequestEventService)(nil).Handle), ctx, event)
+}
+
+// MockWebhookSubscriptionService is a mock of WebhookSubscriptionService interface.
+type MockWebhookSubscriptionService struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebhookSubscriptionServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockWebhookSubscriptionServiceMockRecorder is the mock recorder for MockWebhookSubscriptionService.
+type MockWebhookSubscriptionServiceMockRecorder struct {
+	mock *MockWebhookSubscriptionService
+}
+
+// NewMockWebhookSubscriptionService creates a new mock instance.
+func NewMockWebhookSubscriptionService(ctrl *gomock.Controller) *MockWebhookSubscriptionService {
+	mock := &MockWebhookSubscriptionService{ctrl: ctrl}
+	mock.recorder = &MockWebhookSubscriptionServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWebhookSubscriptionService) EXPECT() *MockWebhookSubscriptionServiceMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockWebhookSubscriptionService) Create(ctx context.Context, subscription *domain.WebhookSubscription) (*domain.WebhookSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, subscription)
+	ret0, _ := ret[0].(*domain.WebhookSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockWebhookSubscriptionServiceMockRecorder) Create(ctx, subscription any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockWebhookSubscriptionService)(nil).Create), ctx, subscription)
+}
+
+// Delete mocks base method.
+func (m *MockWebhookSubscriptionService) Delete(ctx context.Context, id domain.WebhookSubscriptionID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockWebhookSubscriptionServiceMockRecorder) Delete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockWebhookSubscriptionService)(nil).Delete), ctx, id)
+}
+
+// List mocks base method.
+func (m *MockWebhookSubscriptionService) List(ctx context.Context) ([]domain.WebhookSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx)
+	ret0, _ := ret[0].([]domain.WebhookSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockWebhookSubscriptionServiceMockRecorder) List(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockWebhookSubscriptionService)(nil).List), ctx)
+}
+
+// ListDeliveries mocks base method.
+func (m *MockWebhookSubscriptionService) ListDeliveries(ctx context.Context, id domain.WebhookSubscriptionID, limit int) ([]domain.WebhookSubscriptionDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDeliveries", ctx, id, limit)
+	ret0, _ := ret[0].([]domain.WebhookSubscriptionDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDeliveries indicates an expected call of ListDeliveries.
+func (mr *MockWebhookSubscriptionServiceMockRecorder) ListDeliveries(ctx, id, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeliveries", reflect.TypeOf((*MockWebhookSubscriptionService)(nil).ListDeliveries), ctx, id, limit)
+}
+
+// MockEventStreamService is a mock of EventStreamService interface.
+type MockEventStreamService struct {
+	ctrl     *gomock.Controller
+	recorder *MockEventStreamServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockEventStreamServiceMockRecorder is the mock recorder for MockEventStreamService.
+type MockEventStreamServiceMockRecorder struct {
+	mock *MockEventStreamService
+}
+
+// NewMockEventStreamService creates a new mock instance.
+func NewMockEventStreamService(ctrl *gomock.Controller) *MockEventStreamService {
+	mock := &MockEventStreamService{ctrl: ctrl}
+	mock.recorder = &MockEventStreamServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEventStreamService) EXPECT() *MockEventStreamServiceMockRecorder {
+	return m.recorder
+}
+
+// LatestCursor mocks base method.
+func (m *MockEventStreamService) LatestCursor(ctx context.Context) (domain.EventStreamCursor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LatestCursor", ctx)
+	ret0, _ := ret[0].(domain.EventStreamCursor)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LatestCursor indicates an expected call of LatestCursor.
+func (mr *MockEventStreamServiceMockRecorder) LatestCursor(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LatestCursor", reflect.TypeOf((*MockEventStreamService)(nil).LatestCursor), ctx)
+}
+
+// ListAfter mocks base method.
+func (m *MockEventStreamService) ListAfter(ctx context.Context, filter domain.EventStreamFilter, after domain.EventStreamCursor) (*domain.EventStreamPage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAfter", ctx, filter, after)
+	ret0, _ := ret[0].(*domain.EventStreamPage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAfter indicates an expected call of ListAfter.
+func (mr *MockEventStreamServiceMockRecorder) ListAfter(ctx, filter, after any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAfter", reflect.TypeOf((*MockEventStreamService)(nil).ListAfter), ctx, filter, after)
+}
+
+// MockStatsService is a mock of StatsService interface.
+type MockStatsService struct {
+	ctrl     *gomock.Controller
+	recorder *MockStatsServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockStatsServiceMockRecorder is the mock recorder for MockStatsService.
+type MockStatsServiceMockRecorder struct {
+	mock *MockStatsService
+}
+
+// NewMockStatsService creates a new mock instance.
+func NewMockStatsService(ctrl *gomock.Controller) *MockStatsService {
+	mock := &MockStatsService{ctrl: ctrl}
+	mock.recorder = &MockStatsServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStatsService) EXPECT() *MockStatsServiceMockRecorder {
+	return m.recorder
+}
+
+// Fairness mocks base method.
+func (m *MockStatsService) Fairness(ctx context.Context, name domain.TeamName, window domain.StatsWindow) (*domain.FairnessReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Fairness", ctx, name, window)
+	ret0, _ := ret[0].(*domain.FairnessReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Fairness indicates an expected call of Fairness.
+func (mr *MockStatsServiceMockRecorder) Fairness(ctx, name, window any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Fairness", reflect.TypeOf((*MockStatsService)(nil).Fairness), ctx, name, window)
+}
+
+// Overview mocks base method.
+func (m *MockStatsService) Overview(ctx context.Context) (*domain.StatsOverview, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Overview", ctx)
+	ret0, _ := ret[0].(*domain.StatsOverview)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Overview indicates an expected call of Overview.
+func (mr *MockStatsServiceMockRecorder) Overview(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Overview", reflect.TypeOf((*MockStatsService)(nil).Overview), ctx)
+}
+
+// UserReviewStats mocks base method.
+func (m *MockStatsService) UserReviewStats(ctx context.Context, filter domain.UserStatsFilter) ([]domain.UserReviewStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UserReviewStats", ctx, filter)
+	ret0, _ := ret[0].([]domain.UserReviewStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UserReviewStats indicates an expected call of UserReviewStats.
+func (mr *MockStatsServiceMockRecorder) UserReviewStats(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserReviewStats", reflect.TypeOf((*MockStatsService)(nil).UserReviewStats), ctx, filter)
+}
+
+// MockExportService is a mock of ExportService interface.
+type MockExportService struct {
+	ctrl     *gomock.Controller
+	recorder *MockExportServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockExportServiceMockRecorder is the mock recorder for MockExportService.
+type MockExportServiceMockRecorder struct {
+	mock *MockExportService
+}
+
+// NewMockExportService creates a new mock instance.
+func NewMockExportService(ctrl *gomock.Controller) *MockExportService {
+	mock := &MockExportService{ctrl: ctrl}
+	mock.recorder = &MockExportServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockExportService) EXPECT() *MockExportServiceMockRecorder {
+	return m.recorder
+}
+
+// ExportAssignments mocks base method.
+func (m *MockExportService) ExportAssignments(ctx context.Context, filter domain.AssignmentExportFilter, fn func(domain.AssignmentExportRow) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportAssignments", ctx, filter, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExportAssignments indicates an expected call of ExportAssignments.
+func (mr *MockExportServiceMockRecorder) ExportAssignments(ctx, filter, fn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportAssignments", reflect.TypeOf((*MockExportService)(nil).ExportAssignments), ctx, filter, fn)
+}
+
+// ExportPullRequests mocks base method.
+func (m *MockExportService) ExportPullRequests(ctx context.Context, filter domain.PullRequestExportFilter, fn func(domain.PullRequestExportRow) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportPullRequests", ctx, filter, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExportPullRequests indicates an expected call of ExportPullRequests.
+func (mr *MockExportServiceMockRecorder) ExportPullRequests(ctx, filter, fn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportPullRequests", reflect.TypeOf((*MockExportService)(nil).ExportPullRequests), ctx, filter, fn)
+}
+
+// MockTeamSyncService is a mock of TeamSyncService interface.
+type MockTeamSyncService struct {
+	ctrl     *gomock.Controller
+	recorder *MockTeamSyncServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockTeamSyncServiceMockRecorder is the mock recorder for MockTeamSyncService.
+type MockTeamSyncServiceMockRecorder struct {
+	mock *MockTeamSyncService
+}
+
+// NewMockTeamSyncService creates a new mock instance.
+func NewMockTeamSyncService(ctrl *gomock.Controller) *MockTeamSyncService {
+	mock := &MockTeamSyncService{ctrl: ctrl}
+	mock.recorder = &MockTeamSyncServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTeamSyncService) EXPECT() *MockTeamSyncServiceMockRecorder {
+	return m.recorder
+}
+
+// Sync mocks base method.
+func (m *MockTeamSyncService) Sync(ctx context.Context, team domain.Team) (*domain.TeamSyncResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Sync", ctx, team)
+	ret0, _ := ret[0].(*domain.TeamSyncResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Sync indicates an expected call of Sync.
+func (mr *MockTeamSyncServiceMockRecorder) Sync(ctx, team any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Sync", reflect.TypeOf((*MockTeamSyncService)(nil).Sync), ctx, team)
+}
+
+// MockProvisioningService is a mock of ProvisioningService interface.
+type MockProvisioningService struct {
+	ctrl     *gomock.Controller
+	recorder *MockProvisioningServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockProvisioningServiceMockRecorder is the mock recorder for MockProvisioningService.
+type MockProvisioningServiceMockRecorder struct {
+	mock *MockProvisioningService
+}
+
+// NewMockProvisioningService creates a new mock instance.
+func NewMockProvisioningService(ctrl *gomock.Controller) *MockProvisioningService {
+	mock := &MockProvisioningService{ctrl: ctrl}
+	mock.recorder = &MockProvisioningServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockProvisioningService) EXPECT() *MockProvisioningServiceMockRecorder {
+	return m.recorder
+}
+
+// CreateGroup mocks base method.
+func (m *MockProvisioningService) CreateGroup(ctx context.Context, name domain.TeamName, memberIDs []domain.UserID) (*domain.Team, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateGroup", ctx, name, memberIDs)
+	ret0, _ := ret[0].(*domain.Team)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateGroup indicates an expected call of CreateGroup.
+func (mr *MockProvisioningServiceMockRecorder) CreateGroup(ctx, name, memberIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateGroup", reflect.TypeOf((*MockProvisioningService)(nil).CreateGroup), ctx, name, memberIDs)
+}
+
+// CreateUser mocks base method.
+func (m *MockProvisioningService) CreateUser(ctx context.Context, user domain.User) (*domain.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateUser", ctx, user)
+	ret0, _ := ret[0].(*domain.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateUser indicates an expected call of CreateUser.
+func (mr *MockProvisioningServiceMockRecorder) CreateUser(ctx, user any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*MockProvisioningService)(nil).CreateUser), ctx, user)
+}
+
+// DeactivateUser mocks base method.
+func (m *MockProvisioningService) DeactivateUser(ctx context.Context, id domain.UserID) (*domain.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeactivateUser", ctx, id)
+	ret0, _ := ret[0].(*domain.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeactivateUser indicates an expected call of DeactivateUser.
+func (mr *MockProvisioningServiceMockRecorder) DeactivateUser(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeactivateUser", reflect.TypeOf((*MockProvisioningService)(nil).DeactivateUser), ctx, id)
+}
+
+// GetGroup mocks base method.
+func (m *MockProvisioningService) GetGroup(ctx context.Context, name domain.TeamName) (*domain.Team, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGroup", ctx, name)
+	ret0, _ := ret[0].(*domain.Team)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGroup indicates an expected call of GetGroup.
+func (mr *MockProvisioningServiceMockRecorder) GetGroup(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroup", reflect.TypeOf((*MockProvisioningService)(nil).GetGroup), ctx, name)
+}
+
+// GetUser mocks base method.
+func (m *MockProvisioningService) GetUser(ctx context.Context, id domain.UserID) (*domain.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUser", ctx, id)
+	ret0, _ := ret[0].(*domain.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUser indicates an expected call of GetUser.
+func (mr *MockProvisioningServiceMockRecorder) GetUser(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUser", reflect.TypeOf((*MockProvisioningService)(nil).GetUser), ctx, id)
+}
+
+// ListGroups mocks base method.
+func (m *MockProvisioningService) ListGroups(ctx context.Context, filter domain.ProvisioningGroupFilter) ([]domain.Team, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListGroups", ctx, filter)
+	ret0, _ := ret[0].([]domain.Team)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListGroups indicates an expected call of ListGroups.
+func (mr *MockProvisioningServiceMockRecorder) ListGroups(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListGroups", reflect.TypeOf((*MockProvisioningService)(nil).ListGroups), ctx, filter)
+}
+
+// ListUsers mocks base method.
+func (m *MockProvisioningService) ListUsers(ctx context.Context, filter domain.ProvisioningUserFilter) ([]domain.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUsers", ctx, filter)
+	ret0, _ := ret[0].([]domain.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUsers indicates an expected call of ListUsers.
+func (mr *MockProvisioningServiceMockRecorder) ListUsers(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUsers", reflect.TypeOf((*MockProvisioningService)(nil).ListUsers), ctx, filter)
+}
+
+// ReplaceUser mocks base method.
+func (m *MockProvisioningService) ReplaceUser(ctx context.Context, user domain.User) (*domain.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceUser", ctx, user)
+	ret0, _ := ret[0].(*domain.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReplaceUser indicates an expected call of ReplaceUser.
+func (mr *MockProvisioningServiceMockRecorder) ReplaceUser(ctx, user any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceUser", reflect.TypeOf((*MockProvisioningService)(nil).ReplaceUser), ctx, user)
+}
+
+// UpdateGroupMembers mocks base method.
+func (m *MockProvisioningService) UpdateGroupMembers(ctx context.Context, name domain.TeamName, ops []domain.GroupMembersOp) (*domain.Team, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateGroupMembers", ctx, name, ops)
+	ret0, _ := ret[0].(*domain.Team)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateGroupMembers indicates an expected call of UpdateGroupMembers.
+func (mr *MockProvisioningServiceMockRecorder) UpdateGroupMembers(ctx, name, ops any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateGroupMembers", reflect.TypeOf((*MockProvisioningService)(nil).UpdateGroupMembers), ctx, name, ops)
+}
+
+// MockAPIKeyService is a mock of APIKeyService interface.
+type MockAPIKeyService struct {
+	ctrl     *gomock.Controller
+	recorder *MockAPIKeyServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockAPIKeyServiceMockRecorder is the mock recorder for MockAPIKeyService.
+type MockAPIKeyServiceMockRecorder struct {
+	mock *MockAPIKeyService
+}
+
+// NewMockAPIKeyService creates a new mock instance.
+func NewMockAPIKeyService(ctrl *gomock.Controller) *MockAPIKeyService {
+	mock := &MockAPIKeyService{ctrl: ctrl}
+	mock.recorder = &MockAPIKeyServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAPIKeyService) EXPECT() *MockAPIKeyServiceMockRecorder {
+	return m.recorder
+}
+
+// Authenticate mocks base method.
+func (m *MockAPIKeyService) Authenticate(ctx context.Context, secret string) (*domain.APIKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Authenticate", ctx, secret)
+	ret0, _ := ret[0].(*domain.APIKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Authenticate indicates an expected call of Authenticate.
+func (mr *MockAPIKeyServiceMockRecorder) Authenticate(ctx, secret any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Authenticate", reflect.TypeOf((*MockAPIKeyService)(nil).Authenticate), ctx, secret)
+}
+
+// Create mocks base method.
+func (m *MockAPIKeyService) Create(ctx context.Context, name string, scopes []domain.APIKeyScope) (*domain.IssuedAPIKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, name, scopes)
+	ret0, _ := ret[0].(*domain.IssuedAPIKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockAPIKeyServiceMockRecorder) Create(ctx, name, scopes any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockAPIKeyService)(nil).Create), ctx, name, scopes)
+}
+
+// List mocks base method.
+func (m *MockAPIKeyService) List(ctx context.Context) ([]domain.APIKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx)
+	ret0, _ := ret[0].([]domain.APIKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockAPIKeyServiceMockRecorder) List(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockAPIKeyService)(nil).List), ctx)
+}
+
+// Revoke mocks base method.
+func (m *MockAPIKeyService) Revoke(ctx context.Context, id domain.APIKeyID) (*domain.APIKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Revoke", ctx, id)
+	ret0, _ := ret[0].(*domain.APIKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Revoke indicates an expected call of Revoke.
+func (mr *MockAPIKeyServiceMockRecorder) Revoke(ctx, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetIsActive", reflect.TypeOf((*MockUserService)(nil).SetIsActive), ctx, userID, isActive)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Revoke", reflect.TypeOf((*MockAPIKeyService)(nil).Revoke), ctx, id)
 }
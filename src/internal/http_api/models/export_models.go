@@ -0,0 +1,141 @@
+package models
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"PrService/src/internal/domain"
+)
+
+// exportListSeparator joins list values into a single CSV cell.
+const exportListSeparator = ";"
+
+// PullRequestExportHeader names the CSV columns of PullRequestExportRecord.CSV.
+var PullRequestExportHeader = []string{
+	"pull_request_id",
+	"pull_request_name",
+	"author_id",
+	"team_name",
+	"status",
+	"tags",
+	"assigned_reviewers",
+	"createdAt",
+	"mergedAt",
+}
+
+// PullRequestExportRecord is a line of the pull request export. In CSV lists are joined with ";"
+// and a missing time is an empty cell.
+type PullRequestExportRecord struct {
+	PullRequestID     string   `json:"pull_request_id"`
+	PullRequestName   string   `json:"pull_request_name"`
+	AuthorID          string   `json:"author_id"`
+	TeamName          string   `json:"team_name"`
+	Status            string   `json:"status"`
+	Tags              []string `json:"tags"`
+	AssignedReviewers []string `json:"assigned_reviewers"`
+	CreatedAt         *string  `json:"createdAt"`
+	MergedAt          *string  `json:"mergedAt"`
+}
+
+func MapToPullRequestExportRecord(row domain.PullRequestExportRow) PullRequestExportRecord {
+	tags := row.Tags
+	if tags == nil {
+		tags = make([]string, 0)
+	}
+
+	reviewers := make([]string, 0, len(row.Reviewers))
+	for _, reviewer := range row.Reviewers {
+		reviewers = append(reviewers, string(reviewer))
+	}
+
+	return PullRequestExportRecord{
+		PullRequestID:     string(row.ID),
+		PullRequestName:   row.Name,
+		AuthorID:          string(row.AuthorID),
+		TeamName:          string(row.TeamName),
+		Status:            string(row.Status),
+		Tags:              tags,
+		AssignedReviewers: reviewers,
+		CreatedAt:         formatExportTime(row.CreatedAt),
+		MergedAt:          formatExportTime(row.MergedAt),
+	}
+}
+
+func (r PullRequestExportRecord) CSV() []string {
+	return []string{
+		r.PullRequestID,
+		r.PullRequestName,
+		r.AuthorID,
+		r.TeamName,
+		r.Status,
+		strings.Join(r.Tags, exportListSeparator),
+		strings.Join(r.AssignedReviewers, exportListSeparator),
+		derefExportTime(r.CreatedAt),
+		derefExportTime(r.MergedAt),
+	}
+}
+
+// AssignmentExportHeader names the CSV columns of AssignmentExportRecord.CSV.
+var AssignmentExportHeader = []string{
+	"pull_request_id",
+	"pull_request_status",
+	"reviewer_id",
+	"team_name",
+	"assigned_at",
+	"unassigned_at",
+	"reassigned",
+}
+
+// AssignmentExportRecord is a line of the review assignment export; unassigned_at is empty
+// while the reviewer is still assigned.
+type AssignmentExportRecord struct {
+	PullRequestID     string  `json:"pull_request_id"`
+	PullRequestStatus string  `json:"pull_request_status"`
+	ReviewerID        string  `json:"reviewer_id"`
+	TeamName          string  `json:"team_name"`
+	AssignedAt        string  `json:"assigned_at"`
+	UnassignedAt      *string `json:"unassigned_at"`
+	Reassigned        bool    `json:"reassigned"`
+}
+
+func MapToAssignmentExportRecord(row domain.AssignmentExportRow) AssignmentExportRecord {
+	return AssignmentExportRecord{
+		PullRequestID:     string(row.PullRequestID),
+		PullRequestStatus: string(row.PullRequestStatus),
+		ReviewerID:        string(row.ReviewerID),
+		TeamName:          string(row.TeamName),
+		AssignedAt:        formatOptionalTime(row.AssignedAt),
+		UnassignedAt:      formatExportTime(row.UnassignedAt),
+		Reassigned:        row.Reassigned,
+	}
+}
+
+func (r AssignmentExportRecord) CSV() []string {
+	return []string{
+		r.PullRequestID,
+		r.PullRequestStatus,
+		r.ReviewerID,
+		r.TeamName,
+		r.AssignedAt,
+		derefExportTime(r.UnassignedAt),
+		strconv.FormatBool(r.Reassigned),
+	}
+}
+
+func formatExportTime(t *time.Time) *string {
+	if t == nil {
+		return nil
+	}
+
+	formatted := t.UTC().Format("2006-01-02T15:04:05Z")
+	return &formatted
+}
+
+func derefExportTime(t *string) string {
+	if t == nil {
+		return ""
+	}
+
+	return *t
+}
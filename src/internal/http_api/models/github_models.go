@@ -0,0 +1,93 @@
+package models
+
+import (
+	"fmt"
+
+	"PrService/src/internal/domain"
+)
+
+// GitHubPullRequestEvent is the subset of the GitHub "pull_request" webhook payload used by the service.
+type GitHubPullRequestEvent struct {
+	Action      string            `json:"action"`
+	Number      int               `json:"number"`
+	PullRequest GitHubPullRequest `json:"pull_request"`
+	Repository  GitHubRepository  `json:"repository"`
+}
+
+type GitHubPullRequest struct {
+	Number int        `json:"number"`
+	Title  string     `json:"title"`
+	Draft  bool       `json:"draft"`
+	Merged bool       `json:"merged"`
+	User   GitHubUser `json:"user"`
+}
+
+// GitHubPullRequestReviewEvent is the subset of the GitHub "pull_request_review" webhook payload
+// used by the service.
+type GitHubPullRequestReviewEvent struct {
+	Action      string            `json:"action"`
+	Review      GitHubReview      `json:"review"`
+	PullRequest GitHubPullRequest `json:"pull_request"`
+	Repository  GitHubRepository  `json:"repository"`
+}
+
+type GitHubReview struct {
+	State string     `json:"state"`
+	User  GitHubUser `json:"user"`
+}
+
+type GitHubUser struct {
+	Login string `json:"login"`
+}
+
+type GitHubRepository struct {
+	FullName string `json:"full_name"`
+}
+
+// MapToDomain converts the payload to a lifecycle event.
+// It reports false for actions that do not change the pull request lifecycle.
+func (e GitHubPullRequestEvent) MapToDomain(deliveryID string) (domain.PullRequestEvent, bool) {
+	event := domain.PullRequestEvent{
+		Provider:      domain.ExternalProviderGitHub,
+		DeliveryID:    deliveryID,
+		PullRequestID: domain.PullRequestID(fmt.Sprintf("%s#%d", e.Repository.FullName, e.Number)),
+		Title:         e.PullRequest.Title,
+		AuthorLogin:   e.PullRequest.User.Login,
+		Draft:         e.PullRequest.Draft,
+	}
+
+	switch e.Action {
+	case "opened":
+		event.Action = domain.PullRequestEventOpened
+	case "closed":
+		event.Action = domain.PullRequestEventClosed
+		if e.PullRequest.Merged {
+			event.Action = domain.PullRequestEventMerged
+		}
+	case "ready_for_review":
+		event.Action = domain.PullRequestEventReadyForReview
+	default:
+		return domain.PullRequestEvent{}, false
+	}
+
+	return event, true
+}
+
+// MapToDomain converts a submitted review of any state to a review event.
+// It reports false for edited and dismissed reviews.
+func (e GitHubPullRequestReviewEvent) MapToDomain(deliveryID string) (domain.PullRequestEvent, bool) {
+	if e.Action != "submitted" {
+		return domain.PullRequestEvent{}, false
+	}
+
+	return domain.PullRequestEvent{
+		Provider:      domain.ExternalProviderGitHub,
+		DeliveryID:    deliveryID,
+		Action:        domain.PullRequestEventReviewed,
+		PullRequestID: domain.PullRequestID(fmt.Sprintf("%s#%d", e.Repository.FullName, e.PullRequest.Number)),
+		Title:         e.PullRequest.Title,
+		AuthorLogin:   e.PullRequest.User.Login,
+		ReviewerLogin: e.Review.User.Login,
+		Draft:         e.PullRequest.Draft,
+	}, true
+}
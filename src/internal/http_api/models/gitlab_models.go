@@ -0,0 +1,67 @@
+package models
+
+import (
+	"fmt"
+
+	"PrService/src/internal/domain"
+)
+
+// GitLabMergeRequestEvent is the subset of the GitLab "Merge Request Hook" payload used by the service.
+type GitLabMergeRequestEvent struct {
+	ObjectKind       string                       `json:"object_kind"`
+	User             GitLabUser                   `json:"user"`
+	Project          GitLabProject                `json:"project"`
+	ObjectAttributes GitLabMergeRequestAttributes `json:"object_attributes"`
+}
+
+type GitLabUser struct {
+	Username string `json:"username"`
+}
+
+type GitLabProject struct {
+	PathWithNamespace string `json:"path_with_namespace"`
+}
+
+type GitLabMergeRequestAttributes struct {
+	IID            int    `json:"iid"`
+	Title          string `json:"title"`
+	Action         string `json:"action"`
+	Draft          bool   `json:"draft"`
+	WorkInProgress bool   `json:"work_in_progress"`
+}
+
+// MapToDomain converts the payload to a lifecycle event.
+// The user that triggered the hook is the author of an opened merge request and the reviewer of
+// an approval. It reports false for actions the service does not track.
+func (e GitLabMergeRequestEvent) MapToDomain(deliveryID string) (domain.PullRequestEvent, bool) {
+	attrs := e.ObjectAttributes
+	event := domain.PullRequestEvent{
+		Provider:      domain.ExternalProviderGitLab,
+		DeliveryID:    deliveryID,
+		PullRequestID: domain.PullRequestID(fmt.Sprintf("%s!%d", e.Project.PathWithNamespace, attrs.IID)),
+		Title:         attrs.Title,
+		AuthorLogin:   e.User.Username,
+		Draft:         attrs.Draft || attrs.WorkInProgress,
+	}
+
+	switch attrs.Action {
+	case "open":
+		event.Action = domain.PullRequestEventOpened
+	case "merge":
+		event.Action = domain.PullRequestEventMerged
+	case "close":
+		event.Action = domain.PullRequestEventClosed
+	case "reopen":
+		event.Action = domain.PullRequestEventReopened
+	case "update":
+		event.Action = domain.PullRequestEventUpdated
+	case "approved", "approval":
+		event.Action = domain.PullRequestEventReviewed
+		event.AuthorLogin = ""
+		event.ReviewerLogin = e.User.Username
+	default:
+		return domain.PullRequestEvent{}, false
+	}
+
+	return event, true
+}
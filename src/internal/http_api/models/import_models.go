@@ -0,0 +1,265 @@
+package models
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"PrService/src/internal/domain"
+
+	"gopkg.in/yaml.v3"
+)
+
+// TeamImportDocument is a YAML or CSV team import. A member without is_active is active.
+//
+// YAML lists teams with their members:
+//
+//	teams:
+//	  - team_name: backend
+//	    max_reviewers: 2
+//	    members:
+//	      - user_id: u1
+//	        username: Alice
+//	        seniority: SENIOR
+//	        tags: [db]
+//
+// CSV has a header row and a row per member; team_name, user_id and username are required columns,
+// is_active, seniority, tags (separated by ";") and max_reviewers are optional.
+type TeamImportDocument struct {
+	Teams []TeamImportTeam `yaml:"teams"`
+}
+
+type TeamImportTeam struct {
+	TeamName     string             `yaml:"team_name"`
+	MaxReviewers int                `yaml:"max_reviewers"`
+	Members      []TeamImportMember `yaml:"members"`
+}
+
+type TeamImportMember struct {
+	UserID    string   `yaml:"user_id"`
+	Username  string   `yaml:"username"`
+	IsActive  *bool    `yaml:"is_active"`
+	Seniority string   `yaml:"seniority"`
+	Tags      []string `yaml:"tags"`
+}
+
+func ParseTeamImportYAML(r io.Reader) (TeamImportDocument, error) {
+	var doc TeamImportDocument
+
+	dec := yaml.NewDecoder(r)
+	dec.KnownFields(true)
+	if err := dec.Decode(&doc); err != nil {
+		if errors.Is(err, io.EOF) {
+			return doc, errors.New("empty document")
+		}
+		return doc, err
+	}
+
+	return doc, nil
+}
+
+// ParseTeamImportCSV groups the member rows by team in the order the teams first appear.
+func ParseTeamImportCSV(r io.Reader) (TeamImportDocument, error) {
+	var doc TeamImportDocument
+
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return doc, errors.New("empty document")
+		}
+		return doc, err
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	for _, required := range []string{"team_name", "user_id", "username"} {
+		if _, ok := columns[required]; !ok {
+			return doc, fmt.Errorf("missing column %s", required)
+		}
+	}
+
+	teams := make(map[string]int)
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return doc, err
+		}
+		line, _ := reader.FieldPos(0)
+
+		cell := func(name string) string {
+			i, ok := columns[name]
+			if !ok {
+				return ""
+			}
+			return strings.TrimSpace(record[i])
+		}
+
+		member := TeamImportMember{
+			UserID:    cell("user_id"),
+			Username:  cell("username"),
+			Seniority: cell("seniority"),
+		}
+		if raw := cell("is_active"); raw != "" {
+			isActive, err := strconv.ParseBool(raw)
+			if err != nil {
+				return doc, fmt.Errorf("line %d: is_active must be true or false", line)
+			}
+			member.IsActive = &isActive
+		}
+		if raw := cell("tags"); raw != "" {
+			for _, tag := range strings.Split(raw, exportListSeparator) {
+				if tag = strings.TrimSpace(tag); tag != "" {
+					member.Tags = append(member.Tags, tag)
+				}
+			}
+		}
+
+		teamName := cell("team_name")
+		i, ok := teams[teamName]
+		if !ok {
+			i = len(doc.Teams)
+			teams[teamName] = i
+			doc.Teams = append(doc.Teams, TeamImportTeam{TeamName: teamName})
+		}
+		team := &doc.Teams[i]
+		team.Members = append(team.Members, member)
+
+		if raw := cell("max_reviewers"); raw != "" {
+			maxReviewers, err := strconv.Atoi(raw)
+			if err != nil {
+				return doc, fmt.Errorf("line %d: max_reviewers must be an integer", line)
+			}
+			if team.MaxReviewers != 0 && team.MaxReviewers != maxReviewers {
+				return doc, fmt.Errorf("line %d: conflicting max_reviewers of team %s", line, teamName)
+			}
+			team.MaxReviewers = maxReviewers
+		}
+	}
+
+	return doc, nil
+}
+
+func (d TeamImportDocument) MapToDomain() domain.TeamImport {
+	teams := make([]domain.Team, 0, len(d.Teams))
+	for _, team := range d.Teams {
+		members := make([]domain.TeamMember, 0, len(team.Members))
+		for _, member := range team.Members {
+			isActive := true
+			if member.IsActive != nil {
+				isActive = *member.IsActive
+			}
+
+			members = append(members, domain.TeamMember{
+				ID:        domain.UserID(member.UserID),
+				Username:  member.Username,
+				IsActive:  isActive,
+				Seniority: domain.Seniority(member.Seniority),
+				Tags:      member.Tags,
+			})
+		}
+
+		teams = append(teams, domain.Team{
+			Name:         domain.TeamName(team.TeamName),
+			Members:      members,
+			MaxReviewers: team.MaxReviewers,
+		})
+	}
+
+	return domain.TeamImport{Teams: teams}
+}
+
+type UserMoveResponse struct {
+	FromTeamName string       `json:"from_team_name"`
+	User         UserResponse `json:"user"`
+}
+
+// TeamImportDiffResponse lists the changes of an import; users are shown in their state after it.
+type TeamImportDiffResponse struct {
+	CreatedTeams     []string           `json:"created_teams"`
+	UpdatedTeams     []string           `json:"updated_teams"`
+	CreatedUsers     []UserResponse     `json:"created_users"`
+	UpdatedUsers     []UserResponse     `json:"updated_users"`
+	MovedUsers       []UserMoveResponse `json:"moved_users"`
+	DeactivatedUsers []UserResponse     `json:"deactivated_users"`
+}
+
+type TeamImportResponse struct {
+	DryRun bool                   `json:"dry_run"`
+	Diff   TeamImportDiffResponse `json:"diff"`
+}
+
+func MapToTeamImportResponse(diff domain.TeamImportDiff, dryRun bool) TeamImportResponse {
+	return TeamImportResponse{DryRun: dryRun, Diff: MapToTeamImportDiffResponse(diff)}
+}
+
+func MapToTeamImportDiffResponse(diff domain.TeamImportDiff) TeamImportDiffResponse {
+	users := func(users []domain.User) []UserResponse {
+		resp := make([]UserResponse, 0, len(users))
+		for _, user := range users {
+			resp = append(resp, MapToUserResponse(user))
+		}
+		return resp
+	}
+	teams := func(names []domain.TeamName) []string {
+		resp := make([]string, 0, len(names))
+		for _, name := range names {
+			resp = append(resp, string(name))
+		}
+		return resp
+	}
+
+	moved := make([]UserMoveResponse, 0, len(diff.MovedUsers))
+	for _, move := range diff.MovedUsers {
+		moved = append(moved, UserMoveResponse{
+			FromTeamName: string(move.FromTeam),
+			User:         MapToUserResponse(move.User),
+		})
+	}
+
+	return TeamImportDiffResponse{
+		CreatedTeams:     teams(diff.CreatedTeams),
+		UpdatedTeams:     teams(diff.UpdatedTeams),
+		CreatedUsers:     users(diff.CreatedUsers),
+		UpdatedUsers:     users(diff.UpdatedUsers),
+		MovedUsers:       moved,
+		DeactivatedUsers: users(diff.DeactivatedUsers),
+	}
+}
+
+type UserReviewHandoverResponse struct {
+	UserID string `json:"user_id"`
+	ReviewHandoverResponse
+}
+
+type SyncTeamResponse struct {
+	TeamName        string                       `json:"team_name"`
+	Changes         TeamImportDiffResponse       `json:"changes"`
+	ReviewHandovers []UserReviewHandoverResponse `json:"review_handovers"`
+}
+
+func MapToSyncTeamResponse(name domain.TeamName, result domain.TeamSyncResult) SyncTeamResponse {
+	resp := SyncTeamResponse{
+		TeamName:        string(name),
+		Changes:         MapToTeamImportDiffResponse(result.Changes),
+		ReviewHandovers: make([]UserReviewHandoverResponse, 0, len(result.Handovers)),
+	}
+	for _, handover := range result.Handovers {
+		resp.ReviewHandovers = append(resp.ReviewHandovers, UserReviewHandoverResponse{
+			UserID:                 string(handover.UserID),
+			ReviewHandoverResponse: MapToReviewHandoverResponse(handover.ReviewHandover),
+		})
+	}
+
+	return resp
+}
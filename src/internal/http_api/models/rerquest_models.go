@@ -1,6 +1,10 @@
 package models
 
-import "PrService/src/internal/domain"
+import (
+	"time"
+
+	"PrService/src/internal/domain"
+)
 
 type AddTeamRequest struct {
 	TeamName string              `json:"team_name" validate:"required"`
@@ -8,18 +12,22 @@ type AddTeamRequest struct {
 }
 
 type TeamMemberRequest struct {
-	UserID   string `json:"user_id" validate:"required"`
-	Username string `json:"username" validate:"required"`
-	IsActive bool   `json:"is_active"`
+	UserID    string   `json:"user_id" validate:"required"`
+	Username  string   `json:"username" validate:"required"`
+	IsActive  bool     `json:"is_active"`
+	Seniority string   `json:"seniority,omitempty" validate:"omitempty,oneof=JUNIOR MIDDLE SENIOR LEAD"`
+	Tags      []string `json:"tags,omitempty" validate:"omitempty,dive,required"`
 }
 
 func (team AddTeamRequest) MapToDomain() domain.Team {
 	members := make([]domain.TeamMember, 0, len(team.Members))
 	for _, member := range team.Members {
 		members = append(members, domain.TeamMember{
-			ID:       domain.UserID(member.UserID),
-			Username: member.Username,
-			IsActive: member.IsActive,
+			ID:        domain.UserID(member.UserID),
+			Username:  member.Username,
+			IsActive:  member.IsActive,
+			Seniority: domain.Seniority(member.Seniority),
+			Tags:      member.Tags,
 		})
 	}
 
@@ -33,15 +41,170 @@ type GetTeamRequest struct {
 	TeamName string `validate:"required"`
 }
 
+type SetTeamMaxReviewersRequest struct {
+	TeamName     string `json:"team_name" validate:"required"`
+	MaxReviewers int    `json:"max_reviewers" validate:"required,min=1"`
+}
+
+// SyncTeamRequest is the desired state of a team; it is accepted as JSON or YAML.
+// A member without is_active is active.
+type SyncTeamRequest struct {
+	TeamName     string                  `json:"team_name" yaml:"team_name" validate:"required"`
+	MaxReviewers int                     `json:"max_reviewers,omitempty" yaml:"max_reviewers" validate:"min=0"`
+	Members      []SyncTeamMemberRequest `json:"members" yaml:"members" validate:"required,dive"`
+}
+
+type SyncTeamMemberRequest struct {
+	UserID    string   `json:"user_id" yaml:"user_id" validate:"required"`
+	Username  string   `json:"username" yaml:"username" validate:"required"`
+	IsActive  *bool    `json:"is_active" yaml:"is_active"`
+	Seniority string   `json:"seniority" yaml:"seniority" validate:"omitempty,oneof=JUNIOR MIDDLE SENIOR LEAD"`
+	Tags      []string `json:"tags" yaml:"tags" validate:"omitempty,dive,required"`
+}
+
+func (req SyncTeamRequest) MapToDomain() domain.Team {
+	members := make([]domain.TeamMember, 0, len(req.Members))
+	for _, member := range req.Members {
+		isActive := true
+		if member.IsActive != nil {
+			isActive = *member.IsActive
+		}
+
+		members = append(members, domain.TeamMember{
+			ID:        domain.UserID(member.UserID),
+			Username:  member.Username,
+			IsActive:  isActive,
+			Seniority: domain.Seniority(member.Seniority),
+			Tags:      member.Tags,
+		})
+	}
+
+	return domain.Team{
+		Name:         domain.TeamName(req.TeamName),
+		Members:      members,
+		MaxReviewers: req.MaxReviewers,
+	}
+}
+
+type CreateTeamRuleRequest struct {
+	TeamName   string   `json:"team_name" validate:"required"`
+	Kind       string   `json:"kind" validate:"required,oneof=EXCLUDE_PAIR REQUIRE_ONE_OF MAX_OF"`
+	ReviewerID string   `json:"reviewer_id,omitempty"`
+	AuthorID   string   `json:"author_id,omitempty"`
+	Users      []string `json:"users,omitempty" validate:"omitempty,dive,required"`
+	Seniority  string   `json:"seniority,omitempty" validate:"omitempty,oneof=JUNIOR MIDDLE SENIOR LEAD"`
+	MaxCount   int      `json:"max_count,omitempty" validate:"min=0"`
+}
+
+func (req CreateTeamRuleRequest) MapToDomain() domain.TeamRule {
+	users := make([]domain.UserID, 0, len(req.Users))
+	for _, user := range req.Users {
+		users = append(users, domain.UserID(user))
+	}
+
+	return domain.TeamRule{
+		TeamName:   domain.TeamName(req.TeamName),
+		Kind:       domain.TeamRuleKind(req.Kind),
+		ReviewerID: domain.UserID(req.ReviewerID),
+		AuthorID:   domain.UserID(req.AuthorID),
+		Users:      users,
+		Seniority:  domain.Seniority(req.Seniority),
+		MaxCount:   req.MaxCount,
+	}
+}
+
+type UploadCodeOwnersRequest struct {
+	TeamName     string `json:"team_name" validate:"required"`
+	Content      string `json:"content" validate:"required"`
+	Mode         string `json:"mode,omitempty" validate:"omitempty,oneof=PREFER REQUIRE"`
+	FillStrategy string `json:"fill_strategy,omitempty" validate:"omitempty,oneof=RANDOM LOAD"`
+}
+
+func (req UploadCodeOwnersRequest) MapToDomain() domain.TeamCodeOwners {
+	return domain.TeamCodeOwners{
+		TeamName:     domain.TeamName(req.TeamName),
+		Content:      req.Content,
+		Mode:         domain.CodeOwnersMode(req.Mode),
+		FillStrategy: domain.FillStrategy(req.FillStrategy),
+	}
+}
+
+type SetReviewSLARequest struct {
+	TeamName             string `json:"team_name" validate:"required"`
+	FirstReviewWithinSec int64  `json:"first_review_within_sec" validate:"gt=0"`
+	EscalateAfterSec     int64  `json:"escalate_after_sec" validate:"min=0"`
+	Action               string `json:"action" validate:"required,oneof=REASSIGN ESCALATE"`
+}
+
+func (req SetReviewSLARequest) MapToDomain() domain.ReviewSLA {
+	return domain.ReviewSLA{
+		TeamName:          domain.TeamName(req.TeamName),
+		FirstReviewWithin: time.Duration(req.FirstReviewWithinSec) * time.Second,
+		EscalateAfter:     time.Duration(req.EscalateAfterSec) * time.Second,
+		Action:            domain.ReviewSLAAction(req.Action),
+	}
+}
+
+// SetUserIsActiveRequest sets the activity flag; ReassignReviews also hands the OPEN reviews
+// of a deactivated user over to other eligible members.
 type SetUserIsActiveRequest struct {
+	UserID          string `json:"user_id" validate:"required"`
+	IsActive        bool   `json:"is_active"`
+	ReassignReviews bool   `json:"reassign_reviews"`
+}
+
+type SetUserChatHandleRequest struct {
+	UserID     string `json:"user_id" validate:"required"`
+	ChatHandle string `json:"chat_handle" validate:"max=64"`
+}
+
+type SetUserEmailRequest struct {
+	UserID      string `json:"user_id" validate:"required"`
+	Email       string `json:"email" validate:"omitempty,email"`
+	EmailOptOut bool   `json:"email_opt_out"`
+}
+
+type LinkExternalAccountRequest struct {
 	UserID   string `json:"user_id" validate:"required"`
-	IsActive bool   `json:"is_active"`
+	Provider string `json:"provider" validate:"required,oneof=GITHUB GITLAB"`
+	Login    string `json:"login" validate:"required"`
+}
+
+func (req LinkExternalAccountRequest) MapToDomain() domain.ExternalAccount {
+	return domain.ExternalAccount{
+		Provider: domain.ExternalProvider(req.Provider),
+		Login:    req.Login,
+		UserID:   domain.UserID(req.UserID),
+	}
 }
 
 type CreatePullRequestRequest struct {
-	PullRequestID   string `json:"pull_request_id" validate:"required"`
-	PullRequestName string `json:"pull_request_name" validate:"required"`
-	AuthorID        string `json:"author_id" validate:"required"`
+	PullRequestID     string   `json:"pull_request_id" validate:"required"`
+	PullRequestName   string   `json:"pull_request_name" validate:"required"`
+	AuthorID          string   `json:"author_id" validate:"required"`
+	ExtraTeams        []string `json:"extra_teams,omitempty" validate:"omitempty,dive,required"`
+	RequiredReviewers []string `json:"required_reviewers,omitempty" validate:"omitempty,dive,required"`
+	Tags              []string `json:"tags,omitempty" validate:"omitempty,dive,required"`
+	ChangedFiles      []string `json:"changed_files,omitempty" validate:"omitempty,dive,required"`
+}
+
+func (req CreatePullRequestRequest) MapToDomainOptions() domain.CreatePullRequestOptions {
+	extraTeams := make([]domain.TeamName, 0, len(req.ExtraTeams))
+	for _, teamName := range req.ExtraTeams {
+		extraTeams = append(extraTeams, domain.TeamName(teamName))
+	}
+
+	requiredReviewers := make([]domain.UserID, 0, len(req.RequiredReviewers))
+	for _, reviewerID := range req.RequiredReviewers {
+		requiredReviewers = append(requiredReviewers, domain.UserID(reviewerID))
+	}
+
+	return domain.CreatePullRequestOptions{
+		ExtraTeams:        extraTeams,
+		RequiredReviewers: requiredReviewers,
+		Tags:              req.Tags,
+		ChangedFiles:      req.ChangedFiles,
+	}
 }
 
 type MergePullRequestRequest struct {
@@ -51,4 +214,45 @@ type MergePullRequestRequest struct {
 type ReassignPullRequestRequest struct {
 	PullRequestID string `json:"pull_request_id" validate:"required"`
 	OldUserID     string `json:"old_reviewer_id" validate:"required"`
+	NewUserID     string `json:"new_reviewer_id,omitempty"`
+}
+
+type PullRequestReviewerRequest struct {
+	PullRequestID string `json:"pull_request_id" validate:"required"`
+	ReviewerID    string `json:"reviewer_id" validate:"required"`
+}
+
+// CreateWebhookSubscriptionRequest leaves checking event types against the known ones to the service.
+type CreateWebhookSubscriptionRequest struct {
+	URL        string   `json:"url" validate:"required,http_url"`
+	Secret     string   `json:"secret" validate:"required,min=16"`
+	EventTypes []string `json:"event_types" validate:"required,min=1,dive,required"`
+}
+
+func (req CreateWebhookSubscriptionRequest) MapToDomain() domain.WebhookSubscription {
+	eventTypes := make([]domain.EventType, 0, len(req.EventTypes))
+	for _, eventType := range req.EventTypes {
+		eventTypes = append(eventTypes, domain.EventType(eventType))
+	}
+
+	return domain.WebhookSubscription{
+		URL:        req.URL,
+		Secret:     req.Secret,
+		EventTypes: eventTypes,
+	}
+}
+
+// CreateAPIKeyRequest leaves checking scopes against the known ones to the service.
+type CreateAPIKeyRequest struct {
+	Name   string   `json:"name" validate:"required"`
+	Scopes []string `json:"scopes" validate:"required,min=1,dive,required"`
+}
+
+func (req CreateAPIKeyRequest) MapScopesToDomain() []domain.APIKeyScope {
+	scopes := make([]domain.APIKeyScope, 0, len(req.Scopes))
+	for _, scope := range req.Scopes {
+		scopes = append(scopes, domain.APIKeyScope(scope))
+	}
+
+	return scopes
 }
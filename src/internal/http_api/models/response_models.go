@@ -1,6 +1,8 @@
 package models
 
 import (
+	"time"
+
 	"PrService/src/internal/domain"
 )
 
@@ -25,41 +27,63 @@ type ErrorBody struct {
 type ErrorCode string
 
 const (
-	ErrorCodeTeamExists       ErrorCode = "TEAM_EXISTS"
-	ErrorCodePRExists         ErrorCode = "PR_EXISTS"
-	ErrorCodePRMerged         ErrorCode = "PR_MERGED"
-	ErrorCodeNotAssigned      ErrorCode = "NOT_ASSIGNED"
-	ErrorCodeNoCandidate      ErrorCode = "NO_CANDIDATE"
-	ErrorCodeNotFound         ErrorCode = "NOT_FOUND"
-	ErrorCodeDecodeFailed     ErrorCode = "DECODE_FAILED"
-	ErrorCodeValidationFailed ErrorCode = "VALIDATION_FAILED"
-	ErrorCodeInternalServer   ErrorCode = "INTERNAL_SERVER_ERROR"
+	ErrorCodeTeamExists        ErrorCode = "TEAM_EXISTS"
+	ErrorCodePRExists          ErrorCode = "PR_EXISTS"
+	ErrorCodePRMerged          ErrorCode = "PR_MERGED"
+	ErrorCodeNotAssigned       ErrorCode = "NOT_ASSIGNED"
+	ErrorCodeNoCandidate       ErrorCode = "NO_CANDIDATE"
+	ErrorCodeReviewerInactive  ErrorCode = "REVIEWER_INACTIVE"
+	ErrorCodeReviewerIsAuthor  ErrorCode = "REVIEWER_IS_AUTHOR"
+	ErrorCodeAlreadyAssigned   ErrorCode = "ALREADY_ASSIGNED"
+	ErrorCodeNotInTeam         ErrorCode = "NOT_IN_TEAM"
+	ErrorCodeReviewersLimit    ErrorCode = "REVIEWERS_LIMIT"
+	ErrorCodeRuleViolation     ErrorCode = "RULE_VIOLATION"
+	ErrorCodeInvalidCodeOwners ErrorCode = "INVALID_CODEOWNERS"
+	ErrorCodePRClosed          ErrorCode = "PR_CLOSED"
+	ErrorCodePRNotOpen         ErrorCode = "PR_NOT_OPEN"
+	ErrorCodeUnknownAccount    ErrorCode = "UNKNOWN_ACCOUNT"
+	ErrorCodeInvalidSignature  ErrorCode = "INVALID_SIGNATURE"
+	ErrorCodeInvalidToken      ErrorCode = "INVALID_TOKEN"
+	ErrorCodeUnauthorized      ErrorCode = "UNAUTHORIZED"
+	ErrorCodeForbidden         ErrorCode = "FORBIDDEN"
+	ErrorCodeNotFound          ErrorCode = "NOT_FOUND"
+	ErrorCodeDecodeFailed      ErrorCode = "DECODE_FAILED"
+	ErrorCodeValidationFailed  ErrorCode = "VALIDATION_FAILED"
+	ErrorCodeInternalServer    ErrorCode = "INTERNAL_SERVER_ERROR"
+	ErrorCodeNotAcceptable     ErrorCode = "NOT_ACCEPTABLE"
+	ErrorCodeUnsupportedMedia  ErrorCode = "UNSUPPORTED_MEDIA_TYPE"
 )
 
 type TeamMemberResponse struct {
-	UserID   string `json:"user_id"`
-	Username string `json:"username"`
-	IsActive bool   `json:"is_active"`
+	UserID    string   `json:"user_id"`
+	Username  string   `json:"username"`
+	IsActive  bool     `json:"is_active"`
+	Seniority string   `json:"seniority,omitempty"`
+	Tags      []string `json:"tags,omitempty"`
 }
 
 type TeamResponse struct {
-	TeamName string               `json:"team_name"`
-	Members  []TeamMemberResponse `json:"members"`
+	TeamName     string               `json:"team_name"`
+	MaxReviewers int                  `json:"max_reviewers"`
+	Members      []TeamMemberResponse `json:"members"`
 }
 
 func MapToTeamResponse(team domain.Team) TeamResponse {
 	members := make([]TeamMemberResponse, 0, len(team.Members))
 	for _, member := range team.Members {
 		members = append(members, TeamMemberResponse{
-			UserID:   string(member.ID),
-			Username: member.Username,
-			IsActive: member.IsActive,
+			UserID:    string(member.ID),
+			Username:  member.Username,
+			IsActive:  member.IsActive,
+			Seniority: string(member.Seniority),
+			Tags:      member.Tags,
 		})
 	}
 
 	return TeamResponse{
-		TeamName: string(team.Name),
-		Members:  members,
+		TeamName:     string(team.Name),
+		MaxReviewers: team.ReviewersLimit(),
+		Members:      members,
 	}
 }
 
@@ -73,24 +97,290 @@ func MapToAddTeamResponse(team domain.Team) AddTeamResponse {
 	}
 }
 
+type SetTeamMaxReviewersResponse struct {
+	Team TeamResponse `json:"team"`
+}
+
+func MapToSetTeamMaxReviewersResponse(team domain.Team) SetTeamMaxReviewersResponse {
+	return SetTeamMaxReviewersResponse{
+		Team: MapToTeamResponse(team),
+	}
+}
+
+type TeamRuleResponse struct {
+	RuleID     int64    `json:"rule_id"`
+	TeamName   string   `json:"team_name"`
+	Kind       string   `json:"kind"`
+	ReviewerID string   `json:"reviewer_id,omitempty"`
+	AuthorID   string   `json:"author_id,omitempty"`
+	Users      []string `json:"users,omitempty"`
+	Seniority  string   `json:"seniority,omitempty"`
+	MaxCount   int      `json:"max_count,omitempty"`
+}
+
+func MapToTeamRuleResponse(rule domain.TeamRule) TeamRuleResponse {
+	users := make([]string, 0, len(rule.Users))
+	for _, user := range rule.Users {
+		users = append(users, string(user))
+	}
+
+	return TeamRuleResponse{
+		RuleID:     int64(rule.ID),
+		TeamName:   string(rule.TeamName),
+		Kind:       string(rule.Kind),
+		ReviewerID: string(rule.ReviewerID),
+		AuthorID:   string(rule.AuthorID),
+		Users:      users,
+		Seniority:  string(rule.Seniority),
+		MaxCount:   rule.MaxCount,
+	}
+}
+
+type TeamRuleEnvelopeResponse struct {
+	Rule TeamRuleResponse `json:"rule"`
+}
+
+type TeamRulesResponse struct {
+	TeamName string             `json:"team_name"`
+	Rules    []TeamRuleResponse `json:"rules"`
+}
+
+func MapToTeamRulesResponse(teamName domain.TeamName, rules []domain.TeamRule) TeamRulesResponse {
+	resp := TeamRulesResponse{
+		TeamName: string(teamName),
+		Rules:    make([]TeamRuleResponse, 0, len(rules)),
+	}
+	for _, rule := range rules {
+		resp.Rules = append(resp.Rules, MapToTeamRuleResponse(rule))
+	}
+
+	return resp
+}
+
+type ReviewSLAResponse struct {
+	TeamName             string `json:"team_name"`
+	FirstReviewWithinSec int64  `json:"first_review_within_sec"`
+	EscalateAfterSec     int64  `json:"escalate_after_sec"`
+	Action               string `json:"action"`
+}
+
+func MapToReviewSLAResponse(sla domain.ReviewSLA) ReviewSLAResponse {
+	return ReviewSLAResponse{
+		TeamName:             string(sla.TeamName),
+		FirstReviewWithinSec: int64(sla.FirstReviewWithin / time.Second),
+		EscalateAfterSec:     int64(sla.EscalateAfter / time.Second),
+		Action:               string(sla.Action),
+	}
+}
+
+type ReviewSLAEnvelopeResponse struct {
+	SLA ReviewSLAResponse `json:"sla"`
+}
+
+type PullRequestHistoryEntryResponse struct {
+	EntryID    int64    `json:"entry_id"`
+	Action     string   `json:"action"`
+	ReviewerID string   `json:"reviewer_id,omitempty"`
+	TargetIDs  []string `json:"target_ids"`
+	Details    string   `json:"details,omitempty"`
+	Actor      string   `json:"actor,omitempty"`
+	OccurredAt string   `json:"occurred_at"`
+}
+
+type PullRequestHistoryResponse struct {
+	PullRequestID string                            `json:"pull_request_id"`
+	History       []PullRequestHistoryEntryResponse `json:"history"`
+}
+
+func MapToPullRequestHistoryResponse(
+	id domain.PullRequestID,
+	entries []domain.PullRequestHistoryEntry,
+) PullRequestHistoryResponse {
+	resp := PullRequestHistoryResponse{
+		PullRequestID: string(id),
+		History:       make([]PullRequestHistoryEntryResponse, 0, len(entries)),
+	}
+	for _, entry := range entries {
+		targetIDs := make([]string, 0, len(entry.TargetIDs))
+		for _, targetID := range entry.TargetIDs {
+			targetIDs = append(targetIDs, string(targetID))
+		}
+
+		resp.History = append(resp.History, PullRequestHistoryEntryResponse{
+			EntryID:    entry.ID,
+			Action:     string(entry.Action),
+			ReviewerID: string(entry.ReviewerID),
+			TargetIDs:  targetIDs,
+			Details:    entry.Details,
+			Actor:      entry.Actor,
+			OccurredAt: entry.OccurredAt.UTC().Format("2006-01-02T15:04:05Z"),
+		})
+	}
+
+	return resp
+}
+
+type CodeOwnersEntryResponse struct {
+	Line    int      `json:"line"`
+	Pattern string   `json:"pattern"`
+	Owners  []string `json:"owners"`
+}
+
+type CodeOwnersResponse struct {
+	TeamName     string                    `json:"team_name"`
+	Mode         string                    `json:"mode"`
+	FillStrategy string                    `json:"fill_strategy"`
+	Content      string                    `json:"content"`
+	Entries      []CodeOwnersEntryResponse `json:"entries"`
+}
+
+func MapToCodeOwnersResponse(codeOwners domain.TeamCodeOwners) CodeOwnersResponse {
+	entries := make([]CodeOwnersEntryResponse, 0, len(codeOwners.Entries))
+	for _, entry := range codeOwners.Entries {
+		owners := entry.Owners
+		if owners == nil {
+			owners = []string{}
+		}
+		entries = append(entries, CodeOwnersEntryResponse{
+			Line:    entry.Line,
+			Pattern: entry.Pattern,
+			Owners:  owners,
+		})
+	}
+
+	return CodeOwnersResponse{
+		TeamName:     string(codeOwners.TeamName),
+		Mode:         string(codeOwners.Mode),
+		FillStrategy: string(codeOwners.FillStrategy),
+		Content:      codeOwners.Content,
+		Entries:      entries,
+	}
+}
+
 type UserResponse struct {
-	UserID   string `json:"user_id"`
-	Username string `json:"username"`
-	TeamName string `json:"team_name"`
-	IsActive bool   `json:"is_active"`
+	UserID      string   `json:"user_id"`
+	Username    string   `json:"username"`
+	TeamName    string   `json:"team_name"`
+	IsActive    bool     `json:"is_active"`
+	Seniority   string   `json:"seniority,omitempty"`
+	Tags        []string `json:"tags,omitempty"`
+	ChatHandle  string   `json:"chat_handle,omitempty"`
+	Email       string   `json:"email,omitempty"`
+	EmailOptOut bool     `json:"email_opt_out,omitempty"`
+}
+
+func MapToUserResponse(user domain.User) UserResponse {
+	return UserResponse{
+		UserID:      string(user.ID),
+		Username:    user.Username,
+		TeamName:    string(user.TeamName),
+		IsActive:    user.IsActive,
+		Seniority:   string(user.Seniority),
+		Tags:        user.Tags,
+		ChatHandle:  user.ChatHandle,
+		Email:       user.Email,
+		EmailOptOut: user.EmailOptOut,
+	}
+}
+
+type ListUsersResponse struct {
+	Users []UserResponse `json:"users"`
+}
+
+func MapToListUsersResponse(users []domain.User) ListUsersResponse {
+	resp := ListUsersResponse{Users: make([]UserResponse, 0, len(users))}
+	for _, user := range users {
+		resp.Users = append(resp.Users, MapToUserResponse(user))
+	}
+
+	return resp
+}
+
+type ReviewReassignmentResponse struct {
+	PullRequestID string `json:"pull_request_id"`
+	NewReviewerID string `json:"new_reviewer_id"`
+}
+
+// ReviewHandoverResponse lists the OPEN reviews of the deactivated user that were reassigned
+// and those that stayed with them because no eligible reviewer was found.
+type ReviewHandoverResponse struct {
+	Reassigned    []ReviewReassignmentResponse `json:"reassigned"`
+	NotReassigned []string                     `json:"not_reassigned"`
 }
 
 type SetUserIsActiveResponse struct {
+	User           UserResponse            `json:"user"`
+	ReviewHandover *ReviewHandoverResponse `json:"review_handover,omitempty"`
+}
+
+func MapToSetUserIsActiveResponse(user domain.User, handover *domain.ReviewHandover) SetUserIsActiveResponse {
+	resp := SetUserIsActiveResponse{
+		User: MapToUserResponse(user),
+	}
+	if handover == nil {
+		return resp
+	}
+
+	handoverResp := MapToReviewHandoverResponse(*handover)
+	resp.ReviewHandover = &handoverResp
+
+	return resp
+}
+
+func MapToReviewHandoverResponse(handover domain.ReviewHandover) ReviewHandoverResponse {
+	resp := ReviewHandoverResponse{
+		Reassigned:    make([]ReviewReassignmentResponse, 0, len(handover.Reassigned)),
+		NotReassigned: make([]string, 0, len(handover.Unreassigned)),
+	}
+	for _, reassignment := range handover.Reassigned {
+		resp.Reassigned = append(resp.Reassigned, ReviewReassignmentResponse{
+			PullRequestID: string(reassignment.PullRequestID),
+			NewReviewerID: string(reassignment.NewReviewerID),
+		})
+	}
+	for _, id := range handover.Unreassigned {
+		resp.NotReassigned = append(resp.NotReassigned, string(id))
+	}
+
+	return resp
+}
+
+type SetUserChatHandleResponse struct {
 	User UserResponse `json:"user"`
 }
 
-func MapToSetUserIsActiveResponse(user domain.User) SetUserIsActiveResponse {
-	return SetUserIsActiveResponse{
-		User: UserResponse{
-			UserID:   string(user.ID),
-			Username: user.Username,
-			TeamName: string(user.TeamName),
-			IsActive: user.IsActive,
+func MapToSetUserChatHandleResponse(user domain.User) SetUserChatHandleResponse {
+	return SetUserChatHandleResponse{
+		User: MapToUserResponse(user),
+	}
+}
+
+type SetUserEmailResponse struct {
+	User UserResponse `json:"user"`
+}
+
+func MapToSetUserEmailResponse(user domain.User) SetUserEmailResponse {
+	return SetUserEmailResponse{
+		User: MapToUserResponse(user),
+	}
+}
+
+type ExternalAccountResponse struct {
+	Provider string `json:"provider"`
+	Login    string `json:"login"`
+	UserID   string `json:"user_id"`
+}
+
+type LinkExternalAccountResponse struct {
+	Account ExternalAccountResponse `json:"account"`
+}
+
+func MapToLinkExternalAccountResponse(account domain.ExternalAccount) LinkExternalAccountResponse {
+	return LinkExternalAccountResponse{
+		Account: ExternalAccountResponse{
+			Provider: string(account.Provider),
+			Login:    account.Login,
+			UserID:   string(account.UserID),
 		},
 	}
 }
@@ -125,19 +415,58 @@ func MapToGetUserReviewsResponse(userID domain.UserID, prs []domain.PullRequest)
 }
 
 type PullRequestResponse struct {
-	PullRequestID     string   `json:"pull_request_id"`
-	PullRequestName   string   `json:"pull_request_name"`
-	AuthorID          string   `json:"author_id"`
-	Status            string   `json:"status"`
-	AssignedReviewers []string `json:"assigned_reviewers"`
-	CreatedAt         *string  `json:"createdAt,omitempty"`
-	MergedAt          *string  `json:"mergedAt,omitempty"`
+	PullRequestID     string                            `json:"pull_request_id"`
+	PullRequestName   string                            `json:"pull_request_name"`
+	AuthorID          string                            `json:"author_id"`
+	Status            string                            `json:"status"`
+	AssignedReviewers []string                          `json:"assigned_reviewers"`
+	ReviewerTeams     map[string]string                 `json:"reviewer_teams,omitempty"`
+	Tags              []string                          `json:"tags,omitempty"`
+	UnfilledSlots     []UnfilledSlotResponse            `json:"unfilled_slots,omitempty"`
+	AssignmentReasons map[string]ReviewerReasonResponse `json:"assignment_reasons,omitempty"`
+	CreatedAt         *string                           `json:"createdAt,omitempty"`
+	MergedAt          *string                           `json:"mergedAt,omitempty"`
 }
 
 func MapToPullRequestResponse(pr domain.PullRequest) PullRequestResponse {
 	reviewers := make([]string, 0, len(pr.AssignedReviewers))
+	var reviewerTeams map[string]string
 	for _, reviewer := range pr.AssignedReviewers {
 		reviewers = append(reviewers, string(reviewer))
+
+		if teamName, ok := pr.ReviewerTeams[reviewer]; ok {
+			if reviewerTeams == nil {
+				reviewerTeams = make(map[string]string, len(pr.AssignedReviewers))
+			}
+			reviewerTeams[string(reviewer)] = string(teamName)
+		}
+	}
+
+	var unfilledSlots []UnfilledSlotResponse
+	for _, slot := range pr.UnfilledSlots {
+		unfilledSlots = append(unfilledSlots, UnfilledSlotResponse{
+			TeamName:          string(slot.TeamName),
+			RuleID:            int64(slot.Rule.ID),
+			RuleKind:          string(slot.Rule.Kind),
+			Tag:               slot.Tag,
+			CodeOwnersPattern: slot.CodeOwners.Pattern,
+			CodeOwnersLine:    slot.CodeOwners.Line,
+		})
+	}
+
+	var assignmentReasons map[string]ReviewerReasonResponse
+	for reviewer, reason := range pr.AssignmentReasons {
+		if assignmentReasons == nil {
+			assignmentReasons = make(map[string]ReviewerReasonResponse, len(pr.AssignmentReasons))
+		}
+		assignmentReasons[string(reviewer)] = ReviewerReasonResponse{
+			Source:            string(reason.Source),
+			TeamName:          string(reason.TeamName),
+			RuleID:            int64(reason.RuleID),
+			Tag:               reason.Tag,
+			CodeOwnersPattern: reason.CodeOwners.Pattern,
+			CodeOwnersLine:    reason.CodeOwners.Line,
+		}
 	}
 
 	var createdAt *string
@@ -158,11 +487,36 @@ func MapToPullRequestResponse(pr domain.PullRequest) PullRequestResponse {
 		AuthorID:          string(pr.AuthorID),
 		Status:            string(pr.Status),
 		AssignedReviewers: reviewers,
+		ReviewerTeams:     reviewerTeams,
+		Tags:              pr.Tags,
+		UnfilledSlots:     unfilledSlots,
+		AssignmentReasons: assignmentReasons,
 		CreatedAt:         createdAt,
 		MergedAt:          mergedAt,
 	}
 }
 
+// UnfilledSlotResponse names the team rule that left a reviewer slot of the team empty,
+// the pull request tag that no assigned reviewer carries or the required CODEOWNERS entry.
+type UnfilledSlotResponse struct {
+	TeamName          string `json:"team_name"`
+	RuleID            int64  `json:"rule_id,omitempty"`
+	RuleKind          string `json:"rule_kind,omitempty"`
+	Tag               string `json:"tag,omitempty"`
+	CodeOwnersPattern string `json:"codeowners_pattern,omitempty"`
+	CodeOwnersLine    int    `json:"codeowners_line,omitempty"`
+}
+
+// ReviewerReasonResponse explains why the reviewer was assigned.
+type ReviewerReasonResponse struct {
+	Source            string `json:"source"`
+	TeamName          string `json:"team_name"`
+	RuleID            int64  `json:"rule_id,omitempty"`
+	Tag               string `json:"tag,omitempty"`
+	CodeOwnersPattern string `json:"codeowners_pattern,omitempty"`
+	CodeOwnersLine    int    `json:"codeowners_line,omitempty"`
+}
+
 type PullRequestEnvelopeResponse struct {
 	PR PullRequestResponse `json:"pr"`
 }
@@ -210,3 +564,371 @@ func MapToTeamStatsResponse(s domain.TeamStats) TeamStatsResponse {
 		AvgTimeToMergeSec:  s.AvgTimeToMergeSec,
 	}
 }
+
+type UserReviewStatsResponse struct {
+	UserID               string `json:"user_id"`
+	Username             string `json:"username"`
+	TeamName             string `json:"team_name"`
+	IsActive             bool   `json:"is_active"`
+	TotalAssignments     int    `json:"total_assignments"`
+	OpenReviews          int    `json:"open_reviews"`
+	CompletedReviews     int    `json:"completed_reviews"`
+	ReassignedAway       int    `json:"reassigned_away"`
+	MedianTimeToMergeSec int64  `json:"median_time_to_merge_seconds"`
+}
+
+type UserStatsResponse struct {
+	TeamName string                    `json:"team_name,omitempty"`
+	From     string                    `json:"from,omitempty"`
+	To       string                    `json:"to,omitempty"`
+	Users    []UserReviewStatsResponse `json:"users"`
+}
+
+func MapToUserStatsResponse(filter domain.UserStatsFilter, stats []domain.UserReviewStats) UserStatsResponse {
+	resp := UserStatsResponse{
+		TeamName: string(filter.TeamName),
+		From:     formatOptionalTime(filter.Window.From),
+		To:       formatOptionalTime(filter.Window.To),
+		Users:    make([]UserReviewStatsResponse, 0, len(stats)),
+	}
+	for _, s := range stats {
+		resp.Users = append(resp.Users, UserReviewStatsResponse{
+			UserID:               string(s.UserID),
+			Username:             s.Username,
+			TeamName:             string(s.TeamName),
+			IsActive:             s.IsActive,
+			TotalAssignments:     s.TotalAssignments,
+			OpenReviews:          s.OpenReviews,
+			CompletedReviews:     s.CompletedReviews,
+			ReassignedAway:       s.ReassignedAway,
+			MedianTimeToMergeSec: s.MedianTimeToMergeSec,
+		})
+	}
+
+	return resp
+}
+
+type MemberLoadResponse struct {
+	UserID      string `json:"user_id"`
+	Username    string `json:"username"`
+	Assignments int    `json:"assignments"`
+}
+
+type FairnessOutlierResponse struct {
+	MemberLoadResponse
+	Kind  string  `json:"kind"`
+	Ratio float64 `json:"ratio_to_mean"`
+}
+
+// FairnessResponse leaves max_min_ratio null when an active member got no assignments.
+type FairnessResponse struct {
+	TeamName         string                    `json:"team_name"`
+	From             string                    `json:"from,omitempty"`
+	To               string                    `json:"to,omitempty"`
+	TotalAssignments int                       `json:"total_assignments"`
+	MeanAssignments  float64                   `json:"mean_assignments"`
+	Gini             float64                   `json:"gini"`
+	MaxMinRatio      *float64                  `json:"max_min_ratio"`
+	Members          []MemberLoadResponse      `json:"members"`
+	Outliers         []FairnessOutlierResponse `json:"outliers"`
+}
+
+func mapToMemberLoadResponse(m domain.MemberLoad) MemberLoadResponse {
+	return MemberLoadResponse{
+		UserID:      string(m.UserID),
+		Username:    m.Username,
+		Assignments: m.Assignments,
+	}
+}
+
+func MapToFairnessResponse(report domain.FairnessReport) FairnessResponse {
+	resp := FairnessResponse{
+		TeamName:         string(report.TeamName),
+		From:             formatOptionalTime(report.Window.From),
+		To:               formatOptionalTime(report.Window.To),
+		TotalAssignments: report.TotalAssignments,
+		MeanAssignments:  report.MeanAssignments,
+		Gini:             report.Gini,
+		MaxMinRatio:      report.MaxMinRatio,
+		Members:          make([]MemberLoadResponse, 0, len(report.Members)),
+		Outliers:         make([]FairnessOutlierResponse, 0, len(report.Outliers)),
+	}
+	for _, m := range report.Members {
+		resp.Members = append(resp.Members, mapToMemberLoadResponse(m))
+	}
+	for _, o := range report.Outliers {
+		resp.Outliers = append(resp.Outliers, FairnessOutlierResponse{
+			MemberLoadResponse: mapToMemberLoadResponse(o.MemberLoad),
+			Kind:               string(o.Kind),
+			Ratio:              o.Ratio,
+		})
+	}
+
+	return resp
+}
+
+type PullRequestStaffingResponse struct {
+	PullRequestID string `json:"pull_request_id"`
+	Name          string `json:"pull_request_name"`
+	AuthorID      string `json:"author_id"`
+	TeamName      string `json:"team_name"`
+	Status        string `json:"status"`
+	CreatedAt     string `json:"createdAt,omitempty"`
+	Reviewers     int    `json:"reviewers"`
+	MaxReviewers  int    `json:"max_reviewers"`
+}
+
+type StatsOverviewResponse struct {
+	Teams                 int                           `json:"teams"`
+	ActiveUsers           int                           `json:"active_users"`
+	InactiveUsers         int                           `json:"inactive_users"`
+	OpenPRs               int                           `json:"open_prs"`
+	DraftPRs              int                           `json:"draft_prs"`
+	MergedPRs             int                           `json:"merged_prs"`
+	ClosedPRs             int                           `json:"closed_prs"`
+	OldestOpenPRs         []PullRequestStaffingResponse `json:"oldest_open_prs"`
+	TeamsWithoutReviewers []string                      `json:"teams_without_reviewers"`
+	UnderstaffedPRsCount  int                           `json:"understaffed_prs_count"`
+	UnderstaffedPRs       []PullRequestStaffingResponse `json:"understaffed_prs"`
+	GeneratedAt           string                        `json:"generated_at"`
+}
+
+func mapToPullRequestStaffingResponses(prs []domain.PullRequestStaffing) []PullRequestStaffingResponse {
+	resp := make([]PullRequestStaffingResponse, 0, len(prs))
+	for _, pr := range prs {
+		item := PullRequestStaffingResponse{
+			PullRequestID: string(pr.ID),
+			Name:          pr.Name,
+			AuthorID:      string(pr.AuthorID),
+			TeamName:      string(pr.TeamName),
+			Status:        string(pr.Status),
+			Reviewers:     pr.Reviewers,
+			MaxReviewers:  pr.MaxReviewers,
+		}
+		if pr.CreatedAt != nil {
+			item.CreatedAt = formatOptionalTime(*pr.CreatedAt)
+		}
+		resp = append(resp, item)
+	}
+
+	return resp
+}
+
+func MapToStatsOverviewResponse(overview domain.StatsOverview) StatsOverviewResponse {
+	teams := make([]string, 0, len(overview.TeamsWithoutReviewers))
+	for _, name := range overview.TeamsWithoutReviewers {
+		teams = append(teams, string(name))
+	}
+
+	return StatsOverviewResponse{
+		Teams:                 overview.Teams,
+		ActiveUsers:           overview.ActiveUsers,
+		InactiveUsers:         overview.InactiveUsers,
+		OpenPRs:               overview.OpenPRs,
+		DraftPRs:              overview.DraftPRs,
+		MergedPRs:             overview.MergedPRs,
+		ClosedPRs:             overview.ClosedPRs,
+		OldestOpenPRs:         mapToPullRequestStaffingResponses(overview.OldestOpenPRs),
+		TeamsWithoutReviewers: teams,
+		UnderstaffedPRsCount:  overview.UnderstaffedPRsCount,
+		UnderstaffedPRs:       mapToPullRequestStaffingResponses(overview.UnderstaffedPRs),
+		GeneratedAt:           overview.GeneratedAt.UTC().Format("2006-01-02T15:04:05Z"),
+	}
+}
+
+// formatOptionalTime leaves a zero time out of the response.
+func formatOptionalTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+
+	return t.UTC().Format("2006-01-02T15:04:05Z")
+}
+
+type TeamStatsBucketResponse struct {
+	Start                string `json:"start"`
+	End                  string `json:"end"`
+	OpenedPRs            int    `json:"opened_prs"`
+	MergedPRs            int    `json:"merged_prs"`
+	MedianTimeToMergeSec int64  `json:"median_time_to_merge_seconds"`
+	P90TimeToMergeSec    int64  `json:"p90_time_to_merge_seconds"`
+	Reassignments        int    `json:"reassignments"`
+}
+
+type TeamStatsTimeSeriesResponse struct {
+	TeamName string                    `json:"team_name"`
+	Interval string                    `json:"interval"`
+	Buckets  []TeamStatsBucketResponse `json:"buckets"`
+}
+
+func MapToTeamStatsTimeSeriesResponse(series domain.TeamStatsTimeSeries) TeamStatsTimeSeriesResponse {
+	resp := TeamStatsTimeSeriesResponse{
+		TeamName: string(series.TeamName),
+		Interval: string(series.Interval),
+		Buckets:  make([]TeamStatsBucketResponse, 0, len(series.Buckets)),
+	}
+	for _, b := range series.Buckets {
+		resp.Buckets = append(resp.Buckets, TeamStatsBucketResponse{
+			Start:                b.Start.UTC().Format("2006-01-02T15:04:05Z"),
+			End:                  b.End.UTC().Format("2006-01-02T15:04:05Z"),
+			OpenedPRs:            b.OpenedPRs,
+			MergedPRs:            b.MergedPRs,
+			MedianTimeToMergeSec: b.MedianTimeToMergeSec,
+			P90TimeToMergeSec:    b.P90TimeToMergeSec,
+			Reassignments:        b.Reassignments,
+		})
+	}
+
+	return resp
+}
+
+// WebhookSubscriptionResponse never echoes the signing secret.
+type WebhookSubscriptionResponse struct {
+	SubscriptionID int64    `json:"subscription_id"`
+	URL            string   `json:"url"`
+	EventTypes     []string `json:"event_types"`
+	CreatedAt      string   `json:"created_at"`
+}
+
+func MapToWebhookSubscriptionResponse(subscription domain.WebhookSubscription) WebhookSubscriptionResponse {
+	eventTypes := make([]string, 0, len(subscription.EventTypes))
+	for _, eventType := range subscription.EventTypes {
+		eventTypes = append(eventTypes, string(eventType))
+	}
+
+	return WebhookSubscriptionResponse{
+		SubscriptionID: int64(subscription.ID),
+		URL:            subscription.URL,
+		EventTypes:     eventTypes,
+		CreatedAt:      subscription.CreatedAt.UTC().Format("2006-01-02T15:04:05Z"),
+	}
+}
+
+type WebhookSubscriptionEnvelopeResponse struct {
+	Subscription WebhookSubscriptionResponse `json:"subscription"`
+}
+
+type WebhookSubscriptionsResponse struct {
+	Subscriptions []WebhookSubscriptionResponse `json:"subscriptions"`
+}
+
+func MapToWebhookSubscriptionsResponse(subscriptions []domain.WebhookSubscription) WebhookSubscriptionsResponse {
+	resp := WebhookSubscriptionsResponse{
+		Subscriptions: make([]WebhookSubscriptionResponse, 0, len(subscriptions)),
+	}
+	for _, subscription := range subscriptions {
+		resp.Subscriptions = append(resp.Subscriptions, MapToWebhookSubscriptionResponse(subscription))
+	}
+
+	return resp
+}
+
+type WebhookDeliveryResponse struct {
+	DeliveryID     int64   `json:"delivery_id"`
+	EventType      string  `json:"event_type"`
+	Status         string  `json:"status"`
+	Attempts       int     `json:"attempts"`
+	NextAttemptAt  *string `json:"next_attempt_at,omitempty"`
+	LastStatusCode int     `json:"last_status_code,omitempty"`
+	LastError      string  `json:"last_error,omitempty"`
+	CreatedAt      string  `json:"created_at"`
+	DeliveredAt    *string `json:"delivered_at,omitempty"`
+}
+
+func MapToWebhookDeliveryResponse(delivery domain.WebhookSubscriptionDelivery) WebhookDeliveryResponse {
+	resp := WebhookDeliveryResponse{
+		DeliveryID:     delivery.ID,
+		EventType:      string(delivery.EventType),
+		Status:         string(delivery.Status),
+		Attempts:       delivery.Attempts,
+		LastStatusCode: delivery.LastStatusCode,
+		LastError:      delivery.LastError,
+		CreatedAt:      delivery.CreatedAt.UTC().Format("2006-01-02T15:04:05Z"),
+	}
+	if delivery.Status == domain.WebhookDeliveryPending {
+		nextAttemptAt := delivery.NextAttemptAt.UTC().Format("2006-01-02T15:04:05Z")
+		resp.NextAttemptAt = &nextAttemptAt
+	}
+	if delivery.DeliveredAt != nil {
+		deliveredAt := delivery.DeliveredAt.UTC().Format("2006-01-02T15:04:05Z")
+		resp.DeliveredAt = &deliveredAt
+	}
+
+	return resp
+}
+
+type WebhookDeliveriesResponse struct {
+	SubscriptionID int64                     `json:"subscription_id"`
+	Deliveries     []WebhookDeliveryResponse `json:"deliveries"`
+}
+
+func MapToWebhookDeliveriesResponse(
+	id domain.WebhookSubscriptionID,
+	deliveries []domain.WebhookSubscriptionDelivery,
+) WebhookDeliveriesResponse {
+	resp := WebhookDeliveriesResponse{
+		SubscriptionID: int64(id),
+		Deliveries:     make([]WebhookDeliveryResponse, 0, len(deliveries)),
+	}
+	for _, delivery := range deliveries {
+		resp.Deliveries = append(resp.Deliveries, MapToWebhookDeliveryResponse(delivery))
+	}
+
+	return resp
+}
+
+// APIKeyResponse never carries the secret; Prefix is its first characters.
+type APIKeyResponse struct {
+	APIKeyID  int64    `json:"api_key_id"`
+	Name      string   `json:"name"`
+	Prefix    string   `json:"prefix"`
+	Scopes    []string `json:"scopes"`
+	CreatedAt string   `json:"created_at"`
+	RevokedAt *string  `json:"revoked_at,omitempty"`
+}
+
+func MapToAPIKeyResponse(key domain.APIKey) APIKeyResponse {
+	scopes := make([]string, 0, len(key.Scopes))
+	for _, scope := range key.Scopes {
+		scopes = append(scopes, string(scope))
+	}
+
+	resp := APIKeyResponse{
+		APIKeyID:  int64(key.ID),
+		Name:      key.Name,
+		Prefix:    key.Prefix,
+		Scopes:    scopes,
+		CreatedAt: key.CreatedAt.UTC().Format("2006-01-02T15:04:05Z"),
+	}
+	if key.RevokedAt != nil {
+		revokedAt := key.RevokedAt.UTC().Format("2006-01-02T15:04:05Z")
+		resp.RevokedAt = &revokedAt
+	}
+
+	return resp
+}
+
+type APIKeyEnvelopeResponse struct {
+	APIKey APIKeyResponse `json:"api_key"`
+}
+
+// CreatedAPIKeyResponse is the only response that shows the secret of a key.
+type CreatedAPIKeyResponse struct {
+	APIKey APIKeyResponse `json:"api_key"`
+	Key    string         `json:"key"`
+}
+
+type APIKeysResponse struct {
+	APIKeys []APIKeyResponse `json:"api_keys"`
+}
+
+func MapToAPIKeysResponse(keys []domain.APIKey) APIKeysResponse {
+	resp := APIKeysResponse{
+		APIKeys: make([]APIKeyResponse, 0, len(keys)),
+	}
+	for _, key := range keys {
+		resp.APIKeys = append(resp.APIKeys, MapToAPIKeyResponse(key))
+	}
+
+	return resp
+}
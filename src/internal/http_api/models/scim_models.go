@@ -0,0 +1,668 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"PrService/src/internal/domain"
+)
+
+const (
+	ScimUserSchema                  = "urn:ietf:params:scim:schemas:core:2.0:User"
+	ScimGroupSchema                 = "urn:ietf:params:scim:schemas:core:2.0:Group"
+	ScimServiceProviderConfigSchema = "urn:ietf:params:scim:schemas:core:2.0:ServiceProviderConfig"
+	ScimListResponseSchema          = "urn:ietf:params:scim:api:messages:2.0:ListResponse"
+	ScimPatchOpSchema               = "urn:ietf:params:scim:api:messages:2.0:PatchOp"
+	ScimErrorSchema                 = "urn:ietf:params:scim:api:messages:2.0:Error"
+
+	// ScimBasePath is where the SCIM endpoints are mounted.
+	ScimBasePath = "/scim/v2"
+	// ScimMaxResults bounds the page size of SCIM list responses.
+	ScimMaxResults = 100
+)
+
+// ScimType is the detail error keyword of a SCIM error response.
+type ScimType string
+
+const (
+	ScimTypeInvalidFilter ScimType = "invalidFilter"
+	ScimTypeInvalidPath   ScimType = "invalidPath"
+	ScimTypeInvalidSyntax ScimType = "invalidSyntax"
+	ScimTypeInvalidValue  ScimType = "invalidValue"
+	ScimTypeMutability    ScimType = "mutability"
+	ScimTypeUniqueness    ScimType = "uniqueness"
+)
+
+// ScimError is a request the SCIM endpoints reject with 400 and the given keyword.
+type ScimError struct {
+	ScimType ScimType
+	Detail   string
+}
+
+func (e *ScimError) Error() string {
+	return e.Detail
+}
+
+func newScimError(scimType ScimType, format string, args ...any) *ScimError {
+	return &ScimError{ScimType: scimType, Detail: fmt.Sprintf(format, args...)}
+}
+
+type ScimErrorResponse struct {
+	Schemas  []string `json:"schemas"`
+	Status   string   `json:"status"`
+	ScimType ScimType `json:"scimType,omitempty"`
+	Detail   string   `json:"detail"`
+}
+
+func CreateScimErrorResponse(status int, scimType ScimType, detail string) ScimErrorResponse {
+	return ScimErrorResponse{
+		Schemas:  []string{ScimErrorSchema},
+		Status:   strconv.Itoa(status),
+		ScimType: scimType,
+		Detail:   detail,
+	}
+}
+
+type ScimMeta struct {
+	ResourceType string `json:"resourceType"`
+	Location     string `json:"location"`
+}
+
+type ScimEmail struct {
+	Value   string `json:"value" validate:"required"`
+	Type    string `json:"type,omitempty"`
+	Primary bool   `json:"primary,omitempty"`
+}
+
+// ScimMemberRef references a user from a group or a group from a user.
+type ScimMemberRef struct {
+	Value   string `json:"value" validate:"required"`
+	Display string `json:"display,omitempty"`
+	Ref     string `json:"$ref,omitempty"`
+}
+
+// ScimUserRequest creates or replaces a user. The id of a new user is externalId, or userName
+// when it is not set; a user without active is active. Attributes the service does not store are ignored.
+type ScimUserRequest struct {
+	Schemas    []string    `json:"schemas"`
+	ExternalID string      `json:"externalId"`
+	UserName   string      `json:"userName" validate:"required"`
+	Active     *bool       `json:"active"`
+	Emails     []ScimEmail `json:"emails" validate:"omitempty,dive"`
+}
+
+func (req ScimUserRequest) MapToDomain(id domain.UserID) domain.User {
+	if id == "" {
+		id = domain.UserID(req.ExternalID)
+	}
+	if id == "" {
+		id = domain.UserID(req.UserName)
+	}
+
+	isActive := true
+	if req.Active != nil {
+		isActive = *req.Active
+	}
+
+	return domain.User{
+		ID:       id,
+		Username: req.UserName,
+		IsActive: isActive,
+		Email:    primaryScimEmail(req.Emails),
+	}
+}
+
+// primaryScimEmail returns the primary email, or the first one when none is marked primary.
+func primaryScimEmail(emails []ScimEmail) string {
+	for _, email := range emails {
+		if email.Primary {
+			return email.Value
+		}
+	}
+	if len(emails) > 0 {
+		return emails[0].Value
+	}
+
+	return ""
+}
+
+// ScimUserResponse lists the team of the user under groups while the user is active.
+type ScimUserResponse struct {
+	Schemas  []string        `json:"schemas"`
+	ID       string          `json:"id"`
+	UserName string          `json:"userName"`
+	Active   bool            `json:"active"`
+	Emails   []ScimEmail     `json:"emails,omitempty"`
+	Groups   []ScimMemberRef `json:"groups"`
+	Meta     ScimMeta        `json:"meta"`
+}
+
+func MapToScimUserResponse(user domain.User) ScimUserResponse {
+	resp := ScimUserResponse{
+		Schemas:  []string{ScimUserSchema},
+		ID:       string(user.ID),
+		UserName: user.Username,
+		Active:   user.IsActive,
+		Groups:   make([]ScimMemberRef, 0, 1),
+		Meta:     ScimMeta{ResourceType: "User", Location: scimUserLocation(user.ID)},
+	}
+	if user.Email != "" {
+		resp.Emails = []ScimEmail{{Value: user.Email, Type: "work", Primary: true}}
+	}
+	if user.IsActive {
+		resp.Groups = append(resp.Groups, ScimMemberRef{
+			Value:   string(user.TeamName),
+			Display: string(user.TeamName),
+			Ref:     scimGroupLocation(user.TeamName),
+		})
+	}
+
+	return resp
+}
+
+type ScimUserListResponse struct {
+	Schemas      []string           `json:"schemas"`
+	TotalResults int                `json:"totalResults"`
+	StartIndex   int                `json:"startIndex"`
+	ItemsPerPage int                `json:"itemsPerPage"`
+	Resources    []ScimUserResponse `json:"Resources"`
+}
+
+func MapToScimUserListResponse(users []domain.User, total, startIndex int) ScimUserListResponse {
+	resp := ScimUserListResponse{
+		Schemas:      []string{ScimListResponseSchema},
+		TotalResults: total,
+		StartIndex:   startIndex,
+		ItemsPerPage: len(users),
+		Resources:    make([]ScimUserResponse, 0, len(users)),
+	}
+	for _, user := range users {
+		resp.Resources = append(resp.Resources, MapToScimUserResponse(user))
+	}
+
+	return resp
+}
+
+// ScimGroupRequest creates a team or replaces its members; displayName is the team name and cannot change.
+type ScimGroupRequest struct {
+	Schemas     []string        `json:"schemas"`
+	DisplayName string          `json:"displayName" validate:"required"`
+	Members     []ScimMemberRef `json:"members" validate:"omitempty,dive"`
+}
+
+func (req ScimGroupRequest) MemberIDs() []domain.UserID {
+	return scimMemberIDs(req.Members)
+}
+
+func scimMemberIDs(members []ScimMemberRef) []domain.UserID {
+	ids := make([]domain.UserID, 0, len(members))
+	for _, member := range members {
+		ids = append(ids, domain.UserID(member.Value))
+	}
+
+	return ids
+}
+
+// ScimGroupResponse lists the active users of the team as its members.
+type ScimGroupResponse struct {
+	Schemas     []string        `json:"schemas"`
+	ID          string          `json:"id"`
+	DisplayName string          `json:"displayName"`
+	Members     []ScimMemberRef `json:"members"`
+	Meta        ScimMeta        `json:"meta"`
+}
+
+func MapToScimGroupResponse(team domain.Team) ScimGroupResponse {
+	members := domain.GroupMembers(team)
+
+	resp := ScimGroupResponse{
+		Schemas:     []string{ScimGroupSchema},
+		ID:          string(team.Name),
+		DisplayName: string(team.Name),
+		Members:     make([]ScimMemberRef, 0, len(members)),
+		Meta:        ScimMeta{ResourceType: "Group", Location: scimGroupLocation(team.Name)},
+	}
+	for _, member := range members {
+		resp.Members = append(resp.Members, ScimMemberRef{
+			Value:   string(member.ID),
+			Display: member.Username,
+			Ref:     scimUserLocation(member.ID),
+		})
+	}
+
+	return resp
+}
+
+type ScimGroupListResponse struct {
+	Schemas      []string            `json:"schemas"`
+	TotalResults int                 `json:"totalResults"`
+	StartIndex   int                 `json:"startIndex"`
+	ItemsPerPage int                 `json:"itemsPerPage"`
+	Resources    []ScimGroupResponse `json:"Resources"`
+}
+
+func MapToScimGroupListResponse(teams []domain.Team, total, startIndex int) ScimGroupListResponse {
+	resp := ScimGroupListResponse{
+		Schemas:      []string{ScimListResponseSchema},
+		TotalResults: total,
+		StartIndex:   startIndex,
+		ItemsPerPage: len(teams),
+		Resources:    make([]ScimGroupResponse, 0, len(teams)),
+	}
+	for _, team := range teams {
+		resp.Resources = append(resp.Resources, MapToScimGroupResponse(team))
+	}
+
+	return resp
+}
+
+func scimUserLocation(id domain.UserID) string {
+	return ScimBasePath + "/Users/" + url.PathEscape(string(id))
+}
+
+func scimGroupLocation(name domain.TeamName) string {
+	return ScimBasePath + "/Groups/" + url.PathEscape(string(name))
+}
+
+type ScimPatchRequest struct {
+	Schemas    []string             `json:"schemas"`
+	Operations []ScimPatchOperation `json:"Operations" validate:"required,min=1,dive"`
+}
+
+type ScimPatchOperation struct {
+	Op    string          `json:"op" validate:"required"`
+	Path  string          `json:"path"`
+	Value json.RawMessage `json:"value" swaggertype:"object"`
+}
+
+// kind returns the lowercased operation; identity providers differ in its case.
+func (op ScimPatchOperation) kind() (string, error) {
+	kind := strings.ToLower(op.Op)
+	switch kind {
+	case "add", "replace", "remove":
+		return kind, nil
+	default:
+		return "", newScimError(ScimTypeInvalidSyntax, "unknown patch operation %q", op.Op)
+	}
+}
+
+// ApplyScimUserPatch applies the operations to userName, active and emails of the user;
+// other attributes are not stored and are ignored.
+func ApplyScimUserPatch(user domain.User, ops []ScimPatchOperation) (domain.User, error) {
+	for _, op := range ops {
+		kind, err := op.kind()
+		if err != nil {
+			return user, err
+		}
+
+		if op.Path == "" {
+			if kind == "remove" {
+				return user, newScimError(ScimTypeInvalidPath, "remove requires a path")
+			}
+
+			var attrs map[string]json.RawMessage
+			if err := json.Unmarshal(op.Value, &attrs); err != nil {
+				return user, newScimError(ScimTypeInvalidValue, "value of a patch without a path must be an object")
+			}
+			for attr, value := range attrs {
+				if err := setScimUserAttribute(&user, attr, value); err != nil {
+					return user, err
+				}
+			}
+			continue
+		}
+
+		if kind == "remove" {
+			if isScimEmailPath(strings.ToLower(op.Path)) {
+				user.Email = ""
+				continue
+			}
+			if attr := strings.ToLower(op.Path); attr == "username" || attr == "active" {
+				return user, newScimError(ScimTypeMutability, "%s cannot be removed", op.Path)
+			}
+			continue
+		}
+
+		if err := setScimUserAttribute(&user, op.Path, op.Value); err != nil {
+			return user, err
+		}
+	}
+
+	return user, nil
+}
+
+func setScimUserAttribute(user *domain.User, attr string, value json.RawMessage) error {
+	attr = strings.ToLower(attr)
+	switch {
+	case attr == "username":
+		var username string
+		if err := json.Unmarshal(value, &username); err != nil || username == "" {
+			return newScimError(ScimTypeInvalidValue, "userName must be a non-empty string")
+		}
+		user.Username = username
+	case attr == "active":
+		isActive, err := parseScimBool(value)
+		if err != nil {
+			return newScimError(ScimTypeInvalidValue, "active must be a boolean")
+		}
+		user.IsActive = isActive
+	case attr == "emails":
+		var emails []ScimEmail
+		if err := json.Unmarshal(value, &emails); err != nil {
+			return newScimError(ScimTypeInvalidValue, "emails must be a list of emails")
+		}
+		user.Email = primaryScimEmail(emails)
+	case isScimEmailPath(attr):
+		var email string
+		if err := json.Unmarshal(value, &email); err != nil {
+			return newScimError(ScimTypeInvalidValue, "email value must be a string")
+		}
+		user.Email = email
+	}
+
+	return nil
+}
+
+// isScimEmailPath matches emails and its sub-attributes, e.g. emails[type eq "work"].value,
+// as the user has a single email.
+func isScimEmailPath(attr string) bool {
+	return attr == "emails" || strings.HasPrefix(attr, "emails.") || strings.HasPrefix(attr, "emails[")
+}
+
+// parseScimBool accepts JSON booleans and the "True"/"False" strings some identity providers send.
+func parseScimBool(value json.RawMessage) (bool, error) {
+	var b bool
+	if err := json.Unmarshal(value, &b); err == nil {
+		return b, nil
+	}
+
+	var s string
+	if err := json.Unmarshal(value, &s); err != nil {
+		return false, err
+	}
+
+	return strconv.ParseBool(s)
+}
+
+// MapScimGroupPatch turns the operations into member changes of the team. displayName may only be
+// set to the current name.
+func MapScimGroupPatch(name domain.TeamName, ops []ScimPatchOperation) ([]domain.GroupMembersOp, error) {
+	result := make([]domain.GroupMembersOp, 0, len(ops))
+	for _, op := range ops {
+		kind, err := op.kind()
+		if err != nil {
+			return nil, err
+		}
+
+		path := strings.ToLower(op.Path)
+		switch {
+		case path == "":
+			if kind == "remove" {
+				return nil, newScimError(ScimTypeInvalidPath, "remove requires a path")
+			}
+
+			var attrs map[string]json.RawMessage
+			if err := json.Unmarshal(op.Value, &attrs); err != nil {
+				return nil, newScimError(ScimTypeInvalidValue, "value of a patch without a path must be an object")
+			}
+			for attr, value := range attrs {
+				switch strings.ToLower(attr) {
+				case "members":
+					membersOp, err := scimMembersOp(kind, value)
+					if err != nil {
+						return nil, err
+					}
+					result = append(result, membersOp)
+				case "displayname":
+					if err := checkScimDisplayName(name, value); err != nil {
+						return nil, err
+					}
+				}
+			}
+		case path == "members":
+			if kind == "remove" && len(op.Value) == 0 {
+				result = append(result, domain.GroupMembersOp{Kind: domain.GroupMembersReplace})
+				continue
+			}
+			membersOp, err := scimMembersOp(kind, op.Value)
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, membersOp)
+		case strings.HasPrefix(path, "members[") && strings.HasSuffix(path, "]"):
+			if kind != "remove" {
+				return nil, newScimError(ScimTypeInvalidPath, "only remove supports a members filter")
+			}
+
+			id, err := parseScimMemberFilter(op.Path[len("members[") : len(op.Path)-1])
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, domain.GroupMembersOp{
+				Kind:    domain.GroupMembersRemove,
+				UserIDs: []domain.UserID{id},
+			})
+		case path == "displayname":
+			if kind == "remove" {
+				return nil, newScimError(ScimTypeMutability, "displayName cannot be removed")
+			}
+			if err := checkScimDisplayName(name, op.Value); err != nil {
+				return nil, err
+			}
+		default:
+			return nil, newScimError(ScimTypeInvalidPath, "unsupported path %q", op.Path)
+		}
+	}
+
+	return result, nil
+}
+
+func scimMembersOp(kind string, value json.RawMessage) (domain.GroupMembersOp, error) {
+	var members []ScimMemberRef
+	if err := json.Unmarshal(value, &members); err != nil {
+		return domain.GroupMembersOp{}, newScimError(ScimTypeInvalidValue, "members must be a list of members")
+	}
+	for _, member := range members {
+		if member.Value == "" {
+			return domain.GroupMembersOp{}, newScimError(ScimTypeInvalidValue, "member without a value")
+		}
+	}
+
+	return domain.GroupMembersOp{Kind: domain.GroupMembersOpKind(kind), UserIDs: scimMemberIDs(members)}, nil
+}
+
+func checkScimDisplayName(name domain.TeamName, value json.RawMessage) error {
+	var displayName string
+	if err := json.Unmarshal(value, &displayName); err != nil || displayName != string(name) {
+		return newScimError(ScimTypeMutability, "displayName of a group cannot change")
+	}
+
+	return nil
+}
+
+// parseScimMemberFilter reads the value eq "id" filter of a members path.
+func parseScimMemberFilter(filter string) (domain.UserID, error) {
+	clauses, err := ParseScimFilter(filter)
+	if err != nil {
+		return "", newScimError(ScimTypeInvalidPath, "invalid members filter: %s", err)
+	}
+	if len(clauses) != 1 || clauses[0].Attribute != "value" {
+		return "", newScimError(ScimTypeInvalidPath, "members filter must be value eq \"id\"")
+	}
+
+	return domain.UserID(clauses[0].Value), nil
+}
+
+// ScimFilterClause is an attr eq value comparison; Attribute is lowercased.
+type ScimFilterClause struct {
+	Attribute string
+	Value     string
+}
+
+// ParseScimFilter parses the supported filter subset: eq comparisons joined with and.
+func ParseScimFilter(filter string) ([]ScimFilterClause, error) {
+	tokens, err := scanScimFilter(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	var clauses []ScimFilterClause
+	for i := 0; i < len(tokens); i += 4 {
+		if len(tokens)-i < 3 {
+			return nil, newScimError(ScimTypeInvalidFilter, "incomplete filter %q", filter)
+		}
+		if !strings.EqualFold(tokens[i+1], "eq") {
+			return nil, newScimError(ScimTypeInvalidFilter, "unsupported operator %q, only eq is supported",
+				tokens[i+1])
+		}
+
+		value := tokens[i+2]
+		if strings.HasPrefix(value, `"`) {
+			if value, err = strconv.Unquote(value); err != nil {
+				return nil, newScimError(ScimTypeInvalidFilter, "invalid string in filter %q", filter)
+			}
+		}
+		clauses = append(clauses, ScimFilterClause{Attribute: strings.ToLower(tokens[i]), Value: value})
+
+		if i+3 < len(tokens) && !strings.EqualFold(tokens[i+3], "and") {
+			return nil, newScimError(ScimTypeInvalidFilter, "unsupported operator %q, only and is supported",
+				tokens[i+3])
+		}
+		if i+3 == len(tokens)-1 {
+			return nil, newScimError(ScimTypeInvalidFilter, "incomplete filter %q", filter)
+		}
+	}
+	if len(clauses) == 0 {
+		return nil, newScimError(ScimTypeInvalidFilter, "empty filter")
+	}
+
+	return clauses, nil
+}
+
+// scanScimFilter splits the filter on spaces outside of quoted strings, which keep their quotes.
+func scanScimFilter(filter string) ([]string, error) {
+	var tokens []string
+	for i := 0; i < len(filter); {
+		switch {
+		case filter[i] == ' ':
+			i++
+		case filter[i] == '"':
+			end := i + 1
+			for end < len(filter) && filter[end] != '"' {
+				if filter[end] == '\\' {
+					end++
+				}
+				end++
+			}
+			if end >= len(filter) {
+				return nil, newScimError(ScimTypeInvalidFilter, "unterminated string in filter %q", filter)
+			}
+			tokens = append(tokens, filter[i:end+1])
+			i = end + 1
+		default:
+			end := i
+			for end < len(filter) && filter[end] != ' ' {
+				end++
+			}
+			tokens = append(tokens, filter[i:end])
+			i = end
+		}
+	}
+
+	return tokens, nil
+}
+
+// MapScimUserFilter maps id, externalId, userName, active, emails and groups comparisons to the user filter.
+func MapScimUserFilter(clauses []ScimFilterClause) (domain.ProvisioningUserFilter, error) {
+	var filter domain.ProvisioningUserFilter
+	for _, clause := range clauses {
+		switch clause.Attribute {
+		case "id", "externalid":
+			filter.ID = domain.UserID(clause.Value)
+		case "username":
+			filter.Username = clause.Value
+		case "emails", "emails.value":
+			filter.Email = clause.Value
+		case "groups", "groups.value":
+			filter.TeamName = domain.TeamName(clause.Value)
+		case "active":
+			isActive, err := strconv.ParseBool(clause.Value)
+			if err != nil {
+				return filter, newScimError(ScimTypeInvalidFilter, "active must be compared with true or false")
+			}
+			filter.IsActive = &isActive
+		default:
+			return filter, newScimError(ScimTypeInvalidFilter, "unsupported filter attribute %q", clause.Attribute)
+		}
+	}
+
+	return filter, nil
+}
+
+// MapScimGroupFilter maps id and displayName comparisons to the group filter.
+func MapScimGroupFilter(clauses []ScimFilterClause) (domain.ProvisioningGroupFilter, error) {
+	var filter domain.ProvisioningGroupFilter
+	for _, clause := range clauses {
+		switch clause.Attribute {
+		case "id", "displayname":
+			filter.Name = domain.TeamName(clause.Value)
+		default:
+			return filter, newScimError(ScimTypeInvalidFilter, "unsupported filter attribute %q", clause.Attribute)
+		}
+	}
+
+	return filter, nil
+}
+
+type ScimSupported struct {
+	Supported bool `json:"supported"`
+}
+
+type ScimFilterSupport struct {
+	Supported  bool `json:"supported"`
+	MaxResults int  `json:"maxResults"`
+}
+
+type ScimBulkSupport struct {
+	Supported      bool `json:"supported"`
+	MaxOperations  int  `json:"maxOperations"`
+	MaxPayloadSize int  `json:"maxPayloadSize"`
+}
+
+type ScimAuthenticationScheme struct {
+	Type        string `json:"type"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+type ScimServiceProviderConfigResponse struct {
+	Schemas               []string                   `json:"schemas"`
+	Patch                 ScimSupported              `json:"patch"`
+	Bulk                  ScimBulkSupport            `json:"bulk"`
+	Filter                ScimFilterSupport          `json:"filter"`
+	ChangePassword        ScimSupported              `json:"changePassword"`
+	Sort                  ScimSupported              `json:"sort"`
+	ETag                  ScimSupported              `json:"etag"`
+	AuthenticationSchemes []ScimAuthenticationScheme `json:"authenticationSchemes"`
+	Meta                  ScimMeta                   `json:"meta"`
+}
+
+func NewScimServiceProviderConfigResponse() ScimServiceProviderConfigResponse {
+	return ScimServiceProviderConfigResponse{
+		Schemas: []string{ScimServiceProviderConfigSchema},
+		Patch:   ScimSupported{Supported: true},
+		Filter:  ScimFilterSupport{Supported: true, MaxResults: ScimMaxResults},
+		AuthenticationSchemes: []ScimAuthenticationScheme{{
+			Type:        "oauthbearertoken",
+			Name:        "Bearer token",
+			Description: "The token set in SCIM_BEARER_TOKEN",
+		}},
+		Meta: ScimMeta{
+			ResourceType: "ServiceProviderConfig",
+			Location:     ScimBasePath + "/ServiceProviderConfig",
+		},
+	}
+}
@@ -0,0 +1,25 @@
+package models
+
+import "PrService/src/internal/domain"
+
+type WebhookResult string
+
+const (
+	WebhookResultProcessed WebhookResult = "processed"
+	WebhookResultIgnored   WebhookResult = "ignored"
+)
+
+type WebhookResponse struct {
+	Result WebhookResult        `json:"result"`
+	PR     *PullRequestResponse `json:"pr,omitempty"`
+}
+
+func MapToWebhookResponse(pr *domain.PullRequest) WebhookResponse {
+	if pr == nil {
+		return WebhookResponse{Result: WebhookResultIgnored}
+	}
+
+	resp := MapToPullRequestResponse(*pr)
+
+	return WebhookResponse{Result: WebhookResultProcessed, PR: &resp}
+}
@@ -15,27 +15,45 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/health": {
+        "/admin/apiKeys": {
             "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Health"
+                    "Admin"
                 ],
-                "summary": "Health check",
+                "summary": "Получить API-ключи",
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "Ключи, включая отозванные",
                         "schema": {
-                            "$ref": "#/definitions/models.HealthResponse"
+                            "$ref": "#/definitions/models.APIKeysResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
                         }
                     }
                 }
-            }
-        },
-        "/pullRequest/create": {
+            },
             "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Ключ возвращается только в этом ответе: в базе хранится лишь его хеш.",
                 "consumes": [
                     "application/json"
                 ],
@@ -43,25 +61,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "PullRequests"
+                    "Admin"
                 ],
-                "summary": "Создать PR и автоматически назначить до 2 ревьюверов из команды автора",
+                "summary": "Выпустить API-ключ",
                 "parameters": [
                     {
-                        "description": "Create pull request body",
+                        "description": "Create API key body",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.CreatePullRequestRequest"
+                            "$ref": "#/definitions/models.CreateAPIKeyRequest"
                         }
                     }
                 ],
                 "responses": {
                     "201": {
-                        "description": "PR создан",
+                        "description": "Ключ выпущен",
                         "schema": {
-                            "$ref": "#/definitions/models.PullRequestEnvelopeResponse"
+                            "$ref": "#/definitions/models.CreatedAPIKeyResponse"
                         }
                     },
                     "400": {
@@ -70,18 +88,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/models.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Автор/команда не найдены",
-                        "schema": {
-                            "$ref": "#/definitions/models.ErrorResponse"
-                        }
-                    },
-                    "409": {
-                        "description": "PR уже существует",
-                        "schema": {
-                            "$ref": "#/definitions/models.ErrorResponse"
-                        }
-                    },
                     "500": {
                         "description": "Ошибка сервера",
                         "schema": {
@@ -89,36 +95,35 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/pullRequest/merge": {
-            "post": {
-                "consumes": [
-                    "application/json"
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
                 ],
+                "description": "Отозванный ключ остаётся в списке, но больше не принимается.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "PullRequests"
+                    "Admin"
                 ],
-                "summary": "Пометить PR как MERGED (идемпотентная операция)",
+                "summary": "Отозвать API-ключ",
                 "parameters": [
                     {
-                        "description": "Merge pull request body",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/models.MergePullRequestRequest"
-                        }
+                        "type": "integer",
+                        "description": "Идентификатор ключа",
+                        "name": "api_key_id",
+                        "in": "query",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "PR в состоянии MERGED",
+                        "description": "Ключ отозван",
                         "schema": {
-                            "$ref": "#/definitions/models.PullRequestEnvelopeResponse"
+                            "$ref": "#/definitions/models.APIKeyEnvelopeResponse"
                         }
                     },
                     "400": {
@@ -128,7 +133,7 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "PR не найден",
+                        "description": "Ключ не найден",
                         "schema": {
                             "$ref": "#/definitions/models.ErrorResponse"
                         }
@@ -142,34 +147,52 @@ const docTemplate = `{
                 }
             }
         },
-        "/pullRequest/reassign": {
-            "post": {
-                "consumes": [
-                    "application/json"
+        "/events/stream": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
                 ],
+                "description": "События о назначениях ревьюверов и статусе PR (PR_CREATED, REVIEWER_ASSIGNED, REVIEWER_REASSIGNED, REVIEWER_REMOVED, PR_MERGED, PR_CLOSED, PR_REOPENED, PR_UPDATED) в формате Server-Sent Events.\nid события — позиция в журнале вида \"\u003cтранзакция\u003e-\u003cномер\u003e\"; после переподключения поток продолжается с заголовка Last-Event-ID.",
                 "produces": [
-                    "application/json"
+                    "text/event-stream"
                 ],
                 "tags": [
-                    "PullRequests"
+                    "Events"
                 ],
-                "summary": "Переназначить конкретного ревьювера на другого из его команды",
+                "summary": "Поток событий назначения ревьюверов (SSE)",
                 "parameters": [
                     {
-                        "description": "Reassign pull request reviewer body",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/models.ReassignPullRequestRequest"
-                        }
+                        "type": "string",
+                        "description": "Только события, где пользователь автор или ревьювер",
+                        "name": "user_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Только события с участием членов команды",
+                        "name": "team_name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Продолжить после события (если нет заголовка Last-Event-ID)",
+                        "name": "last_event_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Продолжить после события",
+                        "name": "Last-Event-ID",
+                        "in": "header"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Переназначение выполнено",
+                        "description": "Поток событий",
                         "schema": {
-                            "$ref": "#/definitions/models.ReassignPullRequestResponse"
+                            "type": "string"
                         }
                     },
                     "400": {
@@ -179,13 +202,7 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "PR или пользователь не найден",
-                        "schema": {
-                            "$ref": "#/definitions/models.ErrorResponse"
-                        }
-                    },
-                    "409": {
-                        "description": "Нарушение доменных правил переназначения",
+                        "description": "Команда не найдена",
                         "schema": {
                             "$ref": "#/definitions/models.ErrorResponse"
                         }
@@ -199,81 +216,78 @@ const docTemplate = `{
                 }
             }
         },
-        "/team/add": {
-            "post": {
-                "consumes": [
-                    "application/json"
+        "/export/assignments": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
                 ],
+                "description": "Формат выбирается заголовком Accept: text/csv (по умолчанию) или application/x-ndjson.\nСтроки передаются потоком по мере чтения из базы в порядке назначения.",
                 "produces": [
-                    "application/json"
+                    "text/csv",
+                    "application/x-ndjson"
                 ],
                 "tags": [
-                    "Teams"
+                    "Export"
                 ],
-                "summary": "Создать команду с участниками (создаёт/обновляет пользователей)",
+                "summary": "Выгрузить журнал назначений ревьюверов в CSV или NDJSON",
                 "parameters": [
                     {
-                        "description": "Add team body",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/models.AddTeamRequest"
-                        }
+                        "type": "string",
+                        "description": "Только назначения в слоты команды",
+                        "name": "team_name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Только назначения ревьювера",
+                        "name": "reviewer_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Только назначения на PR",
+                        "name": "pull_request_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Начало окна назначений (RFC3339 или YYYY-MM-DD)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Конец окна назначений, не включая (RFC3339 или YYYY-MM-DD)",
+                        "name": "to",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Команда создана",
+                    "200": {
+                        "description": "Выгрузка назначений",
                         "schema": {
-                            "$ref": "#/definitions/models.AddTeamResponse"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.AssignmentExportRecord"
+                            }
                         }
                     },
                     "400": {
-                        "description": "Команда уже существует или неверный запрос",
+                        "description": "Неверный запрос",
                         "schema": {
                             "$ref": "#/definitions/models.ErrorResponse"
                         }
                     },
-                    "500": {
-                        "description": "Ошибка сервера",
+                    "404": {
+                        "description": "Команда не найдена",
                         "schema": {
                             "$ref": "#/definitions/models.ErrorResponse"
                         }
-                    }
-                }
-            }
-        },
-        "/team/get": {
-            "get": {
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Teams"
-                ],
-                "summary": "Получить команду с участниками",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Уникальное имя команды",
-                        "name": "team_name",
-                        "in": "query",
-                        "required": true
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "Объект команды",
-                        "schema": {
-                            "$ref": "#/definitions/models.TeamResponse"
-                        }
                     },
-                    "404": {
-                        "description": "Команда не найдена",
+                    "406": {
+                        "description": "Неподдерживаемый формат",
                         "schema": {
                             "$ref": "#/definitions/models.ErrorResponse"
                         }
@@ -287,32 +301,68 @@ const docTemplate = `{
                 }
             }
         },
-        "/team/stats": {
+        "/export/pullRequests": {
             "get": {
-                "consumes": [
-                    "application/json"
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
                 ],
+                "description": "Формат выбирается заголовком Accept: text/csv (по умолчанию) или application/x-ndjson.\nСтроки передаются потоком по мере чтения из базы, самые старые PR первыми.",
                 "produces": [
-                    "application/json"
+                    "text/csv",
+                    "application/x-ndjson"
                 ],
                 "tags": [
-                    "Teams"
+                    "Export"
                 ],
-                "summary": "Получить статистику по команде",
+                "summary": "Выгрузить PR в CSV или NDJSON",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Уникальное имя команды",
+                        "description": "Статус PR (DRAFT, OPEN, MERGED, CLOSED)",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Только PR авторов из команды",
                         "name": "team_name",
-                        "in": "query",
-                        "required": true
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Только PR автора",
+                        "name": "author_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Только PR, где пользователь назначен ревьювером",
+                        "name": "reviewer_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Начало окна создания PR (RFC3339 или YYYY-MM-DD)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Конец окна создания PR, не включая (RFC3339 или YYYY-MM-DD)",
+                        "name": "to",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Статистика по команде",
+                        "description": "Выгрузка PR",
                         "schema": {
-                            "$ref": "#/definitions/models.TeamStatsResponse"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.PullRequestExportRecord"
+                            }
                         }
                     },
                     "400": {
@@ -327,6 +377,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/models.ErrorResponse"
                         }
                     },
+                    "406": {
+                        "description": "Неподдерживаемый формат",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Ошибка сервера",
                         "schema": {
@@ -336,87 +392,76 @@ const docTemplate = `{
                 }
             }
         },
-        "/users/getReview": {
+        "/health": {
             "get": {
-                "consumes": [
-                    "application/json"
-                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Users"
-                ],
-                "summary": "Получить PR'ы, где пользователь назначен ревьювером",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Идентификатор пользователя",
-                        "name": "user_id",
-                        "in": "query",
-                        "required": true
-                    }
+                    "Health"
                 ],
+                "summary": "Health check",
                 "responses": {
                     "200": {
-                        "description": "Список PR'ов пользователя",
-                        "schema": {
-                            "$ref": "#/definitions/models.GetUserReviewsResponse"
-                        }
-                    },
-                    "400": {
-                        "description": "отсутствующий или неверный user_id",
-                        "schema": {
-                            "$ref": "#/definitions/models.ErrorResponse"
-                        }
-                    },
-                    "500": {
-                        "description": "Ошибка сервера",
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.ErrorResponse"
+                            "$ref": "#/definitions/models.HealthResponse"
                         }
                     }
                 }
             }
         },
-        "/users/setIsActive": {
+        "/import/teams": {
             "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Формат определяется заголовком Content-Type: application/yaml или text/csv.\nУчастники перечисленных команд, которых нет в документе, деактивируются; остальные команды не меняются.\nС dry_run=true изменения только рассчитываются, иначе применяются в одной транзакции.",
                 "consumes": [
-                    "application/json"
+                    "application/yaml",
+                    "text/csv"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Users"
+                    "Teams"
                 ],
-                "summary": "Установить флаг активности пользователя",
+                "summary": "Массово создать и обновить команды и пользователей из YAML или CSV",
                 "parameters": [
                     {
-                        "description": "Set is active body",
+                        "type": "boolean",
+                        "description": "Только показать изменения",
+                        "name": "dry_run",
+                        "in": "query"
+                    },
+                    {
+                        "description": "Документ с командами и участниками",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.SetUserIsActiveRequest"
+                            "type": "string"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Обновлённый пользователь",
+                        "description": "Изменения импорта",
                         "schema": {
-                            "$ref": "#/definitions/models.SetUserIsActiveResponse"
+                            "$ref": "#/definitions/models.TeamImportResponse"
                         }
                     },
                     "400": {
-                        "description": "неверный запрос",
+                        "description": "Неверный документ",
                         "schema": {
                             "$ref": "#/definitions/models.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Пользователь не найден",
+                    "415": {
+                        "description": "Неподдерживаемый формат",
                         "schema": {
                             "$ref": "#/definitions/models.ErrorResponse"
                         }
@@ -429,9 +474,2972 @@ const docTemplate = `{
                     }
                 }
             }
-        }
-    },
+        },
+        "/pullRequest/addReviewer": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "PullRequests"
+                ],
+                "summary": "Вручную добавить ревьювера на PR",
+                "parameters": [
+                    {
+                        "description": "Add reviewer body",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.PullRequestReviewerRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Ревьювер добавлен",
+                        "schema": {
+                            "$ref": "#/definitions/models.PullRequestEnvelopeResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Неверный запрос",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Нет прав менять ревьюверов PR",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "PR или пользователь не найден",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "PR не открыт или нарушены доменные правила назначения",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/pullRequest/create": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "PullRequests"
+                ],
+                "summary": "Создать PR и назначить ревьюверов из команды автора, extra_teams и required_reviewers",
+                "parameters": [
+                    {
+                        "description": "Create pull request body",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.CreatePullRequestRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "PR создан",
+                        "schema": {
+                            "$ref": "#/definitions/models.PullRequestEnvelopeResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Неверный запрос",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "PR от имени другого пользователя",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Автор/команда/ревьювер не найдены",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "PR уже существует или ревьювер недоступен",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/pullRequest/history": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "PullRequests"
+                ],
+                "summary": "Получить историю автоматических действий над PR",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор PR",
+                        "name": "pull_request_id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "История PR",
+                        "schema": {
+                            "$ref": "#/definitions/models.PullRequestHistoryResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Неверный запрос",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "PR не найден",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/pullRequest/markReviewed": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Завершает SLA назначения: ревьюверу больше не отправляются напоминания и ревью не эскалируется.\nПользователь может отметить только своё ревью.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "PullRequests"
+                ],
+                "summary": "Отметить, что ревьювер оставил ревью",
+                "parameters": [
+                    {
+                        "description": "Mark reviewed body",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.PullRequestReviewerRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Ревью отмечено",
+                        "schema": {
+                            "$ref": "#/definitions/models.PullRequestEnvelopeResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Неверный запрос",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Чужое ревью",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "PR не найден",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Ревьювер не назначен",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/pullRequest/merge": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "PullRequests"
+                ],
+                "summary": "Пометить PR как MERGED (идемпотентная операция)",
+                "parameters": [
+                    {
+                        "description": "Merge pull request body",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.MergePullRequestRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "PR в состоянии MERGED",
+                        "schema": {
+                            "$ref": "#/definitions/models.PullRequestEnvelopeResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Неверный запрос",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Мержить может только автор PR или администратор",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "PR не найден",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "PR закрыт без merge",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/pullRequest/reassign": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "PullRequests"
+                ],
+                "summary": "Переназначить ревьювера на случайного или указанного (new_reviewer_id) участника его команды",
+                "parameters": [
+                    {
+                        "description": "Reassign pull request reviewer body",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.ReassignPullRequestRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Переназначение выполнено",
+                        "schema": {
+                            "$ref": "#/definitions/models.ReassignPullRequestResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Неверный запрос",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Нет прав менять ревьюверов PR",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "PR или пользователь не найден",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Нарушение доменных правил переназначения",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/pullRequest/removeReviewer": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "PullRequests"
+                ],
+                "summary": "Вручную снять ревьювера с PR",
+                "parameters": [
+                    {
+                        "description": "Remove reviewer body",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.PullRequestReviewerRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Ревьювер снят",
+                        "schema": {
+                            "$ref": "#/definitions/models.PullRequestEnvelopeResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Неверный запрос",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Нет прав менять ревьюверов PR",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "PR не найден",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "PR уже смержен или ревьювер не назначен",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/scim/v2/Groups": {
+            "get": {
+                "description": "Группа — это команда, её участники — активные пользователи команды.\nfilter поддерживает сравнения eq по id и displayName.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "SCIM"
+                ],
+                "summary": "Список групп SCIM",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer \u003cSCIM_BEARER_TOKEN\u003e",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Например, displayName eq \\",
+                        "name": "filter",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Номер первого результата",
+                        "name": "startIndex",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Размер страницы",
+                        "name": "count",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Группы",
+                        "schema": {
+                            "$ref": "#/definitions/models.ScimGroupListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Неверный фильтр",
+                        "schema": {
+                            "$ref": "#/definitions/models.ScimErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Неверный токен",
+                        "schema": {
+                            "$ref": "#/definitions/models.ScimErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/models.ScimErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Создаёт команду с именем displayName; участники переносятся в неё и активируются.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "SCIM"
+                ],
+                "summary": "Создать группу SCIM",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer \u003cSCIM_BEARER_TOKEN\u003e",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "SCIM group",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.ScimGroupRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Группа создана",
+                        "schema": {
+                            "$ref": "#/definitions/models.ScimGroupResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Неверный запрос",
+                        "schema": {
+                            "$ref": "#/definitions/models.ScimErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Неверный токен",
+                        "schema": {
+                            "$ref": "#/definitions/models.ScimErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Команда уже существует",
+                        "schema": {
+                            "$ref": "#/definitions/models.ScimErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/models.ScimErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/scim/v2/Groups/{id}": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "SCIM"
+                ],
+                "summary": "Получить группу SCIM",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer \u003cSCIM_BEARER_TOKEN\u003e",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Имя команды",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Группа",
+                        "schema": {
+                            "$ref": "#/definitions/models.ScimGroupResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Неверный токен",
+                        "schema": {
+                            "$ref": "#/definitions/models.ScimErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Команда не найдена",
+                        "schema": {
+                            "$ref": "#/definitions/models.ScimErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/models.ScimErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Новые участники переносятся в команду и активируются, отсутствующие в списке деактивируются,\nа их открытые ревью переназначаются. displayName менять нельзя.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "SCIM"
+                ],
+                "summary": "Заменить участников группы SCIM",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer \u003cSCIM_BEARER_TOKEN\u003e",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Имя команды",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "SCIM group",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.ScimGroupRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Группа обновлена",
+                        "schema": {
+                            "$ref": "#/definitions/models.ScimGroupResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Неверный запрос",
+                        "schema": {
+                            "$ref": "#/definitions/models.ScimErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Неверный токен",
+                        "schema": {
+                            "$ref": "#/definitions/models.ScimErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Команда не найдена",
+                        "schema": {
+                            "$ref": "#/definitions/models.ScimErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/models.ScimErrorResponse"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "description": "Поддерживаются add, replace и remove над members, включая путь members[value eq \"id\"].\nУдалённые участники деактивируются, а их открытые ревью переназначаются.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "SCIM"
+                ],
+                "summary": "Изменить участников группы SCIM",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer \u003cSCIM_BEARER_TOKEN\u003e",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Имя команды",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "SCIM PatchOp",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.ScimPatchRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Группа обновлена",
+                        "schema": {
+                            "$ref": "#/definitions/models.ScimGroupResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Неверный запрос",
+                        "schema": {
+                            "$ref": "#/definitions/models.ScimErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Неверный токен",
+                        "schema": {
+                            "$ref": "#/definitions/models.ScimErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Команда не найдена",
+                        "schema": {
+                            "$ref": "#/definitions/models.ScimErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/models.ScimErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/scim/v2/ServiceProviderConfig": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "SCIM"
+                ],
+                "summary": "Возможности SCIM-сервера",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer \u003cSCIM_BEARER_TOKEN\u003e",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Поддерживаемые возможности",
+                        "schema": {
+                            "$ref": "#/definitions/models.ScimServiceProviderConfigResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Неверный токен",
+                        "schema": {
+                            "$ref": "#/definitions/models.ScimErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/scim/v2/Users": {
+            "get": {
+                "description": "filter поддерживает сравнения eq, объединённые and, по id, externalId, userName, active,\nemails и groups. Нумерация startIndex начинается с 1, count — не больше 100.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "SCIM"
+                ],
+                "summary": "Список пользователей SCIM",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer \u003cSCIM_BEARER_TOKEN\u003e",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Например, userName eq \\",
+                        "name": "filter",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Номер первого результата",
+                        "name": "startIndex",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Размер страницы",
+                        "name": "count",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Пользователи",
+                        "schema": {
+                            "$ref": "#/definitions/models.ScimUserListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Неверный фильтр",
+                        "schema": {
+                            "$ref": "#/definitions/models.ScimErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Неверный токен",
+                        "schema": {
+                            "$ref": "#/definitions/models.ScimErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/models.ScimErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "id пользователя — externalId, а без него — userName. Пользователь попадает в команду\nSCIM_DEFAULT_TEAM, пока его не добавят в группу.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "SCIM"
+                ],
+                "summary": "Создать пользователя SCIM",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer \u003cSCIM_BEARER_TOKEN\u003e",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "SCIM user",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.ScimUserRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Пользователь создан",
+                        "schema": {
+                            "$ref": "#/definitions/models.ScimUserResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Неверный запрос",
+                        "schema": {
+                            "$ref": "#/definitions/models.ScimErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Неверный токен",
+                        "schema": {
+                            "$ref": "#/definitions/models.ScimErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Пользователь уже существует",
+                        "schema": {
+                            "$ref": "#/definitions/models.ScimErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/models.ScimErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/scim/v2/Users/{id}": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "SCIM"
+                ],
+                "summary": "Получить пользователя SCIM",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer \u003cSCIM_BEARER_TOKEN\u003e",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Идентификатор пользователя",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Пользователь",
+                        "schema": {
+                            "$ref": "#/definitions/models.ScimUserResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Неверный токен",
+                        "schema": {
+                            "$ref": "#/definitions/models.ScimErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Пользователь не найден",
+                        "schema": {
+                            "$ref": "#/definitions/models.ScimErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/models.ScimErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Меняет userName, active и email; команда меняется только через группы.\nПри деактивации открытые ревью пользователя переназначаются.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "SCIM"
+                ],
+                "summary": "Заменить пользователя SCIM",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer \u003cSCIM_BEARER_TOKEN\u003e",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Идентификатор пользователя",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "SCIM user",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.ScimUserRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Пользователь обновлён",
+                        "schema": {
+                            "$ref": "#/definitions/models.ScimUserResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Неверный запрос",
+                        "schema": {
+                            "$ref": "#/definitions/models.ScimErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Неверный токен",
+                        "schema": {
+                            "$ref": "#/definitions/models.ScimErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Пользователь не найден",
+                        "schema": {
+                            "$ref": "#/definitions/models.ScimErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/models.ScimErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Пользователь деактивируется, его открытые ревью переназначаются; история ревью сохраняется.",
+                "tags": [
+                    "SCIM"
+                ],
+                "summary": "Удалить пользователя SCIM",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer \u003cSCIM_BEARER_TOKEN\u003e",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Идентификатор пользователя",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Пользователь деактивирован"
+                    },
+                    "401": {
+                        "description": "Неверный токен",
+                        "schema": {
+                            "$ref": "#/definitions/models.ScimErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Пользователь не найден",
+                        "schema": {
+                            "$ref": "#/definitions/models.ScimErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/models.ScimErrorResponse"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "description": "Поддерживаются операции add, replace и remove над userName, active и emails.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "SCIM"
+                ],
+                "summary": "Изменить пользователя SCIM",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer \u003cSCIM_BEARER_TOKEN\u003e",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Идентификатор пользователя",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "SCIM PatchOp",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.ScimPatchRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Пользователь обновлён",
+                        "schema": {
+                            "$ref": "#/definitions/models.ScimUserResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Неверный запрос",
+                        "schema": {
+                            "$ref": "#/definitions/models.ScimErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Неверный токен",
+                        "schema": {
+                            "$ref": "#/definitions/models.ScimErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Пользователь не найден",
+                        "schema": {
+                            "$ref": "#/definitions/models.ScimErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/models.ScimErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/stats/fairness": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Stats"
+                ],
+                "summary": "Получить отчёт о равномерности назначений в команде",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Уникальное имя команды",
+                        "name": "team_name",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Начало окна назначений (RFC3339 или YYYY-MM-DD)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Конец окна назначений, не включая (RFC3339 или YYYY-MM-DD)",
+                        "name": "to",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Распределение назначений по активным участникам",
+                        "schema": {
+                            "$ref": "#/definitions/models.FairnessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Неверный запрос",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Команда не найдена",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/stats/overview": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Stats"
+                ],
+                "summary": "Получить сводную статистику по всем командам",
+                "responses": {
+                    "200": {
+                        "description": "Сводная статистика",
+                        "schema": {
+                            "$ref": "#/definitions/models.StatsOverviewResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/stats/users": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Stats"
+                ],
+                "summary": "Получить статистику назначений по пользователям",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Только участники команды",
+                        "name": "team_name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Начало окна назначений (RFC3339 или YYYY-MM-DD)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Конец окна назначений, не включая (RFC3339 или YYYY-MM-DD)",
+                        "name": "to",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Статистика пользователей, самые загруженные первыми",
+                        "schema": {
+                            "$ref": "#/definitions/models.UserStatsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Неверный запрос",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Команда не найдена",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/team/add": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Teams"
+                ],
+                "summary": "Создать команду с участниками (создаёт/обновляет пользователей)",
+                "parameters": [
+                    {
+                        "description": "Add team body",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.AddTeamRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Команда создана",
+                        "schema": {
+                            "$ref": "#/definitions/models.AddTeamResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Команда уже существует или неверный запрос",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/team/codeowners": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Teams"
+                ],
+                "summary": "Получить CODEOWNERS команды",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Уникальное имя команды",
+                        "name": "team_name",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "CODEOWNERS команды",
+                        "schema": {
+                            "$ref": "#/definitions/models.CodeOwnersResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Неверный запрос",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Команда или CODEOWNERS не найдены",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Teams"
+                ],
+                "summary": "Загрузить CODEOWNERS команды (синтаксис GitHub)",
+                "parameters": [
+                    {
+                        "description": "Upload CODEOWNERS body",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.UploadCodeOwnersRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Разобранный CODEOWNERS",
+                        "schema": {
+                            "$ref": "#/definitions/models.CodeOwnersResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Неверный запрос или синтаксис CODEOWNERS",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Команда не найдена",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/team/get": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Teams"
+                ],
+                "summary": "Получить команду с участниками",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Уникальное имя команды",
+                        "name": "team_name",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Объект команды",
+                        "schema": {
+                            "$ref": "#/definitions/models.TeamResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Команда не найдена",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/team/rules": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Teams"
+                ],
+                "summary": "Получить правила назначения ревьюверов команды",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Уникальное имя команды",
+                        "name": "team_name",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Правила команды",
+                        "schema": {
+                            "$ref": "#/definitions/models.TeamRulesResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Неверный запрос",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Команда не найдена",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Teams"
+                ],
+                "summary": "Добавить правило назначения ревьюверов команды",
+                "parameters": [
+                    {
+                        "description": "Create team rule body",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.CreateTeamRuleRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Правило создано",
+                        "schema": {
+                            "$ref": "#/definitions/models.TeamRuleEnvelopeResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Неверный запрос или правило",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Команда не найдена",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Teams"
+                ],
+                "summary": "Удалить правило назначения ревьюверов команды",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Уникальное имя команды",
+                        "name": "team_name",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Идентификатор правила",
+                        "name": "rule_id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Правило удалено"
+                    },
+                    "400": {
+                        "description": "Неверный запрос",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Правило не найдено",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/team/setMaxReviewers": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Teams"
+                ],
+                "summary": "Установить максимальное число ревьюверов на PR из команды",
+                "parameters": [
+                    {
+                        "description": "Set max reviewers body",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.SetTeamMaxReviewersRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Обновлённая команда",
+                        "schema": {
+                            "$ref": "#/definitions/models.SetTeamMaxReviewersResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Неверный запрос",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Команда не найдена",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/team/sla": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Teams"
+                ],
+                "summary": "Получить SLA ревью команды",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Уникальное имя команды",
+                        "name": "team_name",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "SLA команды",
+                        "schema": {
+                            "$ref": "#/definitions/models.ReviewSLAEnvelopeResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Неверный запрос",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Команда или SLA не найдены",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Teams"
+                ],
+                "summary": "Задать SLA ревью команды",
+                "parameters": [
+                    {
+                        "description": "Set review SLA body",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.SetReviewSLARequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "SLA сохранён",
+                        "schema": {
+                            "$ref": "#/definitions/models.ReviewSLAEnvelopeResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Неверный запрос",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Команда не найдена",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Teams"
+                ],
+                "summary": "Отключить SLA ревью команды",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Уникальное имя команды",
+                        "name": "team_name",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "SLA удалён"
+                    },
+                    "400": {
+                        "description": "Неверный запрос",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "SLA не найден",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/team/stats": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Teams"
+                ],
+                "summary": "Получить статистику по команде",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Уникальное имя команды",
+                        "name": "team_name",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Статистика по команде",
+                        "schema": {
+                            "$ref": "#/definitions/models.TeamStatsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Неверный запрос",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Команда не найдена",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/team/stats/timeseries": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Teams"
+                ],
+                "summary": "Получить динамику PR команды по интервалам",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Уникальное имя команды",
+                        "name": "team_name",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Интервал: day (по умолчанию) или week",
+                        "name": "interval",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Начало окна (RFC3339 или YYYY-MM-DD), по умолчанию 30 интервалов до to",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Конец окна, не включая (RFC3339 или YYYY-MM-DD), по умолчанию сейчас",
+                        "name": "to",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Показатели по интервалам",
+                        "schema": {
+                            "$ref": "#/definitions/models.TeamStatsTimeSeriesResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Неверный запрос",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Команда не найдена",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/team/sync": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Тело — JSON или YAML (Content-Type: application/yaml) с полным списком участников.\nНовые участники добавляются, изменённые обновляются, отсутствующие в списке деактивируются,\nа их открытые ревью переназначаются. Повторный вызов с тем же списком ничего не меняет.",
+                "consumes": [
+                    "application/json",
+                    "application/yaml"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Teams"
+                ],
+                "summary": "Привести состав команды к желаемому (идемпотентно)",
+                "parameters": [
+                    {
+                        "description": "Sync team body",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.SyncTeamRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Применённые изменения",
+                        "schema": {
+                            "$ref": "#/definitions/models.SyncTeamResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Неверный запрос",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/getReview": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Получить PR'ы, где пользователь назначен ревьювером",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор пользователя",
+                        "name": "user_id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Список PR'ов пользователя",
+                        "schema": {
+                            "$ref": "#/definitions/models.GetUserReviewsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "отсутствующий или неверный user_id",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/linkAccount": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Связать логин внешней платформы (GitHub, GitLab) с пользователем",
+                "parameters": [
+                    {
+                        "description": "Link external account body",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.LinkExternalAccountRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Связанный аккаунт",
+                        "schema": {
+                            "$ref": "#/definitions/models.LinkExternalAccountResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Неверный запрос",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Пользователь из чужой команды",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Пользователь не найден",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/list": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Получить список пользователей с фильтрами по команде, грейду и тегам",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Имя команды",
+                        "name": "team_name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Грейд: JUNIOR, MIDDLE, SENIOR, LEAD",
+                        "name": "seniority",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Теги, которые должны быть у пользователя (все)",
+                        "name": "tag",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Список пользователей",
+                        "schema": {
+                            "$ref": "#/definitions/models.ListUsersResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Неверный грейд",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/setChatHandle": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Пустой chat_handle отключает уведомления в чат.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Указать ник пользователя в чате для уведомлений",
+                "parameters": [
+                    {
+                        "description": "Set chat handle body",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.SetUserChatHandleRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Обновлённый пользователь",
+                        "schema": {
+                            "$ref": "#/definitions/models.SetUserChatHandleResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Неверный запрос",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Пользователь из чужой команды",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Пользователь не найден",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/setEmail": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "email_opt_out=true отключает все письма пользователю, включая ежедневный дайджест.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Указать email пользователя для уведомлений и дайджеста",
+                "parameters": [
+                    {
+                        "description": "Set email body",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.SetUserEmailRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Обновлённый пользователь",
+                        "schema": {
+                            "$ref": "#/definitions/models.SetUserEmailResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Неверный запрос",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Пользователь из чужой команды",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Пользователь не найден",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/setIsActive": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Установить флаг активности пользователя",
+                "parameters": [
+                    {
+                        "description": "Set is active body",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.SetUserIsActiveRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Обновлённый пользователь и переназначенные ревью",
+                        "schema": {
+                            "$ref": "#/definitions/models.SetUserIsActiveResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "неверный запрос",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Тимлид может менять только участников своей команды",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Пользователь не найден",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/webhooks/github": {
+            "post": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "Принять webhook GitHub (события pull_request и pull_request_review) и применить его к PR",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Тип события GitHub",
+                        "name": "X-GitHub-Event",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "HMAC-SHA256 подпись тела запроса",
+                        "name": "X-Hub-Signature-256",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "GitHub pull_request payload",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.GitHubPullRequestEvent"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Событие применено или проигнорировано",
+                        "schema": {
+                            "$ref": "#/definitions/models.WebhookResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Неверный payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Неверная подпись",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "PR, пользователь или команда не найдены",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Недопустимый переход статуса PR",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Логин GitHub не связан с пользователем",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/webhooks/gitlab": {
+            "post": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "Принять webhook GitLab (Merge Request Hook) и применить его к жизненному циклу PR",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Тип события GitLab",
+                        "name": "X-Gitlab-Event",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Секретный токен webhook'а",
+                        "name": "X-Gitlab-Token",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Идентификатор события для дедупликации",
+                        "name": "X-Gitlab-Event-UUID",
+                        "in": "header"
+                    },
+                    {
+                        "description": "GitLab merge request payload",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.GitLabMergeRequestEvent"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Событие применено или проигнорировано",
+                        "schema": {
+                            "$ref": "#/definitions/models.WebhookResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Неверный payload",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Неверный токен",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "PR, пользователь или команда не найдены",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Недопустимый переход статуса PR",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Логин GitLab не связан с пользователем",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/webhooks/subscriptions": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "Получить подписки на исходящие вебхуки",
+                "responses": {
+                    "200": {
+                        "description": "Подписки",
+                        "schema": {
+                            "$ref": "#/definitions/models.WebhookSubscriptionsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "События доставляются POST-запросом с JSON-телом и подписью HMAC-SHA256 в X-PRService-Signature-256.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "Подписаться на события сервиса",
+                "parameters": [
+                    {
+                        "description": "Create subscription body",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.CreateWebhookSubscriptionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Подписка создана",
+                        "schema": {
+                            "$ref": "#/definitions/models.WebhookSubscriptionEnvelopeResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Неверный запрос",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "Удалить подписку на вебхуки",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Идентификатор подписки",
+                        "name": "subscription_id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Подписка удалена"
+                    },
+                    "400": {
+                        "description": "Неверный запрос",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Подписка не найдена",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/webhooks/subscriptions/deliveries": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "Получить журнал доставок подписки",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Идентификатор подписки",
+                        "name": "subscription_id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Количество последних доставок (по умолчанию 50, максимум 500)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Доставки, новые первыми",
+                        "schema": {
+                            "$ref": "#/definitions/models.WebhookDeliveriesResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Неверный запрос",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Подписка не найдена",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        }
+    },
     "definitions": {
+        "models.APIKeyEnvelopeResponse": {
+            "type": "object",
+            "properties": {
+                "api_key": {
+                    "$ref": "#/definitions/models.APIKeyResponse"
+                }
+            }
+        },
+        "models.APIKeyResponse": {
+            "type": "object",
+            "properties": {
+                "api_key_id": {
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "prefix": {
+                    "type": "string"
+                },
+                "revoked_at": {
+                    "type": "string"
+                },
+                "scopes": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "models.APIKeysResponse": {
+            "type": "object",
+            "properties": {
+                "api_keys": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.APIKeyResponse"
+                    }
+                }
+            }
+        },
         "models.AddTeamRequest": {
             "type": "object",
             "required": [
@@ -442,7 +3450,1483 @@ const docTemplate = `{
                 "members": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/models.TeamMemberRequest"
+                        "$ref": "#/definitions/models.TeamMemberRequest"
+                    }
+                },
+                "team_name": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.AddTeamResponse": {
+            "type": "object",
+            "properties": {
+                "team": {
+                    "$ref": "#/definitions/models.TeamResponse"
+                }
+            }
+        },
+        "models.AssignmentExportRecord": {
+            "type": "object",
+            "properties": {
+                "assigned_at": {
+                    "type": "string"
+                },
+                "pull_request_id": {
+                    "type": "string"
+                },
+                "pull_request_status": {
+                    "type": "string"
+                },
+                "reassigned": {
+                    "type": "boolean"
+                },
+                "reviewer_id": {
+                    "type": "string"
+                },
+                "team_name": {
+                    "type": "string"
+                },
+                "unassigned_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.CodeOwnersEntryResponse": {
+            "type": "object",
+            "properties": {
+                "line": {
+                    "type": "integer"
+                },
+                "owners": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "pattern": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.CodeOwnersResponse": {
+            "type": "object",
+            "properties": {
+                "content": {
+                    "type": "string"
+                },
+                "entries": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.CodeOwnersEntryResponse"
+                    }
+                },
+                "fill_strategy": {
+                    "type": "string"
+                },
+                "mode": {
+                    "type": "string"
+                },
+                "team_name": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.CreateAPIKeyRequest": {
+            "type": "object",
+            "required": [
+                "name",
+                "scopes"
+            ],
+            "properties": {
+                "name": {
+                    "type": "string"
+                },
+                "scopes": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "models.CreatePullRequestRequest": {
+            "type": "object",
+            "required": [
+                "author_id",
+                "changed_files",
+                "extra_teams",
+                "pull_request_id",
+                "pull_request_name",
+                "required_reviewers",
+                "tags"
+            ],
+            "properties": {
+                "author_id": {
+                    "type": "string"
+                },
+                "changed_files": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "extra_teams": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "pull_request_id": {
+                    "type": "string"
+                },
+                "pull_request_name": {
+                    "type": "string"
+                },
+                "required_reviewers": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "models.CreateTeamRuleRequest": {
+            "type": "object",
+            "required": [
+                "kind",
+                "team_name",
+                "users"
+            ],
+            "properties": {
+                "author_id": {
+                    "type": "string"
+                },
+                "kind": {
+                    "type": "string",
+                    "enum": [
+                        "EXCLUDE_PAIR",
+                        "REQUIRE_ONE_OF",
+                        "MAX_OF"
+                    ]
+                },
+                "max_count": {
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "reviewer_id": {
+                    "type": "string"
+                },
+                "seniority": {
+                    "type": "string",
+                    "enum": [
+                        "JUNIOR",
+                        "MIDDLE",
+                        "SENIOR",
+                        "LEAD"
+                    ]
+                },
+                "team_name": {
+                    "type": "string"
+                },
+                "users": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "models.CreateWebhookSubscriptionRequest": {
+            "type": "object",
+            "required": [
+                "event_types",
+                "secret",
+                "url"
+            ],
+            "properties": {
+                "event_types": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "secret": {
+                    "type": "string",
+                    "minLength": 16
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.CreatedAPIKeyResponse": {
+            "type": "object",
+            "properties": {
+                "api_key": {
+                    "$ref": "#/definitions/models.APIKeyResponse"
+                },
+                "key": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.ErrorBody": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "$ref": "#/definitions/models.ErrorCode"
+                },
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.ErrorCode": {
+            "type": "string",
+            "enum": [
+                "TEAM_EXISTS",
+                "PR_EXISTS",
+                "PR_MERGED",
+                "NOT_ASSIGNED",
+                "NO_CANDIDATE",
+                "REVIEWER_INACTIVE",
+                "REVIEWER_IS_AUTHOR",
+                "ALREADY_ASSIGNED",
+                "NOT_IN_TEAM",
+                "REVIEWERS_LIMIT",
+                "RULE_VIOLATION",
+                "INVALID_CODEOWNERS",
+                "PR_CLOSED",
+                "PR_NOT_OPEN",
+                "UNKNOWN_ACCOUNT",
+                "INVALID_SIGNATURE",
+                "INVALID_TOKEN",
+                "UNAUTHORIZED",
+                "FORBIDDEN",
+                "NOT_FOUND",
+                "DECODE_FAILED",
+                "VALIDATION_FAILED",
+                "INTERNAL_SERVER_ERROR",
+                "NOT_ACCEPTABLE",
+                "UNSUPPORTED_MEDIA_TYPE"
+            ],
+            "x-enum-varnames": [
+                "ErrorCodeTeamExists",
+                "ErrorCodePRExists",
+                "ErrorCodePRMerged",
+                "ErrorCodeNotAssigned",
+                "ErrorCodeNoCandidate",
+                "ErrorCodeReviewerInactive",
+                "ErrorCodeReviewerIsAuthor",
+                "ErrorCodeAlreadyAssigned",
+                "ErrorCodeNotInTeam",
+                "ErrorCodeReviewersLimit",
+                "ErrorCodeRuleViolation",
+                "ErrorCodeInvalidCodeOwners",
+                "ErrorCodePRClosed",
+                "ErrorCodePRNotOpen",
+                "ErrorCodeUnknownAccount",
+                "ErrorCodeInvalidSignature",
+                "ErrorCodeInvalidToken",
+                "ErrorCodeUnauthorized",
+                "ErrorCodeForbidden",
+                "ErrorCodeNotFound",
+                "ErrorCodeDecodeFailed",
+                "ErrorCodeValidationFailed",
+                "ErrorCodeInternalServer",
+                "ErrorCodeNotAcceptable",
+                "ErrorCodeUnsupportedMedia"
+            ]
+        },
+        "models.ErrorResponse": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "$ref": "#/definitions/models.ErrorBody"
+                }
+            }
+        },
+        "models.ExternalAccountResponse": {
+            "type": "object",
+            "properties": {
+                "login": {
+                    "type": "string"
+                },
+                "provider": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.FairnessOutlierResponse": {
+            "type": "object",
+            "properties": {
+                "assignments": {
+                    "type": "integer"
+                },
+                "kind": {
+                    "type": "string"
+                },
+                "ratio_to_mean": {
+                    "type": "number"
+                },
+                "user_id": {
+                    "type": "string"
+                },
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.FairnessResponse": {
+            "type": "object",
+            "properties": {
+                "from": {
+                    "type": "string"
+                },
+                "gini": {
+                    "type": "number"
+                },
+                "max_min_ratio": {
+                    "type": "number"
+                },
+                "mean_assignments": {
+                    "type": "number"
+                },
+                "members": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.MemberLoadResponse"
+                    }
+                },
+                "outliers": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.FairnessOutlierResponse"
+                    }
+                },
+                "team_name": {
+                    "type": "string"
+                },
+                "to": {
+                    "type": "string"
+                },
+                "total_assignments": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.GetUserReviewsResponse": {
+            "type": "object",
+            "properties": {
+                "pull_requests": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.PullRequestShortResponse"
+                    }
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.GitHubPullRequest": {
+            "type": "object",
+            "properties": {
+                "draft": {
+                    "type": "boolean"
+                },
+                "merged": {
+                    "type": "boolean"
+                },
+                "number": {
+                    "type": "integer"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "user": {
+                    "$ref": "#/definitions/models.GitHubUser"
+                }
+            }
+        },
+        "models.GitHubPullRequestEvent": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "type": "string"
+                },
+                "number": {
+                    "type": "integer"
+                },
+                "pull_request": {
+                    "$ref": "#/definitions/models.GitHubPullRequest"
+                },
+                "repository": {
+                    "$ref": "#/definitions/models.GitHubRepository"
+                }
+            }
+        },
+        "models.GitHubRepository": {
+            "type": "object",
+            "properties": {
+                "full_name": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.GitHubUser": {
+            "type": "object",
+            "properties": {
+                "login": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.GitLabMergeRequestAttributes": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "type": "string"
+                },
+                "draft": {
+                    "type": "boolean"
+                },
+                "iid": {
+                    "type": "integer"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "work_in_progress": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "models.GitLabMergeRequestEvent": {
+            "type": "object",
+            "properties": {
+                "object_attributes": {
+                    "$ref": "#/definitions/models.GitLabMergeRequestAttributes"
+                },
+                "object_kind": {
+                    "type": "string"
+                },
+                "project": {
+                    "$ref": "#/definitions/models.GitLabProject"
+                },
+                "user": {
+                    "$ref": "#/definitions/models.GitLabUser"
+                }
+            }
+        },
+        "models.GitLabProject": {
+            "type": "object",
+            "properties": {
+                "path_with_namespace": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.GitLabUser": {
+            "type": "object",
+            "properties": {
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.HealthResponse": {
+            "type": "object",
+            "properties": {
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.LinkExternalAccountRequest": {
+            "type": "object",
+            "required": [
+                "login",
+                "provider",
+                "user_id"
+            ],
+            "properties": {
+                "login": {
+                    "type": "string"
+                },
+                "provider": {
+                    "type": "string",
+                    "enum": [
+                        "GITHUB",
+                        "GITLAB"
+                    ]
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.LinkExternalAccountResponse": {
+            "type": "object",
+            "properties": {
+                "account": {
+                    "$ref": "#/definitions/models.ExternalAccountResponse"
+                }
+            }
+        },
+        "models.ListUsersResponse": {
+            "type": "object",
+            "properties": {
+                "users": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.UserResponse"
+                    }
+                }
+            }
+        },
+        "models.MemberLoadResponse": {
+            "type": "object",
+            "properties": {
+                "assignments": {
+                    "type": "integer"
+                },
+                "user_id": {
+                    "type": "string"
+                },
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.MergePullRequestRequest": {
+            "type": "object",
+            "required": [
+                "pull_request_id"
+            ],
+            "properties": {
+                "pull_request_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.PullRequestEnvelopeResponse": {
+            "type": "object",
+            "properties": {
+                "pr": {
+                    "$ref": "#/definitions/models.PullRequestResponse"
+                }
+            }
+        },
+        "models.PullRequestExportRecord": {
+            "type": "object",
+            "properties": {
+                "assigned_reviewers": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "author_id": {
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "mergedAt": {
+                    "type": "string"
+                },
+                "pull_request_id": {
+                    "type": "string"
+                },
+                "pull_request_name": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "team_name": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.PullRequestHistoryEntryResponse": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "type": "string"
+                },
+                "actor": {
+                    "type": "string"
+                },
+                "details": {
+                    "type": "string"
+                },
+                "entry_id": {
+                    "type": "integer"
+                },
+                "occurred_at": {
+                    "type": "string"
+                },
+                "reviewer_id": {
+                    "type": "string"
+                },
+                "target_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "models.PullRequestHistoryResponse": {
+            "type": "object",
+            "properties": {
+                "history": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.PullRequestHistoryEntryResponse"
+                    }
+                },
+                "pull_request_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.PullRequestResponse": {
+            "type": "object",
+            "properties": {
+                "assigned_reviewers": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "assignment_reasons": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "$ref": "#/definitions/models.ReviewerReasonResponse"
+                    }
+                },
+                "author_id": {
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "mergedAt": {
+                    "type": "string"
+                },
+                "pull_request_id": {
+                    "type": "string"
+                },
+                "pull_request_name": {
+                    "type": "string"
+                },
+                "reviewer_teams": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "status": {
+                    "type": "string"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "unfilled_slots": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.UnfilledSlotResponse"
+                    }
+                }
+            }
+        },
+        "models.PullRequestReviewerRequest": {
+            "type": "object",
+            "required": [
+                "pull_request_id",
+                "reviewer_id"
+            ],
+            "properties": {
+                "pull_request_id": {
+                    "type": "string"
+                },
+                "reviewer_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.PullRequestShortResponse": {
+            "type": "object",
+            "properties": {
+                "author_id": {
+                    "type": "string"
+                },
+                "pull_request_id": {
+                    "type": "string"
+                },
+                "pull_request_name": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.PullRequestStaffingResponse": {
+            "type": "object",
+            "properties": {
+                "author_id": {
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "max_reviewers": {
+                    "type": "integer"
+                },
+                "pull_request_id": {
+                    "type": "string"
+                },
+                "pull_request_name": {
+                    "type": "string"
+                },
+                "reviewers": {
+                    "type": "integer"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "team_name": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.ReassignPullRequestRequest": {
+            "type": "object",
+            "required": [
+                "old_reviewer_id",
+                "pull_request_id"
+            ],
+            "properties": {
+                "new_reviewer_id": {
+                    "type": "string"
+                },
+                "old_reviewer_id": {
+                    "type": "string"
+                },
+                "pull_request_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.ReassignPullRequestResponse": {
+            "type": "object",
+            "properties": {
+                "pr": {
+                    "$ref": "#/definitions/models.PullRequestResponse"
+                },
+                "replaced_by": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.ReviewHandoverResponse": {
+            "type": "object",
+            "properties": {
+                "not_reassigned": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "reassigned": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.ReviewReassignmentResponse"
+                    }
+                }
+            }
+        },
+        "models.ReviewReassignmentResponse": {
+            "type": "object",
+            "properties": {
+                "new_reviewer_id": {
+                    "type": "string"
+                },
+                "pull_request_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.ReviewSLAEnvelopeResponse": {
+            "type": "object",
+            "properties": {
+                "sla": {
+                    "$ref": "#/definitions/models.ReviewSLAResponse"
+                }
+            }
+        },
+        "models.ReviewSLAResponse": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "type": "string"
+                },
+                "escalate_after_sec": {
+                    "type": "integer"
+                },
+                "first_review_within_sec": {
+                    "type": "integer"
+                },
+                "team_name": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.ReviewerReasonResponse": {
+            "type": "object",
+            "properties": {
+                "codeowners_line": {
+                    "type": "integer"
+                },
+                "codeowners_pattern": {
+                    "type": "string"
+                },
+                "rule_id": {
+                    "type": "integer"
+                },
+                "source": {
+                    "type": "string"
+                },
+                "tag": {
+                    "type": "string"
+                },
+                "team_name": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.ScimAuthenticationScheme": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.ScimBulkSupport": {
+            "type": "object",
+            "properties": {
+                "maxOperations": {
+                    "type": "integer"
+                },
+                "maxPayloadSize": {
+                    "type": "integer"
+                },
+                "supported": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "models.ScimEmail": {
+            "type": "object",
+            "required": [
+                "value"
+            ],
+            "properties": {
+                "primary": {
+                    "type": "boolean"
+                },
+                "type": {
+                    "type": "string"
+                },
+                "value": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.ScimErrorResponse": {
+            "type": "object",
+            "properties": {
+                "detail": {
+                    "type": "string"
+                },
+                "schemas": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "scimType": {
+                    "$ref": "#/definitions/models.ScimType"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.ScimFilterSupport": {
+            "type": "object",
+            "properties": {
+                "maxResults": {
+                    "type": "integer"
+                },
+                "supported": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "models.ScimGroupListResponse": {
+            "type": "object",
+            "properties": {
+                "Resources": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.ScimGroupResponse"
+                    }
+                },
+                "itemsPerPage": {
+                    "type": "integer"
+                },
+                "schemas": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "startIndex": {
+                    "type": "integer"
+                },
+                "totalResults": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.ScimGroupRequest": {
+            "type": "object",
+            "required": [
+                "displayName"
+            ],
+            "properties": {
+                "displayName": {
+                    "type": "string"
+                },
+                "members": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.ScimMemberRef"
+                    }
+                },
+                "schemas": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "models.ScimGroupResponse": {
+            "type": "object",
+            "properties": {
+                "displayName": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "members": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.ScimMemberRef"
+                    }
+                },
+                "meta": {
+                    "$ref": "#/definitions/models.ScimMeta"
+                },
+                "schemas": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "models.ScimMemberRef": {
+            "type": "object",
+            "required": [
+                "value"
+            ],
+            "properties": {
+                "$ref": {
+                    "type": "string"
+                },
+                "display": {
+                    "type": "string"
+                },
+                "value": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.ScimMeta": {
+            "type": "object",
+            "properties": {
+                "location": {
+                    "type": "string"
+                },
+                "resourceType": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.ScimPatchOperation": {
+            "type": "object",
+            "required": [
+                "op"
+            ],
+            "properties": {
+                "op": {
+                    "type": "string"
+                },
+                "path": {
+                    "type": "string"
+                },
+                "value": {
+                    "type": "object"
+                }
+            }
+        },
+        "models.ScimPatchRequest": {
+            "type": "object",
+            "required": [
+                "Operations"
+            ],
+            "properties": {
+                "Operations": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "$ref": "#/definitions/models.ScimPatchOperation"
+                    }
+                },
+                "schemas": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "models.ScimServiceProviderConfigResponse": {
+            "type": "object",
+            "properties": {
+                "authenticationSchemes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.ScimAuthenticationScheme"
+                    }
+                },
+                "bulk": {
+                    "$ref": "#/definitions/models.ScimBulkSupport"
+                },
+                "changePassword": {
+                    "$ref": "#/definitions/models.ScimSupported"
+                },
+                "etag": {
+                    "$ref": "#/definitions/models.ScimSupported"
+                },
+                "filter": {
+                    "$ref": "#/definitions/models.ScimFilterSupport"
+                },
+                "meta": {
+                    "$ref": "#/definitions/models.ScimMeta"
+                },
+                "patch": {
+                    "$ref": "#/definitions/models.ScimSupported"
+                },
+                "schemas": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "sort": {
+                    "$ref": "#/definitions/models.ScimSupported"
+                }
+            }
+        },
+        "models.ScimSupported": {
+            "type": "object",
+            "properties": {
+                "supported": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "models.ScimType": {
+            "type": "string",
+            "enum": [
+                "invalidFilter",
+                "invalidPath",
+                "invalidSyntax",
+                "invalidValue",
+                "mutability",
+                "uniqueness"
+            ],
+            "x-enum-varnames": [
+                "ScimTypeInvalidFilter",
+                "ScimTypeInvalidPath",
+                "ScimTypeInvalidSyntax",
+                "ScimTypeInvalidValue",
+                "ScimTypeMutability",
+                "ScimTypeUniqueness"
+            ]
+        },
+        "models.ScimUserListResponse": {
+            "type": "object",
+            "properties": {
+                "Resources": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.ScimUserResponse"
+                    }
+                },
+                "itemsPerPage": {
+                    "type": "integer"
+                },
+                "schemas": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "startIndex": {
+                    "type": "integer"
+                },
+                "totalResults": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.ScimUserRequest": {
+            "type": "object",
+            "required": [
+                "userName"
+            ],
+            "properties": {
+                "active": {
+                    "type": "boolean"
+                },
+                "emails": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.ScimEmail"
+                    }
+                },
+                "externalId": {
+                    "type": "string"
+                },
+                "schemas": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "userName": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.ScimUserResponse": {
+            "type": "object",
+            "properties": {
+                "active": {
+                    "type": "boolean"
+                },
+                "emails": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.ScimEmail"
+                    }
+                },
+                "groups": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.ScimMemberRef"
+                    }
+                },
+                "id": {
+                    "type": "string"
+                },
+                "meta": {
+                    "$ref": "#/definitions/models.ScimMeta"
+                },
+                "schemas": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "userName": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.SetReviewSLARequest": {
+            "type": "object",
+            "required": [
+                "action",
+                "team_name"
+            ],
+            "properties": {
+                "action": {
+                    "type": "string",
+                    "enum": [
+                        "REASSIGN",
+                        "ESCALATE"
+                    ]
+                },
+                "escalate_after_sec": {
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "first_review_within_sec": {
+                    "type": "integer"
+                },
+                "team_name": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.SetTeamMaxReviewersRequest": {
+            "type": "object",
+            "required": [
+                "max_reviewers",
+                "team_name"
+            ],
+            "properties": {
+                "max_reviewers": {
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "team_name": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.SetTeamMaxReviewersResponse": {
+            "type": "object",
+            "properties": {
+                "team": {
+                    "$ref": "#/definitions/models.TeamResponse"
+                }
+            }
+        },
+        "models.SetUserChatHandleRequest": {
+            "type": "object",
+            "required": [
+                "user_id"
+            ],
+            "properties": {
+                "chat_handle": {
+                    "type": "string",
+                    "maxLength": 64
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.SetUserChatHandleResponse": {
+            "type": "object",
+            "properties": {
+                "user": {
+                    "$ref": "#/definitions/models.UserResponse"
+                }
+            }
+        },
+        "models.SetUserEmailRequest": {
+            "type": "object",
+            "required": [
+                "user_id"
+            ],
+            "properties": {
+                "email": {
+                    "type": "string"
+                },
+                "email_opt_out": {
+                    "type": "boolean"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.SetUserEmailResponse": {
+            "type": "object",
+            "properties": {
+                "user": {
+                    "$ref": "#/definitions/models.UserResponse"
+                }
+            }
+        },
+        "models.SetUserIsActiveRequest": {
+            "type": "object",
+            "required": [
+                "user_id"
+            ],
+            "properties": {
+                "is_active": {
+                    "type": "boolean"
+                },
+                "reassign_reviews": {
+                    "type": "boolean"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.SetUserIsActiveResponse": {
+            "type": "object",
+            "properties": {
+                "review_handover": {
+                    "$ref": "#/definitions/models.ReviewHandoverResponse"
+                },
+                "user": {
+                    "$ref": "#/definitions/models.UserResponse"
+                }
+            }
+        },
+        "models.StatsOverviewResponse": {
+            "type": "object",
+            "properties": {
+                "active_users": {
+                    "type": "integer"
+                },
+                "closed_prs": {
+                    "type": "integer"
+                },
+                "draft_prs": {
+                    "type": "integer"
+                },
+                "generated_at": {
+                    "type": "string"
+                },
+                "inactive_users": {
+                    "type": "integer"
+                },
+                "merged_prs": {
+                    "type": "integer"
+                },
+                "oldest_open_prs": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.PullRequestStaffingResponse"
+                    }
+                },
+                "open_prs": {
+                    "type": "integer"
+                },
+                "teams": {
+                    "type": "integer"
+                },
+                "teams_without_reviewers": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "understaffed_prs": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.PullRequestStaffingResponse"
+                    }
+                },
+                "understaffed_prs_count": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.SyncTeamMemberRequest": {
+            "type": "object",
+            "required": [
+                "tags",
+                "user_id",
+                "username"
+            ],
+            "properties": {
+                "is_active": {
+                    "type": "boolean"
+                },
+                "seniority": {
+                    "type": "string",
+                    "enum": [
+                        "JUNIOR",
+                        "MIDDLE",
+                        "SENIOR",
+                        "LEAD"
+                    ]
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "user_id": {
+                    "type": "string"
+                },
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.SyncTeamRequest": {
+            "type": "object",
+            "required": [
+                "members",
+                "team_name"
+            ],
+            "properties": {
+                "max_reviewers": {
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "members": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.SyncTeamMemberRequest"
                     }
                 },
                 "team_name": {
@@ -450,222 +4934,414 @@ const docTemplate = `{
                 }
             }
         },
-        "models.AddTeamResponse": {
+        "models.SyncTeamResponse": {
             "type": "object",
             "properties": {
-                "team": {
-                    "$ref": "#/definitions/models.TeamResponse"
+                "changes": {
+                    "$ref": "#/definitions/models.TeamImportDiffResponse"
+                },
+                "review_handovers": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.UserReviewHandoverResponse"
+                    }
+                },
+                "team_name": {
+                    "type": "string"
                 }
             }
         },
-        "models.CreatePullRequestRequest": {
+        "models.TeamImportDiffResponse": {
+            "type": "object",
+            "properties": {
+                "created_teams": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "created_users": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.UserResponse"
+                    }
+                },
+                "deactivated_users": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.UserResponse"
+                    }
+                },
+                "moved_users": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.UserMoveResponse"
+                    }
+                },
+                "updated_teams": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "updated_users": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.UserResponse"
+                    }
+                }
+            }
+        },
+        "models.TeamImportResponse": {
+            "type": "object",
+            "properties": {
+                "diff": {
+                    "$ref": "#/definitions/models.TeamImportDiffResponse"
+                },
+                "dry_run": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "models.TeamMemberRequest": {
             "type": "object",
             "required": [
-                "author_id",
-                "pull_request_id",
-                "pull_request_name"
+                "tags",
+                "user_id",
+                "username"
             ],
             "properties": {
-                "author_id": {
-                    "type": "string"
+                "is_active": {
+                    "type": "boolean"
                 },
-                "pull_request_id": {
+                "seniority": {
+                    "type": "string",
+                    "enum": [
+                        "JUNIOR",
+                        "MIDDLE",
+                        "SENIOR",
+                        "LEAD"
+                    ]
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "user_id": {
                     "type": "string"
                 },
-                "pull_request_name": {
+                "username": {
                     "type": "string"
                 }
             }
         },
-        "models.ErrorBody": {
+        "models.TeamMemberResponse": {
             "type": "object",
             "properties": {
-                "code": {
-                    "$ref": "#/definitions/models.ErrorCode"
+                "is_active": {
+                    "type": "boolean"
                 },
-                "message": {
+                "seniority": {
+                    "type": "string"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "user_id": {
+                    "type": "string"
+                },
+                "username": {
                     "type": "string"
                 }
             }
         },
-        "models.ErrorCode": {
-            "type": "string",
-            "enum": [
-                "TEAM_EXISTS",
-                "PR_EXISTS",
-                "PR_MERGED",
-                "NOT_ASSIGNED",
-                "NO_CANDIDATE",
-                "NOT_FOUND",
-                "DECODE_FAILED",
-                "VALIDATION_FAILED",
-                "INTERNAL_SERVER_ERROR"
-            ],
-            "x-enum-varnames": [
-                "ErrorCodeTeamExists",
-                "ErrorCodePRExists",
-                "ErrorCodePRMerged",
-                "ErrorCodeNotAssigned",
-                "ErrorCodeNoCandidate",
-                "ErrorCodeNotFound",
-                "ErrorCodeDecodeFailed",
-                "ErrorCodeValidationFailed",
-                "ErrorCodeInternalServer"
-            ]
+        "models.TeamResponse": {
+            "type": "object",
+            "properties": {
+                "max_reviewers": {
+                    "type": "integer"
+                },
+                "members": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.TeamMemberResponse"
+                    }
+                },
+                "team_name": {
+                    "type": "string"
+                }
+            }
         },
-        "models.ErrorResponse": {
+        "models.TeamRuleEnvelopeResponse": {
             "type": "object",
             "properties": {
-                "error": {
-                    "$ref": "#/definitions/models.ErrorBody"
+                "rule": {
+                    "$ref": "#/definitions/models.TeamRuleResponse"
                 }
             }
         },
-        "models.GetUserReviewsResponse": {
+        "models.TeamRuleResponse": {
             "type": "object",
             "properties": {
-                "pull_requests": {
+                "author_id": {
+                    "type": "string"
+                },
+                "kind": {
+                    "type": "string"
+                },
+                "max_count": {
+                    "type": "integer"
+                },
+                "reviewer_id": {
+                    "type": "string"
+                },
+                "rule_id": {
+                    "type": "integer"
+                },
+                "seniority": {
+                    "type": "string"
+                },
+                "team_name": {
+                    "type": "string"
+                },
+                "users": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/models.PullRequestShortResponse"
+                        "type": "string"
                     }
-                },
-                "user_id": {
-                    "type": "string"
                 }
             }
         },
-        "models.HealthResponse": {
+        "models.TeamRulesResponse": {
             "type": "object",
             "properties": {
-                "status": {
+                "rules": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.TeamRuleResponse"
+                    }
+                },
+                "team_name": {
                     "type": "string"
                 }
             }
         },
-        "models.MergePullRequestRequest": {
+        "models.TeamStatsBucketResponse": {
             "type": "object",
-            "required": [
-                "pull_request_id"
-            ],
             "properties": {
-                "pull_request_id": {
+                "end": {
+                    "type": "string"
+                },
+                "median_time_to_merge_seconds": {
+                    "type": "integer"
+                },
+                "merged_prs": {
+                    "type": "integer"
+                },
+                "opened_prs": {
+                    "type": "integer"
+                },
+                "p90_time_to_merge_seconds": {
+                    "type": "integer"
+                },
+                "reassignments": {
+                    "type": "integer"
+                },
+                "start": {
                     "type": "string"
                 }
             }
         },
-        "models.PullRequestEnvelopeResponse": {
+        "models.TeamStatsResponse": {
             "type": "object",
             "properties": {
-                "pr": {
-                    "$ref": "#/definitions/models.PullRequestResponse"
+                "active_members_count": {
+                    "type": "integer"
+                },
+                "avg_time_to_merge_seconds": {
+                    "type": "integer"
+                },
+                "members_count": {
+                    "type": "integer"
+                },
+                "merged_prs": {
+                    "type": "integer"
+                },
+                "open_prs": {
+                    "type": "integer"
+                },
+                "team_name": {
+                    "type": "string"
+                },
+                "total_prs": {
+                    "type": "integer"
                 }
             }
         },
-        "models.PullRequestResponse": {
+        "models.TeamStatsTimeSeriesResponse": {
             "type": "object",
             "properties": {
-                "assigned_reviewers": {
+                "buckets": {
                     "type": "array",
                     "items": {
-                        "type": "string"
+                        "$ref": "#/definitions/models.TeamStatsBucketResponse"
                     }
                 },
-                "author_id": {
-                    "type": "string"
-                },
-                "createdAt": {
-                    "type": "string"
-                },
-                "mergedAt": {
-                    "type": "string"
-                },
-                "pull_request_id": {
-                    "type": "string"
-                },
-                "pull_request_name": {
+                "interval": {
                     "type": "string"
                 },
-                "status": {
+                "team_name": {
                     "type": "string"
                 }
             }
         },
-        "models.PullRequestShortResponse": {
+        "models.UnfilledSlotResponse": {
             "type": "object",
             "properties": {
-                "author_id": {
+                "codeowners_line": {
+                    "type": "integer"
+                },
+                "codeowners_pattern": {
                     "type": "string"
                 },
-                "pull_request_id": {
+                "rule_id": {
+                    "type": "integer"
+                },
+                "rule_kind": {
                     "type": "string"
                 },
-                "pull_request_name": {
+                "tag": {
                     "type": "string"
                 },
-                "status": {
+                "team_name": {
                     "type": "string"
                 }
             }
         },
-        "models.ReassignPullRequestRequest": {
+        "models.UploadCodeOwnersRequest": {
             "type": "object",
             "required": [
-                "old_reviewer_id",
-                "pull_request_id"
+                "content",
+                "team_name"
             ],
             "properties": {
-                "old_reviewer_id": {
+                "content": {
                     "type": "string"
                 },
-                "pull_request_id": {
+                "fill_strategy": {
+                    "type": "string",
+                    "enum": [
+                        "RANDOM",
+                        "LOAD"
+                    ]
+                },
+                "mode": {
+                    "type": "string",
+                    "enum": [
+                        "PREFER",
+                        "REQUIRE"
+                    ]
+                },
+                "team_name": {
                     "type": "string"
                 }
             }
         },
-        "models.ReassignPullRequestResponse": {
+        "models.UserMoveResponse": {
             "type": "object",
             "properties": {
-                "pr": {
-                    "$ref": "#/definitions/models.PullRequestResponse"
-                },
-                "replaced_by": {
+                "from_team_name": {
                     "type": "string"
+                },
+                "user": {
+                    "$ref": "#/definitions/models.UserResponse"
                 }
             }
         },
-        "models.SetUserIsActiveRequest": {
+        "models.UserResponse": {
             "type": "object",
-            "required": [
-                "user_id"
-            ],
             "properties": {
+                "chat_handle": {
+                    "type": "string"
+                },
+                "email": {
+                    "type": "string"
+                },
+                "email_opt_out": {
+                    "type": "boolean"
+                },
                 "is_active": {
                     "type": "boolean"
                 },
+                "seniority": {
+                    "type": "string"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "team_name": {
+                    "type": "string"
+                },
                 "user_id": {
                     "type": "string"
+                },
+                "username": {
+                    "type": "string"
                 }
             }
         },
-        "models.SetUserIsActiveResponse": {
+        "models.UserReviewHandoverResponse": {
             "type": "object",
             "properties": {
-                "user": {
-                    "$ref": "#/definitions/models.UserResponse"
+                "not_reassigned": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "reassigned": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.ReviewReassignmentResponse"
+                    }
+                },
+                "user_id": {
+                    "type": "string"
                 }
             }
         },
-        "models.TeamMemberRequest": {
+        "models.UserReviewStatsResponse": {
             "type": "object",
-            "required": [
-                "user_id",
-                "username"
-            ],
             "properties": {
+                "completed_reviews": {
+                    "type": "integer"
+                },
                 "is_active": {
                     "type": "boolean"
                 },
+                "median_time_to_merge_seconds": {
+                    "type": "integer"
+                },
+                "open_reviews": {
+                    "type": "integer"
+                },
+                "reassigned_away": {
+                    "type": "integer"
+                },
+                "team_name": {
+                    "type": "string"
+                },
+                "total_assignments": {
+                    "type": "integer"
+                },
                 "user_id": {
                     "type": "string"
                 },
@@ -674,76 +5350,146 @@ const docTemplate = `{
                 }
             }
         },
-        "models.TeamMemberResponse": {
+        "models.UserStatsResponse": {
             "type": "object",
             "properties": {
-                "is_active": {
-                    "type": "boolean"
+                "from": {
+                    "type": "string"
                 },
-                "user_id": {
+                "team_name": {
                     "type": "string"
                 },
-                "username": {
+                "to": {
                     "type": "string"
+                },
+                "users": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.UserReviewStatsResponse"
+                    }
                 }
             }
         },
-        "models.TeamResponse": {
+        "models.WebhookDeliveriesResponse": {
             "type": "object",
             "properties": {
-                "members": {
+                "deliveries": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/models.TeamMemberResponse"
+                        "$ref": "#/definitions/models.WebhookDeliveryResponse"
                     }
                 },
-                "team_name": {
-                    "type": "string"
+                "subscription_id": {
+                    "type": "integer"
                 }
             }
         },
-        "models.TeamStatsResponse": {
+        "models.WebhookDeliveryResponse": {
             "type": "object",
             "properties": {
-                "active_members_count": {
+                "attempts": {
                     "type": "integer"
                 },
-                "avg_time_to_merge_seconds": {
-                    "type": "integer"
+                "created_at": {
+                    "type": "string"
                 },
-                "members_count": {
-                    "type": "integer"
+                "delivered_at": {
+                    "type": "string"
                 },
-                "merged_prs": {
+                "delivery_id": {
                     "type": "integer"
                 },
-                "open_prs": {
-                    "type": "integer"
+                "event_type": {
+                    "type": "string"
                 },
-                "team_name": {
+                "last_error": {
                     "type": "string"
                 },
-                "total_prs": {
+                "last_status_code": {
                     "type": "integer"
+                },
+                "next_attempt_at": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
                 }
             }
         },
-        "models.UserResponse": {
+        "models.WebhookResponse": {
             "type": "object",
             "properties": {
-                "is_active": {
-                    "type": "boolean"
+                "pr": {
+                    "$ref": "#/definitions/models.PullRequestResponse"
                 },
-                "team_name": {
+                "result": {
+                    "$ref": "#/definitions/models.WebhookResult"
+                }
+            }
+        },
+        "models.WebhookResult": {
+            "type": "string",
+            "enum": [
+                "processed",
+                "ignored"
+            ],
+            "x-enum-varnames": [
+                "WebhookResultProcessed",
+                "WebhookResultIgnored"
+            ]
+        },
+        "models.WebhookSubscriptionEnvelopeResponse": {
+            "type": "object",
+            "properties": {
+                "subscription": {
+                    "$ref": "#/definitions/models.WebhookSubscriptionResponse"
+                }
+            }
+        },
+        "models.WebhookSubscriptionResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
                     "type": "string"
                 },
-                "user_id": {
-                    "type": "string"
+                "event_types": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 },
-                "username": {
+                "subscription_id": {
+                    "type": "integer"
+                },
+                "url": {
                     "type": "string"
                 }
             }
+        },
+        "models.WebhookSubscriptionsResponse": {
+            "type": "object",
+            "properties": {
+                "subscriptions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.WebhookSubscriptionResponse"
+                    }
+                }
+            }
+        }
+    },
+    "securityDefinitions": {
+        "ApiKeyAuth": {
+            "description": "API key with the scope the endpoint requires; \"Authorization: Bearer \u003ckey\u003e\" works as well.",
+            "type": "apiKey",
+            "name": "X-API-Key",
+            "in": "header"
+        },
+        "BearerAuth": {
+            "description": "\"Bearer \u003cJWT\u003e\" of the identity provider when JWT_JWKS_FILE or JWT_JWKS_URL is set.",
+            "type": "apiKey",
+            "name": "Authorization",
+            "in": "header"
         }
     }
 }`
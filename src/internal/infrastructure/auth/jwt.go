@@ -0,0 +1,185 @@
+package auth
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"slices"
+	"strings"
+	"time"
+)
+
+// jwtLeeway tolerates clock skew between the identity provider and the service.
+const jwtLeeway = 30 * time.Second
+
+var ErrInvalidToken = errors.New("invalid token")
+
+// JWTVerifier checks the signature and the registered claims of JWTs signed with the keys of an
+// identity provider. Only asymmetric algorithms are accepted: RS*, PS* and ES*.
+type JWTVerifier struct {
+	keys     KeySet
+	issuer   string
+	audience string
+	now      func() time.Time
+}
+
+// NewJWTVerifier checks the iss and aud claims only when issuer and audience are set.
+func NewJWTVerifier(keys KeySet, issuer, audience string) *JWTVerifier {
+	return &JWTVerifier{
+		keys:     keys,
+		issuer:   issuer,
+		audience: audience,
+		now:      time.Now,
+	}
+}
+
+// Verify returns the claims of a valid token. Errors wrap ErrInvalidToken, unless the signing keys
+// could not be loaded.
+func (v *JWTVerifier) Verify(ctx context.Context, token string) (map[string]any, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("%w: malformed token", ErrInvalidToken)
+	}
+
+	var header struct {
+		Alg string `json:"alg"`
+		Kid string `json:"kid"`
+	}
+	if err := decodeSegment(parts[0], &header); err != nil {
+		return nil, fmt.Errorf("%w: header: %w", ErrInvalidToken, err)
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, fmt.Errorf("%w: signature: %w", ErrInvalidToken, err)
+	}
+
+	key, err := v.keys.Key(ctx, header.Kid)
+	if err != nil {
+		if errors.Is(err, ErrKeyNotFound) {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidToken, err)
+		}
+		return nil, err
+	}
+
+	if err := verifySignature(header.Alg, key, parts[0]+"."+parts[1], signature); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidToken, err)
+	}
+
+	var claims map[string]any
+	if err := decodeSegment(parts[1], &claims); err != nil {
+		return nil, fmt.Errorf("%w: claims: %w", ErrInvalidToken, err)
+	}
+	if err := v.validateClaims(claims); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidToken, err)
+	}
+
+	return claims, nil
+}
+
+func (v *JWTVerifier) validateClaims(claims map[string]any) error {
+	now := v.now()
+
+	exp, ok := claims["exp"].(float64)
+	if !ok {
+		return errors.New("exp claim is required")
+	}
+	if now.After(time.Unix(int64(exp), 0).Add(jwtLeeway)) {
+		return errors.New("token is expired")
+	}
+
+	if nbf, ok := claims["nbf"].(float64); ok && now.Add(jwtLeeway).Before(time.Unix(int64(nbf), 0)) {
+		return errors.New("token is not valid yet")
+	}
+
+	if v.issuer != "" && claims["iss"] != v.issuer {
+		return fmt.Errorf("unexpected issuer %v", claims["iss"])
+	}
+
+	if v.audience != "" && !hasAudience(claims["aud"], v.audience) {
+		return fmt.Errorf("unexpected audience %v", claims["aud"])
+	}
+
+	return nil
+}
+
+// hasAudience accepts aud both as a string and as a list of strings.
+func hasAudience(aud any, audience string) bool {
+	switch aud := aud.(type) {
+	case string:
+		return aud == audience
+	case []any:
+		return slices.Contains(aud, any(audience))
+	default:
+		return false
+	}
+}
+
+type jwtAlgorithm struct {
+	family string
+	hash   crypto.Hash
+	// curveBits is the size of the only curve an ES* algorithm goes with.
+	curveBits int
+}
+
+var jwtAlgorithms = map[string]jwtAlgorithm{
+	"RS256": {family: "RS", hash: crypto.SHA256},
+	"RS384": {family: "RS", hash: crypto.SHA384},
+	"RS512": {family: "RS", hash: crypto.SHA512},
+	"PS256": {family: "PS", hash: crypto.SHA256},
+	"PS384": {family: "PS", hash: crypto.SHA384},
+	"PS512": {family: "PS", hash: crypto.SHA512},
+	"ES256": {family: "ES", hash: crypto.SHA256, curveBits: 256},
+	"ES384": {family: "ES", hash: crypto.SHA384, curveBits: 384},
+	"ES512": {family: "ES", hash: crypto.SHA512, curveBits: 521},
+}
+
+func verifySignature(alg string, key crypto.PublicKey, signingInput string, signature []byte) error {
+	algorithm, ok := jwtAlgorithms[alg]
+	if !ok {
+		return fmt.Errorf("unsupported algorithm %q", alg)
+	}
+
+	h := algorithm.hash.New()
+	h.Write([]byte(signingInput))
+	digest := h.Sum(nil)
+
+	switch key := key.(type) {
+	case *rsa.PublicKey:
+		switch algorithm.family {
+		case "RS":
+			return rsa.VerifyPKCS1v15(key, algorithm.hash, digest, signature)
+		case "PS":
+			opts := &rsa.PSSOptions{SaltLength: rsa.PSSSaltLengthAuto}
+			return rsa.VerifyPSS(key, algorithm.hash, digest, signature, opts)
+		}
+	case *ecdsa.PublicKey:
+		// The signature is r and s, each the size of the curve.
+		size := (key.Curve.Params().BitSize + 7) / 8
+		if algorithm.family == "ES" && key.Curve.Params().BitSize == algorithm.curveBits && len(signature) == 2*size {
+			r := new(big.Int).SetBytes(signature[:size])
+			s := new(big.Int).SetBytes(signature[size:])
+			if !ecdsa.Verify(key, digest, r, s) {
+				return errors.New("signature mismatch")
+			}
+			return nil
+		}
+	}
+
+	return fmt.Errorf("algorithm %q does not match the key", alg)
+}
+
+func decodeSegment(segment string, dst any) error {
+	data, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(data, dst)
+}
@@ -0,0 +1,314 @@
+package auth
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+var jwtTestNow = time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+
+type testSigningKey struct {
+	kid string
+	alg string
+	key crypto.Signer
+}
+
+func newRSATestKey(t *testing.T, kid, alg string) testSigningKey {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("failed to generate RSA key: %v", err)
+	}
+
+	return testSigningKey{kid: kid, alg: alg, key: key}
+}
+
+func newECTestKey(t *testing.T, kid string) testSigningKey {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate EC key: %v", err)
+	}
+
+	return testSigningKey{kid: kid, alg: "ES256", key: key}
+}
+
+// testJWKS encodes the public keys the way identity providers publish them.
+func testJWKS(t *testing.T, keys ...testSigningKey) []byte {
+	t.Helper()
+
+	encode := func(b []byte) string { return base64.RawURLEncoding.EncodeToString(b) }
+
+	set := map[string][]map[string]string{"keys": {}}
+	for _, k := range keys {
+		switch pub := k.key.Public().(type) {
+		case *rsa.PublicKey:
+			set["keys"] = append(set["keys"], map[string]string{
+				"kty": "RSA", "kid": k.kid, "use": "sig",
+				"n": encode(pub.N.Bytes()), "e": encode(big.NewInt(int64(pub.E)).Bytes()),
+			})
+		case *ecdsa.PublicKey:
+			point, err := pub.Bytes()
+			if err != nil {
+				t.Fatalf("failed to encode EC key: %v", err)
+			}
+			size := (len(point) - 1) / 2
+			set["keys"] = append(set["keys"], map[string]string{
+				"kty": "EC", "kid": k.kid, "crv": "P-256",
+				"x": encode(point[1 : 1+size]), "y": encode(point[1+size:]),
+			})
+		}
+	}
+	// Keys the verifier does not use are skipped.
+	set["keys"] = append(set["keys"], map[string]string{"kty": "oct", "kid": "hmac", "k": "c2VjcmV0"})
+
+	data, err := json.Marshal(set)
+	if err != nil {
+		t.Fatalf("failed to marshal JWKS: %v", err)
+	}
+
+	return data
+}
+
+func signTestToken(t *testing.T, k testSigningKey, claims map[string]any) string {
+	t.Helper()
+
+	encode := func(v any) string {
+		data, err := json.Marshal(v)
+		if err != nil {
+			t.Fatalf("failed to marshal token segment: %v", err)
+		}
+		return base64.RawURLEncoding.EncodeToString(data)
+	}
+
+	signingInput := encode(map[string]string{"alg": k.alg, "kid": k.kid, "typ": "JWT"}) + "." + encode(claims)
+	digest := crypto.SHA256.New()
+	digest.Write([]byte(signingInput))
+
+	var (
+		signature []byte
+		err       error
+	)
+	switch key := k.key.(type) {
+	case *rsa.PrivateKey:
+		if strings.HasPrefix(k.alg, "PS") {
+			signature, err = rsa.SignPSS(rand.Reader, key, crypto.SHA256, digest.Sum(nil), nil)
+		} else {
+			signature, err = rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest.Sum(nil))
+		}
+	case *ecdsa.PrivateKey:
+		var r, s *big.Int
+		r, s, err = ecdsa.Sign(rand.Reader, key, digest.Sum(nil))
+		if err == nil {
+			signature = append(r.FillBytes(make([]byte, 32)), s.FillBytes(make([]byte, 32))...)
+		}
+	}
+	if err != nil {
+		t.Fatalf("failed to sign token: %v", err)
+	}
+
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+func validTestClaims() map[string]any {
+	return map[string]any{
+		"sub":  "u1",
+		"role": "member",
+		"iss":  "https://idp.example.com",
+		"aud":  []string{"pr-service", "other"},
+		"exp":  jwtTestNow.Add(time.Hour).Unix(),
+		"nbf":  jwtTestNow.Add(-time.Minute).Unix(),
+	}
+}
+
+func TestJWTVerifier_Verify(t *testing.T) {
+	rsaKey := newRSATestKey(t, "rsa-1", "RS256")
+	psKey := testSigningKey{kid: "rsa-1", alg: "PS256", key: rsaKey.key}
+	ecKey := newECTestKey(t, "ec-1")
+	unknownKey := newRSATestKey(t, "rsa-2", "RS256")
+
+	keys, err := ParseJWKS(testJWKS(t, rsaKey, ecKey))
+	if err != nil {
+		t.Fatalf("ParseJWKS failed: %v", err)
+	}
+	verifier := NewJWTVerifier(NewStaticKeySet(keys), "https://idp.example.com", "pr-service")
+	verifier.now = func() time.Time { return jwtTestNow }
+
+	withClaim := func(name string, value any) string {
+		claims := validTestClaims()
+		claims[name] = value
+		return signTestToken(t, rsaKey, claims)
+	}
+	tamper := func(token string) string {
+		parts := strings.Split(token, ".")
+		parts[1] = base64.RawURLEncoding.EncodeToString([]byte(`{"sub":"admin","role":"admin","exp":9999999999}`))
+		return strings.Join(parts, ".")
+	}
+	withAlg := func(alg string) string {
+		parts := strings.Split(signTestToken(t, rsaKey, validTestClaims()), ".")
+		parts[0] = base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"` + alg + `","kid":"rsa-1"}`))
+		return strings.Join(parts, ".")
+	}
+
+	tests := []struct {
+		name    string
+		token   string
+		wantErr bool
+	}{
+		{name: "RS256", token: signTestToken(t, rsaKey, validTestClaims())},
+		{name: "PS256", token: signTestToken(t, psKey, validTestClaims())},
+		{name: "ES256", token: signTestToken(t, ecKey, validTestClaims())},
+		{name: "within leeway", token: withClaim("exp", jwtTestNow.Add(-10*time.Second).Unix())},
+		{name: "expired", token: withClaim("exp", jwtTestNow.Add(-time.Hour).Unix()), wantErr: true},
+		{name: "no exp", token: withClaim("exp", nil), wantErr: true},
+		{name: "not valid yet", token: withClaim("nbf", jwtTestNow.Add(time.Hour).Unix()), wantErr: true},
+		{name: "other issuer", token: withClaim("iss", "https://evil.example.com"), wantErr: true},
+		{name: "other audience", token: withClaim("aud", "other"), wantErr: true},
+		{name: "tampered claims", token: tamper(signTestToken(t, rsaKey, validTestClaims())), wantErr: true},
+		{name: "unknown key", token: signTestToken(t, unknownKey, validTestClaims()), wantErr: true},
+		{name: "alg none", token: withAlg("none"), wantErr: true},
+		{name: "alg of another key type", token: withAlg("ES256"), wantErr: true},
+		{name: "malformed", token: "not-a-jwt", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			claims, err := verifier.Verify(context.Background(), tt.token)
+
+			if tt.wantErr {
+				if !errors.Is(err, ErrInvalidToken) {
+					t.Fatalf("expected ErrInvalidToken, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if claims["sub"] != "u1" || claims["role"] != "member" {
+				t.Fatalf("unexpected claims: %v", claims)
+			}
+		})
+	}
+}
+
+func TestRemoteKeySet_RefetchesOnRotation(t *testing.T) {
+	oldKey := newRSATestKey(t, "old", "RS256")
+	newKey := newECTestKey(t, "new")
+
+	var (
+		requests atomic.Int32
+		jwks     atomic.Pointer[[]byte]
+	)
+	initial := testJWKS(t, oldKey)
+	jwks.Store(&initial)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		requests.Add(1)
+		_, _ = w.Write(*jwks.Load())
+	}))
+	defer server.Close()
+
+	now := jwtTestNow
+	keySet := NewRemoteKeySet(server.URL, server.Client(), time.Hour)
+	keySet.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	if _, err := keySet.Key(ctx, "old"); err != nil {
+		t.Fatalf("Key failed: %v", err)
+	}
+	if _, err := keySet.Key(ctx, "old"); err != nil || requests.Load() != 1 {
+		t.Fatalf("expected cached key after one request, got %d requests, err %v", requests.Load(), err)
+	}
+
+	rotated := testJWKS(t, oldKey, newKey)
+	jwks.Store(&rotated)
+
+	if _, err := keySet.Key(ctx, "new"); !errors.Is(err, ErrKeyNotFound) || requests.Load() != 1 {
+		t.Fatalf("expected unknown key not to be refetched right away, got %d requests, err %v", requests.Load(), err)
+	}
+
+	now = now.Add(time.Minute)
+	if _, err := keySet.Key(ctx, "new"); err != nil || requests.Load() != 2 {
+		t.Fatalf("expected rotated key after a refetch, got %d requests, err %v", requests.Load(), err)
+	}
+}
+
+func TestRemoteKeySet_ServesCachedKeysWhileRefreshing(t *testing.T) {
+	oldKey := newRSATestKey(t, "old", "RS256")
+	newKey := newECTestKey(t, "new")
+
+	var (
+		requests atomic.Int32
+		jwks     atomic.Pointer[[]byte]
+	)
+	initial := testJWKS(t, oldKey)
+	jwks.Store(&initial)
+	release := make(chan struct{})
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		if requests.Add(1) > 1 {
+			<-release
+		}
+		_, _ = w.Write(*jwks.Load())
+	}))
+	defer server.Close()
+
+	now := jwtTestNow
+	keySet := NewRemoteKeySet(server.URL, server.Client(), time.Hour)
+	keySet.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	if _, err := keySet.Key(ctx, "old"); err != nil {
+		t.Fatalf("Key failed: %v", err)
+	}
+
+	rotated := testJWKS(t, oldKey, newKey)
+	jwks.Store(&rotated)
+	now = now.Add(2 * time.Hour)
+
+	lookup := func(kid string) <-chan error {
+		errCh := make(chan error, 1)
+		go func() {
+			_, err := keySet.Key(ctx, kid)
+			errCh <- err
+		}()
+		return errCh
+	}
+
+	select {
+	case err := <-lookup("old"):
+		if err != nil {
+			t.Fatalf("expected the stale key, got %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected the stale key without waiting for the refresh")
+	}
+
+	first, second := lookup("new"), lookup("new")
+	close(release)
+
+	for _, errCh := range []<-chan error{first, second} {
+		if err := <-errCh; err != nil {
+			t.Fatalf("expected the rotated key after the refresh, got %v", err)
+		}
+	}
+	if requests.Load() != 2 {
+		t.Fatalf("expected the refresh to be shared, got %d requests", requests.Load())
+	}
+}
@@ -0,0 +1,71 @@
+//go:build integration
+
+package integration_tests
+
+import (
+	"PrService/src/internal/domain"
+	"PrService/src/internal/infrastructure/data/repositories"
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestAPIKeyRepository_CreateGetRevoke(t *testing.T) {
+	ctx := context.Background()
+	truncateAll(t, ctx)
+
+	repo := repositories.NewAPIKeyRepository(testPool)
+
+	key := &domain.APIKey{
+		Name:   "ci",
+		Prefix: "prs_abcd",
+		Hash:   domain.HashAPIKey("prs_abcdef"),
+		Scopes: []domain.APIKeyScope{domain.ScopePullRequestWrite, domain.ScopeStatsRead},
+	}
+	if err := repo.Create(ctx, key); err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+	if key.ID == 0 || key.CreatedAt.IsZero() {
+		t.Fatalf("expected id and created_at to be returned, got %+v", key)
+	}
+
+	got, err := repo.GetByHash(ctx, key.Hash)
+	if err != nil {
+		t.Fatalf("GetByHash failed: %v", err)
+	}
+	if got.ID != key.ID || len(got.Scopes) != 2 || got.Scopes[1] != domain.ScopeStatsRead || got.IsRevoked() {
+		t.Fatalf("unexpected key: %+v", got)
+	}
+	if _, err := repo.GetByHash(ctx, domain.HashAPIKey("other")); !errors.Is(err, domain.ErrAPIKeyNotFound) {
+		t.Fatalf("expected ErrAPIKeyNotFound, got %v", err)
+	}
+
+	revokedAt := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	revoked, err := repo.Revoke(ctx, key.ID, revokedAt)
+	if err != nil {
+		t.Fatalf("Revoke failed: %v", err)
+	}
+	if revoked.RevokedAt == nil || !revoked.RevokedAt.Equal(revokedAt) {
+		t.Fatalf("expected revoked_at %v, got %+v", revokedAt, revoked.RevokedAt)
+	}
+
+	again, err := repo.Revoke(ctx, key.ID, revokedAt.Add(time.Hour))
+	if err != nil {
+		t.Fatalf("repeated Revoke failed: %v", err)
+	}
+	if !again.RevokedAt.Equal(revokedAt) {
+		t.Fatalf("expected repeated revoke to keep %v, got %v", revokedAt, again.RevokedAt)
+	}
+	if _, err := repo.Revoke(ctx, key.ID+1, revokedAt); !errors.Is(err, domain.ErrAPIKeyNotFound) {
+		t.Fatalf("expected ErrAPIKeyNotFound, got %v", err)
+	}
+
+	list, err := repo.List(ctx)
+	if err != nil {
+		t.Fatalf("List failed: %v", err)
+	}
+	if len(list) != 1 || list[0].RevokedAt == nil {
+		t.Fatalf("unexpected keys: %+v", list)
+	}
+}
@@ -0,0 +1,63 @@
+//go:build integration
+
+package integration_tests
+
+import (
+	"PrService/src/internal/domain"
+	"PrService/src/internal/infrastructure/data/repositories"
+	"context"
+	"testing"
+)
+
+func TestCodeOwnersRepository_Upsert_LoadWithTeam(t *testing.T) {
+	ctx := context.Background()
+	truncateAll(t, ctx)
+
+	teamRepo := repositories.NewTeamRepository(testPool)
+	codeOwnersRepo := repositories.NewCodeOwnersRepository(testPool)
+
+	teamName := domain.TeamName("backend")
+	insertTeam(t, ctx, teamName)
+
+	team, err := teamRepo.GetByName(ctx, teamName)
+	if err != nil {
+		t.Fatalf("GetByName returned error: %v", err)
+	}
+	if team.CodeOwners != nil {
+		t.Fatalf("expected no CODEOWNERS before upload, got %+v", team.CodeOwners)
+	}
+
+	err = codeOwnersRepo.Upsert(ctx, &domain.TeamCodeOwners{
+		TeamName:     teamName,
+		Content:      "* @alice\n",
+		Mode:         domain.CodeOwnersModePrefer,
+		FillStrategy: domain.FillStrategyRandom,
+	})
+	if err != nil {
+		t.Fatalf("Upsert failed: %v", err)
+	}
+
+	err = codeOwnersRepo.Upsert(ctx, &domain.TeamCodeOwners{
+		TeamName:     teamName,
+		Content:      "/db/ @dba\n*.go @bob\n",
+		Mode:         domain.CodeOwnersModeRequire,
+		FillStrategy: domain.FillStrategyLoad,
+	})
+	if err != nil {
+		t.Fatalf("second Upsert failed: %v", err)
+	}
+
+	team, err = teamRepo.GetByName(ctx, teamName)
+	if err != nil {
+		t.Fatalf("GetByName returned error: %v", err)
+	}
+	if team.CodeOwners == nil {
+		t.Fatalf("expected CODEOWNERS to be loaded with team")
+	}
+	if team.CodeOwners.Mode != domain.CodeOwnersModeRequire || team.CodeOwners.FillStrategy != domain.FillStrategyLoad {
+		t.Fatalf("expected overwritten settings, got %+v", team.CodeOwners)
+	}
+	if len(team.CodeOwners.Entries) != 2 || team.CodeOwners.Entries[0].Pattern != "/db/" {
+		t.Fatalf("unexpected parsed entries: %+v", team.CodeOwners.Entries)
+	}
+}
@@ -0,0 +1,131 @@
+//go:build integration
+
+package integration_tests
+
+import (
+	"PrService/src/internal/infrastructure/data/repositories"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"PrService/src/internal/domain"
+)
+
+func TestExportRepository_StreamPullRequests(t *testing.T) {
+	ctx := context.Background()
+	truncateAll(t, ctx)
+
+	exportRepo := repositories.NewExportRepository(testPool)
+	prRepo := repositories.NewPullRequestRepository(testPool)
+
+	insertTeam(t, ctx, "backend")
+	insertTeam(t, ctx, "frontend")
+	insertUser(t, ctx, domain.User{ID: "u1", Username: "Alice", TeamName: "backend", IsActive: true})
+	insertUser(t, ctx, domain.User{ID: "u2", Username: "Bob", TeamName: "backend", IsActive: true})
+	insertUser(t, ctx, domain.User{ID: "u3", Username: "Carol", TeamName: "frontend", IsActive: true})
+
+	older := time.Date(2025, 3, 1, 10, 0, 0, 0, time.UTC)
+	newer := older.Add(24 * time.Hour)
+	for _, pr := range []*domain.PullRequest{
+		{ID: "pr-2", Name: "Second", AuthorID: "u1", Status: domain.PullRequestStatusOpen,
+			CreatedAt: &newer, AssignedReviewers: []domain.UserID{"u2"}},
+		{ID: "pr-1", Name: "First", AuthorID: "u1", Status: domain.PullRequestStatusOpen, Tags: []string{"db"},
+			CreatedAt: &older, AssignedReviewers: []domain.UserID{"u2", "u3"}},
+		{ID: "pr-3", Name: "Frontend", AuthorID: "u3", Status: domain.PullRequestStatusMerged, CreatedAt: &older},
+	} {
+		if err := prRepo.Create(ctx, pr); err != nil {
+			t.Fatalf("Create %s failed: %v", pr.ID, err)
+		}
+	}
+
+	var rows []domain.PullRequestExportRow
+	filter := domain.PullRequestExportFilter{TeamName: "backend", ReviewerID: "u2"}
+	err := exportRepo.StreamPullRequests(ctx, filter, func(row domain.PullRequestExportRow) error {
+		rows = append(rows, row)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("StreamPullRequests failed: %v", err)
+	}
+
+	if len(rows) != 2 || rows[0].ID != "pr-1" || rows[1].ID != "pr-2" {
+		t.Fatalf("expected pr-1 and pr-2 oldest first, got %+v", rows)
+	}
+	if rows[0].TeamName != "backend" || len(rows[0].Reviewers) != 2 || rows[0].Reviewers[0] != "u2" ||
+		len(rows[0].Tags) != 1 || rows[0].CreatedAt == nil || !rows[0].CreatedAt.Equal(older) {
+		t.Fatalf("unexpected first row: %+v", rows[0])
+	}
+
+	stop := errors.New("stop")
+	calls := 0
+	err = exportRepo.StreamPullRequests(ctx, domain.PullRequestExportFilter{}, func(domain.PullRequestExportRow) error {
+		calls++
+		return stop
+	})
+	if !errors.Is(err, stop) || calls != 1 {
+		t.Fatalf("expected the callback error after one row, got %v after %d rows", err, calls)
+	}
+}
+
+func TestExportRepository_StreamAssignments(t *testing.T) {
+	ctx := context.Background()
+	truncateAll(t, ctx)
+
+	exportRepo := repositories.NewExportRepository(testPool)
+	prRepo := repositories.NewPullRequestRepository(testPool)
+
+	insertTeam(t, ctx, "backend")
+	insertUser(t, ctx, domain.User{ID: "u1", Username: "Alice", TeamName: "backend", IsActive: true})
+	insertUser(t, ctx, domain.User{ID: "u2", Username: "Bob", TeamName: "backend", IsActive: true})
+	insertUser(t, ctx, domain.User{ID: "u3", Username: "Carol", TeamName: "backend", IsActive: true})
+
+	pr := &domain.PullRequest{
+		ID:                "pr-1",
+		Name:              "First",
+		AuthorID:          "u1",
+		Status:            domain.PullRequestStatusOpen,
+		AssignedReviewers: []domain.UserID{"u2"},
+		ReviewerTeams:     map[domain.UserID]domain.TeamName{"u2": "backend"},
+	}
+	if err := prRepo.Create(ctx, pr); err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+
+	pr.AssignedReviewers = []domain.UserID{"u3"}
+	pr.ReviewerTeams = map[domain.UserID]domain.TeamName{"u3": "backend"}
+	if err := prRepo.Update(ctx, pr); err != nil {
+		t.Fatalf("Update failed: %v", err)
+	}
+
+	var rows []domain.AssignmentExportRow
+	err := exportRepo.StreamAssignments(ctx, domain.AssignmentExportFilter{PullRequestID: "pr-1"},
+		func(row domain.AssignmentExportRow) error {
+			rows = append(rows, row)
+			return nil
+		})
+	if err != nil {
+		t.Fatalf("StreamAssignments failed: %v", err)
+	}
+
+	if len(rows) != 2 {
+		t.Fatalf("expected 2 assignments, got %+v", rows)
+	}
+	if rows[0].ReviewerID != "u2" || rows[0].UnassignedAt == nil || !rows[0].Reassigned {
+		t.Fatalf("expected u2 to be reassigned away, got %+v", rows[0])
+	}
+	if rows[1].ReviewerID != "u3" || rows[1].UnassignedAt != nil || rows[1].PullRequestStatus != "OPEN" ||
+		rows[1].TeamName != "backend" {
+		t.Fatalf("expected u3 to be assigned, got %+v", rows[1])
+	}
+
+	var none int
+	err = exportRepo.StreamAssignments(ctx, domain.AssignmentExportFilter{ReviewerID: "u1"},
+		func(domain.AssignmentExportRow) error {
+			none++
+			return nil
+		})
+	if err != nil || none != 0 {
+		t.Fatalf("expected no assignments of u1, got %d (err %v)", none, err)
+	}
+}
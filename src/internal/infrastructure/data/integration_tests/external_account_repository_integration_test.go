@@ -0,0 +1,46 @@
+//go:build integration
+
+package integration_tests
+
+import (
+	"PrService/src/internal/domain"
+	"PrService/src/internal/infrastructure/data/repositories"
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestExternalAccountRepository_Upsert_GetUserID(t *testing.T) {
+	ctx := context.Background()
+	truncateAll(t, ctx)
+
+	repo := repositories.NewExternalAccountRepository(testPool)
+
+	teamName := domain.TeamName("backend")
+	insertTeam(t, ctx, teamName)
+	insertUser(t, ctx, domain.User{ID: "u1", Username: "Alice", TeamName: teamName, IsActive: true})
+	insertUser(t, ctx, domain.User{ID: "u2", Username: "Bob", TeamName: teamName, IsActive: true})
+
+	_, err := repo.GetUserID(ctx, domain.ExternalProviderGitHub, "octocat")
+	if !errors.Is(err, domain.ErrExternalAccountNotFound) {
+		t.Fatalf("expected ErrExternalAccountNotFound, got %v", err)
+	}
+
+	account := domain.ExternalAccount{Provider: domain.ExternalProviderGitHub, Login: "octocat", UserID: "u1"}
+	if err := repo.Upsert(ctx, account); err != nil {
+		t.Fatalf("Upsert failed: %v", err)
+	}
+
+	account.UserID = "u2"
+	if err := repo.Upsert(ctx, account); err != nil {
+		t.Fatalf("second Upsert failed: %v", err)
+	}
+
+	userID, err := repo.GetUserID(ctx, domain.ExternalProviderGitHub, "octocat")
+	if err != nil {
+		t.Fatalf("GetUserID returned error: %v", err)
+	}
+	if userID != "u2" {
+		t.Fatalf("expected login to be relinked to u2, got %s", userID)
+	}
+}
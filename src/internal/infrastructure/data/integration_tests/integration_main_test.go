@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"sort"
 	"testing"
 
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -16,7 +17,7 @@ import (
 
 var testPool *pgxpool.Pool
 
-const migrationFile = "../migrations/0001_create_schema.up.sql"
+const migrationsGlob = "../migrations/*.up.sql"
 
 func TestMain(m *testing.M) {
 	ctx := context.Background()
@@ -55,8 +56,8 @@ func TestMain(m *testing.M) {
 		os.Exit(1)
 	}
 
-	if err := applyMigration(ctx, testPool, migrationFile); err != nil {
-		fmt.Fprintf(os.Stderr, "failed to apply migration: %v\n", err)
+	if err := applyMigrations(ctx, testPool, migrationsGlob); err != nil {
+		fmt.Fprintf(os.Stderr, "failed to apply migrations: %v\n", err)
 		cleanup()
 		os.Exit(1)
 	}
@@ -67,6 +68,23 @@ func TestMain(m *testing.M) {
 	os.Exit(code)
 }
 
+func applyMigrations(ctx context.Context, pool *pgxpool.Pool, pattern string) error {
+	files, err := filepath.Glob(filepath.FromSlash(pattern))
+	if err != nil {
+		return fmt.Errorf("glob migrations %s: %w", pattern, err)
+	}
+
+	sort.Strings(files)
+
+	for _, file := range files {
+		if err := applyMigration(ctx, pool, file); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func applyMigration(ctx context.Context, pool *pgxpool.Pool, path string) error {
 	migrationPath := filepath.FromSlash(path)
 
@@ -86,7 +104,10 @@ func truncateAll(t *testing.T, ctx context.Context) {
 	t.Helper()
 
 	_, err := testPool.Exec(ctx, `
-		TRUNCATE TABLE pull_request_reviewers, pull_requests, users, teams
+		TRUNCATE TABLE
+			outbox_events, webhook_subscription_deliveries, webhook_subscriptions,
+			webhook_deliveries, external_accounts, team_codeowners, team_rules, team_review_slas,
+			pull_request_history, review_assignments, pull_request_reviewers, pull_requests, users, teams, api_keys
 		RESTART IDENTITY CASCADE;
 	`)
 	if err != nil {
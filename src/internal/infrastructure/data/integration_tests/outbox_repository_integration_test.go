@@ -0,0 +1,211 @@
+//go:build integration
+
+package integration_tests
+
+import (
+	"PrService/src/internal/domain"
+	"PrService/src/internal/infrastructure/data"
+	"PrService/src/internal/infrastructure/data/repositories"
+	"context"
+	"testing"
+	"time"
+)
+
+func TestOutboxRepository_ClaimPending_HeadOfEachAggregate(t *testing.T) {
+	ctx := context.Background()
+	truncateAll(t, ctx)
+
+	repo := repositories.NewOutboxRepository(testPool)
+
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	message := func(aggregateID string, eventType domain.EventType) domain.OutboxMessage {
+		return domain.OutboxMessage{
+			AggregateType: domain.AggregatePullRequest,
+			AggregateID:   aggregateID,
+			EventType:     eventType,
+			Payload:       []byte(`{}`),
+			OccurredAt:    now,
+			NextAttemptAt: now,
+		}
+	}
+
+	err := repo.Append(ctx, []domain.OutboxMessage{
+		message("pr-1", domain.EventPullRequestCreated),
+		message("pr-1", domain.EventPullRequestMerged),
+		message("pr-2", domain.EventPullRequestCreated),
+	})
+	if err != nil {
+		t.Fatalf("Append failed: %v", err)
+	}
+
+	claimed, err := repo.ClaimPending(ctx, now, time.Minute, 10)
+	if err != nil {
+		t.Fatalf("ClaimPending failed: %v", err)
+	}
+	if len(claimed) != 2 || claimed[0].ID != 1 || claimed[1].ID != 3 {
+		t.Fatalf("expected the first event of each aggregate, got %+v", claimed)
+	}
+	if claimed[0].Status != domain.OutboxPending || !claimed[0].NextAttemptAt.Equal(now.Add(time.Minute)) {
+		t.Fatalf("expected the claimed event to be leased, got %+v", claimed[0])
+	}
+
+	// Leased events are not claimed again while sinks run.
+	again, err := repo.ClaimPending(ctx, now.Add(time.Second), time.Minute, 10)
+	if err != nil {
+		t.Fatalf("ClaimPending failed: %v", err)
+	}
+	if len(again) != 0 {
+		t.Fatalf("expected leased events to be skipped, got %+v", again)
+	}
+
+	// A failed head keeps later events of its aggregate waiting; the sinks that accepted it are kept.
+	failed := claimed[0]
+	failed.Attempts = 1
+	failed.LastError = "sink down"
+	failed.DeliveredSinks = []string{"chat"}
+	failed.NextAttemptAt = now.Add(2 * time.Minute)
+	if err := repo.Update(ctx, failed); err != nil {
+		t.Fatalf("Update failed: %v", err)
+	}
+
+	published := claimed[1]
+	published.Status = domain.OutboxPublished
+	published.PublishedAt = &now
+	if err := repo.Update(ctx, published); err != nil {
+		t.Fatalf("Update failed: %v", err)
+	}
+
+	claimed, err = repo.ClaimPending(ctx, now.Add(time.Minute), time.Minute, 10)
+	if err != nil {
+		t.Fatalf("ClaimPending failed: %v", err)
+	}
+	if len(claimed) != 0 {
+		t.Fatalf("expected nothing due while the head is backing off, got %+v", claimed)
+	}
+
+	claimed, err = repo.ClaimPending(ctx, now.Add(2*time.Minute), time.Minute, 10)
+	if err != nil {
+		t.Fatalf("ClaimPending failed: %v", err)
+	}
+	if len(claimed) != 1 || claimed[0].ID != 1 || claimed[0].Attempts != 1 || claimed[0].LastError != "sink down" ||
+		len(claimed[0].DeliveredSinks) != 1 || claimed[0].DeliveredSinks[0] != "chat" {
+		t.Fatalf("expected the failed head to be retried, got %+v", claimed)
+	}
+
+	// A head the relay gave up on no longer holds its aggregate back.
+	failed = claimed[0]
+	failed.Status = domain.OutboxFailed
+	if err := repo.Update(ctx, failed); err != nil {
+		t.Fatalf("Update failed: %v", err)
+	}
+
+	claimed, err = repo.ClaimPending(ctx, now.Add(2*time.Minute), time.Minute, 10)
+	if err != nil {
+		t.Fatalf("ClaimPending failed: %v", err)
+	}
+	if len(claimed) != 1 || claimed[0].ID != 2 || claimed[0].EventType != domain.EventPullRequestMerged {
+		t.Fatalf("expected the next event of pr-1, got %+v", claimed)
+	}
+}
+
+func TestOutboxRepository_ListAfter(t *testing.T) {
+	ctx := context.Background()
+	truncateAll(t, ctx)
+
+	repo := repositories.NewOutboxRepository(testPool)
+	txManager := data.NewTxManager(testPool)
+
+	latest, err := repo.LatestCursor(ctx)
+	if err != nil {
+		t.Fatalf("LatestCursor failed: %v", err)
+	}
+	if latest != (domain.EventStreamCursor{}) {
+		t.Fatalf("expected zero cursor for empty outbox, got %+v", latest)
+	}
+
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	message := func(eventType domain.EventType) domain.OutboxMessage {
+		return domain.OutboxMessage{
+			AggregateType: domain.AggregatePullRequest,
+			AggregateID:   "pr-1",
+			EventType:     eventType,
+			Payload:       []byte(`{}`),
+			OccurredAt:    now,
+			NextAttemptAt: now,
+		}
+	}
+
+	err = repo.Append(ctx, []domain.OutboxMessage{
+		message(domain.EventPullRequestCreated),
+		message(domain.EventTeamUpdated),
+		message(domain.EventPullRequestMerged),
+	})
+	if err != nil {
+		t.Fatalf("Append failed: %v", err)
+	}
+
+	types := []domain.EventType{
+		domain.EventPullRequestCreated,
+		domain.EventPullRequestMerged,
+		domain.EventReviewerAssigned,
+	}
+
+	// Relay state does not matter: the stream reads the log, not the relay queue.
+	messages, err := repo.ListAfter(ctx, domain.EventStreamCursor{}, types, 10)
+	if err != nil {
+		t.Fatalf("ListAfter failed: %v", err)
+	}
+	if len(messages) != 2 || messages[0].ID != 1 || messages[1].ID != 3 {
+		t.Fatalf("expected committed events of requested types, got %+v", messages)
+	}
+	cursor := messages[1].StreamCursor()
+
+	// A transaction that takes an ID first and commits last must not be skipped by the cursor.
+	inserted := make(chan struct{})
+	release := make(chan struct{})
+	done := make(chan error, 1)
+	go func() {
+		done <- txManager.WithinTransaction(ctx, func(txCtx context.Context) error {
+			if err := repo.Append(txCtx, []domain.OutboxMessage{message(domain.EventReviewerAssigned)}); err != nil {
+				return err
+			}
+			close(inserted)
+			<-release
+			return nil
+		})
+	}()
+	<-inserted
+
+	if err := repo.Append(ctx, []domain.OutboxMessage{message(domain.EventPullRequestMerged)}); err != nil {
+		t.Fatalf("Append failed: %v", err)
+	}
+
+	messages, err = repo.ListAfter(ctx, cursor, types, 10)
+	if err != nil {
+		t.Fatalf("ListAfter failed: %v", err)
+	}
+	if len(messages) != 0 {
+		t.Fatalf("expected events behind a running transaction to be held back, got %+v", messages)
+	}
+
+	close(release)
+	if err := <-done; err != nil {
+		t.Fatalf("transaction failed: %v", err)
+	}
+
+	messages, err = repo.ListAfter(ctx, cursor, types, 10)
+	if err != nil {
+		t.Fatalf("ListAfter failed: %v", err)
+	}
+	if len(messages) != 2 || messages[0].ID != 4 || messages[1].ID != 5 {
+		t.Fatalf("expected both events in commit-safe order, got %+v", messages)
+	}
+
+	latest, err = repo.LatestCursor(ctx)
+	if err != nil {
+		t.Fatalf("LatestCursor failed: %v", err)
+	}
+	if latest != messages[1].StreamCursor() {
+		t.Fatalf("expected latest cursor %+v, got %+v", messages[1].StreamCursor(), latest)
+	}
+}
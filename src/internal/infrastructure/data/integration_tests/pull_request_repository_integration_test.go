@@ -3,6 +3,7 @@
 package integration_tests
 
 import (
+	"PrService/src/internal/infrastructure/data"
 	"PrService/src/internal/infrastructure/data/repositories"
 	"context"
 	"errors"
@@ -91,6 +92,53 @@ func TestPullRequestRepository_Create_And_GetByID_WithReviewers(t *testing.T) {
 	}
 }
 
+func TestPullRequestRepository_Create_PersistsReviewerTeams(t *testing.T) {
+	ctx := context.Background()
+	truncateAll(t, ctx)
+
+	repo := repositories.NewPullRequestRepository(testPool)
+
+	backend := domain.TeamName("backend")
+	security := domain.TeamName("security")
+	insertTeam(t, ctx, backend)
+	insertTeam(t, ctx, security)
+
+	author := domain.User{ID: "author1", Username: "Author", TeamName: backend, IsActive: true}
+	teammate := domain.User{ID: "r1", Username: "Reviewer1", TeamName: backend, IsActive: true}
+	securityReviewer := domain.User{ID: "s1", Username: "Security1", TeamName: security, IsActive: true}
+
+	insertUser(t, ctx, author)
+	insertUser(t, ctx, teammate)
+	insertUser(t, ctx, securityReviewer)
+
+	pr := &domain.PullRequest{
+		ID:                "pr-cross",
+		Name:              "Cross-team review",
+		AuthorID:          author.ID,
+		Status:            domain.PullRequestStatusOpen,
+		AssignedReviewers: []domain.UserID{teammate.ID, securityReviewer.ID},
+		ReviewerTeams: map[domain.UserID]domain.TeamName{
+			securityReviewer.ID: security,
+		},
+	}
+
+	if err := repo.Create(ctx, pr); err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+
+	got, err := repo.GetByID(ctx, pr.ID)
+	if err != nil {
+		t.Fatalf("GetByID returned error: %v", err)
+	}
+
+	if slotTeam := got.ReviewerTeams[securityReviewer.ID]; slotTeam != security {
+		t.Errorf("expected %q slot team %q, got %q", securityReviewer.ID, security, slotTeam)
+	}
+	if got.ReviewerTeams[teammate.ID] != backend {
+		t.Errorf("expected %q to fall back to team %q, got %q", teammate.ID, backend, got.ReviewerTeams[teammate.ID])
+	}
+}
+
 func TestPullRequestRepository_Create_NoReviewers(t *testing.T) {
 	ctx := context.Background()
 	truncateAll(t, ctx)
@@ -292,6 +340,15 @@ func TestPullRequestRepository_ListByReviewer(t *testing.T) {
 	if len(got2.AssignedReviewers) != 1 || got2.AssignedReviewers[0] != r1.ID {
 		t.Errorf("expected pr-2 AssignedReviewers = [%s], got %v", r1.ID, got2.AssignedReviewers)
 	}
+
+	// a transaction runs every query on one connection
+	err = data.NewTxManager(testPool).WithinTransaction(ctx, func(txCtx context.Context) error {
+		list, err = repo.ListByReviewer(txCtx, r1.ID)
+		return err
+	})
+	if err != nil || len(list) != 2 {
+		t.Fatalf("expected 2 PRs within a transaction, got %d, err %v", len(list), err)
+	}
 }
 
 func TestPullRequestRepository_Update_Success_ReplaceReviewers(t *testing.T) {
@@ -402,3 +459,89 @@ func TestPullRequestRepository_Update_NotFound(t *testing.T) {
 		t.Fatalf("expected ErrPullRequestNotFound, got %v", err)
 	}
 }
+
+func TestPullRequestRepository_CountOpenReviews(t *testing.T) {
+	ctx := context.Background()
+	truncateAll(t, ctx)
+
+	repo := repositories.NewPullRequestRepository(testPool)
+
+	teamName := domain.TeamName("backend")
+	insertTeam(t, ctx, teamName)
+
+	for _, id := range []domain.UserID{"author", "r1", "r2", "r3"} {
+		insertUser(t, ctx, domain.User{ID: id, Username: string(id), TeamName: teamName, IsActive: true})
+	}
+
+	prs := []*domain.PullRequest{
+		{
+			ID:                "pr-1",
+			Name:              "Open 1",
+			AuthorID:          "author",
+			Status:            domain.PullRequestStatusOpen,
+			AssignedReviewers: []domain.UserID{"r1", "r2"},
+		},
+		{
+			ID:                "pr-2",
+			Name:              "Open 2",
+			AuthorID:          "author",
+			Status:            domain.PullRequestStatusOpen,
+			AssignedReviewers: []domain.UserID{"r1"},
+		},
+		{
+			ID:                "pr-3",
+			Name:              "Merged",
+			AuthorID:          "author",
+			Status:            domain.PullRequestStatusMerged,
+			AssignedReviewers: []domain.UserID{"r2"},
+		},
+	}
+	for _, pr := range prs {
+		if err := repo.Create(ctx, pr); err != nil {
+			t.Fatalf("Create %s failed: %v", pr.ID, err)
+		}
+	}
+
+	counts, err := repo.CountOpenReviews(ctx, []domain.UserID{"r1", "r2", "r3"})
+	if err != nil {
+		t.Fatalf("CountOpenReviews returned error: %v", err)
+	}
+
+	if counts["r1"] != 2 || counts["r2"] != 1 || counts["r3"] != 0 {
+		t.Fatalf("unexpected open review counts: %v", counts)
+	}
+}
+
+func TestPullRequestRepository_Update_LifecycleStatuses(t *testing.T) {
+	ctx := context.Background()
+	truncateAll(t, ctx)
+
+	repo := repositories.NewPullRequestRepository(testPool)
+
+	teamName := domain.TeamName("backend")
+	insertTeam(t, ctx, teamName)
+	insertUser(t, ctx, domain.User{ID: "author", Username: "Author", TeamName: teamName, IsActive: true})
+
+	pr := &domain.PullRequest{
+		ID:       "pr-1",
+		Name:     "Draft",
+		AuthorID: "author",
+		Status:   domain.PullRequestStatusDraft,
+	}
+	if err := repo.Create(ctx, pr); err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+
+	pr.Status = domain.PullRequestStatusClosed
+	if err := repo.Update(ctx, pr); err != nil {
+		t.Fatalf("Update failed: %v", err)
+	}
+
+	got, err := repo.GetByID(ctx, pr.ID)
+	if err != nil {
+		t.Fatalf("GetByID returned error: %v", err)
+	}
+	if got.Status != domain.PullRequestStatusClosed {
+		t.Fatalf("expected CLOSED, got %s", got.Status)
+	}
+}
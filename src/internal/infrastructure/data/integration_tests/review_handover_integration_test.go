@@ -0,0 +1,180 @@
+//go:build integration
+
+package integration_tests
+
+import (
+	"PrService/src/internal/application/services"
+	"PrService/src/internal/domain"
+	"PrService/src/internal/infrastructure/data"
+	"PrService/src/internal/infrastructure/data/repositories"
+	"context"
+	"slices"
+	"testing"
+)
+
+// handoverFixture wires the services that hand over reviews to the real repositories, so that
+// the handover runs on a single transaction like in production.
+type handoverFixture struct {
+	pullRequests *repositories.PullRequestRepository
+	users        *services.UserService
+	teamSync     *services.TeamSyncService
+	provisioning *services.ProvisioningService
+}
+
+func newHandoverFixture() handoverFixture {
+	pullRequests := repositories.NewPullRequestRepository(testPool)
+	teams := repositories.NewTeamRepository(testPool)
+	txManager := data.NewTxManager(testPool)
+	publisher := services.NewOutboxPublisher(repositories.NewOutboxRepository(testPool))
+	userRepository := repositories.NewUserRepository(testPool)
+
+	users := services.NewUserService(
+		userRepository,
+		pullRequests,
+		repositories.NewExternalAccountRepository(testPool),
+		repositories.NewPullRequestHistoryRepository(testPool),
+		services.NewPullRequestService(pullRequests, teams, txManager, publisher),
+		txManager,
+		publisher,
+	)
+	teamService := services.NewTeamService(teams, userRepository, txManager, publisher)
+	teamSync := services.NewTeamSyncService(teamService, users, txManager)
+
+	return handoverFixture{
+		pullRequests: pullRequests,
+		users:        users,
+		teamSync:     teamSync,
+		provisioning: services.NewProvisioningService(
+			userRepository, teams, teamService, users, teamSync, txManager, "backend",
+		),
+	}
+}
+
+// seedReviews creates the backend team of the author a1 and the reviewers r1, r2 and r3, and
+// two OPEN pull requests reviewed by r1.
+func seedReviews(t *testing.T, ctx context.Context, f handoverFixture) {
+	t.Helper()
+
+	insertTeam(t, ctx, "backend")
+	for _, id := range []domain.UserID{"a1", "r1", "r2", "r3"} {
+		insertUser(t, ctx, domain.User{ID: id, Username: string(id), TeamName: "backend", IsActive: true})
+	}
+
+	for _, pr := range []*domain.PullRequest{
+		{ID: "pr-1", Name: "PR1", AuthorID: "a1", Status: domain.PullRequestStatusOpen,
+			AssignedReviewers: []domain.UserID{"r1"}},
+		{ID: "pr-2", Name: "PR2", AuthorID: "a1", Status: domain.PullRequestStatusOpen,
+			AssignedReviewers: []domain.UserID{"r1", "r2"}},
+	} {
+		if err := f.pullRequests.Create(ctx, pr); err != nil {
+			t.Fatalf("Create %s failed: %v", pr.ID, err)
+		}
+	}
+}
+
+// assertHandedOver checks that no pull request is reviewed by the user any more.
+func assertHandedOver(t *testing.T, ctx context.Context, f handoverFixture, userID domain.UserID) {
+	t.Helper()
+
+	prs, err := f.pullRequests.ListByReviewer(ctx, userID)
+	if err != nil {
+		t.Fatalf("ListByReviewer failed: %v", err)
+	}
+	if len(prs) != 0 {
+		t.Fatalf("expected the reviews of %s to be handed over, got %+v", userID, prs)
+	}
+
+	for _, id := range []domain.PullRequestID{"pr-1", "pr-2"} {
+		pr, err := f.pullRequests.GetByID(ctx, id)
+		if err != nil {
+			t.Fatalf("GetByID failed: %v", err)
+		}
+		if len(pr.AssignedReviewers) == 0 || slices.Contains(pr.AssignedReviewers, "a1") {
+			t.Fatalf("unexpected reviewers of %s: %v", id, pr.AssignedReviewers)
+		}
+	}
+}
+
+func TestUserService_SetIsActive_ReassignsReviews(t *testing.T) {
+	ctx := context.Background()
+	truncateAll(t, ctx)
+
+	f := newHandoverFixture()
+	seedReviews(t, ctx, f)
+
+	user, handover, err := f.users.SetIsActive(ctx, "r1", false, true)
+	if err != nil {
+		t.Fatalf("SetIsActive failed: %v", err)
+	}
+	if user.IsActive || handover == nil || len(handover.Reassigned) != 2 || len(handover.Unreassigned) != 0 {
+		t.Fatalf("unexpected handover: %+v", handover)
+	}
+
+	assertHandedOver(t, ctx, f, "r1")
+}
+
+func TestTeamSyncService_Sync_HandsOverReviewsOfRemovedMembers(t *testing.T) {
+	ctx := context.Background()
+	truncateAll(t, ctx)
+
+	f := newHandoverFixture()
+	seedReviews(t, ctx, f)
+
+	members := make([]domain.TeamMember, 0, 4)
+	for _, id := range []domain.UserID{"a1", "r2", "r3", "r4"} {
+		members = append(members, domain.TeamMember{
+			ID: id, Username: string(id), IsActive: true, Seniority: domain.SeniorityMiddle,
+		})
+	}
+
+	result, err := f.teamSync.Sync(ctx, domain.Team{Name: "backend", Members: members})
+	if err != nil {
+		t.Fatalf("Sync failed: %v", err)
+	}
+	if len(result.Handovers) != 1 || result.Handovers[0].UserID != "r1" ||
+		len(result.Handovers[0].Reassigned) != 2 {
+		t.Fatalf("unexpected handovers: %+v", result.Handovers)
+	}
+
+	assertHandedOver(t, ctx, f, "r1")
+}
+
+func TestProvisioningService_Deactivation_HandsOverReviews(t *testing.T) {
+	tests := []struct {
+		name       string
+		deactivate func(ctx context.Context, f handoverFixture) (*domain.User, error)
+	}{
+		{
+			name: "PATCH active=false",
+			deactivate: func(ctx context.Context, f handoverFixture) (*domain.User, error) {
+				return f.provisioning.ReplaceUser(ctx, domain.User{ID: "r1", Username: "r1", IsActive: false})
+			},
+		},
+		{
+			name: "DELETE",
+			deactivate: func(ctx context.Context, f handoverFixture) (*domain.User, error) {
+				return f.provisioning.DeactivateUser(ctx, "r1")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			truncateAll(t, ctx)
+
+			f := newHandoverFixture()
+			seedReviews(t, ctx, f)
+
+			user, err := tt.deactivate(ctx, f)
+			if err != nil {
+				t.Fatalf("deactivation failed: %v", err)
+			}
+			if user.IsActive {
+				t.Fatalf("expected r1 to be deactivated, got %+v", user)
+			}
+
+			assertHandedOver(t, ctx, f, "r1")
+		})
+	}
+}
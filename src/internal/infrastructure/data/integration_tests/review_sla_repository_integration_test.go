@@ -0,0 +1,250 @@
+//go:build integration
+
+package integration_tests
+
+import (
+	"PrService/src/internal/infrastructure/data/repositories"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"PrService/src/internal/domain"
+)
+
+func TestReviewSLARepository_Upsert_Get_Delete(t *testing.T) {
+	ctx := context.Background()
+	truncateAll(t, ctx)
+
+	repo := repositories.NewReviewSLARepository(testPool)
+	insertTeam(t, ctx, "backend")
+
+	sla := domain.ReviewSLA{
+		TeamName:          "backend",
+		FirstReviewWithin: 24 * time.Hour,
+		EscalateAfter:     4 * time.Hour,
+		Action:            domain.ReviewSLAActionReassign,
+	}
+	if err := repo.Upsert(ctx, sla); err != nil {
+		t.Fatalf("Upsert failed: %v", err)
+	}
+
+	sla.Action = domain.ReviewSLAActionEscalate
+	if err := repo.Upsert(ctx, sla); err != nil {
+		t.Fatalf("second Upsert failed: %v", err)
+	}
+
+	got, err := repo.Get(ctx, "backend")
+	if err != nil {
+		t.Fatalf("Get failed: %v", err)
+	}
+	if *got != sla {
+		t.Fatalf("expected %+v, got %+v", sla, *got)
+	}
+
+	if err := repo.Delete(ctx, "backend"); err != nil {
+		t.Fatalf("Delete failed: %v", err)
+	}
+	if _, err := repo.Get(ctx, "backend"); !errors.Is(err, domain.ErrReviewSLANotFound) {
+		t.Fatalf("expected ErrReviewSLANotFound, got %v", err)
+	}
+	if err := repo.Delete(ctx, "backend"); !errors.Is(err, domain.ErrReviewSLANotFound) {
+		t.Fatalf("expected ErrReviewSLANotFound on second delete, got %v", err)
+	}
+}
+
+func TestReviewSLARepository_ListOverdue_And_SetStage(t *testing.T) {
+	ctx := context.Background()
+	truncateAll(t, ctx)
+
+	slaRepo := repositories.NewReviewSLARepository(testPool)
+	prRepo := repositories.NewPullRequestRepository(testPool)
+
+	insertTeam(t, ctx, "backend")
+	insertTeam(t, ctx, "frontend")
+	for _, u := range []domain.User{
+		{ID: "u1", Username: "Alice", TeamName: "backend", IsActive: true},
+		{ID: "u2", Username: "Bob", TeamName: "backend", IsActive: true},
+		{ID: "u3", Username: "Carol", TeamName: "frontend", IsActive: true},
+	} {
+		insertUser(t, ctx, u)
+	}
+
+	if err := slaRepo.Upsert(ctx, domain.ReviewSLA{
+		TeamName:          "backend",
+		FirstReviewWithin: time.Hour,
+		EscalateAfter:     time.Hour,
+		Action:            domain.ReviewSLAActionReassign,
+	}); err != nil {
+		t.Fatalf("Upsert failed: %v", err)
+	}
+
+	for _, pr := range []*domain.PullRequest{
+		{ID: "pr-open", Name: "Open", AuthorID: "u1", Status: domain.PullRequestStatusOpen,
+			AssignedReviewers: []domain.UserID{"u2", "u3"},
+			ReviewerTeams:     map[domain.UserID]domain.TeamName{"u2": "backend", "u3": "frontend"}},
+		{ID: "pr-draft", Name: "Draft", AuthorID: "u1", Status: domain.PullRequestStatusDraft,
+			AssignedReviewers: []domain.UserID{"u2"}},
+	} {
+		if err := prRepo.Create(ctx, pr); err != nil {
+			t.Fatalf("Create %s failed: %v", pr.ID, err)
+		}
+	}
+
+	now := time.Now()
+	if got, err := slaRepo.ListOverdue(ctx, now, 10); err != nil || len(got) != 0 {
+		t.Fatalf("expected nothing overdue yet, got %+v, err=%v", got, err)
+	}
+
+	// only u2 on the OPEN pull request is covered by an SLA: frontend has none
+	later := now.Add(90 * time.Minute)
+	overdue, err := slaRepo.ListOverdue(ctx, later, 10)
+	if err != nil {
+		t.Fatalf("ListOverdue failed: %v", err)
+	}
+	if len(overdue) != 1 || overdue[0].PullRequestID != "pr-open" || overdue[0].ReviewerID != "u2" ||
+		overdue[0].Stage != domain.ReviewSLAStagePending || overdue[0].SLA.TeamName != "backend" {
+		t.Fatalf("unexpected overdue reviews: %+v", overdue)
+	}
+
+	moved, err := slaRepo.SetStage(ctx, "pr-open", "u2",
+		domain.ReviewSLAStagePending, domain.ReviewSLAStageNotified, later)
+	if err != nil || !moved {
+		t.Fatalf("SetStage failed: moved=%v err=%v", moved, err)
+	}
+	moved, err = slaRepo.SetStage(ctx, "pr-open", "u2",
+		domain.ReviewSLAStagePending, domain.ReviewSLAStageNotified, later)
+	if err != nil || moved {
+		t.Fatalf("expected second SetStage to be a no-op: moved=%v err=%v", moved, err)
+	}
+
+	if got, err := slaRepo.ListOverdue(ctx, later.Add(30*time.Minute), 10); err != nil || len(got) != 0 {
+		t.Fatalf("expected reminder to wait for EscalateAfter, got %+v, err=%v", got, err)
+	}
+
+	// keeping the reviewer on an update must keep the SLA stage
+	pr, err := prRepo.GetByID(ctx, "pr-open")
+	if err != nil {
+		t.Fatalf("GetByID failed: %v", err)
+	}
+	pr.Name = "Open, renamed"
+	if err := prRepo.Update(ctx, pr); err != nil {
+		t.Fatalf("Update failed: %v", err)
+	}
+
+	overdue, err = slaRepo.ListOverdue(ctx, later.Add(2*time.Hour), 10)
+	if err != nil {
+		t.Fatalf("ListOverdue failed: %v", err)
+	}
+	if len(overdue) != 1 || overdue[0].Stage != domain.ReviewSLAStageNotified {
+		t.Fatalf("expected the NOTIFIED assignment, got %+v", overdue)
+	}
+}
+
+func TestReviewSLARepository_MarkReviewed_NeverOverdue(t *testing.T) {
+	ctx := context.Background()
+	truncateAll(t, ctx)
+
+	slaRepo := repositories.NewReviewSLARepository(testPool)
+	prRepo := repositories.NewPullRequestRepository(testPool)
+
+	insertTeam(t, ctx, "backend")
+	for _, u := range []domain.User{
+		{ID: "u1", Username: "Alice", TeamName: "backend", IsActive: true},
+		{ID: "u2", Username: "Bob", TeamName: "backend", IsActive: true},
+		{ID: "u3", Username: "Carol", TeamName: "backend", IsActive: true},
+	} {
+		insertUser(t, ctx, u)
+	}
+
+	if err := slaRepo.Upsert(ctx, domain.ReviewSLA{
+		TeamName:          "backend",
+		FirstReviewWithin: time.Hour,
+		EscalateAfter:     time.Hour,
+		Action:            domain.ReviewSLAActionEscalate,
+	}); err != nil {
+		t.Fatalf("Upsert failed: %v", err)
+	}
+
+	if err := prRepo.Create(ctx, &domain.PullRequest{
+		ID: "pr-1", Name: "Open", AuthorID: "u1", Status: domain.PullRequestStatusOpen,
+		AssignedReviewers: []domain.UserID{"u2", "u3"},
+	}); err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+
+	now := time.Now()
+	// u2 reviews before the deadline, u3 only after being reminded
+	if err := slaRepo.MarkReviewed(ctx, "pr-1", "u2", now); err != nil {
+		t.Fatalf("MarkReviewed failed: %v", err)
+	}
+	moved, err := slaRepo.SetStage(ctx, "pr-1", "u3",
+		domain.ReviewSLAStagePending, domain.ReviewSLAStageNotified, now.Add(time.Hour))
+	if err != nil || !moved {
+		t.Fatalf("SetStage failed: moved=%v err=%v", moved, err)
+	}
+	if err := slaRepo.MarkReviewed(ctx, "pr-1", "u3", now.Add(90*time.Minute)); err != nil {
+		t.Fatalf("MarkReviewed failed: %v", err)
+	}
+
+	if got, err := slaRepo.ListOverdue(ctx, now.Add(24*time.Hour), 10); err != nil || len(got) != 0 {
+		t.Fatalf("expected reviewed assignments never to be overdue, got %+v, err=%v", got, err)
+	}
+
+	// a late escalation attempt from a stale listing finds the assignment already reviewed
+	moved, err = slaRepo.SetStage(ctx, "pr-1", "u3",
+		domain.ReviewSLAStageNotified, domain.ReviewSLAStageEscalated, now.Add(3*time.Hour))
+	if err != nil || moved {
+		t.Fatalf("expected reviewed assignment not to be escalated: moved=%v err=%v", moved, err)
+	}
+
+	err = slaRepo.MarkReviewed(ctx, "pr-1", "u1", now)
+	if !errors.Is(err, domain.ErrReviewerIsNotAssigned) {
+		t.Fatalf("expected ErrReviewerIsNotAssigned for the author, got %v", err)
+	}
+}
+
+func TestPullRequestHistoryRepository_Append_List(t *testing.T) {
+	ctx := context.Background()
+	truncateAll(t, ctx)
+
+	repo := repositories.NewPullRequestHistoryRepository(testPool)
+	prRepo := repositories.NewPullRequestRepository(testPool)
+
+	insertTeam(t, ctx, "backend")
+	insertUser(t, ctx, domain.User{ID: "u1", Username: "Alice", TeamName: "backend", IsActive: true})
+	if err := prRepo.Create(ctx, &domain.PullRequest{
+		ID: "pr-1", Name: "Add search", AuthorID: "u1", Status: domain.PullRequestStatusOpen,
+	}); err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+
+	occurredAt := time.Date(2025, 3, 4, 10, 0, 0, 0, time.UTC)
+	entries := []domain.PullRequestHistoryEntry{
+		{PullRequestID: "pr-1", Action: domain.HistoryReviewSLANotified, ReviewerID: "u2", OccurredAt: occurredAt},
+		{PullRequestID: "pr-1", Action: domain.HistoryReviewSLAEscalated, ReviewerID: "u2",
+			TargetIDs: []domain.UserID{"u5"}, Details: "still pending", Actor: "user:u1",
+			OccurredAt: occurredAt.Add(time.Hour)},
+	}
+	for i := range entries {
+		if err := repo.Append(ctx, &entries[i]); err != nil {
+			t.Fatalf("Append failed: %v", err)
+		}
+		if entries[i].ID == 0 {
+			t.Fatal("expected Append to set the ID")
+		}
+	}
+
+	got, err := repo.List(ctx, "pr-1")
+	if err != nil {
+		t.Fatalf("List failed: %v", err)
+	}
+	if len(got) != 2 || got[0].Action != domain.HistoryReviewSLANotified || len(got[0].TargetIDs) != 0 ||
+		got[0].Actor != "" {
+		t.Fatalf("unexpected history: %+v", got)
+	}
+	if got[1].TargetIDs[0] != "u5" || got[1].Details != "still pending" || got[1].Actor != "user:u1" ||
+		!got[1].OccurredAt.Equal(occurredAt.Add(time.Hour)) {
+		t.Fatalf("unexpected escalation entry: %+v", got[1])
+	}
+}
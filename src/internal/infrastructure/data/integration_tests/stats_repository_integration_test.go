@@ -0,0 +1,182 @@
+//go:build integration
+
+package integration_tests
+
+import (
+	"PrService/src/internal/infrastructure/data/repositories"
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"PrService/src/internal/domain"
+)
+
+func TestStatsRepository_UserReviewStats(t *testing.T) {
+	ctx := context.Background()
+	truncateAll(t, ctx)
+
+	statsRepo := repositories.NewStatsRepository(testPool)
+	prRepo := repositories.NewPullRequestRepository(testPool)
+
+	insertTeam(t, ctx, "backend")
+	insertTeam(t, ctx, "frontend")
+	for _, u := range []domain.User{
+		{ID: "u1", Username: "Alice", TeamName: "backend", IsActive: true},
+		{ID: "u2", Username: "Bob", TeamName: "backend", IsActive: true},
+		{ID: "u3", Username: "Carol", TeamName: "backend", IsActive: true},
+		{ID: "u4", Username: "Dave", TeamName: "backend", IsActive: true},
+		{ID: "u5", Username: "Eve", TeamName: "frontend", IsActive: true},
+	} {
+		insertUser(t, ctx, u)
+	}
+
+	now := time.Now().UTC().Truncate(time.Second)
+	open := &domain.PullRequest{ID: "pr-1", Name: "Open", AuthorID: "u1", Status: domain.PullRequestStatusOpen,
+		AssignedReviewers: []domain.UserID{"u2", "u3"}, CreatedAt: &now}
+	merged := &domain.PullRequest{ID: "pr-2", Name: "Merged", AuthorID: "u1", Status: domain.PullRequestStatusOpen,
+		AssignedReviewers: []domain.UserID{"u2", "u5"}, CreatedAt: &now}
+	for _, pr := range []*domain.PullRequest{open, merged} {
+		if err := prRepo.Create(ctx, pr); err != nil {
+			t.Fatalf("Create %s failed: %v", pr.ID, err)
+		}
+	}
+
+	// u2 is reassigned away to u4 on pr-1; u5 is removed from pr-2 without a replacement
+	open.AssignedReviewers = []domain.UserID{"u3", "u4"}
+	if err := prRepo.Update(ctx, open); err != nil {
+		t.Fatalf("Update pr-1 failed: %v", err)
+	}
+	mergedAt := now.Add(2 * time.Hour)
+	merged.Status = domain.PullRequestStatusMerged
+	merged.MergedAt = &mergedAt
+	merged.AssignedReviewers = []domain.UserID{"u2"}
+	if err := prRepo.Update(ctx, merged); err != nil {
+		t.Fatalf("Update pr-2 failed: %v", err)
+	}
+
+	stats, err := statsRepo.UserReviewStats(ctx, domain.UserStatsFilter{TeamName: "backend"})
+	if err != nil {
+		t.Fatalf("UserReviewStats failed: %v", err)
+	}
+	if len(stats) != 4 {
+		t.Fatalf("expected the 4 backend users, got %+v", stats)
+	}
+
+	byUser := make(map[domain.UserID]domain.UserReviewStats, len(stats))
+	for _, s := range stats {
+		byUser[s.UserID] = s
+	}
+	if stats[0].UserID != "u2" {
+		t.Fatalf("expected the busiest reviewer first, got %+v", stats)
+	}
+
+	u2 := byUser["u2"]
+	if u2.TotalAssignments != 2 || u2.OpenReviews != 0 || u2.CompletedReviews != 1 || u2.ReassignedAway != 1 {
+		t.Fatalf("unexpected stats of u2: %+v", u2)
+	}
+	if u2.MedianTimeToMergeSec < 7000 || u2.MedianTimeToMergeSec > 7300 {
+		t.Fatalf("expected a median of about 2h for u2, got %d", u2.MedianTimeToMergeSec)
+	}
+	if u3 := byUser["u3"]; u3.TotalAssignments != 1 || u3.OpenReviews != 1 || u3.ReassignedAway != 0 {
+		t.Fatalf("unexpected stats of u3: %+v", u3)
+	}
+	if u4 := byUser["u4"]; u4.TotalAssignments != 1 || u4.OpenReviews != 1 {
+		t.Fatalf("unexpected stats of u4: %+v", u4)
+	}
+	if u1 := byUser["u1"]; u1.TotalAssignments != 0 || u1.MedianTimeToMergeSec != 0 {
+		t.Fatalf("unexpected stats of u1: %+v", u1)
+	}
+
+	all, err := statsRepo.UserReviewStats(ctx, domain.UserStatsFilter{})
+	if err != nil {
+		t.Fatalf("UserReviewStats failed: %v", err)
+	}
+	for _, s := range all {
+		if s.UserID == "u5" && (s.TotalAssignments != 1 || s.ReassignedAway != 0 || s.OpenReviews != 0) {
+			t.Fatalf("a removal must not count as reassigned away: %+v", s)
+		}
+	}
+
+	past := domain.StatsWindow{To: now.Add(-time.Hour)}
+	stats, err = statsRepo.UserReviewStats(ctx, domain.UserStatsFilter{TeamName: "backend", Window: past})
+	if err != nil {
+		t.Fatalf("UserReviewStats failed: %v", err)
+	}
+	for _, s := range stats {
+		if s.TotalAssignments != 0 {
+			t.Fatalf("expected no assignments before the window end, got %+v", s)
+		}
+	}
+}
+
+func TestStatsRepository_Overview(t *testing.T) {
+	ctx := context.Background()
+	truncateAll(t, ctx)
+
+	statsRepo := repositories.NewStatsRepository(testPool)
+	prRepo := repositories.NewPullRequestRepository(testPool)
+
+	insertTeam(t, ctx, "backend")
+	insertTeam(t, ctx, "frontend")
+	insertTeam(t, ctx, "mobile")
+	for _, u := range []domain.User{
+		{ID: "u1", Username: "Alice", TeamName: "backend", IsActive: true},
+		{ID: "u2", Username: "Bob", TeamName: "backend", IsActive: true},
+		{ID: "u3", Username: "Carol", TeamName: "backend", IsActive: true},
+		{ID: "u4", Username: "Dave", TeamName: "frontend", IsActive: false},
+		{ID: "u5", Username: "Eve", TeamName: "mobile", IsActive: true},
+	} {
+		insertUser(t, ctx, u)
+	}
+
+	older := time.Now().UTC().Add(-48 * time.Hour).Truncate(time.Second)
+	newer := older.Add(24 * time.Hour)
+	crossTeam := older.Add(time.Hour)
+	for _, pr := range []*domain.PullRequest{
+		{ID: "pr-full", Name: "Full", AuthorID: "u1", Status: domain.PullRequestStatusOpen,
+			CreatedAt: &older, AssignedReviewers: []domain.UserID{"u2", "u3"}},
+		{ID: "pr-short", Name: "Short", AuthorID: "u1", Status: domain.PullRequestStatusOpen,
+			CreatedAt: &newer, AssignedReviewers: []domain.UserID{"u2"}},
+		{ID: "pr-cross", Name: "Cross", AuthorID: "u1", Status: domain.PullRequestStatusOpen,
+			CreatedAt: &crossTeam, AssignedReviewers: []domain.UserID{"u2", "u5"}},
+		{ID: "pr-draft", Name: "Draft", AuthorID: "u4", Status: domain.PullRequestStatusDraft, CreatedAt: &newer},
+		{ID: "pr-merged", Name: "Merged", AuthorID: "u1", Status: domain.PullRequestStatusMerged, CreatedAt: &older},
+	} {
+		if err := prRepo.Create(ctx, pr); err != nil {
+			t.Fatalf("Create %s failed: %v", pr.ID, err)
+		}
+	}
+
+	overview, err := statsRepo.Overview(ctx, 10)
+	if err != nil {
+		t.Fatalf("Overview failed: %v", err)
+	}
+
+	if overview.Teams != 3 || overview.ActiveUsers != 4 || overview.InactiveUsers != 1 ||
+		overview.OpenPRs != 3 || overview.DraftPRs != 1 || overview.MergedPRs != 1 || overview.ClosedPRs != 0 {
+		t.Fatalf("unexpected counts: %+v", overview)
+	}
+	if len(overview.OldestOpenPRs) != 3 || overview.OldestOpenPRs[0].ID != "pr-full" ||
+		overview.OldestOpenPRs[0].Reviewers != 2 || overview.OldestOpenPRs[1].Reviewers != 1 {
+		t.Fatalf("unexpected oldest open PRs: %+v", overview.OldestOpenPRs)
+	}
+	// a single active member cannot review their own pull requests
+	if !slices.Equal(overview.TeamsWithoutReviewers, []domain.TeamName{"frontend", "mobile"}) {
+		t.Fatalf("unexpected teams without reviewers: %v", overview.TeamsWithoutReviewers)
+	}
+	// the mobile reviewer of pr-cross does not staff the backend team
+	if overview.UnderstaffedPRsCount != 3 || len(overview.UnderstaffedPRs) != 3 ||
+		overview.UnderstaffedPRs[0].ID != "pr-cross" || overview.UnderstaffedPRs[1].ID != "pr-draft" ||
+		overview.UnderstaffedPRs[2].ID != "pr-short" {
+		t.Fatalf("unexpected understaffed PRs: %+v", overview.UnderstaffedPRs)
+	}
+
+	limited, err := statsRepo.Overview(ctx, 1)
+	if err != nil {
+		t.Fatalf("Overview failed: %v", err)
+	}
+	if limited.UnderstaffedPRsCount != 3 || len(limited.UnderstaffedPRs) != 1 {
+		t.Fatalf("expected the count of every understaffed PR with a limited list, got %+v", limited)
+	}
+}
@@ -91,6 +91,41 @@ func TestTeamRepository_GetByName_NotFound(t *testing.T) {
 	}
 }
 
+func TestTeamRepository_SetMaxReviewers(t *testing.T) {
+	ctx := context.Background()
+	truncateAll(t, ctx)
+
+	repo := repositories.NewTeamRepository(testPool)
+
+	teamName := domain.TeamName("backend")
+	insertTeam(t, ctx, teamName)
+
+	team, err := repo.GetByName(ctx, teamName)
+	if err != nil {
+		t.Fatalf("GetByName returned error: %v", err)
+	}
+	if team.MaxReviewers != domain.PullRequestMaxReviewers {
+		t.Fatalf("expected default max reviewers %d, got %d", domain.PullRequestMaxReviewers, team.MaxReviewers)
+	}
+
+	if err := repo.SetMaxReviewers(ctx, teamName, 3); err != nil {
+		t.Fatalf("SetMaxReviewers failed: %v", err)
+	}
+
+	team, err = repo.GetByName(ctx, teamName)
+	if err != nil {
+		t.Fatalf("GetByName returned error: %v", err)
+	}
+	if team.MaxReviewers != 3 {
+		t.Fatalf("expected max reviewers 3, got %d", team.MaxReviewers)
+	}
+
+	err = repo.SetMaxReviewers(ctx, domain.TeamName("ghost"), 3)
+	if !errors.Is(err, domain.ErrTeamNotFound) {
+		t.Fatalf("expected ErrTeamNotFound, got %v", err)
+	}
+}
+
 func TestTeamRepository_GetByUserID_Success(t *testing.T) {
 	ctx := context.Background()
 	truncateAll(t, ctx)
@@ -245,3 +280,107 @@ func TestTeamRepository_GetStats_TeamNotFound(t *testing.T) {
 		t.Fatalf("expected ErrTeamNotFound, got %v", err)
 	}
 }
+
+func TestTeamRepository_GetStatsTimeSeries(t *testing.T) {
+	ctx := context.Background()
+	truncateAll(t, ctx)
+
+	teamRepo := repositories.NewTeamRepository(testPool)
+	prRepo := repositories.NewPullRequestRepository(testPool)
+
+	insertTeam(t, ctx, "backend")
+	for _, u := range []domain.User{
+		{ID: "u1", Username: "Alice", TeamName: "backend", IsActive: true},
+		{ID: "u2", Username: "Bob", TeamName: "backend", IsActive: true},
+		{ID: "u3", Username: "Carol", TeamName: "backend", IsActive: true},
+	} {
+		insertUser(t, ctx, u)
+	}
+
+	now := time.Now().UTC()
+	today := now.Truncate(24 * time.Hour)
+	at := func(days, hours int) *time.Time {
+		ts := today.AddDate(0, 0, days).Add(time.Duration(hours) * time.Hour)
+		return &ts
+	}
+
+	slow := &domain.PullRequest{ID: "pr-slow", Name: "Slow", AuthorID: "u1",
+		Status: domain.PullRequestStatusMerged, CreatedAt: at(-2, 10), MergedAt: at(-1, 10)}
+	fast := &domain.PullRequest{ID: "pr-fast", Name: "Fast", AuthorID: "u1",
+		Status: domain.PullRequestStatusMerged, CreatedAt: at(-2, 12), MergedAt: at(-2, 14)}
+	fresh := &domain.PullRequest{ID: "pr-fresh", Name: "Fresh", AuthorID: "u1",
+		Status: domain.PullRequestStatusOpen, CreatedAt: &now, AssignedReviewers: []domain.UserID{"u2"}}
+	for _, pr := range []*domain.PullRequest{slow, fast, fresh} {
+		if err := prRepo.Create(ctx, pr); err != nil {
+			t.Fatalf("Create %s failed: %v", pr.ID, err)
+		}
+	}
+
+	fresh.AssignedReviewers = []domain.UserID{"u3"}
+	if err := prRepo.Update(ctx, fresh); err != nil {
+		t.Fatalf("Update failed: %v", err)
+	}
+
+	window := domain.StatsWindow{From: today.AddDate(0, 0, -3), To: today.AddDate(0, 0, 1)}
+	series, err := teamRepo.GetStatsTimeSeries(ctx, "backend", domain.StatsIntervalDay, window)
+	if err != nil {
+		t.Fatalf("GetStatsTimeSeries failed: %v", err)
+	}
+
+	if len(series.Buckets) != 4 {
+		t.Fatalf("expected 4 day buckets, got %+v", series.Buckets)
+	}
+	if b := series.Buckets[0]; !b.Start.Equal(window.From) || !b.End.Equal(window.From.AddDate(0, 0, 1)) ||
+		b.OpenedPRs != 0 || b.MergedPRs != 0 {
+		t.Fatalf("unexpected empty bucket: %+v", b)
+	}
+	if b := series.Buckets[1]; b.OpenedPRs != 2 || b.MergedPRs != 1 || b.MedianTimeToMergeSec != 7200 {
+		t.Fatalf("unexpected bucket two days ago: %+v", b)
+	}
+	if b := series.Buckets[2]; b.OpenedPRs != 0 || b.MergedPRs != 1 || b.P90TimeToMergeSec != 86400 {
+		t.Fatalf("unexpected bucket yesterday: %+v", b)
+	}
+	if b := series.Buckets[3]; b.OpenedPRs != 1 || b.Reassignments != 1 {
+		t.Fatalf("unexpected bucket today: %+v", b)
+	}
+
+	_, err = teamRepo.GetStatsTimeSeries(ctx, "ghost", domain.StatsIntervalDay, window)
+	if !errors.Is(err, domain.ErrTeamNotFound) {
+		t.Fatalf("expected ErrTeamNotFound, got %v", err)
+	}
+}
+
+func TestTeamRepository_List(t *testing.T) {
+	ctx := context.Background()
+	truncateAll(t, ctx)
+
+	repo := repositories.NewTeamRepository(testPool)
+
+	insertTeam(t, ctx, "frontend")
+	insertTeam(t, ctx, "backend")
+	insertTeam(t, ctx, "empty")
+	insertUser(t, ctx, domain.User{ID: "u2", Username: "Bob", TeamName: "backend", IsActive: false})
+	insertUser(t, ctx, domain.User{ID: "u1", Username: "Alice", TeamName: "backend", IsActive: true})
+	insertUser(t, ctx, domain.User{ID: "u3", Username: "Carol", TeamName: "frontend", IsActive: true})
+
+	teams, err := repo.List(ctx)
+	if err != nil {
+		t.Fatalf("List returned error: %v", err)
+	}
+
+	if len(teams) != 3 {
+		t.Fatalf("expected 3 teams, got %+v", teams)
+	}
+	if teams[0].Name != "backend" || teams[1].Name != "empty" || teams[2].Name != "frontend" {
+		t.Fatalf("expected teams ordered by name, got %+v", teams)
+	}
+	if len(teams[0].Members) != 2 || teams[0].Members[0].ID != "u1" || teams[0].Members[1].IsActive {
+		t.Fatalf("unexpected backend members: %+v", teams[0].Members)
+	}
+	if len(teams[1].Members) != 0 {
+		t.Fatalf("expected no members in empty team, got %+v", teams[1].Members)
+	}
+	if len(teams[2].Members) != 1 || teams[2].Members[0].Username != "Carol" {
+		t.Fatalf("unexpected frontend members: %+v", teams[2].Members)
+	}
+}
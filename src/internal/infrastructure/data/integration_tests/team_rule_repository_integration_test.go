@@ -0,0 +1,83 @@
+//go:build integration
+
+package integration_tests
+
+import (
+	"PrService/src/internal/domain"
+	"PrService/src/internal/infrastructure/data/repositories"
+	"context"
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestTeamRuleRepository_Create_LoadWithTeam_Delete(t *testing.T) {
+	ctx := context.Background()
+	truncateAll(t, ctx)
+
+	teamRepo := repositories.NewTeamRepository(testPool)
+	ruleRepo := repositories.NewTeamRuleRepository(testPool)
+
+	teamName := domain.TeamName("backend")
+	insertTeam(t, ctx, teamName)
+
+	exclude := &domain.TeamRule{
+		TeamName:   teamName,
+		Kind:       domain.TeamRuleKindExcludePair,
+		ReviewerID: "u2",
+		AuthorID:   "u1",
+	}
+	maxOf := &domain.TeamRule{
+		TeamName: teamName,
+		Kind:     domain.TeamRuleKindMaxOf,
+		Users:    []domain.UserID{"u3", "u4"},
+		MaxCount: 1,
+	}
+	requireSenior := &domain.TeamRule{
+		TeamName:  teamName,
+		Kind:      domain.TeamRuleKindRequireOneOf,
+		Seniority: domain.SenioritySenior,
+	}
+	for _, rule := range []*domain.TeamRule{exclude, maxOf, requireSenior} {
+		if err := ruleRepo.Create(ctx, rule); err != nil {
+			t.Fatalf("Create rule failed: %v", err)
+		}
+		if rule.ID == 0 {
+			t.Fatalf("expected rule id to be set")
+		}
+	}
+
+	team, err := teamRepo.GetByName(ctx, teamName)
+	if err != nil {
+		t.Fatalf("GetByName returned error: %v", err)
+	}
+	if len(team.Rules) != 3 {
+		t.Fatalf("expected 3 rules, got %+v", team.Rules)
+	}
+	if team.Rules[0].ReviewerID != "u2" || team.Rules[0].AuthorID != "u1" {
+		t.Fatalf("unexpected exclude rule: %+v", team.Rules[0])
+	}
+	if !slices.Equal(team.Rules[1].Users, maxOf.Users) || team.Rules[1].MaxCount != 1 {
+		t.Fatalf("unexpected max_of rule: %+v", team.Rules[1])
+	}
+	if team.Rules[2].Seniority != domain.SenioritySenior || len(team.Rules[2].Users) != 0 {
+		t.Fatalf("unexpected require_one_of rule: %+v", team.Rules[2])
+	}
+
+	if err := ruleRepo.Delete(ctx, teamName, exclude.ID); err != nil {
+		t.Fatalf("Delete rule failed: %v", err)
+	}
+
+	err = ruleRepo.Delete(ctx, teamName, exclude.ID)
+	if !errors.Is(err, domain.ErrTeamRuleNotFound) {
+		t.Fatalf("expected ErrTeamRuleNotFound, got %v", err)
+	}
+
+	team, err = teamRepo.GetByName(ctx, teamName)
+	if err != nil {
+		t.Fatalf("GetByName returned error: %v", err)
+	}
+	if len(team.Rules) != 2 || team.Rules[0].ID != maxOf.ID {
+		t.Fatalf("expected exclude rule to be deleted, got %+v", team.Rules)
+	}
+}
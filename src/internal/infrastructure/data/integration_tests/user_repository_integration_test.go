@@ -6,6 +6,7 @@ import (
 	"PrService/src/internal/infrastructure/data/repositories"
 	"context"
 	"errors"
+	"slices"
 	"testing"
 
 	"PrService/src/internal/domain"
@@ -149,6 +150,47 @@ func TestUserRepository_Update_Success(t *testing.T) {
 	}
 }
 
+func TestUserRepository_ContactSettings_KeptOnTeamUpsert(t *testing.T) {
+	ctx := context.Background()
+	truncateAll(t, ctx)
+
+	repo := repositories.NewUserRepository(testPool)
+
+	teamName := domain.TeamName("backend")
+	insertTeam(t, ctx, teamName)
+
+	user := domain.User{ID: "u1", Username: "Alice", TeamName: teamName, IsActive: true}
+	if err := repo.UpsertBatch(ctx, []domain.User{user}); err != nil {
+		t.Fatalf("UpsertBatch failed: %v", err)
+	}
+
+	user.ChatHandle = "alice"
+	user.Email = "alice@example.com"
+	user.EmailOptOut = true
+	if err := repo.Update(ctx, &user); err != nil {
+		t.Fatalf("Update failed: %v", err)
+	}
+
+	// Re-adding the team carries no contact settings and must not clear them.
+	user.ChatHandle = ""
+	user.Email = ""
+	user.EmailOptOut = false
+	if err := repo.UpsertBatch(ctx, []domain.User{user}); err != nil {
+		t.Fatalf("UpsertBatch failed: %v", err)
+	}
+
+	got, err := repo.GetByID(ctx, user.ID)
+	if err != nil {
+		t.Fatalf("GetByID returned error: %v", err)
+	}
+	if got.ChatHandle != "alice" {
+		t.Fatalf("expected chat handle alice, got %q", got.ChatHandle)
+	}
+	if got.Email != "alice@example.com" || !got.EmailOptOut {
+		t.Fatalf("expected email settings to be kept, got %q opt out %v", got.Email, got.EmailOptOut)
+	}
+}
+
 func TestUserRepository_Update_NotFound(t *testing.T) {
 	ctx := context.Background()
 	truncateAll(t, ctx)
@@ -170,3 +212,65 @@ func TestUserRepository_Update_NotFound(t *testing.T) {
 		t.Fatalf("expected ErrUserNotFound, got %v", err)
 	}
 }
+
+func TestUserRepository_List_Filters(t *testing.T) {
+	ctx := context.Background()
+	truncateAll(t, ctx)
+
+	repo := repositories.NewUserRepository(testPool)
+
+	insertTeam(t, ctx, "backend")
+	insertTeam(t, ctx, "frontend")
+
+	users := []domain.User{
+		{ID: "u1", Username: "Alice", TeamName: "backend", IsActive: true, Seniority: domain.SenioritySenior,
+			Tags: []string{"db", "go"}},
+		{ID: "u2", Username: "Bob", TeamName: "backend", IsActive: true, Seniority: domain.SeniorityJunior,
+			Tags: []string{"go"}},
+		{ID: "u3", Username: "Carol", TeamName: "frontend", IsActive: true, Tags: []string{"db"}},
+	}
+	if err := repo.UpsertBatch(ctx, users); err != nil {
+		t.Fatalf("UpsertBatch failed: %v", err)
+	}
+
+	tests := []struct {
+		name   string
+		filter domain.UserFilter
+		want   []domain.UserID
+	}{
+		{name: "no filter", filter: domain.UserFilter{}, want: []domain.UserID{"u1", "u2", "u3"}},
+		{name: "by team", filter: domain.UserFilter{TeamName: "backend"}, want: []domain.UserID{"u1", "u2"}},
+		{name: "by tag", filter: domain.UserFilter{Tags: []string{"db"}}, want: []domain.UserID{"u1", "u3"}},
+		{name: "by all tags", filter: domain.UserFilter{Tags: []string{"db", "go"}}, want: []domain.UserID{"u1"}},
+		{
+			name:   "by seniority",
+			filter: domain.UserFilter{Seniority: domain.SeniorityJunior},
+			want:   []domain.UserID{"u2"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := repo.List(ctx, tt.filter)
+			if err != nil {
+				t.Fatalf("List returned error: %v", err)
+			}
+
+			ids := make([]domain.UserID, 0, len(got))
+			for _, u := range got {
+				ids = append(ids, u.ID)
+			}
+			if !slices.Equal(ids, tt.want) {
+				t.Fatalf("expected %v, got %v", tt.want, ids)
+			}
+		})
+	}
+
+	got, err := repo.GetByID(ctx, "u1")
+	if err != nil {
+		t.Fatalf("GetByID returned error: %v", err)
+	}
+	if got.Seniority != domain.SenioritySenior || !slices.Equal(got.Tags, []string{"db", "go"}) {
+		t.Fatalf("expected seniority and tags to round-trip, got %+v", got)
+	}
+}
@@ -0,0 +1,89 @@
+//go:build integration
+
+package integration_tests
+
+import (
+	"PrService/src/internal/domain"
+	"PrService/src/internal/infrastructure/data"
+	"PrService/src/internal/infrastructure/data/repositories"
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestWebhookDeliveryRepository_Register(t *testing.T) {
+	ctx := context.Background()
+	truncateAll(t, ctx)
+
+	repo := repositories.NewWebhookDeliveryRepository(testPool)
+
+	registered, err := repo.Register(ctx, domain.ExternalProviderGitLab, "uuid-1")
+	if err != nil {
+		t.Fatalf("Register failed: %v", err)
+	}
+	if !registered {
+		t.Fatalf("expected first delivery to be registered")
+	}
+
+	registered, err = repo.Register(ctx, domain.ExternalProviderGitLab, "uuid-1")
+	if err != nil {
+		t.Fatalf("second Register failed: %v", err)
+	}
+	if registered {
+		t.Fatalf("expected repeated delivery to be reported as seen")
+	}
+
+	registered, err = repo.Register(ctx, domain.ExternalProviderGitHub, "uuid-1")
+	if err != nil {
+		t.Fatalf("Register for another provider failed: %v", err)
+	}
+	if !registered {
+		t.Fatalf("expected delivery ids to be scoped by provider")
+	}
+}
+
+func TestWebhookDeliveryRepository_RolledBackWithTransaction(t *testing.T) {
+	ctx := context.Background()
+	truncateAll(t, ctx)
+
+	repo := repositories.NewWebhookDeliveryRepository(testPool)
+	prRepo := repositories.NewPullRequestRepository(testPool)
+	txManager := data.NewTxManager(testPool)
+
+	errApply := errors.New("apply failed")
+	err := txManager.WithinTransaction(ctx, func(txCtx context.Context) error {
+		if _, err := repo.Register(txCtx, domain.ExternalProviderGitLab, "uuid-1"); err != nil {
+			return err
+		}
+
+		// A failed nested transaction is rolled back to its savepoint and leaves the outer one usable.
+		nestedErr := txManager.WithinTransaction(txCtx, func(nestedCtx context.Context) error {
+			return prRepo.Create(nestedCtx, &domain.PullRequest{
+				ID:       "pr-1",
+				Name:     "Unknown author",
+				AuthorID: "ghost",
+				Status:   domain.PullRequestStatusOpen,
+			})
+		})
+		if nestedErr == nil {
+			t.Fatalf("expected nested transaction to fail on unknown author")
+		}
+
+		if _, err := repo.Register(txCtx, domain.ExternalProviderGitLab, "uuid-2"); err != nil {
+			t.Fatalf("expected outer transaction to stay usable, got %v", err)
+		}
+
+		return errApply
+	})
+	if !errors.Is(err, errApply) {
+		t.Fatalf("expected apply error, got %v", err)
+	}
+
+	registered, err := repo.Register(ctx, domain.ExternalProviderGitLab, "uuid-1")
+	if err != nil {
+		t.Fatalf("Register failed: %v", err)
+	}
+	if !registered {
+		t.Fatalf("expected delivery of the failed transaction to be registrable again")
+	}
+}
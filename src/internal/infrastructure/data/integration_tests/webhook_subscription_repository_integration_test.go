@@ -0,0 +1,219 @@
+//go:build integration
+
+package integration_tests
+
+import (
+	"PrService/src/internal/domain"
+	"PrService/src/internal/infrastructure/data/repositories"
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestWebhookSubscriptionRepository_CreateListDelete(t *testing.T) {
+	ctx := context.Background()
+	truncateAll(t, ctx)
+
+	repo := repositories.NewWebhookSubscriptionRepository(testPool)
+
+	merged := &domain.WebhookSubscription{
+		URL:        "https://hooks.example.com/merged",
+		Secret:     "secret-1",
+		EventTypes: []domain.EventType{domain.EventPullRequestMerged},
+	}
+	all := &domain.WebhookSubscription{
+		URL:        "https://hooks.example.com/all",
+		Secret:     "secret-2",
+		EventTypes: []domain.EventType{domain.EventPullRequestCreated, domain.EventPullRequestMerged},
+	}
+	for _, s := range []*domain.WebhookSubscription{merged, all} {
+		if err := repo.Create(ctx, s); err != nil {
+			t.Fatalf("Create failed: %v", err)
+		}
+		if s.ID == 0 || s.CreatedAt.IsZero() {
+			t.Fatalf("expected id and created_at to be returned, got %+v", s)
+		}
+	}
+
+	created, err := repo.ListByEventType(ctx, domain.EventPullRequestCreated)
+	if err != nil {
+		t.Fatalf("ListByEventType failed: %v", err)
+	}
+	if len(created) != 1 || created[0].ID != all.ID || created[0].Secret != "secret-2" {
+		t.Fatalf("unexpected subscriptions for PR_CREATED: %+v", created)
+	}
+
+	if err := repo.Delete(ctx, merged.ID); err != nil {
+		t.Fatalf("Delete failed: %v", err)
+	}
+	if err := repo.Delete(ctx, merged.ID); !errors.Is(err, domain.ErrWebhookSubscriptionNotFound) {
+		t.Fatalf("expected ErrWebhookSubscriptionNotFound on repeated delete, got %v", err)
+	}
+	if _, err := repo.GetByID(ctx, merged.ID); !errors.Is(err, domain.ErrWebhookSubscriptionNotFound) {
+		t.Fatalf("expected ErrWebhookSubscriptionNotFound, got %v", err)
+	}
+
+	list, err := repo.List(ctx)
+	if err != nil {
+		t.Fatalf("List failed: %v", err)
+	}
+	if len(list) != 1 || len(list[0].EventTypes) != 2 {
+		t.Fatalf("unexpected subscriptions: %+v", list)
+	}
+}
+
+func TestWebhookSubscriptionRepository_ClaimAndUpdateDeliveries(t *testing.T) {
+	ctx := context.Background()
+	truncateAll(t, ctx)
+
+	repo := repositories.NewWebhookSubscriptionRepository(testPool)
+
+	subscription := &domain.WebhookSubscription{
+		URL:        "https://hooks.example.com",
+		Secret:     "secret",
+		EventTypes: []domain.EventType{domain.EventPullRequestCreated},
+	}
+	if err := repo.Create(ctx, subscription); err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	err := repo.EnqueueDeliveries(ctx, []domain.WebhookSubscriptionDelivery{
+		{
+			SubscriptionID: subscription.ID,
+			EventID:        1,
+			EventType:      domain.EventPullRequestCreated,
+			Payload:        []byte(`{"type":"PR_CREATED"}`),
+			Status:         domain.WebhookDeliveryPending,
+			NextAttemptAt:  now,
+		},
+		{
+			SubscriptionID: subscription.ID,
+			EventID:        2,
+			EventType:      domain.EventPullRequestCreated,
+			Payload:        []byte(`{"type":"PR_CREATED","later":true}`),
+			Status:         domain.WebhookDeliveryPending,
+			NextAttemptAt:  now.Add(time.Hour),
+		},
+	})
+	if err != nil {
+		t.Fatalf("EnqueueDeliveries failed: %v", err)
+	}
+
+	claimed, err := repo.ClaimDueDeliveries(ctx, now, time.Minute, 10)
+	if err != nil {
+		t.Fatalf("ClaimDueDeliveries failed: %v", err)
+	}
+	if len(claimed) != 1 {
+		t.Fatalf("expected only the due delivery to be claimed, got %+v", claimed)
+	}
+	if claimed[0].URL != subscription.URL || claimed[0].Secret != "secret" || claimed[0].EventID != 1 {
+		t.Fatalf("expected subscription url and secret on claimed delivery, got %+v", claimed[0])
+	}
+
+	again, err := repo.ClaimDueDeliveries(ctx, now, time.Minute, 10)
+	if err != nil {
+		t.Fatalf("second ClaimDueDeliveries failed: %v", err)
+	}
+	if len(again) != 0 {
+		t.Fatalf("expected leased delivery not to be claimed again, got %+v", again)
+	}
+
+	delivery := claimed[0]
+	delivery.Status = domain.WebhookDeliverySucceeded
+	delivery.Attempts = 1
+	delivery.LastStatusCode = 200
+	delivery.DeliveredAt = &now
+	if err := repo.UpdateDelivery(ctx, delivery); err != nil {
+		t.Fatalf("UpdateDelivery failed: %v", err)
+	}
+
+	deliveries, err := repo.ListDeliveries(ctx, subscription.ID, 10)
+	if err != nil {
+		t.Fatalf("ListDeliveries failed: %v", err)
+	}
+	if len(deliveries) != 2 || deliveries[1].ID != delivery.ID {
+		t.Fatalf("expected newest deliveries first, got %+v", deliveries)
+	}
+	if got := deliveries[1]; got.Status != domain.WebhookDeliverySucceeded || got.DeliveredAt == nil {
+		t.Fatalf("unexpected updated delivery: %+v", got)
+	}
+}
+
+func TestWebhookSubscriptionRepository_EnqueueDeliveries_IgnoresRepeatedEvent(t *testing.T) {
+	ctx := context.Background()
+	truncateAll(t, ctx)
+
+	repo := repositories.NewWebhookSubscriptionRepository(testPool)
+
+	subscription := &domain.WebhookSubscription{
+		URL:        "https://hooks.example.com",
+		Secret:     "secret",
+		EventTypes: []domain.EventType{domain.EventPullRequestMerged},
+	}
+	if err := repo.Create(ctx, subscription); err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+
+	delivery := domain.WebhookSubscriptionDelivery{
+		SubscriptionID: subscription.ID,
+		EventID:        42,
+		EventType:      domain.EventPullRequestMerged,
+		Payload:        []byte(`{"type":"PR_MERGED"}`),
+		Status:         domain.WebhookDeliveryPending,
+		NextAttemptAt:  time.Now(),
+	}
+	// The outbox relay may hand over the same event again after a failure in another sink.
+	for range 2 {
+		if err := repo.EnqueueDeliveries(ctx, []domain.WebhookSubscriptionDelivery{delivery}); err != nil {
+			t.Fatalf("EnqueueDeliveries failed: %v", err)
+		}
+	}
+
+	deliveries, err := repo.ListDeliveries(ctx, subscription.ID, 10)
+	if err != nil {
+		t.Fatalf("ListDeliveries failed: %v", err)
+	}
+	if len(deliveries) != 1 || deliveries[0].EventID != 42 {
+		t.Fatalf("expected a single delivery for event 42, got %+v", deliveries)
+	}
+}
+
+func TestWebhookSubscriptionRepository_DeliveriesDeletedWithSubscription(t *testing.T) {
+	ctx := context.Background()
+	truncateAll(t, ctx)
+
+	repo := repositories.NewWebhookSubscriptionRepository(testPool)
+
+	subscription := &domain.WebhookSubscription{
+		URL:        "https://hooks.example.com",
+		Secret:     "secret",
+		EventTypes: []domain.EventType{domain.EventUserDeactivated},
+	}
+	if err := repo.Create(ctx, subscription); err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+	err := repo.EnqueueDeliveries(ctx, []domain.WebhookSubscriptionDelivery{{
+		SubscriptionID: subscription.ID,
+		EventType:      domain.EventUserDeactivated,
+		Payload:        []byte(`{}`),
+		Status:         domain.WebhookDeliveryPending,
+		NextAttemptAt:  time.Now(),
+	}})
+	if err != nil {
+		t.Fatalf("EnqueueDeliveries failed: %v", err)
+	}
+
+	if err := repo.Delete(ctx, subscription.ID); err != nil {
+		t.Fatalf("Delete failed: %v", err)
+	}
+
+	var count int
+	if err := testPool.QueryRow(ctx, `SELECT count(*) FROM webhook_subscription_deliveries`).Scan(&count); err != nil {
+		t.Fatalf("count deliveries: %v", err)
+	}
+	if count != 0 {
+		t.Fatalf("expected deliveries to be deleted with subscription, got %d", count)
+	}
+}
@@ -0,0 +1,6 @@
+BEGIN;
+
+ALTER TABLE pull_request_reviewers
+    DROP COLUMN IF EXISTS team_name;
+
+COMMIT;
\ No newline at end of file
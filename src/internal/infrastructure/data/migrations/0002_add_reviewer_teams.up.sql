@@ -0,0 +1,16 @@
+BEGIN;
+
+ALTER TABLE pull_request_reviewers
+    ADD COLUMN IF NOT EXISTS team_name TEXT
+        CONSTRAINT fk_pr_reviewers_team
+            REFERENCES teams (name)
+            ON UPDATE CASCADE
+            ON DELETE SET NULL;
+
+UPDATE pull_request_reviewers prr
+SET team_name = u.team_name
+FROM users u
+WHERE u.id = prr.reviewer_id
+  AND prr.team_name IS NULL;
+
+COMMIT;
\ No newline at end of file
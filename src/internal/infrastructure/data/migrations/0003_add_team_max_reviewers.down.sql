@@ -0,0 +1,6 @@
+BEGIN;
+
+ALTER TABLE teams
+    DROP COLUMN IF EXISTS max_reviewers;
+
+COMMIT;
\ No newline at end of file
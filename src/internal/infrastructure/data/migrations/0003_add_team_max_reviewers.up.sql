@@ -0,0 +1,7 @@
+BEGIN;
+
+ALTER TABLE teams
+    ADD COLUMN IF NOT EXISTS max_reviewers INT NOT NULL DEFAULT 2
+        CONSTRAINT chk_teams_max_reviewers CHECK (max_reviewers > 0);
+
+COMMIT;
\ No newline at end of file
@@ -0,0 +1,18 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS team_rules (
+    id          BIGSERIAL PRIMARY KEY,
+    team_name   TEXT        NOT NULL REFERENCES teams (name) ON DELETE CASCADE,
+    kind        TEXT        NOT NULL,
+    reviewer_id TEXT        NOT NULL DEFAULT '',
+    author_id   TEXT        NOT NULL DEFAULT '',
+    user_ids    TEXT[]      NOT NULL DEFAULT '{}',
+    max_count   INT         NOT NULL DEFAULT 0,
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT now(),
+    CONSTRAINT chk_team_rules_kind CHECK (kind IN ('EXCLUDE_PAIR', 'REQUIRE_ONE_OF', 'MAX_OF')),
+    CONSTRAINT chk_team_rules_max_count CHECK (max_count >= 0)
+);
+
+CREATE INDEX IF NOT EXISTS idx_team_rules_team_name ON team_rules (team_name);
+
+COMMIT;
\ No newline at end of file
@@ -0,0 +1,13 @@
+BEGIN;
+
+ALTER TABLE pull_requests
+    DROP COLUMN IF EXISTS tags;
+
+DROP INDEX IF EXISTS idx_users_tags;
+
+ALTER TABLE users
+    DROP CONSTRAINT IF EXISTS chk_users_seniority,
+    DROP COLUMN IF EXISTS tags,
+    DROP COLUMN IF EXISTS seniority;
+
+COMMIT;
\ No newline at end of file
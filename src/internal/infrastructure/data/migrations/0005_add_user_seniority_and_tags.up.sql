@@ -0,0 +1,19 @@
+BEGIN;
+
+ALTER TABLE users
+    ADD COLUMN IF NOT EXISTS seniority TEXT NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS tags TEXT[] NOT NULL DEFAULT '{}';
+
+ALTER TABLE users
+    DROP CONSTRAINT IF EXISTS chk_users_seniority;
+
+ALTER TABLE users
+    ADD CONSTRAINT chk_users_seniority
+        CHECK (seniority IN ('', 'JUNIOR', 'MIDDLE', 'SENIOR', 'LEAD'));
+
+CREATE INDEX IF NOT EXISTS idx_users_tags ON users USING GIN (tags);
+
+ALTER TABLE pull_requests
+    ADD COLUMN IF NOT EXISTS tags TEXT[] NOT NULL DEFAULT '{}';
+
+COMMIT;
\ No newline at end of file
@@ -0,0 +1,5 @@
+BEGIN;
+
+DROP TABLE IF EXISTS team_codeowners;
+
+COMMIT;
\ No newline at end of file
@@ -0,0 +1,13 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS team_codeowners (
+    team_name     TEXT PRIMARY KEY REFERENCES teams (name) ON DELETE CASCADE,
+    content       TEXT        NOT NULL,
+    mode          TEXT        NOT NULL DEFAULT 'PREFER',
+    fill_strategy TEXT        NOT NULL DEFAULT 'RANDOM',
+    updated_at    TIMESTAMPTZ NOT NULL DEFAULT now(),
+    CONSTRAINT chk_team_codeowners_mode CHECK (mode IN ('PREFER', 'REQUIRE')),
+    CONSTRAINT chk_team_codeowners_fill_strategy CHECK (fill_strategy IN ('RANDOM', 'LOAD'))
+);
+
+COMMIT;
\ No newline at end of file
@@ -0,0 +1,16 @@
+BEGIN;
+
+DROP TABLE IF EXISTS external_accounts;
+
+UPDATE pull_requests
+SET status = 'OPEN'
+WHERE status IN ('DRAFT', 'CLOSED');
+
+ALTER TABLE pull_requests
+    DROP CONSTRAINT IF EXISTS chk_pull_request_status;
+
+ALTER TABLE pull_requests
+    ADD CONSTRAINT chk_pull_request_status
+        CHECK (status IN ('OPEN', 'MERGED'));
+
+COMMIT;
\ No newline at end of file
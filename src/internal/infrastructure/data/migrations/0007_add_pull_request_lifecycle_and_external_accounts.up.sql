@@ -0,0 +1,20 @@
+BEGIN;
+
+ALTER TABLE pull_requests
+    DROP CONSTRAINT IF EXISTS chk_pull_request_status;
+
+ALTER TABLE pull_requests
+    ADD CONSTRAINT chk_pull_request_status
+        CHECK (status IN ('DRAFT', 'OPEN', 'MERGED', 'CLOSED'));
+
+CREATE TABLE IF NOT EXISTS external_accounts (
+    provider TEXT NOT NULL,
+    login    TEXT NOT NULL,
+    user_id  TEXT NOT NULL REFERENCES users (id) ON UPDATE CASCADE ON DELETE CASCADE,
+    PRIMARY KEY (provider, login),
+    CONSTRAINT chk_external_accounts_provider CHECK (provider IN ('GITHUB'))
+);
+
+CREATE INDEX IF NOT EXISTS idx_external_accounts_user_id ON external_accounts (user_id);
+
+COMMIT;
\ No newline at end of file
@@ -0,0 +1,14 @@
+BEGIN;
+
+DROP TABLE IF EXISTS webhook_deliveries;
+
+DELETE FROM external_accounts
+WHERE provider = 'GITLAB';
+
+ALTER TABLE external_accounts
+    DROP CONSTRAINT IF EXISTS chk_external_accounts_provider;
+
+ALTER TABLE external_accounts
+    ADD CONSTRAINT chk_external_accounts_provider CHECK (provider IN ('GITHUB'));
+
+COMMIT;
\ No newline at end of file
@@ -0,0 +1,16 @@
+BEGIN;
+
+ALTER TABLE external_accounts
+    DROP CONSTRAINT IF EXISTS chk_external_accounts_provider;
+
+ALTER TABLE external_accounts
+    ADD CONSTRAINT chk_external_accounts_provider CHECK (provider IN ('GITHUB', 'GITLAB'));
+
+CREATE TABLE IF NOT EXISTS webhook_deliveries (
+    provider    TEXT        NOT NULL,
+    delivery_id TEXT        NOT NULL,
+    received_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (provider, delivery_id)
+);
+
+COMMIT;
\ No newline at end of file
@@ -0,0 +1,10 @@
+BEGIN;
+
+DROP INDEX IF EXISTS uq_webhook_subscription_deliveries_event;
+
+ALTER TABLE webhook_subscription_deliveries
+    DROP COLUMN IF EXISTS event_id;
+
+DROP TABLE IF EXISTS outbox_events;
+
+COMMIT;
\ No newline at end of file
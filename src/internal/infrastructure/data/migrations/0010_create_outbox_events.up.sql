@@ -0,0 +1,26 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS outbox_events (
+    id              BIGSERIAL PRIMARY KEY,
+    aggregate_type  TEXT        NOT NULL,
+    aggregate_id    TEXT        NOT NULL,
+    event_type      TEXT        NOT NULL,
+    payload         JSONB       NOT NULL,
+    occurred_at     TIMESTAMPTZ NOT NULL,
+    attempts        INT         NOT NULL DEFAULT 0,
+    last_error      TEXT        NOT NULL DEFAULT '',
+    next_attempt_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    published_at    TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS idx_outbox_events_pending
+    ON outbox_events (aggregate_type, aggregate_id, id)
+    WHERE published_at IS NULL;
+
+ALTER TABLE webhook_subscription_deliveries
+    ADD COLUMN IF NOT EXISTS event_id BIGINT;
+
+CREATE UNIQUE INDEX IF NOT EXISTS uq_webhook_subscription_deliveries_event
+    ON webhook_subscription_deliveries (subscription_id, event_id);
+
+COMMIT;
\ No newline at end of file
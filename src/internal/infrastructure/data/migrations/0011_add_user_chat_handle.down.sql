@@ -0,0 +1,6 @@
+BEGIN;
+
+ALTER TABLE users
+    DROP COLUMN IF EXISTS chat_handle;
+
+COMMIT;
\ No newline at end of file
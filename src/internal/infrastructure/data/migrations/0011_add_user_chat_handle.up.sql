@@ -0,0 +1,6 @@
+BEGIN;
+
+ALTER TABLE users
+    ADD COLUMN IF NOT EXISTS chat_handle TEXT NOT NULL DEFAULT '';
+
+COMMIT;
\ No newline at end of file
@@ -0,0 +1,7 @@
+BEGIN;
+
+ALTER TABLE users
+    DROP COLUMN IF EXISTS email_opt_out,
+    DROP COLUMN IF EXISTS email;
+
+COMMIT;
\ No newline at end of file
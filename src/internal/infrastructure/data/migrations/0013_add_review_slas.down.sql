@@ -0,0 +1,15 @@
+BEGIN;
+
+DROP TABLE IF EXISTS pull_request_history;
+
+ALTER TABLE pull_request_reviewers
+    DROP CONSTRAINT IF EXISTS chk_pr_reviewers_sla_stage;
+
+ALTER TABLE pull_request_reviewers
+    DROP COLUMN IF EXISTS sla_stage_at,
+    DROP COLUMN IF EXISTS sla_stage,
+    DROP COLUMN IF EXISTS assigned_at;
+
+DROP TABLE IF EXISTS team_review_slas;
+
+COMMIT;
\ No newline at end of file
@@ -0,0 +1,38 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS team_review_slas (
+    team_name               TEXT PRIMARY KEY REFERENCES teams (name) ON UPDATE CASCADE ON DELETE CASCADE,
+    first_review_within_sec INT         NOT NULL,
+    escalate_after_sec      INT         NOT NULL,
+    action                  TEXT        NOT NULL DEFAULT 'REASSIGN',
+    updated_at              TIMESTAMPTZ NOT NULL DEFAULT now(),
+    CONSTRAINT chk_team_review_slas_first_review CHECK (first_review_within_sec > 0),
+    CONSTRAINT chk_team_review_slas_escalate_after CHECK (escalate_after_sec >= 0),
+    CONSTRAINT chk_team_review_slas_action CHECK (action IN ('REASSIGN', 'ESCALATE'))
+);
+
+ALTER TABLE pull_request_reviewers
+    ADD COLUMN IF NOT EXISTS assigned_at  TIMESTAMPTZ NOT NULL DEFAULT now(),
+    ADD COLUMN IF NOT EXISTS sla_stage    TEXT        NOT NULL DEFAULT 'PENDING',
+    ADD COLUMN IF NOT EXISTS sla_stage_at TIMESTAMPTZ;
+
+ALTER TABLE pull_request_reviewers
+    DROP CONSTRAINT IF EXISTS chk_pr_reviewers_sla_stage;
+
+ALTER TABLE pull_request_reviewers
+    ADD CONSTRAINT chk_pr_reviewers_sla_stage
+        CHECK (sla_stage IN ('PENDING', 'NOTIFIED', 'ESCALATED'));
+
+CREATE TABLE IF NOT EXISTS pull_request_history (
+    id              BIGSERIAL PRIMARY KEY,
+    pull_request_id TEXT        NOT NULL REFERENCES pull_requests (id) ON UPDATE CASCADE ON DELETE CASCADE,
+    action          TEXT        NOT NULL,
+    reviewer_id     TEXT        NOT NULL DEFAULT '',
+    target_ids      TEXT[]      NOT NULL DEFAULT '{}',
+    details         TEXT        NOT NULL DEFAULT '',
+    occurred_at     TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS idx_pull_request_history_pr ON pull_request_history (pull_request_id, id);
+
+COMMIT;
\ No newline at end of file
@@ -0,0 +1,5 @@
+BEGIN;
+
+DROP TABLE IF EXISTS review_assignments;
+
+COMMIT;
\ No newline at end of file
@@ -0,0 +1,39 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS review_assignments
+(
+    id              BIGSERIAL PRIMARY KEY,
+    pull_request_id TEXT        NOT NULL,
+    reviewer_id     TEXT        NOT NULL,
+    team_name       TEXT,
+    assigned_at     TIMESTAMPTZ NOT NULL DEFAULT now(),
+    unassigned_at   TIMESTAMPTZ,
+    reassigned      BOOLEAN     NOT NULL DEFAULT FALSE,
+
+    CONSTRAINT fk_review_assignments_pr
+        FOREIGN KEY (pull_request_id)
+            REFERENCES pull_requests (id)
+            ON UPDATE CASCADE
+            ON DELETE CASCADE,
+
+    CONSTRAINT fk_review_assignments_user
+        FOREIGN KEY (reviewer_id)
+            REFERENCES users (id)
+            ON UPDATE CASCADE
+            ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_review_assignments_reviewer ON review_assignments (reviewer_id, assigned_at);
+
+CREATE UNIQUE INDEX IF NOT EXISTS uq_review_assignments_current
+    ON review_assignments (pull_request_id, reviewer_id)
+    WHERE unassigned_at IS NULL;
+
+-- current assignments made before the ledger existed are dated by the creation of their pull request
+INSERT INTO review_assignments (pull_request_id, reviewer_id, team_name, assigned_at)
+SELECT prr.pull_request_id, prr.reviewer_id, prr.team_name, COALESCE(pr.created_at, prr.assigned_at)
+FROM pull_request_reviewers prr
+JOIN pull_requests pr ON pr.id = prr.pull_request_id
+ON CONFLICT DO NOTHING;
+
+COMMIT;
\ No newline at end of file
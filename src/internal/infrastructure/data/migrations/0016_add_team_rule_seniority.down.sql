@@ -0,0 +1,7 @@
+BEGIN;
+
+ALTER TABLE team_rules
+    DROP CONSTRAINT IF EXISTS chk_team_rules_seniority,
+    DROP COLUMN IF EXISTS seniority;
+
+COMMIT;
\ No newline at end of file
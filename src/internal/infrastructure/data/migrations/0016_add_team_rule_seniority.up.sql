@@ -0,0 +1,13 @@
+BEGIN;
+
+ALTER TABLE team_rules
+    ADD COLUMN IF NOT EXISTS seniority TEXT NOT NULL DEFAULT '';
+
+ALTER TABLE team_rules
+    DROP CONSTRAINT IF EXISTS chk_team_rules_seniority;
+
+ALTER TABLE team_rules
+    ADD CONSTRAINT chk_team_rules_seniority
+        CHECK (seniority IN ('', 'JUNIOR', 'MIDDLE', 'SENIOR', 'LEAD'));
+
+COMMIT;
\ No newline at end of file
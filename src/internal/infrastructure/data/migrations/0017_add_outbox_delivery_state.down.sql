@@ -0,0 +1,14 @@
+BEGIN;
+
+DROP INDEX IF EXISTS idx_outbox_events_pending;
+
+CREATE INDEX IF NOT EXISTS idx_outbox_events_pending
+    ON outbox_events (aggregate_type, aggregate_id, id)
+    WHERE published_at IS NULL;
+
+ALTER TABLE outbox_events
+    DROP CONSTRAINT IF EXISTS chk_outbox_events_status,
+    DROP COLUMN IF EXISTS delivered_sinks,
+    DROP COLUMN IF EXISTS status;
+
+COMMIT;
\ No newline at end of file
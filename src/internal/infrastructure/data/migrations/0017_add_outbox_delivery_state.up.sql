@@ -0,0 +1,24 @@
+BEGIN;
+
+ALTER TABLE outbox_events
+    ADD COLUMN IF NOT EXISTS status TEXT NOT NULL DEFAULT 'PENDING',
+    ADD COLUMN IF NOT EXISTS delivered_sinks TEXT[] NOT NULL DEFAULT '{}';
+
+UPDATE outbox_events
+SET status = 'PUBLISHED'
+WHERE published_at IS NOT NULL;
+
+ALTER TABLE outbox_events
+    DROP CONSTRAINT IF EXISTS chk_outbox_events_status;
+
+ALTER TABLE outbox_events
+    ADD CONSTRAINT chk_outbox_events_status
+        CHECK (status IN ('PENDING', 'PUBLISHED', 'FAILED'));
+
+DROP INDEX IF EXISTS idx_outbox_events_pending;
+
+CREATE INDEX IF NOT EXISTS idx_outbox_events_pending
+    ON outbox_events (aggregate_type, aggregate_id, id)
+    WHERE status = 'PENDING';
+
+COMMIT;
\ No newline at end of file
@@ -0,0 +1,8 @@
+BEGIN;
+
+DROP INDEX IF EXISTS idx_outbox_events_stream;
+
+ALTER TABLE outbox_events
+    DROP COLUMN IF EXISTS tx_id;
+
+COMMIT;
\ No newline at end of file
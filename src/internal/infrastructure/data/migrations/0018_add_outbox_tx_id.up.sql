@@ -0,0 +1,10 @@
+BEGIN;
+
+-- Existing rows all get the id of this migration's transaction and keep their id order.
+ALTER TABLE outbox_events
+    ADD COLUMN IF NOT EXISTS tx_id XID8 NOT NULL DEFAULT pg_current_xact_id();
+
+CREATE INDEX IF NOT EXISTS idx_outbox_events_stream
+    ON outbox_events (tx_id, id);
+
+COMMIT;
\ No newline at end of file
@@ -0,0 +1,15 @@
+BEGIN;
+
+-- ESCALATED is the other stage the SLA worker leaves alone
+UPDATE pull_request_reviewers
+SET sla_stage = 'ESCALATED'
+WHERE sla_stage = 'REVIEWED';
+
+ALTER TABLE pull_request_reviewers
+    DROP CONSTRAINT IF EXISTS chk_pr_reviewers_sla_stage;
+
+ALTER TABLE pull_request_reviewers
+    ADD CONSTRAINT chk_pr_reviewers_sla_stage
+        CHECK (sla_stage IN ('PENDING', 'NOTIFIED', 'ESCALATED'));
+
+COMMIT;
\ No newline at end of file
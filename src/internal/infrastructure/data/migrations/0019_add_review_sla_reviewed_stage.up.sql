@@ -0,0 +1,10 @@
+BEGIN;
+
+ALTER TABLE pull_request_reviewers
+    DROP CONSTRAINT IF EXISTS chk_pr_reviewers_sla_stage;
+
+ALTER TABLE pull_request_reviewers
+    ADD CONSTRAINT chk_pr_reviewers_sla_stage
+        CHECK (sla_stage IN ('PENDING', 'NOTIFIED', 'ESCALATED', 'REVIEWED'));
+
+COMMIT;
\ No newline at end of file
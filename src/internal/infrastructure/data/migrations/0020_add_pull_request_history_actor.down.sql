@@ -0,0 +1,6 @@
+BEGIN;
+
+ALTER TABLE pull_request_history
+    DROP COLUMN IF EXISTS actor;
+
+COMMIT;
\ No newline at end of file
@@ -0,0 +1,6 @@
+BEGIN;
+
+ALTER TABLE pull_request_history
+    ADD COLUMN IF NOT EXISTS actor TEXT NOT NULL DEFAULT '';
+
+COMMIT;
\ No newline at end of file
@@ -0,0 +1,136 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"PrService/src/internal/infrastructure/data"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"PrService/src/internal/domain"
+)
+
+const apiKeyColumns = `id, name, prefix, key_hash, scopes, created_at, revoked_at`
+
+type APIKeyRepository struct {
+	pool *pgxpool.Pool
+}
+
+func NewAPIKeyRepository(pool *pgxpool.Pool) *APIKeyRepository {
+	return &APIKeyRepository{pool: pool}
+}
+
+func (r *APIKeyRepository) Create(ctx context.Context, key *domain.APIKey) error {
+	q := data.QuerierFromContext(ctx, r.pool)
+
+	const query = `
+		INSERT INTO api_keys (name, prefix, key_hash, scopes)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id, created_at
+	`
+
+	return q.QueryRow(
+		ctx,
+		query,
+		key.Name,
+		key.Prefix,
+		key.Hash,
+		apiKeyScopesToStrings(key.Scopes),
+	).Scan(&key.ID, &key.CreatedAt)
+}
+
+func (r *APIKeyRepository) GetByHash(ctx context.Context, hash string) (*domain.APIKey, error) {
+	q := data.QuerierFromContext(ctx, r.pool)
+
+	const query = `
+		SELECT ` + apiKeyColumns + `
+		FROM api_keys
+		WHERE key_hash = $1
+	`
+
+	key, err := scanAPIKey(q.QueryRow(ctx, query, hash))
+	if err != nil {
+		if data.IsNoRows(err) {
+			return nil, domain.ErrAPIKeyNotFound
+		}
+		return nil, err
+	}
+
+	return key, nil
+}
+
+func (r *APIKeyRepository) List(ctx context.Context) ([]domain.APIKey, error) {
+	q := data.QuerierFromContext(ctx, r.pool)
+
+	const query = `
+		SELECT ` + apiKeyColumns + `
+		FROM api_keys
+		ORDER BY id
+	`
+
+	rows, err := q.Query(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	keys := make([]domain.APIKey, 0)
+	for rows.Next() {
+		key, err := scanAPIKey(rows)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, *key)
+	}
+
+	return keys, rows.Err()
+}
+
+func (r *APIKeyRepository) Revoke(ctx context.Context, id domain.APIKeyID, at time.Time) (*domain.APIKey, error) {
+	q := data.QuerierFromContext(ctx, r.pool)
+
+	const query = `
+		UPDATE api_keys
+		SET revoked_at = COALESCE(revoked_at, $2)
+		WHERE id = $1
+		RETURNING ` + apiKeyColumns
+
+	key, err := scanAPIKey(q.QueryRow(ctx, query, id, at))
+	if err != nil {
+		if data.IsNoRows(err) {
+			return nil, domain.ErrAPIKeyNotFound
+		}
+		return nil, err
+	}
+
+	return key, nil
+}
+
+func scanAPIKey(row pgx.Row) (*domain.APIKey, error) {
+	var (
+		key    domain.APIKey
+		scopes []string
+	)
+	err := row.Scan(&key.ID, &key.Name, &key.Prefix, &key.Hash, &scopes, &key.CreatedAt, &key.RevokedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	key.Scopes = make([]domain.APIKeyScope, 0, len(scopes))
+	for _, scope := range scopes {
+		key.Scopes = append(key.Scopes, domain.APIKeyScope(scope))
+	}
+
+	return &key, nil
+}
+
+func apiKeyScopesToStrings(scopes []domain.APIKeyScope) []string {
+	result := make([]string, 0, len(scopes))
+	for _, scope := range scopes {
+		result = append(result, string(scope))
+	}
+
+	return result
+}
@@ -0,0 +1,67 @@
+package repositories
+
+import (
+	"context"
+
+	"PrService/src/internal/infrastructure/data"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"PrService/src/internal/domain"
+)
+
+type CodeOwnersRepository struct {
+	pool *pgxpool.Pool
+}
+
+func NewCodeOwnersRepository(pool *pgxpool.Pool) *CodeOwnersRepository {
+	return &CodeOwnersRepository{pool: pool}
+}
+
+func (r *CodeOwnersRepository) Upsert(ctx context.Context, codeOwners *domain.TeamCodeOwners) error {
+	q := data.QuerierFromContext(ctx, r.pool)
+
+	const query = `
+		INSERT INTO team_codeowners (team_name, content, mode, fill_strategy, updated_at)
+		VALUES ($1, $2, $3, $4, now())
+		ON CONFLICT (team_name) DO UPDATE SET
+			content       = EXCLUDED.content,
+			mode          = EXCLUDED.mode,
+			fill_strategy = EXCLUDED.fill_strategy,
+			updated_at    = EXCLUDED.updated_at
+	`
+
+	_, err := q.Exec(ctx, query,
+		codeOwners.TeamName,
+		codeOwners.Content,
+		codeOwners.Mode,
+		codeOwners.FillStrategy,
+	)
+
+	return err
+}
+
+// loadCodeOwners returns the parsed CODEOWNERS of the team or nil when none was uploaded.
+func loadCodeOwners(ctx context.Context, q data.PgxQuerier, name domain.TeamName) (*domain.TeamCodeOwners, error) {
+	const query = `
+		SELECT team_name, content, mode, fill_strategy
+		FROM team_codeowners
+		WHERE team_name = $1
+	`
+
+	var c domain.TeamCodeOwners
+	if err := q.QueryRow(ctx, query, name).Scan(&c.TeamName, &c.Content, &c.Mode, &c.FillStrategy); err != nil {
+		if data.IsNoRows(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	entries, err := domain.ParseCodeOwners(c.Content)
+	if err != nil {
+		return nil, err
+	}
+	c.Entries = entries
+
+	return &c, nil
+}
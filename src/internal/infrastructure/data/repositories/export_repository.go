@@ -0,0 +1,166 @@
+package repositories
+
+import (
+	"context"
+
+	"PrService/src/internal/infrastructure/data"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"PrService/src/internal/domain"
+)
+
+type ExportRepository struct {
+	pool *pgxpool.Pool
+}
+
+func NewExportRepository(pool *pgxpool.Pool) *ExportRepository {
+	return &ExportRepository{pool: pool}
+}
+
+// StreamPullRequests passes the matching pull requests to fn, oldest first. Rows are read from the
+// pgx cursor one at a time, so the export is never held in memory as a whole.
+func (r *ExportRepository) StreamPullRequests(
+	ctx context.Context,
+	filter domain.PullRequestExportFilter,
+	fn func(domain.PullRequestExportRow) error,
+) error {
+	q := data.QuerierFromContext(ctx, r.pool)
+
+	const query = `
+		SELECT
+			pr.id,
+			pr.name,
+			pr.author_id,
+			COALESCE(u.team_name, ''),
+			pr.status,
+			pr.tags,
+			ARRAY(
+				SELECT prr.reviewer_id
+				FROM pull_request_reviewers prr
+				WHERE prr.pull_request_id = pr.id
+				ORDER BY prr.reviewer_id
+			) AS reviewers,
+			pr.created_at,
+			pr.merged_at
+		FROM pull_requests pr
+		LEFT JOIN users u ON u.id = pr.author_id
+		WHERE ($1 = '' OR pr.status = $1)
+		  AND ($2 = '' OR u.team_name = $2)
+		  AND ($3 = '' OR pr.author_id = $3)
+		  AND ($4 = '' OR EXISTS (
+				SELECT 1
+				FROM pull_request_reviewers prr
+				WHERE prr.pull_request_id = pr.id AND prr.reviewer_id = $4
+			))
+		  AND ($5::timestamptz IS NULL OR pr.created_at >= $5)
+		  AND ($6::timestamptz IS NULL OR pr.created_at < $6)
+		ORDER BY pr.created_at NULLS LAST, pr.id
+	`
+
+	rows, err := q.Query(ctx, query,
+		filter.Status,
+		filter.TeamName,
+		filter.AuthorID,
+		filter.ReviewerID,
+		optionalTime(filter.Window.From),
+		optionalTime(filter.Window.To),
+	)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			row       domain.PullRequestExportRow
+			reviewers []string
+		)
+		if err := rows.Scan(
+			&row.ID,
+			&row.Name,
+			&row.AuthorID,
+			&row.TeamName,
+			&row.Status,
+			&row.Tags,
+			&reviewers,
+			&row.CreatedAt,
+			&row.MergedAt,
+		); err != nil {
+			return err
+		}
+
+		row.Reviewers = make([]domain.UserID, 0, len(reviewers))
+		for _, reviewer := range reviewers {
+			row.Reviewers = append(row.Reviewers, domain.UserID(reviewer))
+		}
+
+		if err := fn(row); err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
+
+// StreamAssignments passes the matching entries of the review_assignments ledger to fn in the order
+// they were made, reading them from the pgx cursor one at a time.
+func (r *ExportRepository) StreamAssignments(
+	ctx context.Context,
+	filter domain.AssignmentExportFilter,
+	fn func(domain.AssignmentExportRow) error,
+) error {
+	q := data.QuerierFromContext(ctx, r.pool)
+
+	const query = `
+		SELECT
+			a.pull_request_id,
+			pr.status,
+			a.reviewer_id,
+			COALESCE(a.team_name, ''),
+			a.assigned_at,
+			a.unassigned_at,
+			a.reassigned
+		FROM review_assignments a
+		JOIN pull_requests pr ON pr.id = a.pull_request_id
+		WHERE ($1 = '' OR a.team_name = $1)
+		  AND ($2 = '' OR a.reviewer_id = $2)
+		  AND ($3 = '' OR a.pull_request_id = $3)
+		  AND ($4::timestamptz IS NULL OR a.assigned_at >= $4)
+		  AND ($5::timestamptz IS NULL OR a.assigned_at < $5)
+		ORDER BY a.assigned_at, a.id
+	`
+
+	rows, err := q.Query(ctx, query,
+		filter.TeamName,
+		filter.ReviewerID,
+		filter.PullRequestID,
+		optionalTime(filter.Window.From),
+		optionalTime(filter.Window.To),
+	)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var row domain.AssignmentExportRow
+		if err := rows.Scan(
+			&row.PullRequestID,
+			&row.PullRequestStatus,
+			&row.ReviewerID,
+			&row.TeamName,
+			&row.AssignedAt,
+			&row.UnassignedAt,
+			&row.Reassigned,
+		); err != nil {
+			return err
+		}
+
+		if err := fn(row); err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
@@ -0,0 +1,58 @@
+package repositories
+
+import (
+	"context"
+
+	"PrService/src/internal/infrastructure/data"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"PrService/src/internal/domain"
+)
+
+type ExternalAccountRepository struct {
+	pool *pgxpool.Pool
+}
+
+func NewExternalAccountRepository(pool *pgxpool.Pool) *ExternalAccountRepository {
+	return &ExternalAccountRepository{pool: pool}
+}
+
+func (r *ExternalAccountRepository) Upsert(ctx context.Context, account domain.ExternalAccount) error {
+	q := data.QuerierFromContext(ctx, r.pool)
+
+	const query = `
+		INSERT INTO external_accounts (provider, login, user_id)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (provider, login) DO UPDATE SET
+			user_id = EXCLUDED.user_id
+	`
+
+	_, err := q.Exec(ctx, query, account.Provider, account.Login, account.UserID)
+
+	return err
+}
+
+func (r *ExternalAccountRepository) GetUserID(
+	ctx context.Context,
+	provider domain.ExternalProvider,
+	login string,
+) (domain.UserID, error) {
+	q := data.QuerierFromContext(ctx, r.pool)
+
+	const query = `
+		SELECT user_id
+		FROM external_accounts
+		WHERE provider = $1 AND login = $2
+	`
+
+	var userID domain.UserID
+	if err := q.QueryRow(ctx, query, provider, login).Scan(&userID); err != nil {
+		if data.IsNoRows(err) {
+			return "", domain.ErrExternalAccountNotFound
+		}
+		return "", err
+	}
+
+	return userID, nil
+}
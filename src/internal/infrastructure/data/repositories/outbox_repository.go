@@ -0,0 +1,219 @@
+package repositories
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"PrService/src/internal/infrastructure/data"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"PrService/src/internal/domain"
+)
+
+const outboxColumns = `
+	o.id, o.tx_id::TEXT::BIGINT, o.aggregate_type, o.aggregate_id, o.event_type, o.payload, o.occurred_at,
+	o.status, o.delivered_sinks, o.attempts, o.last_error, o.next_attempt_at, o.published_at
+`
+
+type OutboxRepository struct {
+	pool *pgxpool.Pool
+}
+
+func NewOutboxRepository(pool *pgxpool.Pool) *OutboxRepository {
+	return &OutboxRepository{pool: pool}
+}
+
+func (r *OutboxRepository) Append(ctx context.Context, messages []domain.OutboxMessage) error {
+	q := data.QuerierFromContext(ctx, r.pool)
+
+	const query = `
+		INSERT INTO outbox_events (aggregate_type, aggregate_id, event_type, payload, occurred_at, next_attempt_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`
+
+	for _, m := range messages {
+		_, err := q.Exec(ctx, query,
+			m.AggregateType,
+			m.AggregateID,
+			m.EventType,
+			m.Payload,
+			m.OccurredAt,
+			m.NextAttemptAt,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *OutboxRepository) ClaimPending(
+	ctx context.Context,
+	now time.Time,
+	lease time.Duration,
+	limit int,
+) ([]domain.OutboxMessage, error) {
+	q := data.QuerierFromContext(ctx, r.pool)
+
+	// status and next_attempt_at are checked again outside the subquery: FOR UPDATE re-evaluates them
+	// against a row that another relay leased or published while this one was waiting, so it is not
+	// relayed twice. A leased head is not due, so later messages of its aggregate keep waiting.
+	const query = `
+		WITH due AS (
+			SELECT o.id
+			FROM outbox_events o
+			WHERE o.id IN (
+				SELECT DISTINCT ON (aggregate_type, aggregate_id) id
+				FROM outbox_events
+				WHERE status = 'PENDING'
+				ORDER BY aggregate_type, aggregate_id, id
+			)
+			AND o.status = 'PENDING'
+			AND o.next_attempt_at <= $1
+			ORDER BY o.id
+			LIMIT $3
+			FOR UPDATE OF o SKIP LOCKED
+		)
+		UPDATE outbox_events o
+		SET next_attempt_at = $2
+		FROM due
+		WHERE o.id = due.id
+		RETURNING ` + outboxColumns + `
+	`
+
+	rows, err := q.Query(ctx, query, now, now.Add(lease), limit)
+	if err != nil {
+		return nil, err
+	}
+
+	messages, err := scanOutboxMessages(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	// RETURNING does not keep the order of the claimed rows.
+	slices.SortFunc(messages, func(a, b domain.OutboxMessage) int {
+		return cmp.Compare(a.ID, b.ID)
+	})
+
+	return messages, nil
+}
+
+func (r *OutboxRepository) Update(ctx context.Context, message domain.OutboxMessage) error {
+	q := data.QuerierFromContext(ctx, r.pool)
+
+	const query = `
+		UPDATE outbox_events
+		SET status          = $2,
+			delivered_sinks = $3,
+			attempts        = $4,
+			last_error      = $5,
+			next_attempt_at = $6,
+			published_at    = $7
+		WHERE id = $1
+	`
+
+	deliveredSinks := message.DeliveredSinks
+	if deliveredSinks == nil {
+		deliveredSinks = []string{}
+	}
+
+	_, err := q.Exec(
+		ctx,
+		query,
+		message.ID,
+		message.Status,
+		deliveredSinks,
+		message.Attempts,
+		message.LastError,
+		message.NextAttemptAt,
+		message.PublishedAt,
+	)
+
+	return err
+}
+
+// ListAfter streams by (tx_id, id) and stops at the snapshot xmin: every transaction below it has
+// finished, so no row can still appear before the returned ones. IDs alone are not enough, as they
+// are taken before commit and a slower transaction can commit a lower one.
+func (r *OutboxRepository) ListAfter(
+	ctx context.Context,
+	after domain.EventStreamCursor,
+	eventTypes []domain.EventType,
+	limit int,
+) ([]domain.OutboxMessage, error) {
+	q := data.QuerierFromContext(ctx, r.pool)
+
+	const query = `
+		SELECT ` + outboxColumns + `
+		FROM outbox_events o
+		WHERE (o.tx_id, o.id) > ($1::BIGINT::TEXT::XID8, $2)
+		AND o.tx_id < pg_snapshot_xmin(pg_current_snapshot())
+		AND o.event_type = ANY ($3)
+		ORDER BY o.tx_id, o.id
+		LIMIT $4
+	`
+
+	rows, err := q.Query(ctx, query, after.TxID, after.ID, eventTypesToStrings(eventTypes), limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return scanOutboxMessages(rows)
+}
+
+func (r *OutboxRepository) LatestCursor(ctx context.Context) (domain.EventStreamCursor, error) {
+	q := data.QuerierFromContext(ctx, r.pool)
+
+	const query = `
+		SELECT tx_id::TEXT::BIGINT, id
+		FROM outbox_events
+		WHERE tx_id < pg_snapshot_xmin(pg_current_snapshot())
+		ORDER BY tx_id DESC, id DESC
+		LIMIT 1
+	`
+
+	var cursor domain.EventStreamCursor
+	err := q.QueryRow(ctx, query).Scan(&cursor.TxID, &cursor.ID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return domain.EventStreamCursor{}, nil
+	}
+
+	return cursor, err
+}
+
+func scanOutboxMessages(rows pgx.Rows) ([]domain.OutboxMessage, error) {
+	defer rows.Close()
+
+	messages := make([]domain.OutboxMessage, 0)
+	for rows.Next() {
+		var m domain.OutboxMessage
+		err := rows.Scan(
+			&m.ID,
+			&m.TxID,
+			&m.AggregateType,
+			&m.AggregateID,
+			&m.EventType,
+			&m.Payload,
+			&m.OccurredAt,
+			&m.Status,
+			&m.DeliveredSinks,
+			&m.Attempts,
+			&m.LastError,
+			&m.NextAttemptAt,
+			&m.PublishedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		messages = append(messages, m)
+	}
+
+	return messages, rows.Err()
+}
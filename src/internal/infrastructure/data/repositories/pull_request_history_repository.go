@@ -0,0 +1,90 @@
+package repositories
+
+import (
+	"context"
+
+	"PrService/src/internal/infrastructure/data"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"PrService/src/internal/domain"
+)
+
+type PullRequestHistoryRepository struct {
+	pool *pgxpool.Pool
+}
+
+func NewPullRequestHistoryRepository(pool *pgxpool.Pool) *PullRequestHistoryRepository {
+	return &PullRequestHistoryRepository{pool: pool}
+}
+
+func (r *PullRequestHistoryRepository) Append(ctx context.Context, entry *domain.PullRequestHistoryEntry) error {
+	q := data.QuerierFromContext(ctx, r.pool)
+
+	const query = `
+		INSERT INTO pull_request_history (pull_request_id, action, reviewer_id, target_ids, details, actor, occurred_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING id
+	`
+
+	return q.QueryRow(ctx, query,
+		entry.PullRequestID,
+		entry.Action,
+		entry.ReviewerID,
+		userIDsToStrings(entry.TargetIDs),
+		entry.Details,
+		entry.Actor,
+		entry.OccurredAt,
+	).Scan(&entry.ID)
+}
+
+func (r *PullRequestHistoryRepository) List(
+	ctx context.Context,
+	id domain.PullRequestID,
+) ([]domain.PullRequestHistoryEntry, error) {
+	q := data.QuerierFromContext(ctx, r.pool)
+
+	const query = `
+		SELECT id, pull_request_id, action, reviewer_id, target_ids, details, actor, occurred_at
+		FROM pull_request_history
+		WHERE pull_request_id = $1
+		ORDER BY id
+	`
+
+	rows, err := q.Query(ctx, query, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	entries := make([]domain.PullRequestHistoryEntry, 0)
+	for rows.Next() {
+		var (
+			e         domain.PullRequestHistoryEntry
+			targetIDs []string
+		)
+		if err := rows.Scan(
+			&e.ID,
+			&e.PullRequestID,
+			&e.Action,
+			&e.ReviewerID,
+			&targetIDs,
+			&e.Details,
+			&e.Actor,
+			&e.OccurredAt,
+		); err != nil {
+			return nil, err
+		}
+
+		e.TargetIDs = make([]domain.UserID, 0, len(targetIDs))
+		for _, id := range targetIDs {
+			e.TargetIDs = append(e.TargetIDs, domain.UserID(id))
+		}
+		entries = append(entries, e)
+	}
+	if rows.Err() != nil {
+		return nil, rows.Err()
+	}
+
+	return entries, nil
+}
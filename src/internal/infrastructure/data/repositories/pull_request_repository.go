@@ -2,6 +2,7 @@ package repositories
 
 import (
 	"context"
+	"slices"
 
 	"PrService/src/internal/infrastructure/data"
 
@@ -23,9 +24,9 @@ func (r *PullRequestRepository) Create(ctx context.Context, pr *domain.PullReque
 
 	const insertPR = `
 		INSERT INTO pull_requests (
-			id, name, author_id, status, created_at, merged_at
+			id, name, author_id, status, tags, created_at, merged_at
 		)
-		VALUES ($1, $2, $3, $4, $5, $6)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
 	`
 
 	_, err := q.Exec(ctx, insertPR,
@@ -33,6 +34,7 @@ func (r *PullRequestRepository) Create(ctx context.Context, pr *domain.PullReque
 		pr.Name,
 		pr.AuthorID,
 		pr.Status,
+		nonNilTags(pr.Tags),
 		pr.CreatedAt,
 		pr.MergedAt,
 	)
@@ -44,7 +46,7 @@ func (r *PullRequestRepository) Create(ctx context.Context, pr *domain.PullReque
 	}
 
 	if len(pr.AssignedReviewers) > 0 {
-		if err := r.replaceAssignedReviewers(ctx, q, pr.ID, pr.AssignedReviewers); err != nil {
+		if err := r.syncAssignedReviewers(ctx, q, pr); err != nil {
 			return err
 		}
 	}
@@ -56,7 +58,7 @@ func (r *PullRequestRepository) GetByID(ctx context.Context, id domain.PullReque
 	q := data.QuerierFromContext(ctx, r.pool)
 
 	const query = `
-		SELECT id, name, author_id, status, created_at, merged_at
+		SELECT id, name, author_id, status, tags, created_at, merged_at
 		FROM pull_requests
 		WHERE id = $1
 	`
@@ -67,6 +69,7 @@ func (r *PullRequestRepository) GetByID(ctx context.Context, id domain.PullReque
 		&pr.Name,
 		&pr.AuthorID,
 		&pr.Status,
+		&pr.Tags,
 		&pr.CreatedAt,
 		&pr.MergedAt,
 	); err != nil {
@@ -76,11 +79,9 @@ func (r *PullRequestRepository) GetByID(ctx context.Context, id domain.PullReque
 		return nil, err
 	}
 
-	reviewers, err := r.loadAssignedReviewers(ctx, q, pr.ID)
-	if err != nil {
+	if err := r.loadAssignedReviewers(ctx, q, &pr); err != nil {
 		return nil, err
 	}
-	pr.AssignedReviewers = reviewers
 
 	return &pr, nil
 }
@@ -92,7 +93,7 @@ func (r *PullRequestRepository) ListByReviewer(
 	q := data.QuerierFromContext(ctx, r.pool)
 
 	const query = `
-		SELECT pr.id, pr.name, pr.author_id, pr.status, pr.created_at, pr.merged_at
+		SELECT pr.id, pr.name, pr.author_id, pr.status, pr.tags, pr.created_at, pr.merged_at
 		FROM pull_requests pr
 		JOIN pull_request_reviewers prr
 		  ON prr.pull_request_id = pr.id
@@ -114,23 +115,26 @@ func (r *PullRequestRepository) ListByReviewer(
 			&pr.Name,
 			&pr.AuthorID,
 			&pr.Status,
+			&pr.Tags,
 			&pr.CreatedAt,
 			&pr.MergedAt,
 		); err != nil {
 			return nil, err
 		}
 
-		reviewers, err := r.loadAssignedReviewers(ctx, q, pr.ID)
-		if err != nil {
-			return nil, err
-		}
-		pr.AssignedReviewers = reviewers
-
 		result = append(result, pr)
 	}
 	if rows.Err() != nil {
 		return nil, rows.Err()
 	}
+	// the connection is busy until the rows are closed, which matters inside a transaction
+	rows.Close()
+
+	for i := range result {
+		if err := r.loadAssignedReviewers(ctx, q, &result[i]); err != nil {
+			return nil, err
+		}
+	}
 
 	return result, nil
 }
@@ -165,35 +169,118 @@ func (r *PullRequestRepository) Update(ctx context.Context, pr *domain.PullReque
 		return domain.ErrPullRequestNotFound
 	}
 
-	return r.replaceAssignedReviewers(ctx, q, pr.ID, pr.AssignedReviewers)
+	return r.syncAssignedReviewers(ctx, q, pr)
 }
 
-func (r *PullRequestRepository) replaceAssignedReviewers(
+func (r *PullRequestRepository) CountOpenReviews(
+	ctx context.Context,
+	reviewerIDs []domain.UserID,
+) (map[domain.UserID]int, error) {
+	q := data.QuerierFromContext(ctx, r.pool)
+
+	const query = `
+		SELECT prr.reviewer_id, COUNT(*)
+		FROM pull_request_reviewers prr
+		JOIN pull_requests pr ON pr.id = prr.pull_request_id
+		WHERE pr.status IN ('OPEN', 'DRAFT') AND prr.reviewer_id = ANY($1)
+		GROUP BY prr.reviewer_id
+	`
+
+	rows, err := q.Query(ctx, query, userIDsToStrings(reviewerIDs))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	loads := make(map[domain.UserID]int, len(reviewerIDs))
+	for rows.Next() {
+		var (
+			id    domain.UserID
+			count int
+		)
+		if err := rows.Scan(&id, &count); err != nil {
+			return nil, err
+		}
+		loads[id] = count
+	}
+	if rows.Err() != nil {
+		return nil, rows.Err()
+	}
+
+	return loads, nil
+}
+
+// syncAssignedReviewers stores the reviewers of the pull request. Assignments that are kept
+// keep their assignment time and SLA stage; new ones start PENDING. Every change is also recorded
+// in the review_assignments ledger: a reviewer removed while another one is added in the same update
+// counts as reassigned away.
+func (r *PullRequestRepository) syncAssignedReviewers(
 	ctx context.Context,
 	q data.PgxQuerier,
-	prID domain.PullRequestID,
-	reviewers []domain.UserID,
+	pr *domain.PullRequest,
 ) error {
+	current, err := r.currentReviewers(ctx, q, pr.ID)
+	if err != nil {
+		return err
+	}
+
+	var added, removed []domain.UserID
+	for _, reviewerID := range pr.AssignedReviewers {
+		if !slices.Contains(current, reviewerID) {
+			added = append(added, reviewerID)
+		}
+	}
+	for _, reviewerID := range current {
+		if !slices.Contains(pr.AssignedReviewers, reviewerID) {
+			removed = append(removed, reviewerID)
+		}
+	}
+
 	const deleteQuery = `
 		DELETE FROM pull_request_reviewers
 		WHERE pull_request_id = $1
+		  AND NOT (reviewer_id = ANY($2))
 	`
 
-	if _, err := q.Exec(ctx, deleteQuery, prID); err != nil {
+	if _, err := q.Exec(ctx, deleteQuery, pr.ID, userIDsToStrings(pr.AssignedReviewers)); err != nil {
 		return err
 	}
 
-	if len(reviewers) == 0 {
-		return nil
+	const upsertQuery = `
+		INSERT INTO pull_request_reviewers (pull_request_id, reviewer_id, team_name)
+		VALUES ($1, $2, NULLIF($3, ''))
+		ON CONFLICT (pull_request_id, reviewer_id) DO UPDATE SET
+			team_name = EXCLUDED.team_name
+	`
+
+	for _, reviewerID := range pr.AssignedReviewers {
+		if _, err := q.Exec(ctx, upsertQuery, pr.ID, reviewerID, pr.ReviewerTeams[reviewerID]); err != nil {
+			return err
+		}
 	}
 
-	const insertQuery = `
-		INSERT INTO pull_request_reviewers (pull_request_id, reviewer_id)
-		VALUES ($1, $2)
+	if len(removed) > 0 {
+		const unassignQuery = `
+			UPDATE review_assignments
+			SET unassigned_at = now(),
+			    reassigned    = $3
+			WHERE pull_request_id = $1
+			  AND reviewer_id = ANY($2)
+			  AND unassigned_at IS NULL
+		`
+
+		if _, err := q.Exec(ctx, unassignQuery, pr.ID, userIDsToStrings(removed), len(added) > 0); err != nil {
+			return err
+		}
+	}
+
+	const assignQuery = `
+		INSERT INTO review_assignments (pull_request_id, reviewer_id, team_name)
+		VALUES ($1, $2, NULLIF($3, ''))
 	`
 
-	for _, reviewerID := range reviewers {
-		if _, err := q.Exec(ctx, insertQuery, prID, reviewerID); err != nil {
+	for _, reviewerID := range added {
+		if _, err := q.Exec(ctx, assignQuery, pr.ID, reviewerID, pr.ReviewerTeams[reviewerID]); err != nil {
 			return err
 		}
 	}
@@ -201,10 +288,10 @@ func (r *PullRequestRepository) replaceAssignedReviewers(
 	return nil
 }
 
-func (r *PullRequestRepository) loadAssignedReviewers(
+func (r *PullRequestRepository) currentReviewers(
 	ctx context.Context,
 	q data.PgxQuerier,
-	prID domain.PullRequestID,
+	id domain.PullRequestID,
 ) ([]domain.UserID, error) {
 	const query = `
 		SELECT reviewer_id
@@ -212,7 +299,7 @@ func (r *PullRequestRepository) loadAssignedReviewers(
 		WHERE pull_request_id = $1
 	`
 
-	rows, err := q.Query(ctx, query, prID)
+	rows, err := q.Query(ctx, query, id)
 	if err != nil {
 		return nil, err
 	}
@@ -220,15 +307,53 @@ func (r *PullRequestRepository) loadAssignedReviewers(
 
 	var reviewers []domain.UserID
 	for rows.Next() {
-		var id domain.UserID
-		if err := rows.Scan(&id); err != nil {
+		var reviewerID domain.UserID
+		if err := rows.Scan(&reviewerID); err != nil {
 			return nil, err
 		}
+		reviewers = append(reviewers, reviewerID)
+	}
+
+	return reviewers, rows.Err()
+}
+
+func (r *PullRequestRepository) loadAssignedReviewers(
+	ctx context.Context,
+	q data.PgxQuerier,
+	pr *domain.PullRequest,
+) error {
+	const query = `
+		SELECT prr.reviewer_id, COALESCE(prr.team_name, u.team_name)
+		FROM pull_request_reviewers prr
+		JOIN users u ON u.id = prr.reviewer_id
+		WHERE prr.pull_request_id = $1
+	`
+
+	rows, err := q.Query(ctx, query, pr.ID)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	var reviewers []domain.UserID
+	reviewerTeams := make(map[domain.UserID]domain.TeamName)
+	for rows.Next() {
+		var (
+			id       domain.UserID
+			teamName domain.TeamName
+		)
+		if err := rows.Scan(&id, &teamName); err != nil {
+			return err
+		}
 		reviewers = append(reviewers, id)
+		reviewerTeams[id] = teamName
 	}
 	if rows.Err() != nil {
-		return nil, rows.Err()
+		return rows.Err()
 	}
 
-	return reviewers, nil
+	pr.AssignedReviewers = reviewers
+	pr.ReviewerTeams = reviewerTeams
+
+	return nil
 }
@@ -0,0 +1,202 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"PrService/src/internal/infrastructure/data"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"PrService/src/internal/domain"
+)
+
+type ReviewSLARepository struct {
+	pool *pgxpool.Pool
+}
+
+func NewReviewSLARepository(pool *pgxpool.Pool) *ReviewSLARepository {
+	return &ReviewSLARepository{pool: pool}
+}
+
+func (r *ReviewSLARepository) Upsert(ctx context.Context, sla domain.ReviewSLA) error {
+	q := data.QuerierFromContext(ctx, r.pool)
+
+	const query = `
+		INSERT INTO team_review_slas (team_name, first_review_within_sec, escalate_after_sec, action, updated_at)
+		VALUES ($1, $2, $3, $4, now())
+		ON CONFLICT (team_name) DO UPDATE SET
+			first_review_within_sec = EXCLUDED.first_review_within_sec,
+			escalate_after_sec      = EXCLUDED.escalate_after_sec,
+			action                  = EXCLUDED.action,
+			updated_at              = EXCLUDED.updated_at
+	`
+
+	_, err := q.Exec(ctx, query,
+		sla.TeamName,
+		int64(sla.FirstReviewWithin/time.Second),
+		int64(sla.EscalateAfter/time.Second),
+		sla.Action,
+	)
+
+	return err
+}
+
+func (r *ReviewSLARepository) Get(ctx context.Context, name domain.TeamName) (*domain.ReviewSLA, error) {
+	q := data.QuerierFromContext(ctx, r.pool)
+
+	const query = `
+		SELECT team_name, first_review_within_sec, escalate_after_sec, action
+		FROM team_review_slas
+		WHERE team_name = $1
+	`
+
+	var (
+		sla                         domain.ReviewSLA
+		firstReviewSec, escalateSec int64
+	)
+	if err := q.QueryRow(ctx, query, name).Scan(&sla.TeamName, &firstReviewSec, &escalateSec, &sla.Action); err != nil {
+		if data.IsNoRows(err) {
+			return nil, domain.ErrReviewSLANotFound
+		}
+		return nil, err
+	}
+	sla.FirstReviewWithin = time.Duration(firstReviewSec) * time.Second
+	sla.EscalateAfter = time.Duration(escalateSec) * time.Second
+
+	return &sla, nil
+}
+
+func (r *ReviewSLARepository) Delete(ctx context.Context, name domain.TeamName) error {
+	q := data.QuerierFromContext(ctx, r.pool)
+
+	const query = `
+		DELETE FROM team_review_slas
+		WHERE team_name = $1
+	`
+
+	tag, err := q.Exec(ctx, query, name)
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return domain.ErrReviewSLANotFound
+	}
+
+	return nil
+}
+
+func (r *ReviewSLARepository) ListOverdue(
+	ctx context.Context,
+	now time.Time,
+	limit int,
+) ([]domain.OverdueReview, error) {
+	q := data.QuerierFromContext(ctx, r.pool)
+
+	// The SLA of an assignment is the one of the team its slot was filled from.
+	const query = `
+		SELECT prr.pull_request_id, prr.reviewer_id, prr.sla_stage, prr.assigned_at,
+		       s.team_name, s.first_review_within_sec, s.escalate_after_sec, s.action
+		FROM pull_request_reviewers prr
+		JOIN pull_requests pr ON pr.id = prr.pull_request_id
+		JOIN users u ON u.id = prr.reviewer_id
+		JOIN team_review_slas s ON s.team_name = COALESCE(prr.team_name, u.team_name)
+		WHERE pr.status = 'OPEN'
+		  -- ESCALATED and REVIEWED assignments have no next step
+		  AND (
+		      (prr.sla_stage = 'PENDING'
+		          AND prr.assigned_at + s.first_review_within_sec * INTERVAL '1 second' <= $1)
+		      OR (prr.sla_stage = 'NOTIFIED'
+		          AND prr.sla_stage_at + s.escalate_after_sec * INTERVAL '1 second' <= $1)
+		  )
+		ORDER BY prr.assigned_at, prr.pull_request_id, prr.reviewer_id
+		LIMIT $2
+	`
+
+	rows, err := q.Query(ctx, query, now, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	reviews := make([]domain.OverdueReview, 0)
+	for rows.Next() {
+		var (
+			review                      domain.OverdueReview
+			firstReviewSec, escalateSec int64
+		)
+		if err := rows.Scan(
+			&review.PullRequestID,
+			&review.ReviewerID,
+			&review.Stage,
+			&review.AssignedAt,
+			&review.SLA.TeamName,
+			&firstReviewSec,
+			&escalateSec,
+			&review.SLA.Action,
+		); err != nil {
+			return nil, err
+		}
+		review.SLA.FirstReviewWithin = time.Duration(firstReviewSec) * time.Second
+		review.SLA.EscalateAfter = time.Duration(escalateSec) * time.Second
+		reviews = append(reviews, review)
+	}
+	if rows.Err() != nil {
+		return nil, rows.Err()
+	}
+
+	return reviews, nil
+}
+
+func (r *ReviewSLARepository) SetStage(
+	ctx context.Context,
+	id domain.PullRequestID,
+	reviewerID domain.UserID,
+	from, to domain.ReviewSLAStage,
+	at time.Time,
+) (bool, error) {
+	q := data.QuerierFromContext(ctx, r.pool)
+
+	const query = `
+		UPDATE pull_request_reviewers
+		SET sla_stage = $4,
+		    sla_stage_at = $5
+		WHERE pull_request_id = $1 AND reviewer_id = $2 AND sla_stage = $3
+	`
+
+	tag, err := q.Exec(ctx, query, id, reviewerID, from, to, at)
+	if err != nil {
+		return false, err
+	}
+
+	return tag.RowsAffected() == 1, nil
+}
+
+func (r *ReviewSLARepository) MarkReviewed(
+	ctx context.Context,
+	id domain.PullRequestID,
+	reviewerID domain.UserID,
+	at time.Time,
+) error {
+	q := data.QuerierFromContext(ctx, r.pool)
+
+	// A repeated review keeps the time of the first one.
+	const query = `
+		UPDATE pull_request_reviewers
+		SET sla_stage = 'REVIEWED',
+		    sla_stage_at = CASE WHEN sla_stage = 'REVIEWED' THEN sla_stage_at ELSE $3 END
+		WHERE pull_request_id = $1 AND reviewer_id = $2
+	`
+
+	tag, err := q.Exec(ctx, query, id, reviewerID, at)
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return domain.ErrReviewerIsNotAssigned
+	}
+
+	return nil
+}
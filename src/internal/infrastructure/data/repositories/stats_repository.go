@@ -0,0 +1,259 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"PrService/src/internal/infrastructure/data"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"PrService/src/internal/domain"
+)
+
+type StatsRepository struct {
+	pool *pgxpool.Pool
+}
+
+func NewStatsRepository(pool *pgxpool.Pool) *StatsRepository {
+	return &StatsRepository{pool: pool}
+}
+
+// UserReviewStats aggregates the review_assignments ledger per user, busiest reviewers first.
+// Users without assignments in the window are listed with zero counters.
+func (r *StatsRepository) UserReviewStats(
+	ctx context.Context,
+	filter domain.UserStatsFilter,
+) ([]domain.UserReviewStats, error) {
+	q := data.QuerierFromContext(ctx, r.pool)
+
+	const query = `
+		SELECT
+			u.id,
+			u.username,
+			u.team_name,
+			u.is_active,
+			COUNT(a.id) AS total_assignments,
+			COUNT(a.id) FILTER (
+				WHERE a.unassigned_at IS NULL AND pr.status IN ('OPEN', 'DRAFT')
+			) AS open_reviews,
+			COUNT(a.id) FILTER (
+				WHERE a.unassigned_at IS NULL AND pr.status = 'MERGED'
+			) AS completed_reviews,
+			COUNT(a.id) FILTER (WHERE a.reassigned) AS reassigned_away,
+			COALESCE(
+				percentile_cont(0.5) WITHIN GROUP (ORDER BY EXTRACT(EPOCH FROM (pr.merged_at - a.assigned_at)))
+					FILTER (WHERE a.unassigned_at IS NULL AND pr.status = 'MERGED' AND pr.merged_at IS NOT NULL),
+				0
+			) AS median_time_to_merge_seconds
+		FROM users u
+		LEFT JOIN review_assignments a
+		  ON a.reviewer_id = u.id
+		 AND ($2::timestamptz IS NULL OR a.assigned_at >= $2)
+		 AND ($3::timestamptz IS NULL OR a.assigned_at < $3)
+		LEFT JOIN pull_requests pr ON pr.id = a.pull_request_id
+		WHERE ($1 = '' OR u.team_name = $1)
+		GROUP BY u.id, u.username, u.team_name, u.is_active
+		ORDER BY total_assignments DESC, u.id
+	`
+
+	rows, err := q.Query(ctx, query,
+		filter.TeamName,
+		optionalTime(filter.Window.From),
+		optionalTime(filter.Window.To),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	stats := make([]domain.UserReviewStats, 0)
+	for rows.Next() {
+		var (
+			s         domain.UserReviewStats
+			medianSec float64
+		)
+		if err := rows.Scan(
+			&s.UserID,
+			&s.Username,
+			&s.TeamName,
+			&s.IsActive,
+			&s.TotalAssignments,
+			&s.OpenReviews,
+			&s.CompletedReviews,
+			&s.ReassignedAway,
+			&medianSec,
+		); err != nil {
+			return nil, err
+		}
+		s.MedianTimeToMergeSec = int64(medianSec)
+		stats = append(stats, s)
+	}
+	if rows.Err() != nil {
+		return nil, rows.Err()
+	}
+
+	return stats, nil
+}
+
+// optionalTime passes a zero time as SQL NULL.
+func optionalTime(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+
+	return &t
+}
+
+func (r *StatsRepository) Overview(ctx context.Context, limit int) (*domain.StatsOverview, error) {
+	q := data.QuerierFromContext(ctx, r.pool)
+
+	const countsQuery = `
+		SELECT
+			(SELECT COUNT(*) FROM teams)                                  AS teams,
+			(SELECT COUNT(*) FROM users WHERE is_active)                  AS active_users,
+			(SELECT COUNT(*) FROM users WHERE NOT is_active)              AS inactive_users,
+			(SELECT COUNT(*) FROM pull_requests WHERE status = 'OPEN')    AS open_prs,
+			(SELECT COUNT(*) FROM pull_requests WHERE status = 'DRAFT')   AS draft_prs,
+			(SELECT COUNT(*) FROM pull_requests WHERE status = 'MERGED')  AS merged_prs,
+			(SELECT COUNT(*) FROM pull_requests WHERE status = 'CLOSED')  AS closed_prs
+	`
+
+	var overview domain.StatsOverview
+	if err := q.QueryRow(ctx, countsQuery).Scan(
+		&overview.Teams,
+		&overview.ActiveUsers,
+		&overview.InactiveUsers,
+		&overview.OpenPRs,
+		&overview.DraftPRs,
+		&overview.MergedPRs,
+		&overview.ClosedPRs,
+	); err != nil {
+		return nil, err
+	}
+
+	var err error
+	overview.OldestOpenPRs, _, err = r.listStaffing(ctx, q, []string{"OPEN"}, false, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	overview.UnderstaffedPRs, overview.UnderstaffedPRsCount, err = r.listStaffing(
+		ctx, q, []string{"OPEN", "DRAFT"}, true, limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if overview.TeamsWithoutReviewers, err = r.teamsWithoutReviewers(ctx, q); err != nil {
+		return nil, err
+	}
+
+	return &overview, nil
+}
+
+// listStaffing returns the oldest pull requests in the statuses, only those with fewer reviewers than
+// the author's team allows when understaffedOnly is set, along with the number of all matching ones.
+// Only active members of the author's team count against its limit, as cross-team reviewers
+// take the slots of their own teams.
+func (r *StatsRepository) listStaffing(
+	ctx context.Context,
+	q data.PgxQuerier,
+	statuses []string,
+	understaffedOnly bool,
+	limit int,
+) ([]domain.PullRequestStaffing, int, error) {
+	const query = `
+		WITH staffing AS (
+			SELECT
+				pr.id,
+				pr.name,
+				pr.author_id,
+				u.team_name,
+				pr.status,
+				pr.created_at,
+				(
+					SELECT COUNT(*)
+					FROM pull_request_reviewers prr
+					JOIN users ru ON ru.id = prr.reviewer_id
+					WHERE prr.pull_request_id = pr.id AND ru.team_name = u.team_name AND ru.is_active
+				) AS reviewers,
+				t.max_reviewers
+			FROM pull_requests pr
+			JOIN users u ON u.id = pr.author_id
+			JOIN teams t ON t.name = u.team_name
+			WHERE pr.status = ANY($1)
+		)
+		SELECT
+			id, name, author_id, team_name, status, created_at, reviewers, max_reviewers,
+			COUNT(*) OVER () AS matching
+		FROM staffing
+		WHERE NOT $2 OR reviewers < max_reviewers
+		ORDER BY created_at NULLS LAST, id
+		LIMIT $3
+	`
+
+	rows, err := q.Query(ctx, query, statuses, understaffedOnly, limit)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	var matching int
+	prs := make([]domain.PullRequestStaffing, 0)
+	for rows.Next() {
+		var pr domain.PullRequestStaffing
+		if err := rows.Scan(
+			&pr.ID,
+			&pr.Name,
+			&pr.AuthorID,
+			&pr.TeamName,
+			&pr.Status,
+			&pr.CreatedAt,
+			&pr.Reviewers,
+			&pr.MaxReviewers,
+			&matching,
+		); err != nil {
+			return nil, 0, err
+		}
+		prs = append(prs, pr)
+	}
+	if rows.Err() != nil {
+		return nil, 0, rows.Err()
+	}
+
+	return prs, matching, nil
+}
+
+// teamsWithoutReviewers returns the teams with fewer than two active members: none of their authors
+// can get a reviewer from the own team, as nobody reviews their own pull request.
+func (r *StatsRepository) teamsWithoutReviewers(ctx context.Context, q data.PgxQuerier) ([]domain.TeamName, error) {
+	const query = `
+		SELECT t.name
+		FROM teams t
+		LEFT JOIN users u ON u.team_name = t.name AND u.is_active
+		GROUP BY t.name
+		HAVING COUNT(u.id) < 2
+		ORDER BY t.name
+	`
+
+	rows, err := q.Query(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	teams := make([]domain.TeamName, 0)
+	for rows.Next() {
+		var name domain.TeamName
+		if err := rows.Scan(&name); err != nil {
+			return nil, err
+		}
+		teams = append(teams, name)
+	}
+	if rows.Err() != nil {
+		return nil, rows.Err()
+	}
+
+	return teams, nil
+}
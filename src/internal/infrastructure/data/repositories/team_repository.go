@@ -41,13 +41,13 @@ func (r *TeamRepository) GetByName(ctx context.Context, name domain.TeamName) (*
 	q := data.QuerierFromContext(ctx, r.pool)
 
 	const teamQuery = `
-		SELECT name
+		SELECT name, max_reviewers
 		FROM teams
 		WHERE name = $1
 	`
 
 	var t domain.Team
-	if err := q.QueryRow(ctx, teamQuery, name).Scan(&t.Name); err != nil {
+	if err := q.QueryRow(ctx, teamQuery, name).Scan(&t.Name, &t.MaxReviewers); err != nil {
 		if data.IsNoRows(err) {
 			return nil, domain.ErrTeamNotFound
 		}
@@ -55,7 +55,7 @@ func (r *TeamRepository) GetByName(ctx context.Context, name domain.TeamName) (*
 	}
 
 	const membersQuery = `
-		SELECT id, username, is_active
+		SELECT id, username, is_active, seniority, tags
 		FROM users
 		WHERE team_name = $1
 	`
@@ -69,7 +69,7 @@ func (r *TeamRepository) GetByName(ctx context.Context, name domain.TeamName) (*
 	var members []domain.TeamMember
 	for rows.Next() {
 		var m domain.TeamMember
-		if err := rows.Scan(&m.ID, &m.Username, &m.IsActive); err != nil {
+		if err := rows.Scan(&m.ID, &m.Username, &m.IsActive, &m.Seniority, &m.Tags); err != nil {
 			return nil, err
 		}
 		members = append(members, m)
@@ -80,9 +80,42 @@ func (r *TeamRepository) GetByName(ctx context.Context, name domain.TeamName) (*
 
 	t.Members = members
 
+	rules, err := loadTeamRules(ctx, q, name)
+	if err != nil {
+		return nil, err
+	}
+	t.Rules = rules
+
+	codeOwners, err := loadCodeOwners(ctx, q, name)
+	if err != nil {
+		return nil, err
+	}
+	t.CodeOwners = codeOwners
+
 	return &t, nil
 }
 
+func (r *TeamRepository) SetMaxReviewers(ctx context.Context, name domain.TeamName, maxReviewers int) error {
+	q := data.QuerierFromContext(ctx, r.pool)
+
+	const query = `
+		UPDATE teams
+		SET max_reviewers = $2
+		WHERE name = $1
+	`
+
+	tag, err := q.Exec(ctx, query, name, maxReviewers)
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return domain.ErrTeamNotFound
+	}
+
+	return nil
+}
+
 func (r *TeamRepository) GetByUserID(ctx context.Context, userID domain.UserID) (*domain.Team, error) {
 	q := data.QuerierFromContext(ctx, r.pool)
 
@@ -103,6 +136,60 @@ func (r *TeamRepository) GetByUserID(ctx context.Context, userID domain.UserID)
 	return r.GetByName(ctx, teamName)
 }
 
+func (r *TeamRepository) List(ctx context.Context) ([]domain.Team, error) {
+	q := data.QuerierFromContext(ctx, r.pool)
+
+	const query = `
+		SELECT t.name, t.max_reviewers, u.id, u.username, u.is_active, u.seniority, u.tags
+		FROM teams t
+		LEFT JOIN users u ON u.team_name = t.name
+		ORDER BY t.name, u.id
+	`
+
+	rows, err := q.Query(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	teams := make([]domain.Team, 0)
+	for rows.Next() {
+		var (
+			name         domain.TeamName
+			maxReviewers int
+			userID       *domain.UserID
+			username     *string
+			isActive     *bool
+			seniority    *domain.Seniority
+			tags         []string
+		)
+		if err := rows.Scan(&name, &maxReviewers, &userID, &username, &isActive, &seniority, &tags); err != nil {
+			return nil, err
+		}
+
+		if len(teams) == 0 || teams[len(teams)-1].Name != name {
+			teams = append(teams, domain.Team{Name: name, MaxReviewers: maxReviewers})
+		}
+		if userID == nil {
+			continue
+		}
+
+		team := &teams[len(teams)-1]
+		team.Members = append(team.Members, domain.TeamMember{
+			ID:        *userID,
+			Username:  *username,
+			IsActive:  *isActive,
+			Seniority: *seniority,
+			Tags:      tags,
+		})
+	}
+	if rows.Err() != nil {
+		return nil, rows.Err()
+	}
+
+	return teams, nil
+}
+
 func (r *TeamRepository) GetStats(ctx context.Context, name domain.TeamName) (*domain.TeamStats, error) {
 	q := data.QuerierFromContext(ctx, r.pool)
 
@@ -174,3 +261,128 @@ func (r *TeamRepository) GetStats(ctx context.Context, name domain.TeamName) (*d
 
 	return stats, nil
 }
+
+// GetStatsTimeSeries buckets the pull requests authored by the team members. Day buckets start at
+// midnight UTC, week buckets on Monday. Reassignments are counted in the bucket the reviewer was replaced in.
+// The only window function is the LEAD that closes each bucket; the counts are grouped per bucket and
+// the percentiles are ordered-set aggregates, as PostgreSQL cannot run percentile_cont over a window.
+func (r *TeamRepository) GetStatsTimeSeries(
+	ctx context.Context,
+	name domain.TeamName,
+	interval domain.StatsInterval,
+	window domain.StatsWindow,
+) (*domain.TeamStatsTimeSeries, error) {
+	q := data.QuerierFromContext(ctx, r.pool)
+
+	const checkTeamQuery = `
+		SELECT 1
+		FROM teams
+		WHERE name = $1
+	`
+	var dummy int
+	if err := q.QueryRow(ctx, checkTeamQuery, name).Scan(&dummy); err != nil {
+		if data.IsNoRows(err) {
+			return nil, domain.ErrTeamNotFound
+		}
+		return nil, err
+	}
+
+	// the series runs one step past the window so that LEAD gives every bucket its end
+	const seriesQuery = `
+		WITH series AS (
+			SELECT
+				bucket_start,
+				LEAD(bucket_start) OVER (ORDER BY bucket_start) AS bucket_end
+			FROM generate_series(
+				date_trunc($2::text, $3::timestamptz, 'UTC'),
+				$4::timestamptz + ('1 ' || $2::text)::interval,
+				('1 ' || $2::text)::interval
+			) AS bucket_start
+		),
+		buckets AS (
+			SELECT bucket_start, bucket_end
+			FROM series
+			WHERE bucket_end IS NOT NULL AND bucket_start < $4
+		),
+		team_prs AS (
+			SELECT pr.id, pr.created_at, pr.merged_at
+			FROM pull_requests pr
+			JOIN users u ON u.id = pr.author_id
+			WHERE u.team_name = $1
+		),
+		opened AS (
+			SELECT b.bucket_start, COUNT(*) AS opened_prs
+			FROM buckets b
+			JOIN team_prs p ON p.created_at >= b.bucket_start AND p.created_at < b.bucket_end
+			GROUP BY b.bucket_start
+		),
+		merged AS (
+			SELECT
+				b.bucket_start,
+				COUNT(*) AS merged_prs,
+				percentile_cont(0.5) WITHIN GROUP (ORDER BY EXTRACT(EPOCH FROM (p.merged_at - p.created_at))) AS median,
+				percentile_cont(0.9) WITHIN GROUP (ORDER BY EXTRACT(EPOCH FROM (p.merged_at - p.created_at))) AS p90
+			FROM buckets b
+			JOIN team_prs p ON p.merged_at >= b.bucket_start AND p.merged_at < b.bucket_end
+			GROUP BY b.bucket_start
+		),
+		reassigned AS (
+			SELECT b.bucket_start, COUNT(*) AS reassignments
+			FROM buckets b
+			JOIN review_assignments a
+			  ON a.reassigned AND a.unassigned_at >= b.bucket_start AND a.unassigned_at < b.bucket_end
+			JOIN team_prs p ON p.id = a.pull_request_id
+			GROUP BY b.bucket_start
+		)
+		SELECT
+			b.bucket_start,
+			b.bucket_end,
+			COALESCE(o.opened_prs, 0),
+			COALESCE(m.merged_prs, 0),
+			COALESCE(m.median, 0),
+			COALESCE(m.p90, 0),
+			COALESCE(ra.reassignments, 0)
+		FROM buckets b
+		LEFT JOIN opened o ON o.bucket_start = b.bucket_start
+		LEFT JOIN merged m ON m.bucket_start = b.bucket_start
+		LEFT JOIN reassigned ra ON ra.bucket_start = b.bucket_start
+		ORDER BY b.bucket_start
+	`
+
+	rows, err := q.Query(ctx, seriesQuery, name, string(interval), window.From, window.To)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	series := &domain.TeamStatsTimeSeries{
+		TeamName: name,
+		Interval: interval,
+		Buckets:  make([]domain.TeamStatsBucket, 0),
+	}
+	for rows.Next() {
+		var (
+			bucket            domain.TeamStatsBucket
+			medianSec, p90Sec float64
+		)
+		if err := rows.Scan(
+			&bucket.Start,
+			&bucket.End,
+			&bucket.OpenedPRs,
+			&bucket.MergedPRs,
+			&medianSec,
+			&p90Sec,
+			&bucket.Reassignments,
+		); err != nil {
+			return nil, err
+		}
+		bucket.MedianTimeToMergeSec = int64(medianSec)
+		bucket.P90TimeToMergeSec = int64(p90Sec)
+		series.Buckets = append(series.Buckets, bucket)
+	}
+	if rows.Err() != nil {
+		return nil, rows.Err()
+	}
+
+	return series, nil
+}
@@ -0,0 +1,116 @@
+package repositories
+
+import (
+	"context"
+
+	"PrService/src/internal/infrastructure/data"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"PrService/src/internal/domain"
+)
+
+type TeamRuleRepository struct {
+	pool *pgxpool.Pool
+}
+
+func NewTeamRuleRepository(pool *pgxpool.Pool) *TeamRuleRepository {
+	return &TeamRuleRepository{pool: pool}
+}
+
+func (r *TeamRuleRepository) Create(ctx context.Context, rule *domain.TeamRule) error {
+	q := data.QuerierFromContext(ctx, r.pool)
+
+	const query = `
+		INSERT INTO team_rules (team_name, kind, reviewer_id, author_id, user_ids, seniority, max_count)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING id
+	`
+
+	return q.QueryRow(
+		ctx,
+		query,
+		rule.TeamName,
+		rule.Kind,
+		rule.ReviewerID,
+		rule.AuthorID,
+		userIDsToStrings(rule.Users),
+		rule.Seniority,
+		rule.MaxCount,
+	).Scan(&rule.ID)
+}
+
+func (r *TeamRuleRepository) Delete(ctx context.Context, name domain.TeamName, id domain.TeamRuleID) error {
+	q := data.QuerierFromContext(ctx, r.pool)
+
+	const query = `
+		DELETE FROM team_rules
+		WHERE team_name = $1 AND id = $2
+	`
+
+	tag, err := q.Exec(ctx, query, name, id)
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return domain.ErrTeamRuleNotFound
+	}
+
+	return nil
+}
+
+func loadTeamRules(ctx context.Context, q data.PgxQuerier, name domain.TeamName) ([]domain.TeamRule, error) {
+	const query = `
+		SELECT id, team_name, kind, reviewer_id, author_id, user_ids, seniority, max_count
+		FROM team_rules
+		WHERE team_name = $1
+		ORDER BY id
+	`
+
+	rows, err := q.Query(ctx, query, name)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	rules := make([]domain.TeamRule, 0)
+	for rows.Next() {
+		var (
+			rule  domain.TeamRule
+			users []string
+		)
+		if err := rows.Scan(
+			&rule.ID,
+			&rule.TeamName,
+			&rule.Kind,
+			&rule.ReviewerID,
+			&rule.AuthorID,
+			&users,
+			&rule.Seniority,
+			&rule.MaxCount,
+		); err != nil {
+			return nil, err
+		}
+
+		rule.Users = make([]domain.UserID, 0, len(users))
+		for _, user := range users {
+			rule.Users = append(rule.Users, domain.UserID(user))
+		}
+		rules = append(rules, rule)
+	}
+	if rows.Err() != nil {
+		return nil, rows.Err()
+	}
+
+	return rules, nil
+}
+
+func userIDsToStrings(ids []domain.UserID) []string {
+	result := make([]string, 0, len(ids))
+	for _, id := range ids {
+		result = append(result, string(id))
+	}
+
+	return result
+}
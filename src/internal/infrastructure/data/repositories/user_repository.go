@@ -20,6 +20,7 @@ func NewUserRepository(pool *pgxpool.Pool) *UserRepository {
 	}
 }
 
+// UpsertBatch creates or replaces the team data of users; contact settings are kept as is.
 func (r *UserRepository) UpsertBatch(ctx context.Context, users []domain.User) error {
 	if len(users) == 0 {
 		return nil
@@ -28,12 +29,14 @@ func (r *UserRepository) UpsertBatch(ctx context.Context, users []domain.User) e
 	q := data.QuerierFromContext(ctx, r.pool)
 
 	const query = `
-		INSERT INTO users (id, username, team_name, is_active)
-		VALUES ($1, $2, $3, $4)
+		INSERT INTO users (id, username, team_name, is_active, seniority, tags)
+		VALUES ($1, $2, $3, $4, $5, $6)
 		ON CONFLICT (id) DO UPDATE SET
 			username  = EXCLUDED.username,
 			team_name = EXCLUDED.team_name,
-			is_active = EXCLUDED.is_active
+			is_active = EXCLUDED.is_active,
+			seniority = EXCLUDED.seniority,
+			tags      = EXCLUDED.tags
 	`
 
 	for _, u := range users {
@@ -42,6 +45,8 @@ func (r *UserRepository) UpsertBatch(ctx context.Context, users []domain.User) e
 			u.Username,
 			u.TeamName,
 			u.IsActive,
+			u.Seniority,
+			nonNilTags(u.Tags),
 		); err != nil {
 			return err
 		}
@@ -54,7 +59,7 @@ func (r *UserRepository) GetByID(ctx context.Context, id domain.UserID) (*domain
 	q := data.QuerierFromContext(ctx, r.pool)
 
 	const query = `
-		SELECT id, username, team_name, is_active
+		SELECT id, username, team_name, is_active, seniority, tags, chat_handle, email, email_opt_out
 		FROM users
 		WHERE id = $1
 	`
@@ -65,6 +70,11 @@ func (r *UserRepository) GetByID(ctx context.Context, id domain.UserID) (*domain
 		&u.Username,
 		&u.TeamName,
 		&u.IsActive,
+		&u.Seniority,
+		&u.Tags,
+		&u.ChatHandle,
+		&u.Email,
+		&u.EmailOptOut,
 	); err != nil {
 		if data.IsNoRows(err) {
 			return nil, domain.ErrUserNotFound
@@ -82,7 +92,12 @@ func (r *UserRepository) Update(ctx context.Context, user *domain.User) error {
 		UPDATE users
 		SET username = $2,
 		    team_name = $3,
-		    is_active = $4
+		    is_active = $4,
+		    seniority = $5,
+		    tags = $6,
+		    chat_handle = $7,
+		    email = $8,
+		    email_opt_out = $9
 		WHERE id = $1
 	`
 
@@ -91,6 +106,11 @@ func (r *UserRepository) Update(ctx context.Context, user *domain.User) error {
 		user.Username,
 		user.TeamName,
 		user.IsActive,
+		user.Seniority,
+		nonNilTags(user.Tags),
+		user.ChatHandle,
+		user.Email,
+		user.EmailOptOut,
 	)
 	if err != nil {
 		return err
@@ -102,3 +122,55 @@ func (r *UserRepository) Update(ctx context.Context, user *domain.User) error {
 
 	return nil
 }
+
+func (r *UserRepository) List(ctx context.Context, filter domain.UserFilter) ([]domain.User, error) {
+	q := data.QuerierFromContext(ctx, r.pool)
+
+	const query = `
+		SELECT id, username, team_name, is_active, seniority, tags, chat_handle, email, email_opt_out
+		FROM users
+		WHERE ($1 = '' OR team_name = $1)
+		  AND ($2 = '' OR seniority = $2)
+		  AND tags @> $3
+		ORDER BY team_name, id
+	`
+
+	rows, err := q.Query(ctx, query, filter.TeamName, filter.Seniority, nonNilTags(filter.Tags))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	users := make([]domain.User, 0)
+	for rows.Next() {
+		var u domain.User
+		if err := rows.Scan(
+			&u.ID,
+			&u.Username,
+			&u.TeamName,
+			&u.IsActive,
+			&u.Seniority,
+			&u.Tags,
+			&u.ChatHandle,
+			&u.Email,
+			&u.EmailOptOut,
+		); err != nil {
+			return nil, err
+		}
+		users = append(users, u)
+	}
+	if rows.Err() != nil {
+		return nil, rows.Err()
+	}
+
+	return users, nil
+}
+
+// nonNilTags keeps NOT NULL tag arrays from being written as NULL.
+func nonNilTags(tags []string) []string {
+	if tags == nil {
+		return []string{}
+	}
+
+	return tags
+}
@@ -0,0 +1,40 @@
+package repositories
+
+import (
+	"context"
+
+	"PrService/src/internal/infrastructure/data"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"PrService/src/internal/domain"
+)
+
+type WebhookDeliveryRepository struct {
+	pool *pgxpool.Pool
+}
+
+func NewWebhookDeliveryRepository(pool *pgxpool.Pool) *WebhookDeliveryRepository {
+	return &WebhookDeliveryRepository{pool: pool}
+}
+
+func (r *WebhookDeliveryRepository) Register(
+	ctx context.Context,
+	provider domain.ExternalProvider,
+	deliveryID string,
+) (bool, error) {
+	q := data.QuerierFromContext(ctx, r.pool)
+
+	const query = `
+		INSERT INTO webhook_deliveries (provider, delivery_id)
+		VALUES ($1, $2)
+		ON CONFLICT (provider, delivery_id) DO NOTHING
+	`
+
+	tag, err := q.Exec(ctx, query, provider, deliveryID)
+	if err != nil {
+		return false, err
+	}
+
+	return tag.RowsAffected() == 1, nil
+}
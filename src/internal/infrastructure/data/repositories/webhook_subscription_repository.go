@@ -0,0 +1,310 @@
+package repositories
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"time"
+
+	"PrService/src/internal/infrastructure/data"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"PrService/src/internal/domain"
+)
+
+const webhookDeliveryColumns = `
+	d.id, d.subscription_id, d.event_id, d.event_type, d.payload, d.status, d.attempts, d.next_attempt_at,
+	d.last_status_code, d.last_error, d.created_at, d.delivered_at
+`
+
+type WebhookSubscriptionRepository struct {
+	pool *pgxpool.Pool
+}
+
+func NewWebhookSubscriptionRepository(pool *pgxpool.Pool) *WebhookSubscriptionRepository {
+	return &WebhookSubscriptionRepository{pool: pool}
+}
+
+func (r *WebhookSubscriptionRepository) Create(ctx context.Context, subscription *domain.WebhookSubscription) error {
+	q := data.QuerierFromContext(ctx, r.pool)
+
+	const query = `
+		INSERT INTO webhook_subscriptions (url, secret, event_types)
+		VALUES ($1, $2, $3)
+		RETURNING id, created_at
+	`
+
+	return q.QueryRow(
+		ctx,
+		query,
+		subscription.URL,
+		subscription.Secret,
+		eventTypesToStrings(subscription.EventTypes),
+	).Scan(&subscription.ID, &subscription.CreatedAt)
+}
+
+func (r *WebhookSubscriptionRepository) GetByID(
+	ctx context.Context,
+	id domain.WebhookSubscriptionID,
+) (*domain.WebhookSubscription, error) {
+	q := data.QuerierFromContext(ctx, r.pool)
+
+	const query = `
+		SELECT id, url, secret, event_types, created_at
+		FROM webhook_subscriptions
+		WHERE id = $1
+	`
+
+	subscription, err := scanWebhookSubscription(q.QueryRow(ctx, query, id))
+	if err != nil {
+		if data.IsNoRows(err) {
+			return nil, domain.ErrWebhookSubscriptionNotFound
+		}
+		return nil, err
+	}
+
+	return subscription, nil
+}
+
+func (r *WebhookSubscriptionRepository) List(ctx context.Context) ([]domain.WebhookSubscription, error) {
+	const query = `
+		SELECT id, url, secret, event_types, created_at
+		FROM webhook_subscriptions
+		ORDER BY id
+	`
+
+	return r.list(ctx, query)
+}
+
+func (r *WebhookSubscriptionRepository) ListByEventType(
+	ctx context.Context,
+	eventType domain.EventType,
+) ([]domain.WebhookSubscription, error) {
+	const query = `
+		SELECT id, url, secret, event_types, created_at
+		FROM webhook_subscriptions
+		WHERE $1 = ANY (event_types)
+		ORDER BY id
+	`
+
+	return r.list(ctx, query, eventType)
+}
+
+func (r *WebhookSubscriptionRepository) list(
+	ctx context.Context,
+	query string,
+	args ...any,
+) ([]domain.WebhookSubscription, error) {
+	q := data.QuerierFromContext(ctx, r.pool)
+
+	rows, err := q.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	subscriptions := make([]domain.WebhookSubscription, 0)
+	for rows.Next() {
+		subscription, err := scanWebhookSubscription(rows)
+		if err != nil {
+			return nil, err
+		}
+		subscriptions = append(subscriptions, *subscription)
+	}
+
+	return subscriptions, rows.Err()
+}
+
+func (r *WebhookSubscriptionRepository) Delete(ctx context.Context, id domain.WebhookSubscriptionID) error {
+	q := data.QuerierFromContext(ctx, r.pool)
+
+	const query = `
+		DELETE FROM webhook_subscriptions
+		WHERE id = $1
+	`
+
+	tag, err := q.Exec(ctx, query, id)
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return domain.ErrWebhookSubscriptionNotFound
+	}
+
+	return nil
+}
+
+func (r *WebhookSubscriptionRepository) EnqueueDeliveries(
+	ctx context.Context,
+	deliveries []domain.WebhookSubscriptionDelivery,
+) error {
+	q := data.QuerierFromContext(ctx, r.pool)
+
+	const query = `
+		INSERT INTO webhook_subscription_deliveries
+			(subscription_id, event_id, event_type, payload, status, next_attempt_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (subscription_id, event_id) DO NOTHING
+	`
+
+	for _, d := range deliveries {
+		_, err := q.Exec(ctx, query, d.SubscriptionID, d.EventID, d.EventType, d.Payload, d.Status, d.NextAttemptAt)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *WebhookSubscriptionRepository) ClaimDueDeliveries(
+	ctx context.Context,
+	now time.Time,
+	lease time.Duration,
+	limit int,
+) ([]domain.WebhookSubscriptionDelivery, error) {
+	q := data.QuerierFromContext(ctx, r.pool)
+
+	const query = `
+		WITH due AS (
+			SELECT id
+			FROM webhook_subscription_deliveries
+			WHERE status = 'PENDING' AND next_attempt_at <= $1
+			ORDER BY next_attempt_at, id
+			LIMIT $3
+			FOR UPDATE SKIP LOCKED
+		)
+		UPDATE webhook_subscription_deliveries d
+		SET next_attempt_at = $2
+		FROM due, webhook_subscriptions s
+		WHERE d.id = due.id AND s.id = d.subscription_id
+		RETURNING ` + webhookDeliveryColumns + `, s.url, s.secret
+	`
+
+	rows, err := q.Query(ctx, query, now, now.Add(lease), limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	deliveries := make([]domain.WebhookSubscriptionDelivery, 0)
+	for rows.Next() {
+		var d domain.WebhookSubscriptionDelivery
+		if err := rows.Scan(append(webhookDeliveryDest(&d), &d.URL, &d.Secret)...); err != nil {
+			return nil, err
+		}
+		deliveries = append(deliveries, d)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	// RETURNING does not keep the order of the claimed rows.
+	slices.SortFunc(deliveries, func(a, b domain.WebhookSubscriptionDelivery) int {
+		return cmp.Compare(a.ID, b.ID)
+	})
+
+	return deliveries, nil
+}
+
+func (r *WebhookSubscriptionRepository) UpdateDelivery(
+	ctx context.Context,
+	delivery domain.WebhookSubscriptionDelivery,
+) error {
+	q := data.QuerierFromContext(ctx, r.pool)
+
+	const query = `
+		UPDATE webhook_subscription_deliveries
+		SET status           = $2,
+			attempts         = $3,
+			next_attempt_at  = $4,
+			last_status_code = $5,
+			last_error       = $6,
+			delivered_at     = $7
+		WHERE id = $1
+	`
+
+	_, err := q.Exec(
+		ctx,
+		query,
+		delivery.ID,
+		delivery.Status,
+		delivery.Attempts,
+		delivery.NextAttemptAt,
+		delivery.LastStatusCode,
+		delivery.LastError,
+		delivery.DeliveredAt,
+	)
+
+	return err
+}
+
+func (r *WebhookSubscriptionRepository) ListDeliveries(
+	ctx context.Context,
+	id domain.WebhookSubscriptionID,
+	limit int,
+) ([]domain.WebhookSubscriptionDelivery, error) {
+	q := data.QuerierFromContext(ctx, r.pool)
+
+	const query = `
+		SELECT ` + webhookDeliveryColumns + `
+		FROM webhook_subscription_deliveries d
+		WHERE d.subscription_id = $1
+		ORDER BY d.id DESC
+		LIMIT $2
+	`
+
+	rows, err := q.Query(ctx, query, id, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	deliveries := make([]domain.WebhookSubscriptionDelivery, 0)
+	for rows.Next() {
+		var d domain.WebhookSubscriptionDelivery
+		if err := rows.Scan(webhookDeliveryDest(&d)...); err != nil {
+			return nil, err
+		}
+		deliveries = append(deliveries, d)
+	}
+
+	return deliveries, rows.Err()
+}
+
+func scanWebhookSubscription(row pgx.Row) (*domain.WebhookSubscription, error) {
+	var (
+		subscription domain.WebhookSubscription
+		eventTypes   []string
+	)
+	err := row.Scan(&subscription.ID, &subscription.URL, &subscription.Secret, &eventTypes, &subscription.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	subscription.EventTypes = make([]domain.EventType, 0, len(eventTypes))
+	for _, eventType := range eventTypes {
+		subscription.EventTypes = append(subscription.EventTypes, domain.EventType(eventType))
+	}
+
+	return &subscription, nil
+}
+
+func webhookDeliveryDest(d *domain.WebhookSubscriptionDelivery) []any {
+	return []any{
+		&d.ID, &d.SubscriptionID, &d.EventID, &d.EventType, &d.Payload, &d.Status, &d.Attempts, &d.NextAttemptAt,
+		&d.LastStatusCode, &d.LastError, &d.CreatedAt, &d.DeliveredAt,
+	}
+}
+
+func eventTypesToStrings(eventTypes []domain.EventType) []string {
+	result := make([]string, 0, len(eventTypes))
+	for _, eventType := range eventTypes {
+		result = append(result, string(eventType))
+	}
+
+	return result
+}
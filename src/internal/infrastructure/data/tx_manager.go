@@ -23,8 +23,18 @@ type contextKey struct{}
 
 var txKey = contextKey{}
 
+// WithinTransaction runs fn in a transaction. A call nested in another transaction runs in a
+// savepoint, so its failure rolls back only its own changes and the caller decides what to do.
 func (m *TxManager) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
-	tx, err := m.pool.BeginTx(ctx, pgx.TxOptions{})
+	var (
+		tx  pgx.Tx
+		err error
+	)
+	if outer := txFromContext(ctx); outer != nil {
+		tx, err = outer.Begin(ctx)
+	} else {
+		tx, err = m.pool.BeginTx(ctx, pgx.TxOptions{})
+	}
 	if err != nil {
 		return err
 	}
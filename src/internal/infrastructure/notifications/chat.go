@@ -0,0 +1,74 @@
+package notifications
+
+import (
+	"context"
+	"encoding/json"
+
+	"PrService/src/internal/application/contracts"
+)
+
+// chatMessage is the incoming-webhook body understood by both Slack and Mattermost.
+// Channel "@handle" sends a direct message.
+type chatMessage struct {
+	Channel  string `json:"channel"`
+	Username string `json:"username,omitempty"`
+	Text     string `json:"text"`
+}
+
+// SlackNotifier sends direct messages through a Slack-compatible incoming webhook.
+type SlackNotifier struct {
+	url      string
+	username string
+	sender   contracts.WebhookSender
+}
+
+func NewSlackNotifier(url, username string, sender contracts.WebhookSender) *SlackNotifier {
+	return &SlackNotifier{url: url, username: username, sender: sender}
+}
+
+func (n *SlackNotifier) Notify(ctx context.Context, notification contracts.Notification) error {
+	// Slack mrkdwn marks bold text with single asterisks.
+	text := "*" + notification.Subject + "*\n" + notification.Text
+
+	return sendChatMessage(ctx, n.sender, n.url, n.username, notification, text)
+}
+
+// MattermostNotifier sends direct messages through a Mattermost incoming webhook.
+type MattermostNotifier struct {
+	url      string
+	username string
+	sender   contracts.WebhookSender
+}
+
+func NewMattermostNotifier(url, username string, sender contracts.WebhookSender) *MattermostNotifier {
+	return &MattermostNotifier{url: url, username: username, sender: sender}
+}
+
+func (n *MattermostNotifier) Notify(ctx context.Context, notification contracts.Notification) error {
+	text := "**" + notification.Subject + "**\n" + notification.Text
+
+	return sendChatMessage(ctx, n.sender, n.url, n.username, notification, text)
+}
+
+func sendChatMessage(
+	ctx context.Context,
+	sender contracts.WebhookSender,
+	url string,
+	username string,
+	notification contracts.Notification,
+	text string,
+) error {
+	handle := notification.Recipient.ChatHandle
+	if handle == "" {
+		return nil
+	}
+
+	body, err := json.Marshal(chatMessage{Channel: "@" + handle, Username: username, Text: text})
+	if err != nil {
+		return err
+	}
+
+	_, err = sender.Send(ctx, url, body, nil)
+
+	return err
+}
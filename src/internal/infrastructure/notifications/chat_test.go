@@ -0,0 +1,106 @@
+package notifications
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"PrService/src/internal/application/contracts"
+	"PrService/src/internal/domain"
+	"PrService/src/internal/infrastructure/webhooks"
+)
+
+// chatServer stands in for an incoming-webhook endpoint and records received messages.
+func chatServer(t *testing.T, status int) (*httptest.Server, *[]chatMessage) {
+	t.Helper()
+
+	received := make([]chatMessage, 0)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var msg chatMessage
+		if err := json.NewDecoder(r.Body).Decode(&msg); err != nil {
+			t.Errorf("invalid message body: %v", err)
+		}
+		received = append(received, msg)
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(server.Close)
+
+	return server, &received
+}
+
+func testNotification(handle string) contracts.Notification {
+	return contracts.Notification{
+		Recipient: domain.User{ID: "u2", ChatHandle: handle},
+		Subject:   "Review requested: Add search",
+		Text:      "You have been assigned to review pull request \"Add search\" (pr-1) by u1.",
+	}
+}
+
+func TestChatNotifiers_Notify_SendsDirectMessage(t *testing.T) {
+	tests := []struct {
+		name     string
+		newNotif func(url string) contracts.Notifier
+		wantText string
+	}{
+		{
+			name: "slack",
+			newNotif: func(url string) contracts.Notifier {
+				return NewSlackNotifier(url, "PR Reviewer", webhooks.NewHTTPSender(time.Second))
+			},
+			wantText: "*Review requested: Add search*\n",
+		},
+		{
+			name: "mattermost",
+			newNotif: func(url string) contracts.Notifier {
+				return NewMattermostNotifier(url, "PR Reviewer", webhooks.NewHTTPSender(time.Second))
+			},
+			wantText: "**Review requested: Add search**\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, received := chatServer(t, http.StatusOK)
+
+			if err := tt.newNotif(server.URL).Notify(context.Background(), testNotification("bob")); err != nil {
+				t.Fatalf("Notify failed: %v", err)
+			}
+
+			if len(*received) != 1 {
+				t.Fatalf("expected one message, got %d", len(*received))
+			}
+			msg := (*received)[0]
+			if msg.Channel != "@bob" || msg.Username != "PR Reviewer" {
+				t.Fatalf("unexpected message: %+v", msg)
+			}
+			if want := tt.wantText + testNotification("").Text; msg.Text != want {
+				t.Fatalf("expected text %q, got %q", want, msg.Text)
+			}
+		})
+	}
+}
+
+func TestChatNotifiers_Notify_SkipsUserWithoutHandle(t *testing.T) {
+	server, received := chatServer(t, http.StatusOK)
+
+	notifier := NewSlackNotifier(server.URL, "", webhooks.NewHTTPSender(time.Second))
+	if err := notifier.Notify(context.Background(), testNotification("")); err != nil {
+		t.Fatalf("Notify failed: %v", err)
+	}
+
+	if len(*received) != 0 {
+		t.Fatalf("expected no message, got %+v", *received)
+	}
+}
+
+func TestChatNotifiers_Notify_ReportsRejectedMessage(t *testing.T) {
+	server, _ := chatServer(t, http.StatusNotFound)
+
+	notifier := NewMattermostNotifier(server.URL, "", webhooks.NewHTTPSender(time.Second))
+	if err := notifier.Notify(context.Background(), testNotification("bob")); err == nil {
+		t.Fatal("expected error for rejected message")
+	}
+}
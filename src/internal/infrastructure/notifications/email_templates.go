@@ -0,0 +1,96 @@
+package notifications
+
+import (
+	"embed"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+	"time"
+)
+
+const (
+	notificationTemplate = "notification.tmpl"
+	digestTemplate       = "digest.tmpl"
+)
+
+//go:embed templates/*.tmpl
+var defaultTemplates embed.FS
+
+// EmailTemplates render email subjects and bodies. Each template file defines a "subject" and a "body".
+type EmailTemplates struct {
+	notification *template.Template
+	digest       *template.Template
+}
+
+// LoadEmailTemplates reads notification.tmpl and digest.tmpl from dir, falling back to the built-in
+// template for a file that is missing there. An empty dir uses the built-in templates only.
+func LoadEmailTemplates(dir string) (*EmailTemplates, error) {
+	notification, err := loadEmailTemplate(dir, notificationTemplate)
+	if err != nil {
+		return nil, err
+	}
+
+	digest, err := loadEmailTemplate(dir, digestTemplate)
+	if err != nil {
+		return nil, err
+	}
+
+	return &EmailTemplates{notification: notification, digest: digest}, nil
+}
+
+func loadEmailTemplate(dir, name string) (*template.Template, error) {
+	text, err := defaultTemplates.ReadFile("templates/" + name)
+	if err != nil {
+		return nil, err
+	}
+
+	if dir != "" {
+		custom, err := os.ReadFile(filepath.Join(dir, name))
+		switch {
+		case err == nil:
+			text = custom
+		case !errors.Is(err, os.ErrNotExist):
+			return nil, err
+		}
+	}
+
+	tmpl, err := template.New(name).Funcs(template.FuncMap{"age": formatAge}).Parse(string(text))
+	if err != nil {
+		return nil, fmt.Errorf("parse %s: %w", name, err)
+	}
+	for _, part := range []string{"subject", "body"} {
+		if tmpl.Lookup(part) == nil {
+			return nil, fmt.Errorf("%s does not define %q", name, part)
+		}
+	}
+
+	return tmpl, nil
+}
+
+func render(tmpl *template.Template, data any) (string, string, error) {
+	var subject, body strings.Builder
+	if err := tmpl.ExecuteTemplate(&subject, "subject", data); err != nil {
+		return "", "", err
+	}
+	if err := tmpl.ExecuteTemplate(&body, "body", data); err != nil {
+		return "", "", err
+	}
+
+	return strings.TrimSpace(subject.String()), body.String(), nil
+}
+
+// formatAge renders a duration in whole days and hours, e.g. "3d 4h".
+func formatAge(d time.Duration) string {
+	hours := int(d.Hours())
+	switch {
+	case hours < 1:
+		return fmt.Sprintf("%dm", int(d.Minutes()))
+	case hours < 24:
+		return fmt.Sprintf("%dh", hours)
+	default:
+		return fmt.Sprintf("%dd %dh", hours/24, hours%24)
+	}
+}
@@ -0,0 +1,140 @@
+package notifications
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"mime"
+	"net"
+	"net/smtp"
+	"strconv"
+	"strings"
+	"time"
+
+	"PrService/src/internal/application/contracts"
+)
+
+type SMTPConfig struct {
+	Host string
+	Port int
+	// Username and Password enable PLAIN authentication; net/smtp only sends them over TLS or to localhost.
+	Username string
+	Password string
+	From     string
+	Timeout  time.Duration
+}
+
+// SMTPNotifier emails notifications and review digests. STARTTLS is used when the server offers it.
+type SMTPNotifier struct {
+	cfg       SMTPConfig
+	templates *EmailTemplates
+	now       func() time.Time
+}
+
+func NewSMTPNotifier(cfg SMTPConfig, templates *EmailTemplates) *SMTPNotifier {
+	return &SMTPNotifier{cfg: cfg, templates: templates, now: time.Now}
+}
+
+func (n *SMTPNotifier) Notify(ctx context.Context, notification contracts.Notification) error {
+	recipient := notification.Recipient
+	if recipient.Email == "" || recipient.EmailOptOut {
+		return nil
+	}
+
+	subject, body, err := render(n.templates.notification, notification)
+	if err != nil {
+		return fmt.Errorf("render notification: %w", err)
+	}
+
+	return n.send(ctx, recipient.Email, subject, body)
+}
+
+func (n *SMTPNotifier) SendDigest(ctx context.Context, digest contracts.Digest) error {
+	recipient := digest.Recipient
+	if recipient.Email == "" || recipient.EmailOptOut {
+		return nil
+	}
+
+	subject, body, err := render(n.templates.digest, digest)
+	if err != nil {
+		return fmt.Errorf("render digest: %w", err)
+	}
+
+	return n.send(ctx, recipient.Email, subject, body)
+}
+
+func (n *SMTPNotifier) send(ctx context.Context, to, subject, body string) (err error) {
+	addr := net.JoinHostPort(n.cfg.Host, strconv.Itoa(n.cfg.Port))
+
+	dialer := net.Dialer{Timeout: n.cfg.Timeout}
+	conn, err := dialer.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return err
+	}
+
+	deadline := time.Now().Add(n.cfg.Timeout)
+	if ctxDeadline, ok := ctx.Deadline(); ok && ctxDeadline.Before(deadline) {
+		deadline = ctxDeadline
+	}
+	if err := conn.SetDeadline(deadline); err != nil {
+		return errors.Join(err, conn.Close())
+	}
+
+	client, err := smtp.NewClient(conn, n.cfg.Host)
+	if err != nil {
+		return errors.Join(err, conn.Close())
+	}
+	// Quit closes the connection on success; every other exit has to close it here.
+	defer func() {
+		if err != nil {
+			err = errors.Join(err, client.Close())
+		}
+	}()
+
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		if err := client.StartTLS(&tls.Config{ServerName: n.cfg.Host, MinVersion: tls.VersionTLS12}); err != nil {
+			return err
+		}
+	}
+	if n.cfg.Username != "" {
+		if err := client.Auth(smtp.PlainAuth("", n.cfg.Username, n.cfg.Password, n.cfg.Host)); err != nil {
+			return err
+		}
+	}
+
+	if err := client.Mail(n.cfg.From); err != nil {
+		return err
+	}
+	if err := client.Rcpt(to); err != nil {
+		return err
+	}
+
+	w, err := client.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(n.message(to, subject, body)); err != nil {
+		return errors.Join(err, w.Close())
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+
+	return client.Quit()
+}
+
+func (n *SMTPNotifier) message(to, subject, body string) []byte {
+	var b strings.Builder
+	b.WriteString("From: " + n.cfg.From + "\r\n")
+	b.WriteString("To: " + to + "\r\n")
+	b.WriteString("Subject: " + mime.QEncoding.Encode("utf-8", subject) + "\r\n")
+	b.WriteString("Date: " + n.now().Format(time.RFC1123Z) + "\r\n")
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=UTF-8\r\n")
+	b.WriteString("Content-Transfer-Encoding: 8bit\r\n")
+	b.WriteString("\r\n")
+	b.WriteString(strings.ReplaceAll(strings.ReplaceAll(body, "\r\n", "\n"), "\n", "\r\n"))
+
+	return []byte(b.String())
+}
@@ -0,0 +1,270 @@
+package notifications
+
+import (
+	"context"
+	"net"
+	"net/textproto"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"PrService/src/internal/application/contracts"
+	"PrService/src/internal/domain"
+)
+
+type receivedMail struct {
+	from string
+	to   []string
+	data string
+}
+
+// fakeSMTPServer speaks just enough SMTP for net/smtp: no extensions, no authentication.
+type fakeSMTPServer struct {
+	listener net.Listener
+	mu       sync.Mutex
+	mails    []receivedMail
+}
+
+func newFakeSMTPServer(t *testing.T) *fakeSMTPServer {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+
+	s := &fakeSMTPServer{listener: listener}
+	go s.serve()
+	t.Cleanup(func() { _ = listener.Close() })
+
+	return s
+}
+
+func (s *fakeSMTPServer) config() SMTPConfig {
+	addr := s.listener.Addr().(*net.TCPAddr)
+	return SMTPConfig{Host: addr.IP.String(), Port: addr.Port, From: "pr-service@example.com", Timeout: time.Second}
+}
+
+func (s *fakeSMTPServer) received() []receivedMail {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]receivedMail(nil), s.mails...)
+}
+
+func (s *fakeSMTPServer) serve() {
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+		s.handle(textproto.NewConn(conn))
+	}
+}
+
+func (s *fakeSMTPServer) handle(conn *textproto.Conn) {
+	defer conn.Close()
+
+	var mail receivedMail
+	reply := func(code int, msg string) bool {
+		return conn.PrintfLine("%d %s", code, msg) == nil
+	}
+
+	if !reply(220, "fake ESMTP") {
+		return
+	}
+	for {
+		line, err := conn.ReadLine()
+		if err != nil {
+			return
+		}
+
+		verb, arg, _ := strings.Cut(line, " ")
+		switch strings.ToUpper(verb) {
+		case "EHLO", "HELO", "NOOP", "RSET":
+			reply(250, "ok")
+		case "MAIL":
+			mail.from = strings.Trim(strings.TrimPrefix(arg, "FROM:"), "<>")
+			reply(250, "ok")
+		case "RCPT":
+			mail.to = append(mail.to, strings.Trim(strings.TrimPrefix(arg, "TO:"), "<>"))
+			reply(250, "ok")
+		case "DATA":
+			reply(354, "go ahead")
+			lines, err := conn.ReadDotLines()
+			if err != nil {
+				return
+			}
+			mail.data = strings.Join(lines, "\n")
+			s.mu.Lock()
+			s.mails = append(s.mails, mail)
+			s.mu.Unlock()
+			reply(250, "queued")
+		case "QUIT":
+			reply(221, "bye")
+			return
+		default:
+			reply(502, "not implemented")
+		}
+	}
+}
+
+func newTestSMTPNotifier(t *testing.T, server *fakeSMTPServer, templatesDir string) *SMTPNotifier {
+	t.Helper()
+
+	templates, err := LoadEmailTemplates(templatesDir)
+	if err != nil {
+		t.Fatalf("LoadEmailTemplates failed: %v", err)
+	}
+
+	return NewSMTPNotifier(server.config(), templates)
+}
+
+func TestSMTPNotifier_Notify_SendsEmail(t *testing.T) {
+	server := newFakeSMTPServer(t)
+	notifier := newTestSMTPNotifier(t, server, "")
+
+	err := notifier.Notify(context.Background(), contracts.Notification{
+		Recipient: domain.User{ID: "u2", Username: "Bob", Email: "bob@example.com"},
+		Subject:   "Review requested: Add search",
+		Text:      "You have been assigned to review pull request \"Add search\" (pr-1) by u1.",
+	})
+	if err != nil {
+		t.Fatalf("Notify failed: %v", err)
+	}
+
+	mails := server.received()
+	if len(mails) != 1 {
+		t.Fatalf("expected one email, got %d", len(mails))
+	}
+	mail := mails[0]
+	if mail.from != "pr-service@example.com" || len(mail.to) != 1 || mail.to[0] != "bob@example.com" {
+		t.Fatalf("unexpected envelope: %+v", mail)
+	}
+	for _, want := range []string{
+		"To: bob@example.com",
+		"Subject: Review requested: Add search",
+		"Hello Bob,",
+		`review pull request "Add search" (pr-1)`,
+	} {
+		if !strings.Contains(mail.data, want) {
+			t.Fatalf("expected %q in email:\n%s", want, mail.data)
+		}
+	}
+}
+
+func TestSMTPNotifier_SkipsOptedOutAndMissingEmail(t *testing.T) {
+	server := newFakeSMTPServer(t)
+	notifier := newTestSMTPNotifier(t, server, "")
+
+	ctx := context.Background()
+	for _, user := range []domain.User{
+		{ID: "u1", Email: "alice@example.com", EmailOptOut: true},
+		{ID: "u2"},
+	} {
+		if err := notifier.Notify(ctx, contracts.Notification{Recipient: user, Subject: "s", Text: "t"}); err != nil {
+			t.Fatalf("Notify failed: %v", err)
+		}
+		if err := notifier.SendDigest(ctx, contracts.Digest{Recipient: user}); err != nil {
+			t.Fatalf("SendDigest failed: %v", err)
+		}
+	}
+
+	if mails := server.received(); len(mails) != 0 {
+		t.Fatalf("expected no email, got %+v", mails)
+	}
+}
+
+func TestSMTPNotifier_SendDigest_ListsReviewsOldestFirst(t *testing.T) {
+	server := newFakeSMTPServer(t)
+	notifier := newTestSMTPNotifier(t, server, "")
+
+	err := notifier.SendDigest(context.Background(), contracts.Digest{
+		Recipient: domain.User{ID: "u2", Username: "Bob", Email: "bob@example.com"},
+		Reviews: []contracts.DigestReview{
+			{PullRequest: domain.PullRequest{ID: "pr-1", Name: "Old one", AuthorID: "u1"}, Age: 50 * time.Hour},
+			{PullRequest: domain.PullRequest{ID: "pr-2", Name: "New one", AuthorID: "u3"}, Age: 3 * time.Hour},
+		},
+	})
+	if err != nil {
+		t.Fatalf("SendDigest failed: %v", err)
+	}
+
+	mails := server.received()
+	if len(mails) != 1 {
+		t.Fatalf("expected one email, got %d", len(mails))
+	}
+	data := mails[0].data
+	if !strings.Contains(data, "Subject: 2 pull request(s) waiting for your review") {
+		t.Fatalf("unexpected subject:\n%s", data)
+	}
+	first := strings.Index(data, "- Old one (pr-1) by u1, open for 2d 2h")
+	second := strings.Index(data, "- New one (pr-2) by u3, open for 3h")
+	if first < 0 || second < 0 || first > second {
+		t.Fatalf("expected reviews oldest first:\n%s", data)
+	}
+}
+
+func TestSMTPNotifier_CustomTemplate(t *testing.T) {
+	dir := t.TempDir()
+	custom := `{{define "subject"}}[PR] {{.Subject}}{{end}}` +
+		`{{define "body"}}{{.Text}} -- sent to {{.Recipient.Email}}{{end}}`
+	if err := os.WriteFile(filepath.Join(dir, notificationTemplate), []byte(custom), 0o600); err != nil {
+		t.Fatalf("write template: %v", err)
+	}
+
+	server := newFakeSMTPServer(t)
+	notifier := newTestSMTPNotifier(t, server, dir)
+
+	err := notifier.Notify(context.Background(), contracts.Notification{
+		Recipient: domain.User{ID: "u2", Email: "bob@example.com"},
+		Subject:   "Merged: Add search",
+		Text:      "Your pull request has been merged.",
+	})
+	if err != nil {
+		t.Fatalf("Notify failed: %v", err)
+	}
+
+	data := server.received()[0].data
+	if !strings.Contains(data, "Subject: [PR] Merged: Add search") ||
+		!strings.Contains(data, "Your pull request has been merged. -- sent to bob@example.com") {
+		t.Fatalf("custom template not applied:\n%s", data)
+	}
+}
+
+func TestLoadEmailTemplates_RejectsIncompleteTemplate(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, digestTemplate), []byte(`{{define "body"}}x{{end}}`), 0o600); err != nil {
+		t.Fatalf("write template: %v", err)
+	}
+
+	if _, err := LoadEmailTemplates(dir); err == nil || !strings.Contains(err.Error(), strconv.Quote("subject")) {
+		t.Fatalf("expected missing subject error, got %v", err)
+	}
+}
+
+func TestSMTPNotifier_UnreachableServer(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	addr := listener.Addr().(*net.TCPAddr)
+	_ = listener.Close()
+
+	templates, err := LoadEmailTemplates("")
+	if err != nil {
+		t.Fatalf("LoadEmailTemplates failed: %v", err)
+	}
+	notifier := NewSMTPNotifier(SMTPConfig{Host: "127.0.0.1", Port: addr.Port, Timeout: time.Second}, templates)
+
+	err = notifier.Notify(context.Background(), contracts.Notification{
+		Recipient: domain.User{ID: "u2", Email: "bob@example.com"},
+	})
+	if err == nil {
+		t.Fatal("expected error for unreachable server")
+	}
+}
@@ -0,0 +1,68 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"sync"
+	"time"
+
+	"PrService/src/internal/domain"
+)
+
+// FileSink appends every relayed event to a file as one JSON document per line.
+type FileSink struct {
+	path string
+	mu   sync.Mutex
+}
+
+type fileRecord struct {
+	ID            int64                `json:"id"`
+	AggregateType domain.AggregateType `json:"aggregate_type"`
+	AggregateID   string               `json:"aggregate_id"`
+	EventType     domain.EventType     `json:"event_type"`
+	OccurredAt    time.Time            `json:"occurred_at"`
+	Payload       json.RawMessage      `json:"payload"`
+}
+
+func NewFileSink(path string) *FileSink {
+	return &FileSink{path: path}
+}
+
+func (s *FileSink) Name() string {
+	return "file"
+}
+
+func (s *FileSink) Publish(_ context.Context, message domain.OutboxMessage) error {
+	line, err := json.Marshal(fileRecord{
+		ID:            message.ID,
+		AggregateType: message.AggregateType,
+		AggregateID:   message.AggregateID,
+		EventType:     message.EventType,
+		OccurredAt:    message.OccurredAt,
+		Payload:       message.Payload,
+	})
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	f, err := os.OpenFile(s.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return err
+	}
+
+	if _, err := f.Write(append(line, '\n')); err != nil {
+		return errors.Join(err, f.Close())
+	}
+
+	// Sync before reporting success: the relay marks the message published right after.
+	if err := f.Sync(); err != nil {
+		return errors.Join(err, f.Close())
+	}
+
+	return f.Close()
+}
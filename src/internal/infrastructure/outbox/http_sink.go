@@ -0,0 +1,44 @@
+package outbox
+
+import (
+	"context"
+	"strconv"
+
+	"PrService/src/internal/application/contracts"
+
+	"PrService/src/internal/domain"
+)
+
+const (
+	eventIDHeader       = "X-PRService-Event-Id"
+	eventTypeHeader     = "X-PRService-Event"
+	aggregateTypeHeader = "X-PRService-Aggregate-Type"
+	aggregateIDHeader   = "X-PRService-Aggregate-Id"
+)
+
+// HTTPSink POSTs every relayed event to a single endpoint. Receivers deduplicate by X-PRService-Event-Id.
+type HTTPSink struct {
+	url    string
+	sender contracts.WebhookSender
+}
+
+func NewHTTPSink(url string, sender contracts.WebhookSender) *HTTPSink {
+	return &HTTPSink{url: url, sender: sender}
+}
+
+func (s *HTTPSink) Name() string {
+	return "http"
+}
+
+func (s *HTTPSink) Publish(ctx context.Context, message domain.OutboxMessage) error {
+	headers := map[string]string{
+		eventIDHeader:       strconv.FormatInt(message.ID, 10),
+		eventTypeHeader:     string(message.EventType),
+		aggregateTypeHeader: string(message.AggregateType),
+		aggregateIDHeader:   message.AggregateID,
+	}
+
+	_, err := s.sender.Send(ctx, s.url, message.Payload, headers)
+
+	return err
+}
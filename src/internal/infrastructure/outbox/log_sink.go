@@ -0,0 +1,34 @@
+package outbox
+
+import (
+	"context"
+	"log/slog"
+
+	"PrService/src/internal/domain"
+)
+
+// LogSink writes every relayed event to the service log.
+type LogSink struct {
+	logger *slog.Logger
+}
+
+func NewLogSink(logger *slog.Logger) *LogSink {
+	return &LogSink{logger: logger}
+}
+
+func (s *LogSink) Name() string {
+	return "log"
+}
+
+func (s *LogSink) Publish(ctx context.Context, message domain.OutboxMessage) error {
+	s.logger.InfoContext(ctx, "domain event",
+		"event_id", message.ID,
+		"event_type", message.EventType,
+		"aggregate_type", message.AggregateType,
+		"aggregate_id", message.AggregateID,
+		"occurred_at", message.OccurredAt,
+		"payload", string(message.Payload),
+	)
+
+	return nil
+}
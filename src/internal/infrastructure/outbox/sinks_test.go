@@ -0,0 +1,107 @@
+package outbox
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"PrService/src/internal/domain"
+	"PrService/src/internal/infrastructure/webhooks"
+)
+
+func testOutboxMessage(id int64) domain.OutboxMessage {
+	return domain.OutboxMessage{
+		ID:            id,
+		AggregateType: domain.AggregatePullRequest,
+		AggregateID:   "org/repo#1",
+		EventType:     domain.EventPullRequestMerged,
+		Payload:       []byte(`{"type":"PR_MERGED"}`),
+		OccurredAt:    time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC),
+	}
+}
+
+func TestFileSink_Publish_AppendsJSONLines(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "events.ndjson")
+	sink := NewFileSink(path)
+
+	for _, id := range []int64{1, 2} {
+		if err := sink.Publish(context.Background(), testOutboxMessage(id)); err != nil {
+			t.Fatalf("Publish failed: %v", err)
+		}
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatalf("open events file: %v", err)
+	}
+	defer f.Close()
+
+	var records []fileRecord
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var record fileRecord
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			t.Fatalf("line is not JSON: %v", err)
+		}
+		records = append(records, record)
+	}
+
+	if len(records) != 2 || records[0].ID != 1 || records[1].ID != 2 {
+		t.Fatalf("expected two records in order, got %+v", records)
+	}
+	if records[0].AggregateID != "org/repo#1" || string(records[0].Payload) != `{"type":"PR_MERGED"}` {
+		t.Fatalf("unexpected record: %+v", records[0])
+	}
+}
+
+func TestFileSink_Publish_UnwritablePath(t *testing.T) {
+	sink := NewFileSink(filepath.Join(t.TempDir(), "missing", "events.ndjson"))
+
+	if err := sink.Publish(context.Background(), testOutboxMessage(1)); err == nil {
+		t.Fatal("expected error for missing directory")
+	}
+}
+
+func TestHTTPSink_Publish_PostsPayloadWithEventHeaders(t *testing.T) {
+	var (
+		gotBody    []byte
+		gotHeaders http.Header
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotHeaders = r.Header.Clone()
+		gotBody, _ = io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	sink := NewHTTPSink(server.URL, webhooks.NewHTTPSender(time.Second))
+	if err := sink.Publish(context.Background(), testOutboxMessage(9)); err != nil {
+		t.Fatalf("Publish failed: %v", err)
+	}
+
+	if string(gotBody) != `{"type":"PR_MERGED"}` {
+		t.Fatalf("unexpected body %s", gotBody)
+	}
+	if gotHeaders.Get(eventIDHeader) != "9" || gotHeaders.Get(aggregateIDHeader) != "org/repo#1" {
+		t.Fatalf("unexpected headers: %v", gotHeaders)
+	}
+}
+
+func TestHTTPSink_Publish_FailureIsReturned(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	sink := NewHTTPSink(server.URL, webhooks.NewHTTPSender(time.Second))
+	if err := sink.Publish(context.Background(), testOutboxMessage(9)); err == nil {
+		t.Fatal("expected error for 500 response")
+	}
+}
@@ -0,0 +1,80 @@
+package webhooks
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestHTTPSender_Send_PostsBodyWithHeaders(t *testing.T) {
+	var (
+		gotMethod  string
+		gotBody    []byte
+		gotHeaders http.Header
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotMethod = r.Method
+		gotHeaders = r.Header.Clone()
+		gotBody, _ = io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	sender := NewHTTPSender(time.Second)
+	status, err := sender.Send(context.Background(), server.URL, []byte(`{"type":"PR_MERGED"}`), map[string]string{
+		"X-PRService-Event": "PR_MERGED",
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if status != http.StatusAccepted {
+		t.Fatalf("expected status %d, got %d", http.StatusAccepted, status)
+	}
+	if gotMethod != http.MethodPost {
+		t.Fatalf("expected POST, got %s", gotMethod)
+	}
+	if string(gotBody) != `{"type":"PR_MERGED"}` {
+		t.Fatalf("unexpected body %s", gotBody)
+	}
+	if got := gotHeaders.Get("Content-Type"); got != "application/json" {
+		t.Fatalf("expected JSON content type, got %q", got)
+	}
+	if got := gotHeaders.Get("X-PRService-Event"); got != "PR_MERGED" {
+		t.Fatalf("expected event header PR_MERGED, got %q", got)
+	}
+}
+
+func TestHTTPSender_Send_NonSuccessStatusIsError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	status, err := NewHTTPSender(time.Second).Send(context.Background(), server.URL, []byte(`{}`), nil)
+
+	if err == nil {
+		t.Fatal("expected error for 503 response")
+	}
+	if status != http.StatusServiceUnavailable {
+		t.Fatalf("expected status %d, got %d", http.StatusServiceUnavailable, status)
+	}
+}
+
+func TestHTTPSender_Send_UnreachableIsError(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	url := server.URL
+	server.Close()
+
+	status, err := NewHTTPSender(time.Second).Send(context.Background(), url, []byte(`{}`), nil)
+
+	if err == nil {
+		t.Fatal("expected error for unreachable subscriber")
+	}
+	if status != 0 {
+		t.Fatalf("expected no status, got %d", status)
+	}
+}